@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/config"
+	"github.com/ikkim/udonggeum-backend/internal/db"
+	"github.com/ikkim/udonggeum-backend/internal/migrate"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+)
+
+// migrationsDir create로 새 파일을 만들 위치 (저장소 루트에서 실행)
+const migrationsDir = "db/migrations"
+
+const usage = `Usage: go run ./cmd/migrate <command>
+
+Commands:
+  up            적용되지 않은 마이그레이션을 모두 적용
+  down [N]      마지막으로 적용된 마이그레이션 N개를 되돌림 (기본 1)
+  status        마이그레이션별 적용 상태 출력
+  create NAME   db/migrations에 빈 up/down 파일 생성 (NAME: 소문자, 숫자, _)`
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatal(usage)
+	}
+	command, args := os.Args[1], os.Args[2:]
+
+	// 파일 생성은 DB 연결이 필요 없음
+	if command == "create" {
+		if len(args) != 1 {
+			log.Fatal(usage)
+		}
+		paths, err := migrate.Create(migrationsDir, args[0], time.Now())
+		if err != nil {
+			log.Fatal("Failed to create migration: ", err)
+		}
+		for _, path := range paths {
+			fmt.Println("Created", path)
+		}
+		return
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("Failed to load config: ", err)
+	}
+	logger.Initialize(logger.Config{
+		Level:       "info",
+		Format:      "console",
+		EnableColor: true,
+	})
+
+	if err := db.Initialize(&cfg.Database); err != nil {
+		log.Fatal("Failed to connect to database: ", err)
+	}
+	defer db.Close()
+
+	migrator, err := db.NewMigrator()
+	if err != nil {
+		log.Fatal("Failed to load migrations: ", err)
+	}
+	ctx := context.Background()
+
+	switch command {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		printMigrations("Applied", applied)
+		if err != nil {
+			log.Fatal("Migration failed: ", err)
+		}
+
+	case "down":
+		steps := 1
+		if len(args) > 0 {
+			if steps, err = strconv.Atoi(args[0]); err != nil || steps < 1 {
+				log.Fatal("Invalid number of migrations to roll back: ", args[0])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		printMigrations("Rolled back", reverted)
+		if err != nil {
+			log.Fatal("Rollback failed: ", err)
+		}
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Fatal("Failed to read migration status: ", err)
+		}
+		fmt.Printf("%-16s %-10s %-20s %s\n", "VERSION", "STATE", "APPLIED AT", "NAME")
+		for _, status := range statuses {
+			appliedAt := "-"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%-16d %-10s %-20s %s\n", status.Version, status.State, appliedAt, status.Name)
+		}
+
+	default:
+		log.Fatal(usage)
+	}
+}
+
+func printMigrations(action string, migrations []migrate.Migration) {
+	if len(migrations) == 0 {
+		fmt.Printf("%s: none\n", action)
+		return
+	}
+	for _, m := range migrations {
+		fmt.Printf("%s: %d_%s\n", action, m.Version, m.Name)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -59,7 +60,7 @@ func main() {
 	// 배치로 저장
 	batchSize := 1000
 	fmt.Printf("Starting bulk import with batch size: %d\n", batchSize)
-	if err := storeRepo.BulkCreate(stores, batchSize); err != nil {
+	if err := storeRepo.BulkCreate(context.Background(), stores, batchSize); err != nil {
 		log.Fatal("Failed to bulk create stores:", err)
 	}
 
@@ -1,25 +1,37 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/ikkim/udonggeum-backend/config"
 	"github.com/ikkim/udonggeum-backend/internal/app/controller"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
 	"github.com/ikkim/udonggeum-backend/internal/app/service"
 	"github.com/ikkim/udonggeum-backend/internal/db"
+	"github.com/ikkim/udonggeum-backend/internal/health"
+	"github.com/ikkim/udonggeum-backend/internal/metrics"
 	"github.com/ikkim/udonggeum-backend/internal/middleware"
 	"github.com/ikkim/udonggeum-backend/internal/router"
 	"github.com/ikkim/udonggeum-backend/internal/scheduler"
 	"github.com/ikkim/udonggeum-backend/internal/storage"
 	"github.com/ikkim/udonggeum-backend/internal/websocket"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/payment/kakaopay"
 	redisClient "github.com/ikkim/udonggeum-backend/pkg/redis"
+	"github.com/ikkim/udonggeum-backend/pkg/util"
 )
 
+// healthCheckTimeout 헬스 체크 점검 하나의 제한 시간
+const healthCheckTimeout = 2 * time.Second
+
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
@@ -60,8 +72,9 @@ func main() {
 		}
 	}()
 
-	if err := db.Migrate(); err != nil {
-		logger.Fatal("Failed to run migrations", err)
+	// 스키마는 cmd/migrate로만 변경 (적용되지 않은 마이그레이션이 있으면 시작하지 않음)
+	if err := db.CheckMigrations(context.Background()); err != nil {
+		logger.Fatal("Database schema is not up to date", err)
 	}
 
 	if err := db.Seed(); err != nil {
@@ -73,70 +86,167 @@ func main() {
 	dbConn := db.GetDB()
 
 	userRepo := repository.NewUserRepository(dbConn)
+	userSessionRepo := repository.NewUserSessionRepository(dbConn)
+	identityRepo := repository.NewExternalIdentityRepository(dbConn)
 	storeRepo := repository.NewStoreRepository(dbConn)
 	passwordResetRepo := repository.NewPasswordResetRepository(dbConn)
 	goldPriceRepo := repository.NewGoldPriceRepository(dbConn)
+	goldPriceRunRepo := repository.NewGoldPriceUpdateRunRepository(dbConn)
 	communityRepo := repository.NewCommunityRepository(dbConn)
 	reviewRepo := repository.NewReviewRepository(dbConn)
 	chatRepo := repository.NewChatRepository(dbConn)
 	notificationRepo := repository.NewNotificationRepository(dbConn)
 	faqRepo := repository.NewFAQRepository(dbConn)
+	paymentRepo := repository.NewPaymentRepository(dbConn)
+	escrowRepo := repository.NewEscrowRepository(dbConn)
+	priceAlertRepo := repository.NewPriceAlertRepository(dbConn)
+	processedImageRepo := repository.NewProcessedImageRepository(dbConn)
+	uploadRepo := repository.NewUploadRepository(dbConn)
+	accountRepo := repository.NewAccountRepository(dbConn)
+
+	tokenManager, err := newTokenManager(&cfg.JWT)
+	if err != nil {
+		logger.Fatal("Failed to load JWT keys", err)
+	}
+	tokenManager.AcceptLegacyRefreshTokens(cfg.JWT.AccessTokenExpiry)
+
+	oauthProviders, err := newOAuthProviders(cfg)
+	if err != nil {
+		logger.Fatal("Failed to configure OAuth providers", err)
+	}
 
 	authService := service.NewAuthService(
 		userRepo,
-		cfg.JWT.Secret,
+		userSessionRepo,
+		identityRepo,
+		tokenManager,
 		cfg.JWT.AccessTokenExpiry,
 		cfg.JWT.RefreshTokenExpiry,
-		cfg.Kakao.ClientID,
-		cfg.Kakao.ClientSecret,
-		cfg.Kakao.RedirectURI,
-		cfg.Google.ClientID,
-		cfg.Google.ClientSecret,
-		cfg.Google.RedirectURI,
+		redisClient.NewVerificationCodeStore(util.DefaultVerificationPolicy()),
+		redisClient.NewOAuthStateStore(),
+		oauthProviders...,
 	)
 	passwordResetService := service.NewPasswordResetService(passwordResetRepo, userRepo)
+	accountService := service.NewAccountService(accountRepo, userRepo, userSessionRepo, authService, cfg.Account.DeletionGracePeriod)
 	storeService := service.NewStoreService(dbConn, storeRepo, userRepo)
 
-	goldPriceAPI := service.NewDefaultGoldPriceAPI(cfg.GoldPrice.APIURL, cfg.GoldPrice.APIKey)
-	goldPriceService := service.NewGoldPriceService(goldPriceRepo, goldPriceAPI, cfg.GoldPrice.KRXAPIURL, cfg.GoldPrice.KRXAPIKey)
+	// 금 시세 공급자 (설정 순서가 우선순위)
+	krxGoldPriceAPI := service.NewKRXGoldPriceAPI(cfg.GoldPrice.KRXAPIURL, cfg.GoldPrice.KRXAPIKey)
+	var goldPriceProviders []service.ExternalGoldPriceAPI
+	for _, name := range cfg.GoldPrice.Providers {
+		switch name {
+		case "goldapi":
+			goldPriceProviders = append(goldPriceProviders, service.NewDefaultGoldPriceAPI(cfg.GoldPrice.APIURL, cfg.GoldPrice.APIKey))
+		case "krx":
+			goldPriceProviders = append(goldPriceProviders, krxGoldPriceAPI)
+		case "csv":
+			goldPriceProviders = append(goldPriceProviders, service.NewCSVGoldPriceFeed(cfg.GoldPrice.CSVPath, cfg.GoldPrice.CSVMaxAge))
+		default:
+			logger.Fatal(fmt.Sprintf("Unknown gold price provider: %s", name), nil)
+		}
+	}
+	goldPriceAPI := service.NewGoldPriceProviderRegistry(cfg.GoldPrice.MaxDeviationPercent, goldPriceProviders...)
+	goldPriceService := service.NewGoldPriceService(goldPriceRepo, goldPriceRunRepo, goldPriceAPI, krxGoldPriceAPI)
 
 	// Initialize WebSocket hub (알림 서비스보다 먼저 생성)
-	hub := websocket.NewHub()
+	var hub *websocket.Hub
+	if cfg.WebSocket.ClusterMode {
+		hub = websocket.NewClusterHub(websocket.NewRedisBackend(redisClient.GetClient()))
+		logger.Info("WebSocket hub running in cluster mode")
+	} else {
+		hub = websocket.NewHub()
+	}
 	go hub.Run() // Hub를 별도 goroutine에서 실행
+	metrics.RegisterHub(hub.Stats)
+
+	// Initialize upload storage (S3/MinIO or local filesystem)
+	var fileStorage storage.Storage
+	switch cfg.Storage.Driver {
+	case "local":
+		localStorage, err := storage.NewLocalStorage(cfg.Storage.LocalRoot, cfg.Storage.LocalBaseURL, cfg.Storage.SigningSecret)
+		if err != nil {
+			logger.Fatal("Failed to initialize local storage", err)
+		}
+		logger.Warn("Using local file storage, not for production", map[string]interface{}{
+			"root": cfg.Storage.LocalRoot,
+		})
+		fileStorage = localStorage
+	case "s3":
+		fileStorage = storage.NewS3Storage(
+			cfg.S3.Region,
+			cfg.S3.Bucket,
+			cfg.S3.AccessKeyID,
+			cfg.S3.SecretAccessKey,
+			cfg.S3.BaseURL,
+			cfg.S3.Endpoint,
+		)
+	default:
+		logger.Fatal("Unknown storage driver", fmt.Errorf("STORAGE_DRIVER=%q", cfg.Storage.Driver))
+	}
+	uploadService := service.NewUploadService(fileStorage, uploadRepo)
+	imageService := service.NewImageService(processedImageRepo, fileStorage, uploadService)
 
 	notificationService := service.NewNotificationService(notificationRepo, hub)
-	communityService := service.NewCommunityService(communityRepo, userRepo, notificationService)
-	reviewService := service.NewReviewService(reviewRepo, storeRepo)
+	priceAlertService := service.NewPriceAlertService(priceAlertRepo, goldPriceRepo, notificationService, goldPriceService)
+	communityService := service.NewCommunityService(communityRepo, userRepo, notificationService, imageService)
+	reviewService := service.NewReviewService(reviewRepo, storeRepo, imageService)
 	tagService := service.NewTagService(dbConn)
 	aiService := service.NewAIService(cfg)
 
-	chatService := service.NewChatService(dbConn, chatRepo, hub)
+	chatService := service.NewChatService(dbConn, chatRepo, hub, uploadService)
+	hub.SetMessageReplayer(chatService)
+	hub.SetDeliveryReceiver(chatService)
 	faqService := service.NewFAQService(faqRepo)
 
-	// Initialize S3 storage
-	s3Storage := storage.NewS3Storage(
-		cfg.S3.Region,
-		cfg.S3.Bucket,
-		cfg.S3.AccessKeyID,
-		cfg.S3.SecretAccessKey,
-		cfg.S3.BaseURL,
-	)
+	// KakaoPay client (설정이 없으면 결제 API는 503 응답)
+	var kakaoPayClient *kakaopay.Client
+	if cfg.Payment.KakaoPay.AdminKey != "" {
+		kakaoPayClient, err = kakaopay.NewClient(kakaopay.Config{
+			AdminKey:    cfg.Payment.KakaoPay.AdminKey,
+			CID:         cfg.Payment.KakaoPay.CID,
+			BaseURL:     cfg.Payment.KakaoPay.BaseURL,
+			ApprovalURL: cfg.Payment.KakaoPay.ApprovalURL,
+			FailURL:     cfg.Payment.KakaoPay.FailURL,
+			CancelURL:   cfg.Payment.KakaoPay.CancelURL,
+		})
+		if err != nil {
+			logger.Fatal("Failed to initialize KakaoPay client", err)
+		}
+	} else {
+		logger.Warn("KAKAOPAY_ADMIN_KEY not set, payments are disabled", nil)
+	}
+	paymentService := service.NewPaymentService(paymentRepo, storeRepo, communityRepo, kakaoPayClient)
+	escrowService := service.NewEscrowService(escrowRepo, communityRepo, paymentService, cfg.Payment.Escrow)
 
 	authController := controller.NewAuthController(authService, passwordResetService)
-	storeController := controller.NewStoreController(storeService, authService, reviewService)
+	accountController := controller.NewAccountController(accountService)
+	storeController := controller.NewStoreController(storeService, authService, reviewService, uploadService)
 	goldPriceController := controller.NewGoldPriceController(goldPriceService)
-	communityController := controller.NewCommunityController(communityService, aiService)
-	reviewController := controller.NewReviewController(reviewService)
-	uploadController := controller.NewUploadController(s3Storage)
+	communityController := controller.NewCommunityController(communityService, aiService, uploadService)
+	reviewController := controller.NewReviewController(reviewService, uploadService)
+	uploadController := controller.NewUploadController(fileStorage, uploadService)
 	tagController := controller.NewTagController(tagService)
-	chatController := controller.NewChatController(chatService, hub)
+	chatController := controller.NewChatController(chatService, uploadService, hub)
 	notificationController := controller.NewNotificationController(notificationService)
 	faqController := controller.NewFAQController(faqService)
+	paymentController := controller.NewPaymentController(paymentService)
+	escrowController := controller.NewEscrowController(escrowService)
+	priceAlertController := controller.NewPriceAlertController(priceAlertService)
 
-	authMiddleware := middleware.NewAuthMiddleware(cfg.JWT.Secret)
+	authMiddleware := middleware.NewAuthMiddleware(tokenManager, authService)
+
+	// 헬스 체크: liveness는 Hub 실행 루프, readiness는 DB/Redis/Hub(필수)와 스케줄러(선택)
+	liveness := health.NewChecker(healthCheckTimeout)
+	liveness.Add("websocket_hub", true, hub.Ping)
+	readiness := health.NewChecker(healthCheckTimeout)
+	readiness.Add("database", true, db.Ping)
+	readiness.Add("redis", true, redisClient.Ping)
+	readiness.Add("websocket_hub", true, hub.Ping)
+	healthController := controller.NewHealthController(liveness, readiness)
 
 	r := router.NewRouter(
 		authController,
+		accountController,
 		storeController,
 		goldPriceController,
 		communityController,
@@ -146,25 +256,57 @@ func main() {
 		chatController,
 		notificationController,
 		faqController,
+		paymentController,
+		escrowController,
+		priceAlertController,
+		healthController,
 		authMiddleware,
 		cfg,
 	)
 	engine := r.Setup()
 
 	// 금 시세 자동 업데이트 스케줄러 시작
-	goldPriceScheduler := scheduler.NewGoldPriceScheduler(goldPriceService)
+	goldPriceScheduler := scheduler.NewGoldPriceScheduler(goldPriceService, cfg.GoldPrice.Schedule)
 	if err := goldPriceScheduler.Start(); err != nil {
 		logger.Fatal("Failed to start gold price scheduler", err)
 	}
-	defer goldPriceScheduler.Stop()
+	readiness.Add("gold_price_scheduler", false, goldPriceScheduler.Check)
+
+	// 업로드 이미지 처리 작업자 시작
+	imageService.Start()
+
+	// 사용되지 않는 업로드 정리 스케줄러 시작
+	uploadSweepScheduler := scheduler.NewUploadSweepScheduler(uploadService, cfg.Storage)
+	if err := uploadSweepScheduler.Start(); err != nil {
+		logger.Fatal("Failed to start upload sweep scheduler", err)
+	}
+	readiness.Add("upload_sweep_scheduler", false, uploadSweepScheduler.Check)
+
+	// 안전거래 기한 만료 처리 스케줄러 시작
+	escrowScheduler := scheduler.NewEscrowScheduler(escrowService)
+	if err := escrowScheduler.Start(); err != nil {
+		logger.Fatal("Failed to start escrow scheduler", err)
+	}
+	readiness.Add("escrow_scheduler", false, escrowScheduler.Check)
+
+	// 탈퇴 유예 기간이 지난 계정 익명화 스케줄러 시작
+	accountDeletionScheduler := scheduler.NewAccountDeletionScheduler(accountService)
+	if err := accountDeletionScheduler.Start(); err != nil {
+		logger.Fatal("Failed to start account deletion scheduler", err)
+	}
+	readiness.Add("account_deletion_scheduler", false, accountDeletionScheduler.Check)
+
+	srv := &http.Server{
+		Addr:    fmt.Sprintf(":%s", cfg.Server.Port),
+		Handler: engine,
+	}
 
 	go func() {
-		addr := fmt.Sprintf(":%s", cfg.Server.Port)
 		logger.Info("Server started successfully", map[string]interface{}{
-			"address": addr,
+			"address": srv.Addr,
 			"pid":     os.Getpid(),
 		})
-		if err := engine.Run(addr); err != nil {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			logger.Fatal("Failed to start server", err)
 		}
 	}()
@@ -173,6 +315,129 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	logger.Info("Shutting down server gracefully...")
+	logger.Info("Shutting down server gracefully...", map[string]interface{}{
+		"timeout": cfg.Server.ShutdownTimeout.String(),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+
+	// 1. 새 요청을 받지 않고 진행 중인 HTTP 요청 완료 대기
+	// (WebSocket처럼 hijack된 연결은 http.Server가 관리하지 않으므로 Hub에서 종료)
+	if err := srv.Shutdown(ctx); err != nil {
+		logger.Error("Failed to drain HTTP requests", err)
+	}
+
+	// 2. 실행 중인 스케줄러 작업 완료 대기 (작업 중 보내는 알림이 Hub를 거치므로 Hub보다 먼저)
+	for name, stop := range map[string]func(context.Context) error{
+		"gold_price":       goldPriceScheduler.Stop,
+		"upload_sweep":     uploadSweepScheduler.Stop,
+		"escrow":           escrowScheduler.Stop,
+		"account_deletion": accountDeletionScheduler.Stop,
+	} {
+		if err := stop(ctx); err != nil {
+			logger.Error("Failed to stop scheduler", err, map[string]interface{}{
+				"scheduler": name,
+			})
+		}
+	}
+	imageService.Stop()
+
+	// 3. WebSocket 클라이언트에 close frame 전송
+	if err := hub.Shutdown(ctx); err != nil {
+		logger.Error("Failed to close WebSocket connections", err)
+	}
+
 	logger.Info("Server stopped successfully")
 }
+
+// newTokenManager 현재 서명 키(JWT_SECRET 또는 개인키 파일)와 교체 전 검증 키로 키링 구성
+func newTokenManager(cfg *config.JWTConfig) (*util.TokenManager, error) {
+	active := util.NewHMACKey(cfg.KeyID, cfg.Secret)
+	if cfg.PrivateKeyFile != "" {
+		data, err := os.ReadFile(cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if active, err = util.ParsePrivateKeyPEM(cfg.KeyID, data); err != nil {
+			return nil, err
+		}
+	}
+
+	var previous []util.SigningKey
+	for _, entry := range cfg.PreviousSecrets {
+		keyID, secret, ok := strings.Cut(entry, ":")
+		if !ok || keyID == "" || secret == "" {
+			return nil, errors.New("invalid JWT_PREVIOUS_SECRETS entry, expected kid:secret")
+		}
+		previous = append(previous, util.NewHMACKey(keyID, secret))
+	}
+	for _, entry := range cfg.PreviousPublicKeyFiles {
+		keyID, path, ok := strings.Cut(entry, ":")
+		if !ok || keyID == "" || path == "" {
+			return nil, fmt.Errorf("invalid JWT_PREVIOUS_PUBLIC_KEY_FILES entry %q, expected kid:path", entry)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := util.ParsePublicKeyPEM(keyID, data)
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, key)
+	}
+
+	keyring, err := util.NewKeyring(active, previous...)
+	if err != nil {
+		return nil, err
+	}
+	return util.NewTokenManager(keyring, cfg.Issuer, cfg.Audience), nil
+}
+
+// newOAuthProviders 소셜 로그인 제공자 (애플/네이버는 설정된 경우에만 사용)
+func newOAuthProviders(cfg *config.Config) ([]service.OAuthProvider, error) {
+	providers := []service.OAuthProvider{
+		service.NewKakaoOAuthProvider(service.OAuthClientConfig{
+			ClientID:     cfg.Kakao.ClientID,
+			ClientSecret: cfg.Kakao.ClientSecret,
+			RedirectURI:  cfg.Kakao.RedirectURI,
+		}),
+		service.NewGoogleOAuthProvider(service.OAuthClientConfig{
+			ClientID:     cfg.Google.ClientID,
+			ClientSecret: cfg.Google.ClientSecret,
+			RedirectURI:  cfg.Google.RedirectURI,
+		}),
+	}
+
+	if cfg.Apple.ClientID != "" {
+		key, err := os.ReadFile(cfg.Apple.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read APPLE_PRIVATE_KEY_FILE: %w", err)
+		}
+		apple, err := service.NewAppleOAuthProvider(service.AppleOAuthConfig{
+			ClientID:      cfg.Apple.ClientID,
+			TeamID:        cfg.Apple.TeamID,
+			KeyID:         cfg.Apple.KeyID,
+			PrivateKeyPEM: key,
+			RedirectURI:   cfg.Apple.RedirectURI,
+		})
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, apple)
+	} else {
+		logger.Info("Apple login disabled (APPLE_CLIENT_ID not set)", nil)
+	}
+
+	if cfg.Naver.ClientID != "" {
+		providers = append(providers, service.NewNaverOAuthProvider(service.OAuthClientConfig{
+			ClientID:     cfg.Naver.ClientID,
+			ClientSecret: cfg.Naver.ClientSecret,
+			RedirectURI:  cfg.Naver.RedirectURI,
+		}))
+	} else {
+		logger.Info("Naver login disabled (NAVER_CLIENT_ID not set)", nil)
+	}
+
+	return providers, nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -13,20 +15,27 @@ type Config struct {
 	Server    ServerConfig
 	Database  DatabaseConfig
 	Redis     RedisConfig
+	WebSocket WebSocketConfig
 	JWT       JWTConfig
+	Account   AccountConfig
 	CORS      CORSConfig
 	Payment   PaymentConfig
 	S3        S3Config
+	Storage   StorageConfig
 	GoldPrice GoldPriceConfig
 	Kakao     KakaoConfig
 	Google    GoogleConfig
+	Apple     AppleConfig
+	Naver     NaverConfig
 	OpenAI    OpenAIConfig
+	Metrics   MetricsConfig
 }
 
 type ServerConfig struct {
-	Port        string
-	GinMode     string
-	Environment string
+	Port            string
+	GinMode         string
+	Environment     string
+	ShutdownTimeout time.Duration // 종료 신호 후 요청/WebSocket/스케줄러 작업을 마무리하는 최대 시간
 }
 
 type DatabaseConfig struct {
@@ -45,10 +54,27 @@ type RedisConfig struct {
 	DB       int
 }
 
+// WebSocketConfig 채팅/알림 WebSocket 설정
+type WebSocketConfig struct {
+	// ClusterMode 여러 서버 인스턴스 간 메시지와 접속 상태를 Redis로 공유 (로컬 개발은 false)
+	ClusterMode bool
+}
+
 type JWTConfig struct {
-	Secret             string
-	AccessTokenExpiry  time.Duration
-	RefreshTokenExpiry time.Duration
+	Secret                 string
+	KeyID                  string   // 현재 서명 키 ID (토큰 헤더의 kid)
+	PrivateKeyFile         string   // RS256/EdDSA 개인키 PEM 경로 (설정하면 Secret 대신 사용)
+	PreviousSecrets        []string // 교체 전 HS256 키 "kid:secret" (검증 전용)
+	PreviousPublicKeyFiles []string // 교체 전 RS256/EdDSA 공개키 "kid:path" (검증 전용)
+	Issuer                 string
+	Audience               string
+	AccessTokenExpiry      time.Duration
+	RefreshTokenExpiry     time.Duration
+}
+
+// AccountConfig 회원 탈퇴 설정
+type AccountConfig struct {
+	DeletionGracePeriod time.Duration // 탈퇴 요청 후 익명화까지 유예 기간 (이 기간에 다시 로그인하면 취소)
 }
 
 type CORSConfig struct {
@@ -57,6 +83,7 @@ type CORSConfig struct {
 
 type PaymentConfig struct {
 	KakaoPay KakaoPayConfig
+	Escrow   EscrowConfig
 }
 
 type KakaoPayConfig struct {
@@ -68,12 +95,33 @@ type KakaoPayConfig struct {
 	CancelURL   string
 }
 
+// EscrowConfig 안전거래 단계별 기한
+type EscrowConfig struct {
+	PaymentTimeout  time.Duration // 결제 대기 기한 (초과 시 취소)
+	HandoverTimeout time.Duration // 판매자 인계 기한 (초과 시 환불)
+	ReceiptTimeout  time.Duration // 구매자 수령 확인 기한 (초과 시 대금 지급)
+}
+
 type S3Config struct {
 	Region          string
 	Bucket          string
 	AccessKeyID     string
 	SecretAccessKey string
 	BaseURL         string // CloudFront or S3 direct URL
+	Endpoint        string // S3 호환 저장소 엔드포인트 (MinIO 등, 비우면 AWS)
+}
+
+// StorageConfig 업로드 파일 저장소 설정
+type StorageConfig struct {
+	Driver        string // s3 또는 local
+	LocalRoot     string // local: 파일 저장 디렉터리
+	LocalBaseURL  string // local: 클라이언트가 접근하는 서버 주소
+	SigningSecret string // local: presigned URL 서명 키
+
+	// 사용되지 않는 업로드 정리
+	OrphanSweepSchedule string        // cron 표현식 (KST, off면 정리하지 않음)
+	OrphanGracePeriod   time.Duration // 발급 후 이 기간이 지난 업로드만 정리 (작성 중인 글의 첨부 보호)
+	OrphanSweepDryRun   bool          // 삭제하지 않고 대상 목록만 기록
 }
 
 type GoldPriceConfig struct {
@@ -81,6 +129,23 @@ type GoldPriceConfig struct {
 	APIKey    string
 	KRXAPIURL string // KRX 금시세 API URL
 	KRXAPIKey string // KRX 금시세 API Key
+	Schedule  GoldPriceScheduleConfig
+
+	// 시세 공급자 (우선순위 순서: goldapi, krx, csv)
+	Providers           []string
+	CSVPath             string        // 수동 CSV 시세 파일 경로
+	CSVMaxAge           time.Duration // 이보다 오래된 CSV 파일은 사용하지 않음
+	MaxDeviationPercent float64       // 공급자 간 허용 시세 차이 (%)
+}
+
+// GoldPriceScheduleConfig 금 시세 자동 수집 스케줄 (KST 기준)
+type GoldPriceScheduleConfig struct {
+	Specs        []string      // cron 표현식 목록 (여러 개면 ';'로 구분)
+	MarketOpen   string        // 장 시작 시각 (HH:MM)
+	MarketClose  string        // 장 마감 시각 (HH:MM)
+	Holidays     []string      // KRX 휴장일 (YYYY-MM-DD, 주말은 자동 제외)
+	MaxAttempts  int           // 외부 API 조회 최대 시도 횟수
+	RetryBackoff time.Duration // 첫 재시도 대기 시간 (이후 2배씩 증가)
 }
 
 type KakaoConfig struct {
@@ -95,11 +160,34 @@ type GoogleConfig struct {
 	RedirectURI  string
 }
 
+// AppleConfig Sign in with Apple (client secret은 개인키로 서명한 JWT)
+type AppleConfig struct {
+	ClientID       string // Services ID (웹) 또는 번들 ID (iOS 앱)
+	TeamID         string
+	KeyID          string
+	PrivateKeyFile string // .p8 개인키 경로
+	RedirectURI    string
+}
+
+type NaverConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+}
+
 type OpenAIConfig struct {
 	APIKey string
 	Model  string
 }
 
+// MetricsConfig Prometheus /metrics 엔드포인트 설정
+// 허용 IP(직접 연결한 주소 기준)에서 오거나 Bearer 토큰이 일치해야 접근 가능하며, 둘 다 비어 있으면 모두 거부
+type MetricsConfig struct {
+	Enabled    bool
+	Token      string   // Authorization: Bearer <token>
+	AllowedIPs []string // IP 또는 CIDR (예: 10.0.0.0/8)
+}
+
 func Load() (*Config, error) {
 	// Load .env file if it exists
 	if err := godotenv.Load(); err != nil {
@@ -108,9 +196,10 @@ func Load() (*Config, error) {
 
 	config := &Config{
 		Server: ServerConfig{
-			Port:        getEnv("SERVER_PORT", "8080"),
-			GinMode:     getEnv("GIN_MODE", "debug"),
-			Environment: getEnv("ENVIRONMENT", "development"),
+			Port:            getEnv("SERVER_PORT", "8080"),
+			GinMode:         getEnv("GIN_MODE", "debug"),
+			Environment:     getEnv("ENVIRONMENT", "development"),
+			ShutdownTimeout: parseDuration(getEnv("SERVER_SHUTDOWN_TIMEOUT", "30s")),
 		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
@@ -126,10 +215,22 @@ func Load() (*Config, error) {
 			Password: getEnv("REDIS_PASSWORD", ""),
 			DB:       parseInt(getEnv("REDIS_DB", "0")),
 		},
+		WebSocket: WebSocketConfig{
+			ClusterMode: parseBool(getEnv("WS_CLUSTER_MODE", "false")),
+		},
 		JWT: JWTConfig{
-			Secret:             getEnv("JWT_SECRET", "your-secret-key"),
-			AccessTokenExpiry:  parseDuration(getEnv("JWT_ACCESS_TOKEN_EXPIRY", "15m")),
-			RefreshTokenExpiry: parseDuration(getEnv("JWT_REFRESH_TOKEN_EXPIRY", "168h")),
+			Secret:                 getEnv("JWT_SECRET", "your-secret-key"),
+			KeyID:                  getEnv("JWT_KEY_ID", "default"),
+			PrivateKeyFile:         getEnv("JWT_PRIVATE_KEY_FILE", ""),
+			PreviousSecrets:        parseSliceSep(getEnv("JWT_PREVIOUS_SECRETS", ""), ","),
+			PreviousPublicKeyFiles: parseSliceSep(getEnv("JWT_PREVIOUS_PUBLIC_KEY_FILES", ""), ","),
+			Issuer:                 getEnv("JWT_ISSUER", "udonggeum-backend"),
+			Audience:               getEnv("JWT_AUDIENCE", "udonggeum-api"),
+			AccessTokenExpiry:      parseDuration(getEnv("JWT_ACCESS_TOKEN_EXPIRY", "15m")),
+			RefreshTokenExpiry:     parseDuration(getEnv("JWT_REFRESH_TOKEN_EXPIRY", "168h")),
+		},
+		Account: AccountConfig{
+			DeletionGracePeriod: parseDuration(getEnv("ACCOUNT_DELETION_GRACE_PERIOD", "336h")),
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: parseSlice(getEnv("ALLOWED_ORIGINS", "http://localhost:3000")),
@@ -143,6 +244,11 @@ func Load() (*Config, error) {
 				FailURL:     getEnv("KAKAOPAY_FAIL_URL", "http://localhost:8080/api/v1/payments/kakao/fail"),
 				CancelURL:   getEnv("KAKAOPAY_CANCEL_URL", "http://localhost:8080/api/v1/payments/kakao/cancel"),
 			},
+			Escrow: EscrowConfig{
+				PaymentTimeout:  parseDuration(getEnv("ESCROW_PAYMENT_TIMEOUT", "30m")),
+				HandoverTimeout: parseDuration(getEnv("ESCROW_HANDOVER_TIMEOUT", "72h")),
+				ReceiptTimeout:  parseDuration(getEnv("ESCROW_RECEIPT_TIMEOUT", "72h")),
+			},
 		},
 		S3: S3Config{
 			Region:          getEnv("AWS_REGION", "ap-northeast-2"),
@@ -150,12 +256,36 @@ func Load() (*Config, error) {
 			AccessKeyID:     getEnv("AWS_ACCESS_KEY_ID", ""),
 			SecretAccessKey: getEnv("AWS_SECRET_ACCESS_KEY", ""),
 			BaseURL:         getEnv("AWS_S3_BASE_URL", ""),
+			Endpoint:        getEnv("AWS_S3_ENDPOINT", ""),
+		},
+		Storage: StorageConfig{
+			Driver:        getEnv("STORAGE_DRIVER", "s3"),
+			LocalRoot:     getEnv("STORAGE_LOCAL_ROOT", "./data/uploads"),
+			LocalBaseURL:  getEnv("STORAGE_LOCAL_BASE_URL", "http://localhost:8080"),
+			SigningSecret: getEnv("STORAGE_SIGNING_SECRET", ""),
+
+			OrphanSweepSchedule: getEnv("STORAGE_ORPHAN_SWEEP_SCHEDULE", "0 4 * * *"),
+			// 잘못된 값이 파일 삭제 쪽으로 해석되지 않도록 안전한 기본값으로 대체
+			OrphanGracePeriod: parseDurationOr(getEnv("STORAGE_ORPHAN_GRACE_PERIOD", "72h"), 72*time.Hour),
+			OrphanSweepDryRun: parseBoolOr(getEnv("STORAGE_ORPHAN_SWEEP_DRY_RUN", "true"), true),
 		},
 		GoldPrice: GoldPriceConfig{
 			APIURL:    getEnv("GOLD_PRICE_API_URL", ""),
 			APIKey:    getEnv("GOLD_PRICE_API_KEY", ""),
 			KRXAPIURL: getEnv("KRX_GOLD_PRICE_API_URL", "https://apis.data.go.kr/1160100/service/GetGeneralProductInfoService/getGoldPriceInfo"),
 			KRXAPIKey: getEnv("KRX_GOLD_PRICE_API_KEY", ""),
+			Schedule: GoldPriceScheduleConfig{
+				Specs:        parseSliceSep(getEnv("GOLD_PRICE_SCHEDULES", "*/30 9-15 * * 1-5"), ";"),
+				MarketOpen:   getEnv("GOLD_PRICE_MARKET_OPEN", "09:00"),
+				MarketClose:  getEnv("GOLD_PRICE_MARKET_CLOSE", "15:30"),
+				Holidays:     parseSliceSep(getEnv("KRX_HOLIDAYS", ""), ","),
+				MaxAttempts:  parseInt(getEnv("GOLD_PRICE_MAX_ATTEMPTS", "3")),
+				RetryBackoff: parseDuration(getEnv("GOLD_PRICE_RETRY_BACKOFF", "30s")),
+			},
+			Providers:           parseSliceSep(getEnv("GOLD_PRICE_PROVIDERS", "goldapi,krx"), ","),
+			CSVPath:             getEnv("GOLD_PRICE_CSV_PATH", ""),
+			CSVMaxAge:           parseDuration(getEnv("GOLD_PRICE_CSV_MAX_AGE", "24h")),
+			MaxDeviationPercent: parseFloat(getEnv("GOLD_PRICE_MAX_DEVIATION_PERCENT", "2")),
 		},
 		Kakao: KakaoConfig{
 			ClientID:     getEnv("KAKAO_CLIENT_ID", ""),
@@ -167,10 +297,27 @@ func Load() (*Config, error) {
 			ClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
 			RedirectURI:  getEnv("GOOGLE_REDIRECT_URI", "http://localhost:3000/oauth/callback/google"),
 		},
+		Apple: AppleConfig{
+			ClientID:       getEnv("APPLE_CLIENT_ID", ""),
+			TeamID:         getEnv("APPLE_TEAM_ID", ""),
+			KeyID:          getEnv("APPLE_KEY_ID", ""),
+			PrivateKeyFile: getEnv("APPLE_PRIVATE_KEY_FILE", ""),
+			RedirectURI:    getEnv("APPLE_REDIRECT_URI", "http://localhost:8080/api/v1/auth/apple/callback"),
+		},
+		Naver: NaverConfig{
+			ClientID:     getEnv("NAVER_CLIENT_ID", ""),
+			ClientSecret: getEnv("NAVER_CLIENT_SECRET", ""),
+			RedirectURI:  getEnv("NAVER_REDIRECT_URI", "http://localhost:3000/oauth/callback/naver"),
+		},
 		OpenAI: OpenAIConfig{
 			APIKey: getEnv("OPENAI_API_KEY", ""),
 			Model:  getEnv("OPENAI_MODEL", "gpt-4o-mini"),
 		},
+		Metrics: MetricsConfig{
+			Enabled:    parseBool(getEnv("METRICS_ENABLED", "true")),
+			Token:      getEnv("METRICS_TOKEN", ""),
+			AllowedIPs: parseSliceSep(getEnv("METRICS_ALLOWED_IPS", "127.0.0.1,::1"), ","),
+		},
 	}
 
 	return config, nil
@@ -199,6 +346,16 @@ func parseDuration(s string) time.Duration {
 	return duration
 }
 
+// parseDurationOr 잘못된 값이거나 0 이하이면 fallback 사용
+func parseDurationOr(s string, fallback time.Duration) time.Duration {
+	duration, err := time.ParseDuration(s)
+	if err != nil || duration <= 0 {
+		log.Printf("Invalid duration %s, using default %s", s, fallback)
+		return fallback
+	}
+	return duration
+}
+
 func parseSlice(s string) []string {
 	if s == "" {
 		return []string{}
@@ -215,8 +372,47 @@ func parseSlice(s string) []string {
 	return result
 }
 
+// parseSliceSep 구분자로 나누고 공백/빈 항목 제거 (cron 표현식처럼 ','가 들어가는 값에 사용)
+func parseSliceSep(s, sep string) []string {
+	result := []string{}
+	for _, item := range strings.Split(s, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 func parseInt(s string) int {
 	var result int
 	fmt.Sscanf(s, "%d", &result)
 	return result
 }
+
+func parseFloat(s string) float64 {
+	result, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		log.Printf("Invalid float %s, using default 0", s)
+		return 0
+	}
+	return result
+}
+
+func parseBool(s string) bool {
+	result, err := strconv.ParseBool(s)
+	if err != nil {
+		log.Printf("Invalid bool %s, using default false", s)
+		return false
+	}
+	return result
+}
+
+// parseBoolOr 잘못된 값이면 fallback 사용
+func parseBoolOr(s string, fallback bool) bool {
+	result, err := strconv.ParseBool(s)
+	if err != nil {
+		log.Printf("Invalid bool %s, using default %t", s, fallback)
+		return fallback
+	}
+	return result
+}
@@ -0,0 +1,25 @@
+-- Migration: Initial schema (down)
+-- Date: 2026-01-01
+-- Description: 모든 테이블 삭제 (데이터가 모두 사라짐)
+
+DROP TABLE IF EXISTS "faqs" CASCADE;
+DROP TABLE IF EXISTS "notification_settings" CASCADE;
+DROP TABLE IF EXISTS "notifications" CASCADE;
+DROP TABLE IF EXISTS "messages" CASCADE;
+DROP TABLE IF EXISTS "chat_rooms" CASCADE;
+DROP TABLE IF EXISTS "store_tags" CASCADE;
+DROP TABLE IF EXISTS "tags" CASCADE;
+DROP TABLE IF EXISTS "store_registration_requests" CASCADE;
+DROP TABLE IF EXISTS "store_likes" CASCADE;
+DROP TABLE IF EXISTS "review_likes" CASCADE;
+DROP TABLE IF EXISTS "store_reviews" CASCADE;
+DROP TABLE IF EXISTS "comment_likes" CASCADE;
+DROP TABLE IF EXISTS "post_likes" CASCADE;
+DROP TABLE IF EXISTS "community_comments" CASCADE;
+DROP TABLE IF EXISTS "community_posts" CASCADE;
+DROP TABLE IF EXISTS "gold_prices" CASCADE;
+DROP TABLE IF EXISTS "store_verifications" CASCADE;
+DROP TABLE IF EXISTS "business_registrations" CASCADE;
+DROP TABLE IF EXISTS "stores" CASCADE;
+DROP TABLE IF EXISTS "password_resets" CASCADE;
+DROP TABLE IF EXISTS "users" CASCADE;
@@ -0,0 +1,427 @@
+-- Migration: Initial schema
+-- Date: 2026-01-01
+-- Description: 버전 관리 이전에 GORM AutoMigrate로 만들던 스키마 (결제/안전거래/업로드/시세 알림 등 이후 추가분 제외)
+--              이 버전 이전에 만들어진 DB는 실행하지 않고 적용된 것으로 기록 (cmd/migrate up)
+
+CREATE TABLE "users" (
+    "id" bigserial,
+    "email" text NOT NULL,
+    "password_hash" text NOT NULL,
+    "name" text NOT NULL,
+    "nickname" text NOT NULL,
+    "phone" text,
+    "email_verified" boolean DEFAULT false,
+    "email_verified_at" timestamptz,
+    "phone_verified" boolean DEFAULT false,
+    "phone_verified_at" timestamptz,
+    "marketing_agreed" boolean DEFAULT false,
+    "marketing_agreed_at" timestamptz,
+    "marketing_sms" boolean DEFAULT false,
+    "marketing_email" boolean DEFAULT false,
+    "marketing_push" boolean DEFAULT false,
+    "profile_image" text,
+    "address" text,
+    "latitude" decimal,
+    "longitude" decimal,
+    "role" varchar(20) DEFAULT 'user',
+    "store_id" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_users_nickname" ON "users" ("nickname");
+CREATE INDEX IF NOT EXISTS "idx_users_email" ON "users" ("email");
+CREATE INDEX IF NOT EXISTS "idx_users_deleted_at" ON "users" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_users_store_id" ON "users" ("store_id");
+
+CREATE TABLE "password_resets" (
+    "id" bigserial,
+    "email" varchar(255) NOT NULL,
+    "token" varchar(255) NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "used" boolean DEFAULT false,
+    "created_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "uni_password_resets_token" UNIQUE ("token")
+);
+CREATE INDEX IF NOT EXISTS "idx_password_resets_token" ON "password_resets" ("token");
+CREATE INDEX IF NOT EXISTS "idx_password_resets_email" ON "password_resets" ("email");
+
+CREATE TABLE "stores" (
+    "id" bigserial,
+    "business_number" varchar(50),
+    "user_id" bigint,
+    "name" text NOT NULL,
+    "branch_name" varchar(100),
+    "slug" text,
+    "region" text NOT NULL,
+    "district" text NOT NULL,
+    "dong" varchar(100),
+    "address" text,
+    "building_name" varchar(200),
+    "floor" varchar(50),
+    "unit" varchar(50),
+    "postal_code" varchar(10),
+    "latitude" decimal(12,9),
+    "longitude" decimal(13,9),
+    "phone_number" varchar(30),
+    "image_url" text,
+    "description" text,
+    "open_time" varchar(10),
+    "close_time" varchar(10),
+    "background" jsonb,
+    "is_managed" boolean DEFAULT false,
+    "is_verified" boolean DEFAULT false,
+    "verified_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_stores_slug" ON "stores" ("slug");
+CREATE INDEX IF NOT EXISTS "idx_stores_user_id" ON "stores" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_stores_business_number" ON "stores" ("business_number");
+CREATE INDEX IF NOT EXISTS "idx_stores_deleted_at" ON "stores" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_stores_is_verified" ON "stores" ("is_verified");
+CREATE INDEX IF NOT EXISTS "idx_stores_is_managed" ON "stores" ("is_managed");
+CREATE INDEX IF NOT EXISTS "idx_stores_district" ON "stores" ("district");
+CREATE INDEX IF NOT EXISTS "idx_stores_region" ON "stores" ("region");
+
+CREATE TABLE "business_registrations" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "store_id" bigint NOT NULL,
+    "business_number" varchar(10) NOT NULL,
+    "business_start_date" varchar(8) NOT NULL,
+    "representative_name" varchar(100) NOT NULL,
+    "business_status" varchar(20),
+    "tax_type" varchar(20),
+    "is_verified" boolean NOT NULL DEFAULT false,
+    "verification_date" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_business_registrations_business_number" ON "business_registrations" ("business_number");
+CREATE INDEX IF NOT EXISTS "idx_business_registrations_store_id" ON "business_registrations" ("store_id");
+CREATE INDEX IF NOT EXISTS "idx_business_registrations_deleted_at" ON "business_registrations" ("deleted_at");
+
+CREATE TABLE "store_verifications" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "store_id" bigint NOT NULL,
+    "business_license_url" text NOT NULL,
+    "status" varchar(20) DEFAULT 'pending',
+    "submitted_at" timestamptz,
+    "reviewed_at" timestamptz,
+    "reviewed_by" bigint,
+    "rejection_reason" text,
+    "ip_address" varchar(50),
+    "user_agent" text,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_store_verifications_status" ON "store_verifications" ("status");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_store_verifications_store_id" ON "store_verifications" ("store_id");
+CREATE INDEX IF NOT EXISTS "idx_store_verifications_deleted_at" ON "store_verifications" ("deleted_at");
+
+CREATE TABLE "gold_prices" (
+    "id" bigserial,
+    "type" varchar(10) NOT NULL,
+    "buy_price" decimal NOT NULL,
+    "sell_price" decimal NOT NULL,
+    "source" varchar(100),
+    "source_date" timestamptz,
+    "description" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_gold_prices_deleted_at" ON "gold_prices" ("deleted_at");
+
+CREATE TABLE "community_posts" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "title" varchar(200) NOT NULL,
+    "slug" text,
+    "content" text NOT NULL,
+    "category" varchar(20) NOT NULL,
+    "type" varchar(20) NOT NULL,
+    "status" varchar(20) DEFAULT 'active',
+    "user_id" bigint NOT NULL,
+    "gold_type" varchar(50),
+    "weight" decimal,
+    "price" bigint,
+    "location" varchar(100),
+    "region" varchar(50),
+    "district" varchar(50),
+    "store_id" bigint,
+    "reservation_status" varchar(20),
+    "reserved_by_user_id" bigint,
+    "reserved_at" timestamptz,
+    "completed_at" timestamptz,
+    "is_answered" boolean DEFAULT false,
+    "accepted_answer_id" bigint,
+    "is_pinned" boolean DEFAULT false,
+    "view_count" bigint DEFAULT 0,
+    "like_count" bigint DEFAULT 0,
+    "comment_count" bigint DEFAULT 0,
+    "image_urls" text[],
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_community_posts_deleted_at" ON "community_posts" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_community_posts_is_pinned" ON "community_posts" ("is_pinned");
+CREATE INDEX IF NOT EXISTS "idx_community_posts_accepted_answer_id" ON "community_posts" ("accepted_answer_id");
+CREATE INDEX IF NOT EXISTS "idx_community_posts_reserved_by_user_id" ON "community_posts" ("reserved_by_user_id");
+CREATE INDEX IF NOT EXISTS "idx_community_posts_store_id" ON "community_posts" ("store_id");
+CREATE INDEX IF NOT EXISTS "idx_community_posts_user_id" ON "community_posts" ("user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_community_posts_slug" ON "community_posts" ("slug");
+
+CREATE TABLE "community_comments" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "content" text NOT NULL,
+    "user_id" bigint NOT NULL,
+    "post_id" bigint NOT NULL,
+    "parent_id" bigint,
+    "is_answer" boolean DEFAULT false,
+    "is_accepted" boolean DEFAULT false,
+    "like_count" bigint DEFAULT 0,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_community_comments_parent_id" ON "community_comments" ("parent_id");
+CREATE INDEX IF NOT EXISTS "idx_community_comments_post_id" ON "community_comments" ("post_id");
+CREATE INDEX IF NOT EXISTS "idx_community_comments_user_id" ON "community_comments" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_community_comments_deleted_at" ON "community_comments" ("deleted_at");
+
+CREATE TABLE "post_likes" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "post_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_post_user_like" ON "post_likes" ("post_id","user_id");
+
+CREATE TABLE "comment_likes" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "comment_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_comment_user_like" ON "comment_likes" ("comment_id","user_id");
+
+CREATE TABLE "store_reviews" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "store_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    "rating" bigint NOT NULL,
+    "content" text NOT NULL,
+    "image_urls" text[],
+    "is_visitor" boolean DEFAULT false,
+    "like_count" bigint DEFAULT 0,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_store_reviews_user_id" ON "store_reviews" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_store_reviews_store_id" ON "store_reviews" ("store_id");
+CREATE INDEX IF NOT EXISTS "idx_store_reviews_deleted_at" ON "store_reviews" ("deleted_at");
+
+CREATE TABLE "review_likes" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "review_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_review_user_like" ON "review_likes" ("review_id","user_id");
+
+CREATE TABLE "store_likes" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "store_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_store_user_like" ON "store_likes" ("store_id","user_id");
+
+CREATE TABLE "store_registration_requests" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "store_id" bigint NOT NULL,
+    "user_id" bigint NOT NULL,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_store_user_request" ON "store_registration_requests" ("store_id","user_id");
+
+CREATE TABLE "tags" (
+    "id" bigserial,
+    "name" varchar(50) NOT NULL,
+    "category" varchar(20),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_tags_deleted_at" ON "tags" ("deleted_at");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_tags_name" ON "tags" ("name");
+
+CREATE TABLE "store_tags" (
+    "store_id" bigint,
+    "tag_id" bigint,
+    "created_at" timestamptz,
+    PRIMARY KEY ("store_id","tag_id")
+);
+CREATE INDEX IF NOT EXISTS "idx_store_tags_tag_id" ON "store_tags" ("tag_id");
+CREATE INDEX IF NOT EXISTS "idx_store_tags_store_id" ON "store_tags" ("store_id");
+
+CREATE TABLE "chat_rooms" (
+    "id" bigserial,
+    "type" varchar(10) NOT NULL,
+    "user1_id" bigint NOT NULL,
+    "user2_id" bigint NOT NULL,
+    "product_id" bigint,
+    "store_id" bigint,
+    "last_message_id" bigint,
+    "last_message_content" text,
+    "last_message_at" timestamptz,
+    "user1_unread_count" bigint DEFAULT 0,
+    "user2_unread_count" bigint DEFAULT 0,
+    "user1_left_at" timestamptz,
+    "user2_left_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_chat_rooms_store_id" ON "chat_rooms" ("store_id");
+CREATE INDEX IF NOT EXISTS "idx_chat_rooms_product_id" ON "chat_rooms" ("product_id");
+CREATE INDEX IF NOT EXISTS "idx_chat_rooms_user2_id" ON "chat_rooms" ("user2_id");
+CREATE INDEX IF NOT EXISTS "idx_user2_last_msg" ON "chat_rooms" ("user2_id","last_message_at");
+CREATE INDEX IF NOT EXISTS "idx_chat_rooms_user1_id" ON "chat_rooms" ("user1_id");
+CREATE INDEX IF NOT EXISTS "idx_user1_last_msg" ON "chat_rooms" ("user1_id","last_message_at");
+CREATE INDEX IF NOT EXISTS "idx_chat_rooms_type" ON "chat_rooms" ("type");
+CREATE INDEX IF NOT EXISTS "idx_chat_rooms_deleted_at" ON "chat_rooms" ("deleted_at");
+
+CREATE TABLE "messages" (
+    "id" bigserial,
+    "chat_room_id" bigint NOT NULL,
+    "sender_id" bigint NOT NULL,
+    "content" text NOT NULL,
+    "message_type" varchar(20) DEFAULT 'TEXT',
+    "file_url" text,
+    "file_name" varchar(255),
+    "is_edited" boolean DEFAULT false,
+    "edited_at" timestamptz,
+    "is_deleted" boolean DEFAULT false,
+    "deleted_by" bigint,
+    "is_read" boolean DEFAULT false,
+    "read_at" timestamptz,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_messages_deleted_at" ON "messages" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_messages_is_read" ON "messages" ("is_read");
+CREATE INDEX IF NOT EXISTS "idx_messages_sender_id" ON "messages" ("sender_id");
+CREATE INDEX IF NOT EXISTS "idx_room_unread" ON "messages" ("is_read","sender_id");
+CREATE INDEX IF NOT EXISTS "idx_messages_chat_room_id" ON "messages" ("chat_room_id");
+CREATE INDEX IF NOT EXISTS "idx_room_created" ON "messages" ("chat_room_id","created_at");
+
+CREATE TABLE "notifications" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "user_id" bigint NOT NULL,
+    "type" varchar(50) NOT NULL,
+    "title" text NOT NULL,
+    "content" text NOT NULL,
+    "link" text NOT NULL,
+    "is_read" boolean DEFAULT false,
+    "related_post_id" bigint,
+    "related_store_id" bigint,
+    "related_user_id" bigint,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_notifications_type" ON "notifications" ("type");
+CREATE INDEX IF NOT EXISTS "idx_notifications_user_id" ON "notifications" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_notifications_deleted_at" ON "notifications" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_notifications_related_user_id" ON "notifications" ("related_user_id");
+CREATE INDEX IF NOT EXISTS "idx_notifications_related_store_id" ON "notifications" ("related_store_id");
+CREATE INDEX IF NOT EXISTS "idx_notifications_related_post_id" ON "notifications" ("related_post_id");
+CREATE INDEX IF NOT EXISTS "idx_notifications_is_read" ON "notifications" ("is_read");
+
+CREATE TABLE "notification_settings" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "user_id" bigint NOT NULL,
+    "sell_post_notification" boolean DEFAULT true,
+    "sell_post_range" varchar(20) DEFAULT 'district',
+    "selected_regions" text[] NOT NULL DEFAULT '{}',
+    "comment_notification" boolean DEFAULT true,
+    "like_notification" boolean DEFAULT true,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_notification_settings_user_id" ON "notification_settings" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_notification_settings_deleted_at" ON "notification_settings" ("deleted_at");
+
+CREATE TABLE "faqs" (
+    "id" bigserial,
+    "target" varchar(10) NOT NULL DEFAULT 'user',
+    "question" text NOT NULL,
+    "answer" text NOT NULL,
+    "sort_order" bigint DEFAULT 0,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_faqs_deleted_at" ON "faqs" ("deleted_at");
+
+-- 외래 키 (users ↔ stores 순환 참조 때문에 테이블 생성 후 추가)
+ALTER TABLE "users" ADD CONSTRAINT "fk_stores_user" FOREIGN KEY ("store_id") REFERENCES "stores"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+ALTER TABLE "stores" ADD CONSTRAINT "fk_users_stores" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "business_registrations" ADD CONSTRAINT "fk_stores_business_registration" FOREIGN KEY ("store_id") REFERENCES "stores"("id");
+ALTER TABLE "store_verifications" ADD CONSTRAINT "fk_stores_verification" FOREIGN KEY ("store_id") REFERENCES "stores"("id");
+ALTER TABLE "community_posts" ADD CONSTRAINT "fk_community_posts_store" FOREIGN KEY ("store_id") REFERENCES "stores"("id");
+ALTER TABLE "community_posts" ADD CONSTRAINT "fk_community_posts_reserved_by_user" FOREIGN KEY ("reserved_by_user_id") REFERENCES "users"("id");
+ALTER TABLE "community_posts" ADD CONSTRAINT "fk_community_posts_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "community_comments" ADD CONSTRAINT "fk_community_comments_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "community_comments" ADD CONSTRAINT "fk_community_comments_replies" FOREIGN KEY ("parent_id") REFERENCES "community_comments"("id");
+ALTER TABLE "community_comments" ADD CONSTRAINT "fk_community_posts_comments" FOREIGN KEY ("post_id") REFERENCES "community_posts"("id");
+ALTER TABLE "post_likes" ADD CONSTRAINT "fk_post_likes_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "post_likes" ADD CONSTRAINT "fk_community_posts_likes" FOREIGN KEY ("post_id") REFERENCES "community_posts"("id");
+ALTER TABLE "comment_likes" ADD CONSTRAINT "fk_comment_likes_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "comment_likes" ADD CONSTRAINT "fk_community_comments_likes" FOREIGN KEY ("comment_id") REFERENCES "community_comments"("id");
+ALTER TABLE "store_reviews" ADD CONSTRAINT "fk_store_reviews_store" FOREIGN KEY ("store_id") REFERENCES "stores"("id");
+ALTER TABLE "store_reviews" ADD CONSTRAINT "fk_store_reviews_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "review_likes" ADD CONSTRAINT "fk_review_likes_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "review_likes" ADD CONSTRAINT "fk_store_reviews_likes" FOREIGN KEY ("review_id") REFERENCES "store_reviews"("id");
+ALTER TABLE "store_likes" ADD CONSTRAINT "fk_store_likes_store" FOREIGN KEY ("store_id") REFERENCES "stores"("id");
+ALTER TABLE "store_likes" ADD CONSTRAINT "fk_store_likes_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "store_registration_requests" ADD CONSTRAINT "fk_store_registration_requests_store" FOREIGN KEY ("store_id") REFERENCES "stores"("id");
+ALTER TABLE "store_registration_requests" ADD CONSTRAINT "fk_store_registration_requests_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "store_tags" ADD CONSTRAINT "fk_store_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "tags"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE "store_tags" ADD CONSTRAINT "fk_store_tags_store" FOREIGN KEY ("store_id") REFERENCES "stores"("id") ON DELETE CASCADE ON UPDATE CASCADE;
+ALTER TABLE "chat_rooms" ADD CONSTRAINT "fk_chat_rooms_user1" FOREIGN KEY ("user1_id") REFERENCES "users"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+ALTER TABLE "chat_rooms" ADD CONSTRAINT "fk_chat_rooms_user2" FOREIGN KEY ("user2_id") REFERENCES "users"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+ALTER TABLE "chat_rooms" ADD CONSTRAINT "fk_chat_rooms_product" FOREIGN KEY ("product_id") REFERENCES "community_posts"("id");
+ALTER TABLE "chat_rooms" ADD CONSTRAINT "fk_chat_rooms_store" FOREIGN KEY ("store_id") REFERENCES "stores"("id");
+ALTER TABLE "messages" ADD CONSTRAINT "fk_messages_sender" FOREIGN KEY ("sender_id") REFERENCES "users"("id") ON DELETE RESTRICT ON UPDATE CASCADE;
+ALTER TABLE "messages" ADD CONSTRAINT "fk_chat_rooms_messages" FOREIGN KEY ("chat_room_id") REFERENCES "chat_rooms"("id");
+ALTER TABLE "notifications" ADD CONSTRAINT "fk_notifications_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
+ALTER TABLE "notification_settings" ADD CONSTRAINT "fk_notification_settings_user" FOREIGN KEY ("user_id") REFERENCES "users"("id");
@@ -0,0 +1,16 @@
+-- Migration: Add partial unique indexes (down)
+-- Date: 2026-01-19
+-- Description: partial unique index를 일반 인덱스로 되돌림 (GORM 모델 태그 기준)
+--              기존 unique index로 되돌리면 삭제된 데이터의 중복 때문에 실패할 수 있어 일반 인덱스로 생성
+
+DROP INDEX IF EXISTS idx_stores_business_number;
+DROP INDEX IF EXISTS idx_stores_slug;
+DROP INDEX IF EXISTS idx_users_email;
+DROP INDEX IF EXISTS idx_users_nickname;
+DROP INDEX IF EXISTS idx_business_registrations_store_id;
+
+CREATE INDEX idx_stores_business_number ON stores(business_number);
+CREATE INDEX idx_stores_slug ON stores(slug);
+CREATE INDEX idx_users_email ON users(email);
+CREATE INDEX idx_users_nickname ON users(nickname);
+CREATE INDEX idx_business_registrations_store_id ON business_registrations(store_id);
@@ -0,0 +1,4 @@
+-- Migration: Add full-text search GIN index on stores table (down)
+-- Date: 2026-04-14
+
+DROP INDEX IF EXISTS idx_stores_fts;
@@ -0,0 +1,6 @@
+-- Migration: Add payments, escrows and escrow_events tables (down)
+-- Date: 2026-10-16
+
+DROP TABLE IF EXISTS "escrow_events";
+DROP TABLE IF EXISTS "escrows";
+DROP TABLE IF EXISTS "payments";
@@ -0,0 +1,88 @@
+-- Migration: Add payments, escrows and escrow_events tables
+-- Date: 2026-10-16
+-- Description: 카카오페이 결제 기록과 금 판매글 안전거래
+--              버전 관리 전환 직전의 AutoMigrate로 이미 만들어진 DB에서는 건너뜀 (IF NOT EXISTS)
+
+CREATE TABLE IF NOT EXISTS "payments" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "order_id" varchar(64) NOT NULL,
+    "item_name" varchar(100) NOT NULL,
+    "quantity" bigint NOT NULL DEFAULT 1,
+    "user_id" bigint NOT NULL,
+    "store_id" bigint,
+    "post_id" bigint,
+    "escrow" boolean NOT NULL DEFAULT false,
+    "total_amount" bigint NOT NULL,
+    "tax_free_amount" bigint NOT NULL DEFAULT 0,
+    "canceled_amount" bigint NOT NULL DEFAULT 0,
+    "provider" varchar(20) NOT NULL,
+    "status" varchar(20) NOT NULL,
+    "t_id" varchar(50),
+    "a_id" varchar(50),
+    "payment_method_type" varchar(20),
+    "fail_reason" text,
+    "approved_at" timestamptz,
+    "canceled_at" timestamptz,
+    "failed_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_payments_user" FOREIGN KEY ("user_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_payments_store" FOREIGN KEY ("store_id") REFERENCES "stores"("id"),
+    CONSTRAINT "fk_payments_post" FOREIGN KEY ("post_id") REFERENCES "community_posts"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_payments_store_id" ON "payments" ("store_id");
+CREATE INDEX IF NOT EXISTS "idx_payments_user_id" ON "payments" ("user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_payments_order_id" ON "payments" ("order_id");
+CREATE INDEX IF NOT EXISTS "idx_payments_deleted_at" ON "payments" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "idx_payments_t_id" ON "payments" ("t_id");
+CREATE INDEX IF NOT EXISTS "idx_payments_status" ON "payments" ("status");
+CREATE INDEX IF NOT EXISTS "idx_payments_post_id" ON "payments" ("post_id");
+
+CREATE TABLE IF NOT EXISTS "escrows" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "post_id" bigint NOT NULL,
+    "seller_id" bigint NOT NULL,
+    "buyer_id" bigint NOT NULL,
+    "payment_id" bigint NOT NULL,
+    "amount" bigint NOT NULL,
+    "status" varchar(20) NOT NULL,
+    "expires_at" timestamptz,
+    "paid_at" timestamptz,
+    "handed_over_at" timestamptz,
+    "released_at" timestamptz,
+    "cancelled_at" timestamptz,
+    "cancel_reason" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_escrows_post" FOREIGN KEY ("post_id") REFERENCES "community_posts"("id"),
+    CONSTRAINT "fk_escrows_seller" FOREIGN KEY ("seller_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_escrows_buyer" FOREIGN KEY ("buyer_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_escrows_payment" FOREIGN KEY ("payment_id") REFERENCES "payments"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_escrows_expires_at" ON "escrows" ("expires_at");
+CREATE INDEX IF NOT EXISTS "idx_escrows_status" ON "escrows" ("status");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_escrows_payment_id" ON "escrows" ("payment_id");
+CREATE INDEX IF NOT EXISTS "idx_escrows_buyer_id" ON "escrows" ("buyer_id");
+CREATE INDEX IF NOT EXISTS "idx_escrows_seller_id" ON "escrows" ("seller_id");
+CREATE INDEX IF NOT EXISTS "idx_escrows_post_id" ON "escrows" ("post_id");
+
+-- 게시글당 진행 중인 안전거래는 하나만 허용
+CREATE UNIQUE INDEX IF NOT EXISTS idx_escrows_active_post ON escrows (post_id)
+WHERE status IN ('awaiting_payment', 'held', 'handed_over');
+
+CREATE TABLE IF NOT EXISTS "escrow_events" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "escrow_id" bigint NOT NULL,
+    "from_status" varchar(20),
+    "to_status" varchar(20) NOT NULL,
+    "actor_id" bigint,
+    "reason" text,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_escrows_events" FOREIGN KEY ("escrow_id") REFERENCES "escrows"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_escrow_events_actor_id" ON "escrow_events" ("actor_id");
+CREATE INDEX IF NOT EXISTS "idx_escrow_events_escrow_id" ON "escrow_events" ("escrow_id");
@@ -0,0 +1,7 @@
+-- Migration: Add gold price update runs, price alerts and source mismatch flag (down)
+-- Date: 2026-10-16
+
+DROP TABLE IF EXISTS "price_alerts";
+DROP TABLE IF EXISTS "gold_price_update_runs";
+DROP INDEX IF EXISTS idx_gold_prices_type_source_date;
+ALTER TABLE "gold_prices" DROP COLUMN IF EXISTS "source_mismatch";
@@ -0,0 +1,44 @@
+-- Migration: Add gold price update runs, price alerts and source mismatch flag
+-- Date: 2026-10-16
+-- Description: 금 시세 자동 수집 실행 기록, 시세 알림, 공급자 간 시세 차이 표시
+--              버전 관리 전환 직전의 AutoMigrate로 이미 만들어진 DB에서는 건너뜀 (IF NOT EXISTS)
+
+ALTER TABLE "gold_prices" ADD COLUMN IF NOT EXISTS "source_mismatch" boolean DEFAULT false;
+
+-- 금 종류별 기간 조회 (시세 이력, 캔들 집계)
+CREATE INDEX IF NOT EXISTS idx_gold_prices_type_source_date ON gold_prices (type, source_date);
+
+CREATE TABLE IF NOT EXISTS "gold_price_update_runs" (
+    "id" bigserial,
+    "started_at" timestamptz NOT NULL,
+    "finished_at" timestamptz,
+    "status" varchar(20) NOT NULL,
+    "attempts" bigint NOT NULL DEFAULT 0,
+    "rows_written" bigint NOT NULL DEFAULT 0,
+    "error" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_gold_price_update_runs_started_at" ON "gold_price_update_runs" ("started_at");
+
+CREATE TABLE IF NOT EXISTS "price_alerts" (
+    "id" bigserial,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz,
+    "user_id" bigint NOT NULL,
+    "type" varchar(10) NOT NULL,
+    "condition" varchar(20) NOT NULL,
+    "direction" varchar(10) NOT NULL,
+    "threshold" decimal NOT NULL,
+    "is_active" boolean DEFAULT true,
+    "last_triggered_at" timestamptz,
+    "last_triggered_price" decimal,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_price_alerts_user" FOREIGN KEY ("user_id") REFERENCES "users"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_price_alerts_is_active" ON "price_alerts" ("is_active");
+CREATE INDEX IF NOT EXISTS "idx_price_alerts_type" ON "price_alerts" ("type");
+CREATE INDEX IF NOT EXISTS "idx_price_alerts_user_id" ON "price_alerts" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_price_alerts_deleted_at" ON "price_alerts" ("deleted_at");
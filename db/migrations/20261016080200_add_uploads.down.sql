@@ -0,0 +1,5 @@
+-- Migration: Add uploads and processed_images tables (down)
+-- Date: 2026-10-16
+
+DROP TABLE IF EXISTS "processed_images";
+DROP TABLE IF EXISTS "uploads";
@@ -0,0 +1,37 @@
+-- Migration: Add uploads and processed_images tables
+-- Date: 2026-10-16
+-- Description: presigned URL 발급 기록(미사용 파일 정리)과 업로드 이미지 처리 결과(썸네일, 위치 정보 제거)
+--              버전 관리 전환 직전의 AutoMigrate로 이미 만들어진 DB에서는 건너뜀 (IF NOT EXISTS)
+
+CREATE TABLE IF NOT EXISTS "uploads" (
+    "id" bigserial,
+    "key" varchar(512) NOT NULL,
+    "file_url" text NOT NULL,
+    "folder" varchar(255) NOT NULL,
+    "user_id" bigint NOT NULL,
+    "content_type" varchar(100),
+    "created_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_uploads_key" ON "uploads" ("key");
+CREATE INDEX IF NOT EXISTS "idx_uploads_created_at" ON "uploads" ("created_at");
+CREATE INDEX IF NOT EXISTS "idx_uploads_user_id" ON "uploads" ("user_id");
+
+CREATE TABLE IF NOT EXISTS "processed_images" (
+    "id" bigserial,
+    "original_key" varchar(512) NOT NULL,
+    "original_url" text NOT NULL,
+    "status" varchar(20) NOT NULL DEFAULT 'pending',
+    "thumbnail_url" text,
+    "web_url" text,
+    "width" bigint,
+    "height" bigint,
+    "gps_stripped" boolean DEFAULT false,
+    "error" text,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_processed_images_status" ON "processed_images" ("status");
+CREATE INDEX IF NOT EXISTS "idx_processed_images_original_url" ON "processed_images" ("original_url");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_processed_images_original_key" ON "processed_images" ("original_key");
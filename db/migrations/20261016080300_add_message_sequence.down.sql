@@ -0,0 +1,7 @@
+-- Migration: Add message sequence numbers and delivery time (down)
+-- Date: 2026-10-16
+
+DROP INDEX IF EXISTS idx_messages_room_seq;
+ALTER TABLE "messages" DROP COLUMN IF EXISTS "delivered_at";
+ALTER TABLE "messages" DROP COLUMN IF EXISTS "seq";
+ALTER TABLE "chat_rooms" DROP COLUMN IF EXISTS "last_seq";
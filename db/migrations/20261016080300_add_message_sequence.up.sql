@@ -0,0 +1,22 @@
+-- Migration: Add message sequence numbers and delivery time
+-- Date: 2026-10-16
+-- Description: 채팅방 내 메시지 순번(재연결 시 누락 메시지 재전송 기준)과 수신 확인 시각
+--              기존 메시지는 작성 순서대로 순번을 부여하고 채팅방의 마지막 순번을 맞춤
+
+ALTER TABLE "chat_rooms" ADD COLUMN IF NOT EXISTS "last_seq" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "messages" ADD COLUMN IF NOT EXISTS "seq" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "messages" ADD COLUMN IF NOT EXISTS "delivered_at" timestamptz;
+
+UPDATE messages m SET seq = numbered.seq
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY chat_room_id ORDER BY created_at, id) AS seq
+    FROM messages
+) numbered
+WHERE m.id = numbered.id AND m.seq = 0;
+
+UPDATE chat_rooms c SET last_seq = s.max_seq
+FROM (SELECT chat_room_id, MAX(seq) AS max_seq FROM messages GROUP BY chat_room_id) s
+WHERE c.id = s.chat_room_id AND c.last_seq < s.max_seq;
+
+-- 채팅방 내 메시지 순번은 중복 불가
+CREATE UNIQUE INDEX IF NOT EXISTS idx_messages_room_seq ON messages (chat_room_id, seq);
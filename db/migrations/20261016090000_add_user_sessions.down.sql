@@ -0,0 +1,4 @@
+-- Migration: Add user_sessions table (down)
+-- Date: 2026-10-16
+
+DROP TABLE IF EXISTS "user_sessions";
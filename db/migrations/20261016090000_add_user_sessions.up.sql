@@ -0,0 +1,22 @@
+-- Migration: Add user_sessions table
+-- Date: 2026-10-16
+-- Description: 로그인 기기별 서버 세션. refresh token은 갱신할 때마다 회전하며
+--              이미 회전된 토큰이 재사용되면 세션 전체를 폐기
+
+CREATE TABLE "user_sessions" (
+    "id" varchar(36),
+    "user_id" bigint NOT NULL,
+    "token_hash" varchar(64) NOT NULL,
+    "device_name" varchar(100),
+    "ip_address" varchar(45),
+    "user_agent" varchar(255),
+    "last_used_at" timestamptz NOT NULL,
+    "expires_at" timestamptz NOT NULL,
+    "revoked_at" timestamptz,
+    "revoked_reason" varchar(30),
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_user_sessions_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_user_sessions_user_id" ON "user_sessions" ("user_id");
@@ -0,0 +1,4 @@
+-- Migration: Add account_deletions table (down)
+-- Date: 2026-10-16
+
+DROP TABLE IF EXISTS "account_deletions";
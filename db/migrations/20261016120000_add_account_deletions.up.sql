@@ -0,0 +1,26 @@
+-- Migration: Add account_deletions table
+-- Date: 2026-10-16
+-- Description: 회원 탈퇴 요청. 유예 기간이 지나면 개인정보를 익명화하고 completed로 변경
+--              (다시 로그인하면 cancelled)
+
+CREATE TABLE "account_deletions" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "status" varchar(20) NOT NULL,
+    "requested_at" timestamptz NOT NULL,
+    "scheduled_at" timestamptz NOT NULL,
+    "cancelled_at" timestamptz,
+    "completed_at" timestamptz,
+    "store_transfer_user_id" bigint,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_account_deletions_user" FOREIGN KEY ("user_id") REFERENCES "users"("id"),
+    CONSTRAINT "fk_account_deletions_store_transfer_user" FOREIGN KEY ("store_transfer_user_id") REFERENCES "users"("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_account_deletions_user_id" ON "account_deletions" ("user_id");
+CREATE INDEX IF NOT EXISTS "idx_account_deletions_status" ON "account_deletions" ("status");
+CREATE INDEX IF NOT EXISTS "idx_account_deletions_scheduled_at" ON "account_deletions" ("scheduled_at");
+
+-- 사용자당 진행 중인 탈퇴 요청은 하나만
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_account_deletions_pending_user" ON "account_deletions" ("user_id") WHERE status = 'pending';
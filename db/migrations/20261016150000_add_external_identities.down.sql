@@ -0,0 +1,4 @@
+-- Migration: Add external_identities table (down)
+-- Date: 2026-10-16
+
+DROP TABLE IF EXISTS "external_identities";
@@ -0,0 +1,20 @@
+-- Migration: Add external_identities table
+-- Date: 2026-10-16
+-- Description: 계정에 연결된 외부 로그인(카카오/구글). 같은 제공자 계정은 한 사용자에게만,
+--              한 사용자에게는 제공자별로 하나만 연결
+
+CREATE TABLE "external_identities" (
+    "id" bigserial,
+    "user_id" bigint NOT NULL,
+    "provider" varchar(20) NOT NULL,
+    "subject" varchar(255) NOT NULL,
+    "email" varchar(255),
+    "linked_at" timestamptz NOT NULL,
+    "created_at" timestamptz,
+    "updated_at" timestamptz,
+    PRIMARY KEY ("id"),
+    CONSTRAINT "fk_external_identities_user" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS "idx_external_identities_user_id" ON "external_identities" ("user_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_external_identities_provider_subject" ON "external_identities" ("provider", "subject");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_external_identities_user_provider" ON "external_identities" ("user_id", "provider");
@@ -0,0 +1,5 @@
+-- Migration: Add store_transfer_accepted_at to account_deletions (down)
+-- Date: 2026-10-16
+
+DROP INDEX IF EXISTS "idx_account_deletions_store_transfer_user_id";
+ALTER TABLE "account_deletions" DROP COLUMN IF EXISTS "store_transfer_accepted_at";
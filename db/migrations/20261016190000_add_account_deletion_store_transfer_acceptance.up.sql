@@ -0,0 +1,6 @@
+-- Migration: Add store_transfer_accepted_at to account_deletions
+-- Date: 2026-10-16
+-- Description: 매장 이전은 넘겨받을 사용자가 수락한 경우에만 진행 (수락하지 않으면 비관리매장으로 전환)
+
+ALTER TABLE "account_deletions" ADD COLUMN IF NOT EXISTS "store_transfer_accepted_at" timestamptz;
+CREATE INDEX IF NOT EXISTS "idx_account_deletions_store_transfer_user_id" ON "account_deletions" ("store_transfer_user_id");
@@ -0,0 +1,6 @@
+-- Migration: Include refunding escrows in the active escrow index (down)
+-- Date: 2026-10-16
+
+DROP INDEX IF EXISTS idx_escrows_active_post;
+CREATE UNIQUE INDEX idx_escrows_active_post ON escrows (post_id)
+WHERE status IN ('awaiting_payment', 'held', 'handed_over');
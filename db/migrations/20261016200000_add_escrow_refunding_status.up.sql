@@ -0,0 +1,7 @@
+-- Migration: Include refunding escrows in the active escrow index
+-- Date: 2026-10-16
+-- Description: 결제 후 취소는 환불 전에 refunding 상태로 선점하므로 환불 중인 게시글에도 새 안전거래를 시작할 수 없어야 함
+
+DROP INDEX IF EXISTS idx_escrows_active_post;
+CREATE UNIQUE INDEX idx_escrows_active_post ON escrows (post_id)
+WHERE status IN ('awaiting_payment', 'held', 'handed_over', 'refunding');
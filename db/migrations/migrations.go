@@ -0,0 +1,9 @@
+// Package migrations 버전별 스키마 변경 SQL (cmd/migrate로 적용)
+package migrations
+
+import "embed"
+
+// FS <version>_<name>.up.sql / <version>_<name>.down.sql 파일
+//
+//go:embed *.sql
+var FS embed.FS
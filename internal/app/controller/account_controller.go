@@ -0,0 +1,205 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	apperrors "github.com/ikkim/udonggeum-backend/internal/errors"
+	"github.com/ikkim/udonggeum-backend/internal/middleware"
+)
+
+// AccountController 회원 탈퇴/개인정보 내보내기 컨트롤러
+type AccountController struct {
+	service service.AccountService
+}
+
+// NewAccountController 회원 탈퇴 컨트롤러 생성자
+func NewAccountController(service service.AccountService) *AccountController {
+	return &AccountController{service: service}
+}
+
+type DeleteAccountRequest struct {
+	Password         string `json:"password"`                                     // 이메일 가입 계정의 재인증
+	TransferStoresTo string `json:"transfer_stores_to" binding:"omitempty,email"` // 매장 이전을 제안할 사용자 이메일 (수락해야 이전됨)
+}
+
+// DeleteMe requests account deletion after a grace period
+// DELETE /api/v1/auth/me
+func (ctrl *AccountController) DeleteMe(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	// 소셜 계정은 비밀번호가 없으므로 본문 없이 요청할 수 있음
+	var req DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		log.Warn("Invalid account deletion request", map[string]interface{}{
+			"error": err.Error(),
+		})
+		apperrors.BadRequest(c, apperrors.ValidationInvalidInput, "입력 정보가 올바르지 않습니다")
+		return
+	}
+	sessionID, _ := middleware.GetSessionID(c)
+
+	deletion, err := ctrl.service.RequestDeletion(c.Request.Context(), userID, service.DeleteAccountInput{
+		Password:         req.Password,
+		SessionID:        sessionID,
+		TransferStoresTo: req.TransferStoresTo,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidCredentials):
+			apperrors.RespondWithError(c, http.StatusUnauthorized, apperrors.AuthInvalidCredentials, "비밀번호가 올바르지 않습니다")
+		case errors.Is(err, service.ErrReauthenticationRequired):
+			apperrors.RespondWithError(c, http.StatusUnauthorized, apperrors.AuthReauthRequired, err.Error())
+		case errors.Is(err, service.ErrAccountDeletionPending), errors.Is(err, service.ErrAccountDeletionBlocked):
+			apperrors.Conflict(c, apperrors.ResourceConflict, err.Error())
+		case errors.Is(err, service.ErrStoreTransferTarget):
+			apperrors.NotFound(c, apperrors.ResourceNotFound, err.Error())
+		case errors.Is(err, service.ErrUserNotFound):
+			apperrors.NotFound(c, apperrors.ResourceNotFound, "사용자를 찾을 수 없습니다")
+		default:
+			log.Error("Failed to request account deletion", err, map[string]interface{}{
+				"user_id": userID,
+			})
+			apperrors.InternalError(c, "회원 탈퇴 요청에 실패했습니다")
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"message":  "Account deletion scheduled",
+		"deletion": deletion,
+	})
+}
+
+// ListStoreTransfers returns store transfer offers addressed to the current user
+// GET /api/v1/auth/me/store-transfers
+func (ctrl *AccountController) ListStoreTransfers(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	offers, err := ctrl.service.ListStoreTransferOffers(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("Failed to list store transfer offers", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		apperrors.InternalError(c, "매장 이전 제안을 불러오지 못했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"offers": offers})
+}
+
+// AcceptStoreTransfer accepts a store transfer offer
+// POST /api/v1/auth/me/store-transfers/:id/accept
+func (ctrl *AccountController) AcceptStoreTransfer(c *gin.Context) {
+	ctrl.respondStoreTransfer(c, ctrl.service.AcceptStoreTransfer, "Store transfer accepted")
+}
+
+// DeclineStoreTransfer declines a store transfer offer
+// POST /api/v1/auth/me/store-transfers/:id/decline
+func (ctrl *AccountController) DeclineStoreTransfer(c *gin.Context) {
+	ctrl.respondStoreTransfer(c, ctrl.service.DeclineStoreTransfer, "Store transfer declined")
+}
+
+func (ctrl *AccountController) respondStoreTransfer(
+	c *gin.Context,
+	apply func(ctx context.Context, userID, deletionID uint) error,
+	message string,
+) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	deletionID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		apperrors.BadRequest(c, apperrors.ValidationInvalidID, "잘못된 매장 이전 ID입니다")
+		return
+	}
+
+	if err := apply(c.Request.Context(), userID, uint(deletionID)); err != nil {
+		if errors.Is(err, service.ErrStoreTransferNotFound) {
+			apperrors.NotFound(c, apperrors.ResourceNotFound, err.Error())
+			return
+		}
+		log.Error("Failed to respond to store transfer", err, map[string]interface{}{
+			"user_id":     userID,
+			"deletion_id": deletionID,
+		})
+		apperrors.InternalError(c, "매장 이전 처리에 실패했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": message})
+}
+
+// ExportMe downloads every record linked to the current user
+// POST /api/v1/auth/me/export?format=zip|json
+func (ctrl *AccountController) ExportMe(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	format := c.DefaultQuery("format", "zip")
+	if format != "zip" && format != "json" {
+		apperrors.BadRequest(c, apperrors.ValidationInvalidFormat, "format은 zip 또는 json이어야 합니다")
+		return
+	}
+
+	export, err := ctrl.service.ExportData(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			apperrors.NotFound(c, apperrors.ResourceNotFound, "사용자를 찾을 수 없습니다")
+			return
+		}
+		log.Error("Failed to export user data", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		apperrors.InternalError(c, "개인정보 내보내기에 실패했습니다")
+		return
+	}
+
+	filename := fmt.Sprintf("udonggeum-export-%d-%s", userID, export.ExportedAt.Format("20060102"))
+	if format == "json" {
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+		c.IndentedJSON(http.StatusOK, export)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := service.WriteExportArchive(&buf, export); err != nil {
+		log.Error("Failed to write export archive", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		apperrors.InternalError(c, "개인정보 내보내기에 실패했습니다")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, filename))
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
@@ -1,11 +1,13 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/service"
 	apperrors "github.com/ikkim/udonggeum-backend/internal/errors"
 	"github.com/ikkim/udonggeum-backend/internal/middleware"
@@ -89,7 +91,7 @@ func (ctrl *AuthController) Register(c *gin.Context) {
 		"nickname": req.Nickname,
 	})
 
-	user, tokens, err := ctrl.authService.Register(req.Email, req.Password, req.Name, req.Nickname, req.Phone, req.MarketingAgreed, req.MarketingSMS, req.MarketingEmail, req.MarketingPush)
+	user, tokens, err := ctrl.authService.Register(sessionContext(c), req.Email, req.Password, req.Name, req.Nickname, req.Phone, req.MarketingAgreed, req.MarketingSMS, req.MarketingEmail, req.MarketingPush)
 	if err != nil {
 		if errors.Is(err, service.ErrEmailAlreadyExists) {
 			log.Warn("Registration failed: email already exists", map[string]interface{}{
@@ -144,7 +146,7 @@ func (ctrl *AuthController) Login(c *gin.Context) {
 		"email": req.Email,
 	})
 
-	user, tokens, err := ctrl.authService.Login(req.Email, req.Password)
+	user, tokens, err := ctrl.authService.Login(sessionContext(c), req.Email, req.Password)
 	if err != nil {
 		if errors.Is(err, service.ErrInvalidCredentials) {
 			log.Warn("Login failed: invalid credentials", map[string]interface{}{
@@ -193,7 +195,7 @@ func (ctrl *AuthController) GetMe(c *gin.Context) {
 		return
 	}
 
-	user, err := ctrl.authService.GetUserByID(userID)
+	user, err := ctrl.authService.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			log.Warn("User not found", map[string]interface{}{
@@ -257,7 +259,7 @@ func (ctrl *AuthController) UpdateMe(c *gin.Context) {
 		"profile_image": req.ProfileImage,
 	})
 
-	user, err := ctrl.authService.UpdateProfile(userID, req.Name, req.Phone, req.Nickname, req.Address, req.ProfileImage)
+	user, err := ctrl.authService.UpdateProfile(c.Request.Context(), userID, req.Name, req.Phone, req.Nickname, req.Address, req.ProfileImage)
 	if err != nil {
 		if errors.Is(err, service.ErrUserNotFound) {
 			log.Warn("User not found for profile update", map[string]interface{}{
@@ -319,7 +321,7 @@ func (ctrl *AuthController) ForgotPassword(c *gin.Context) {
 		"email": req.Email,
 	})
 
-	if err := ctrl.passwordResetService.RequestReset(req.Email); err != nil {
+	if err := ctrl.passwordResetService.RequestReset(c.Request.Context(), req.Email); err != nil {
 		log.Error("Failed to process password reset request", err, map[string]interface{}{
 			"email": req.Email,
 		})
@@ -353,7 +355,7 @@ func (ctrl *AuthController) ResetPassword(c *gin.Context) {
 
 	log.Debug("Processing password reset with token")
 
-	if err := ctrl.passwordResetService.ResetPassword(req.Token, req.NewPassword); err != nil {
+	if err := ctrl.passwordResetService.ResetPassword(c.Request.Context(), req.Token, req.NewPassword); err != nil {
 		if errors.Is(err, service.ErrInvalidResetToken) ||
 			errors.Is(err, service.ErrResetTokenExpired) ||
 			errors.Is(err, service.ErrResetTokenUsed) {
@@ -403,7 +405,7 @@ func (ctrl *AuthController) Logout(c *gin.Context) {
 	}
 
 	// Revoke the refresh token by adding it to blacklist
-	if err := ctrl.authService.RevokeToken(req.RefreshToken); err != nil {
+	if err := ctrl.authService.RevokeToken(c.Request.Context(), req.RefreshToken); err != nil {
 		log.Error("Failed to revoke token during logout", err, nil)
 		// Don't fail the request, logout should always succeed from user perspective
 	}
@@ -429,7 +431,7 @@ func (ctrl *AuthController) RefreshToken(c *gin.Context) {
 
 	log.Debug("Processing token refresh")
 
-	tokens, err := ctrl.authService.RefreshToken(req.RefreshToken)
+	tokens, err := ctrl.authService.RefreshToken(sessionContext(c), req.RefreshToken)
 	if err != nil {
 		// 에러를 세분화하여 프론트엔드가 적절히 처리할 수 있도록 함
 		if errors.Is(err, service.ErrTokenRevoked) {
@@ -439,6 +441,13 @@ func (ctrl *AuthController) RefreshToken(c *gin.Context) {
 			apperrors.RespondWithError(c, 401, apperrors.AuthTokenRevoked, "리프레시 토큰이 폐기되었습니다. 다시 로그인해주세요")
 			return
 		}
+		if errors.Is(err, service.ErrTokenReused) {
+			log.Warn("Token refresh failed: token reused", map[string]interface{}{
+				"error": err.Error(),
+			})
+			apperrors.RespondWithError(c, 401, apperrors.AuthTokenRevoked, "이미 사용된 리프레시 토큰입니다. 보안을 위해 다시 로그인해주세요")
+			return
+		}
 		if errors.Is(err, service.ErrExpiredToken) {
 			log.Warn("Token refresh failed: token expired", map[string]interface{}{
 				"error": err.Error(),
@@ -484,7 +493,7 @@ func (ctrl *AuthController) CheckNickname(c *gin.Context) {
 		"nickname": req.Nickname,
 	})
 
-	isAvailable, err := ctrl.authService.CheckNickname(req.Nickname)
+	isAvailable, err := ctrl.authService.CheckNickname(c.Request.Context(), req.Nickname)
 	if err != nil {
 		log.Error("Failed to check nickname availability", err, map[string]interface{}{
 			"nickname": req.Nickname,
@@ -521,7 +530,7 @@ func (ctrl *AuthController) CheckEmailAvailability(c *gin.Context) {
 		"email": req.Email,
 	})
 
-	isAvailable, err := ctrl.authService.CheckEmailAvailability(req.Email)
+	isAvailable, err := ctrl.authService.CheckEmailAvailability(c.Request.Context(), req.Email)
 	if err != nil {
 		log.Error("Failed to check email availability", err, map[string]interface{}{
 			"email": req.Email,
@@ -540,145 +549,127 @@ func (ctrl *AuthController) CheckEmailAvailability(c *gin.Context) {
 	})
 }
 
-// GetKakaoLoginURL returns the Kakao OAuth login URL
-// GET /api/v1/auth/kakao/login
-func (ctrl *AuthController) GetKakaoLoginURL(c *gin.Context) {
-	log := middleware.GetLoggerFromContext(c)
-
-	log.Debug("Generating Kakao login URL")
-
-	loginURL := ctrl.authService.GetKakaoLoginURL()
-
-	log.Info("Kakao login URL generated", map[string]interface{}{
-		"url": loginURL,
-	})
+// oauthNonceCookie 소셜 로그인을 시작한 브라우저를 콜백에서 확인하기 위한 쿠키
+// 애플은 다른 사이트에서 form_post로 콜백하므로 SameSite=None으로 설정
+const (
+	oauthNonceCookie     = "oauth_nonce"
+	oauthNonceCookiePath = "/api/v1/auth"
+)
 
-	c.JSON(http.StatusOK, gin.H{
-		"login_url": loginURL,
+func setOAuthNonceCookie(c *gin.Context, nonce string, maxAge int) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oauthNonceCookie,
+		Value:    nonce,
+		Path:     oauthNonceCookiePath,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
 	})
 }
 
-// KakaoCallback handles Kakao OAuth callback
-// GET /api/v1/auth/kakao/callback
-func (ctrl *AuthController) KakaoCallback(c *gin.Context) {
+// GetOAuthLoginURL returns the OAuth login URL for the provider (kakao, google, apple, naver)
+// GET /api/v1/auth/:provider/login
+func (ctrl *AuthController) GetOAuthLoginURL(c *gin.Context) {
 	log := middleware.GetLoggerFromContext(c)
 
-	code := c.Query("code")
-	if code == "" {
-		log.Warn("Kakao callback without authorization code", nil)
-		apperrors.BadRequest(c, apperrors.ValidationRequired, "인증 코드가 필요합니다")
-		return
-	}
-
-	log.Debug("Processing Kakao callback", map[string]interface{}{
-		"code": code,
-	})
-
-	user, tokens, err := ctrl.authService.KakaoLogin(code)
+	provider := model.IdentityProvider(c.Param("provider"))
+	loginURL, nonce, err := ctrl.authService.GetOAuthLoginURL(c.Request.Context(), provider)
 	if err != nil {
-		log.Error("Kakao login failed", err, map[string]interface{}{
-			"code": code,
-		})
-
-		// Provide more specific error messages
-		errStr := err.Error()
-		if errors.Is(err, service.ErrUserNotFound) ||
-			strings.Contains(errStr, "email consent required") ||
-			strings.Contains(errStr, "missing email") {
-			apperrors.BadRequest(c, apperrors.AuthEmailNotVerified, "카카오 로그인 시 이메일 동의가 필요합니다")
-			return
-		} else if strings.Contains(errStr, "status 401") ||
-			strings.Contains(errStr, "status 400") {
-			apperrors.Unauthorized(c, "카카오 인증에 실패했습니다. 다시 시도해주세요")
+		if errors.Is(err, service.ErrUnsupportedProvider) {
+			apperrors.BadRequest(c, apperrors.ValidationInvalidInput, err.Error())
 			return
 		}
-
-		apperrors.InternalError(c, "카카오 로그인에 실패했습니다")
+		log.Error("Failed to generate OAuth login URL", err, map[string]interface{}{
+			"provider": provider,
+		})
+		apperrors.InternalError(c, "소셜 로그인을 시작하지 못했습니다")
 		return
 	}
 
-	log.Info("Kakao login successful", map[string]interface{}{
-		"user_id": user.ID,
-		"email":   user.Email,
-	})
-
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Kakao login successful",
-		"user": gin.H{
-			"id":             user.ID,
-			"email":          user.Email,
-			"name":           user.Name,
-			"nickname":       user.Nickname,
-			"phone":          user.Phone,
-			"phone_verified": user.PhoneVerified,
-			"profile_image":  user.ProfileImage,
-			"role":           user.Role,
-		},
-		"tokens": tokens,
-	})
-}
-
-// GetGoogleLoginURL returns the Google OAuth login URL
-// GET /api/v1/auth/google/login
-func (ctrl *AuthController) GetGoogleLoginURL(c *gin.Context) {
-	log := middleware.GetLoggerFromContext(c)
-
-	log.Debug("Generating Google login URL")
-
-	loginURL := ctrl.authService.GetGoogleLoginURL()
-
-	log.Info("Google login URL generated", map[string]interface{}{
-		"url": loginURL,
+	log.Info("OAuth login URL generated", map[string]interface{}{
+		"provider": provider,
+		"url":      loginURL,
 	})
 
+	setOAuthNonceCookie(c, nonce, int(service.OAuthStateTTL.Seconds()))
 	c.JSON(http.StatusOK, gin.H{
 		"login_url": loginURL,
 	})
 }
 
-// GoogleCallback handles Google OAuth callback
-// GET /api/v1/auth/google/callback
-func (ctrl *AuthController) GoogleCallback(c *gin.Context) {
+// OAuthCallback handles the OAuth callback for the provider
+// GET /api/v1/auth/:provider/callback
+// POST /api/v1/auth/:provider/callback (애플 form_post)
+func (ctrl *AuthController) OAuthCallback(c *gin.Context) {
 	log := middleware.GetLoggerFromContext(c)
 
+	provider := model.IdentityProvider(c.Param("provider"))
 	code := c.Query("code")
 	if code == "" {
-		log.Warn("Google callback without authorization code", nil)
+		code = c.PostForm("code")
+	}
+	state := c.Query("state")
+	if state == "" {
+		state = c.PostForm("state")
+	}
+	if code == "" {
+		log.Warn("OAuth callback without authorization code", map[string]interface{}{
+			"provider": provider,
+		})
 		apperrors.BadRequest(c, apperrors.ValidationRequired, "인증 코드가 필요합니다")
 		return
 	}
 
-	log.Debug("Processing Google callback", map[string]interface{}{
-		"code": code,
+	log.Debug("Processing OAuth callback", map[string]interface{}{
+		"provider": provider,
+		"code":     code,
 	})
 
-	user, tokens, err := ctrl.authService.GoogleLogin(code)
-	if err != nil {
-		log.Error("Google login failed", err, map[string]interface{}{
-			"code": code,
-		})
+	// 로그인을 시작한 브라우저인지 확인하고 쿠키는 바로 삭제 (state와 함께 한 번만 사용)
+	nonce, _ := c.Cookie(oauthNonceCookie)
+	setOAuthNonceCookie(c, "", -1)
 
-		errStr := err.Error()
-		if strings.Contains(errStr, "이메일을 제공하지 않았습니다") {
-			apperrors.BadRequest(c, apperrors.AuthEmailNotVerified, "구글 로그인 시 이메일 동의가 필요합니다")
-			return
-		} else if strings.Contains(errStr, "status 401") ||
-			strings.Contains(errStr, "status 400") {
-			apperrors.Unauthorized(c, "구글 인증에 실패했습니다. 다시 시도해주세요")
-			return
+	user, tokens, err := ctrl.authService.OAuthLogin(sessionContext(c), provider, code, state, nonce)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnsupportedProvider):
+			apperrors.BadRequest(c, apperrors.ValidationInvalidInput, err.Error())
+		case errors.Is(err, service.ErrIdentityNotLinked):
+			apperrors.Conflict(c, apperrors.AuthIdentityNotLinked, err.Error())
+		case errors.Is(err, service.ErrOAuthStateInvalid):
+			log.Warn("OAuth callback with unknown state", map[string]interface{}{
+				"provider": provider,
+			})
+			apperrors.BadRequest(c, apperrors.AuthOAuthStateInvalid, err.Error())
+		case errors.Is(err, service.ErrOAuthEmailRequired):
+			apperrors.BadRequest(c, apperrors.AuthEmailNotVerified, "소셜 로그인 시 이메일 동의가 필요합니다")
+		case errors.Is(err, service.ErrOAuthEmailUnverified):
+			apperrors.BadRequest(c, apperrors.AuthEmailNotVerified, err.Error())
+		case errors.Is(err, service.ErrOAuthRejected):
+			log.Warn("OAuth provider rejected login", map[string]interface{}{
+				"provider": provider,
+				"error":    err.Error(),
+			})
+			apperrors.Unauthorized(c, "소셜 로그인 인증에 실패했습니다. 다시 시도해주세요")
+		default:
+			log.Error("OAuth login failed", err, map[string]interface{}{
+				"provider": provider,
+			})
+			apperrors.InternalError(c, "소셜 로그인에 실패했습니다")
 		}
-
-		apperrors.InternalError(c, "구글 로그인에 실패했습니다")
 		return
 	}
 
-	log.Info("Google login successful", map[string]interface{}{
-		"user_id": user.ID,
-		"email":   user.Email,
+	log.Info("OAuth login successful", map[string]interface{}{
+		"provider": provider,
+		"user_id":  user.ID,
+		"email":    user.Email,
 	})
 
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Google login successful",
+		"message":  "OAuth login successful",
+		"provider": provider,
 		"user": gin.H{
 			"id":             user.ID,
 			"email":          user.Email,
@@ -727,8 +718,16 @@ func (ctrl *AuthController) SendEmailVerification(c *gin.Context) {
 		return
 	}
 
-	err := ctrl.authService.SendEmailVerification(req.Email)
+	err := ctrl.authService.SendEmailVerification(c.Request.Context(), req.Email)
 	if err != nil {
+		if respondVerificationLimit(c, err) {
+			log.Warn("Email verification rate limited", map[string]interface{}{
+				"email": req.Email,
+				"error": err.Error(),
+			})
+			return
+		}
+
 		log.Error("Failed to send email verification", err, map[string]interface{}{
 			"email": req.Email,
 		})
@@ -759,8 +758,14 @@ func (ctrl *AuthController) VerifyEmail(c *gin.Context) {
 		return
 	}
 
-	err := ctrl.authService.VerifyEmail(req.Email, req.Code)
+	err := ctrl.authService.VerifyEmail(c.Request.Context(), req.Email, req.Code)
 	if err != nil {
+		if respondVerificationLimit(c, err) {
+			log.Warn("Email verification locked", map[string]interface{}{
+				"email": req.Email,
+			})
+			return
+		}
 		if errors.Is(err, service.ErrInvalidVerificationCode) {
 			log.Warn("Invalid verification code", map[string]interface{}{
 				"email": req.Email,
@@ -807,8 +812,16 @@ func (ctrl *AuthController) SendPhoneVerification(c *gin.Context) {
 		return
 	}
 
-	err := ctrl.authService.SendPhoneVerification(userID.(uint), req.Phone)
+	err := ctrl.authService.SendPhoneVerification(c.Request.Context(), userID.(uint), req.Phone)
 	if err != nil {
+		if respondVerificationLimit(c, err) {
+			log.Warn("Phone verification rate limited", map[string]interface{}{
+				"phone": req.Phone,
+				"error": err.Error(),
+			})
+			return
+		}
+
 		log.Error("Failed to send phone verification", err, map[string]interface{}{
 			"phone": req.Phone,
 		})
@@ -847,8 +860,14 @@ func (ctrl *AuthController) VerifyPhone(c *gin.Context) {
 		return
 	}
 
-	err := ctrl.authService.VerifyPhone(userID.(uint), req.Phone, req.Code)
+	err := ctrl.authService.VerifyPhone(c.Request.Context(), userID.(uint), req.Phone, req.Code)
 	if err != nil {
+		if respondVerificationLimit(c, err) {
+			log.Warn("Phone verification locked", map[string]interface{}{
+				"phone": req.Phone,
+			})
+			return
+		}
 		if errors.Is(err, service.ErrInvalidVerificationCode) {
 			log.Warn("Invalid verification code", map[string]interface{}{
 				"phone": req.Phone,
@@ -872,3 +891,320 @@ func (ctrl *AuthController) VerifyPhone(c *gin.Context) {
 		"message": "Phone verified successfully",
 	})
 }
+
+// respondVerificationLimit 재전송 쿨다운/오답 잠금 에러면 429로 응답하고 true 반환
+func respondVerificationLimit(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, service.ErrVerificationCooldown):
+		apperrors.RespondWithError(c, http.StatusTooManyRequests, apperrors.AuthCodeCooldown, "인증 코드는 잠시 후 다시 요청할 수 있습니다")
+		return true
+	case errors.Is(err, service.ErrVerificationLocked):
+		apperrors.RespondWithError(c, http.StatusTooManyRequests, apperrors.AuthCodeLocked, "인증 시도 횟수를 초과했습니다. 잠시 후 다시 시도해주세요")
+		return true
+	default:
+		return false
+	}
+}
+
+// sessionContext 로그인 세션에 기록할 기기 정보를 요청 context에 담음
+func sessionContext(c *gin.Context) context.Context {
+	userAgent := c.GetHeader("User-Agent")
+	deviceName := strings.TrimSpace(c.GetHeader("X-Device-Name"))
+	if deviceName == "" {
+		deviceName = deviceNameFromUserAgent(userAgent)
+	}
+
+	return service.WithSessionDevice(c.Request.Context(), service.SessionDevice{
+		Name:      deviceName,
+		IPAddress: c.ClientIP(),
+		UserAgent: userAgent,
+	})
+}
+
+// deviceNameFromUserAgent 기기 이름을 보내지 않은 클라이언트는 User-Agent로 대략 표시
+func deviceNameFromUserAgent(userAgent string) string {
+	var platform string
+	switch {
+	case strings.Contains(userAgent, "iPhone"):
+		platform = "iPhone"
+	case strings.Contains(userAgent, "iPad"):
+		platform = "iPad"
+	case strings.Contains(userAgent, "Android"):
+		platform = "Android"
+	case strings.Contains(userAgent, "Windows"):
+		platform = "Windows"
+	case strings.Contains(userAgent, "Macintosh"):
+		platform = "Mac"
+	case strings.Contains(userAgent, "Linux"):
+		platform = "Linux"
+	default:
+		return "알 수 없는 기기"
+	}
+
+	switch {
+	case strings.Contains(userAgent, "Edg/"):
+		return platform + " Edge"
+	case strings.Contains(userAgent, "Chrome/"), strings.Contains(userAgent, "CriOS/"):
+		return platform + " Chrome"
+	case strings.Contains(userAgent, "Firefox/"), strings.Contains(userAgent, "FxiOS/"):
+		return platform + " Firefox"
+	case strings.Contains(userAgent, "Safari/"):
+		return platform + " Safari"
+	default:
+		return platform
+	}
+}
+
+// ListSessions returns the current user's active login sessions
+// GET /api/v1/auth/sessions
+func (ctrl *AuthController) ListSessions(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+	currentSessionID, _ := middleware.GetSessionID(c)
+
+	sessions, err := ctrl.authService.ListSessions(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("Failed to list sessions", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		apperrors.InternalError(c, "로그인 기기 목록을 불러오지 못했습니다")
+		return
+	}
+
+	items := make([]gin.H, 0, len(sessions))
+	for _, session := range sessions {
+		items = append(items, gin.H{
+			"id":           session.ID,
+			"device_name":  session.DeviceName,
+			"ip_address":   session.IPAddress,
+			"user_agent":   session.UserAgent,
+			"last_used_at": session.LastUsedAt,
+			"created_at":   session.CreatedAt,
+			"expires_at":   session.ExpiresAt,
+			"current":      session.ID == currentSessionID,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"sessions": items,
+	})
+}
+
+// RevokeOtherSessions logs out every device except the current one
+// DELETE /api/v1/auth/sessions
+func (ctrl *AuthController) RevokeOtherSessions(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+	currentSessionID, _ := middleware.GetSessionID(c)
+
+	count, err := ctrl.authService.RevokeOtherSessions(c.Request.Context(), userID, currentSessionID)
+	if err != nil {
+		log.Error("Failed to revoke other sessions", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		apperrors.InternalError(c, "다른 기기 로그아웃에 실패했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "Other sessions revoked successfully",
+		"revoked_count": count,
+	})
+}
+
+// RevokeSession logs out a single device
+// DELETE /api/v1/auth/sessions/:id
+func (ctrl *AuthController) RevokeSession(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	sessionID := c.Param("id")
+	if err := ctrl.authService.RevokeSession(c.Request.Context(), userID, sessionID); err != nil {
+		if errors.Is(err, service.ErrSessionNotFound) {
+			apperrors.NotFound(c, apperrors.ResourceNotFound, "로그인 세션을 찾을 수 없습니다")
+			return
+		}
+		log.Error("Failed to revoke session", err, map[string]interface{}{
+			"user_id":    userID,
+			"session_id": sessionID,
+		})
+		apperrors.InternalError(c, "기기 로그아웃에 실패했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Session revoked successfully",
+	})
+}
+
+type LinkIdentityRequest struct {
+	Code  string `json:"code" binding:"required"`  // 제공자 로그인 후 받은 인가 코드
+	State string `json:"state" binding:"required"` // 연결용 로그인 URL을 받을 때 서버가 발급한 state
+}
+
+// ListIdentities returns the login providers linked to the current user
+// GET /api/v1/auth/me/identities
+func (ctrl *AuthController) ListIdentities(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	user, err := ctrl.authService.GetUserByID(c.Request.Context(), userID)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			apperrors.NotFound(c, apperrors.ResourceNotFound, "사용자를 찾을 수 없습니다")
+			return
+		}
+		log.Error("Failed to get user for identities", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		apperrors.InternalError(c, "연결된 로그인을 불러오지 못했습니다")
+		return
+	}
+
+	identities, err := ctrl.authService.ListIdentities(c.Request.Context(), userID)
+	if err != nil {
+		log.Error("Failed to list identities", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		apperrors.InternalError(c, "연결된 로그인을 불러오지 못했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"identities":   identities,
+		"has_password": user.PasswordHash != "",
+	})
+}
+
+// GetOAuthLinkURL returns the provider login URL for linking it to the current user
+// GET /api/v1/auth/me/identities/:provider/login
+func (ctrl *AuthController) GetOAuthLinkURL(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	provider := model.IdentityProvider(c.Param("provider"))
+	loginURL, err := ctrl.authService.GetOAuthLinkURL(c.Request.Context(), userID, provider)
+	if err != nil {
+		if errors.Is(err, service.ErrUnsupportedProvider) {
+			apperrors.BadRequest(c, apperrors.ValidationInvalidInput, err.Error())
+			return
+		}
+		log.Error("Failed to generate OAuth link URL", err, map[string]interface{}{
+			"user_id":  userID,
+			"provider": provider,
+		})
+		apperrors.InternalError(c, "로그인 연결을 시작하지 못했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"login_url": loginURL,
+	})
+}
+
+// LinkIdentity links a social login account to the current user
+// POST /api/v1/auth/me/identities/:provider
+func (ctrl *AuthController) LinkIdentity(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	var req LinkIdentityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperrors.BadRequest(c, apperrors.ValidationRequired, "인증 코드가 필요합니다")
+		return
+	}
+
+	provider := model.IdentityProvider(c.Param("provider"))
+	identity, err := ctrl.authService.LinkIdentity(c.Request.Context(), userID, provider, req.Code, req.State)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnsupportedProvider):
+			apperrors.BadRequest(c, apperrors.ValidationInvalidInput, err.Error())
+		case errors.Is(err, service.ErrIdentityInUse), errors.Is(err, service.ErrIdentityAlreadyLinked):
+			apperrors.Conflict(c, apperrors.ResourceConflict, err.Error())
+		case errors.Is(err, service.ErrOAuthStateInvalid):
+			apperrors.BadRequest(c, apperrors.AuthOAuthStateInvalid, err.Error())
+		case errors.Is(err, service.ErrOAuthEmailRequired):
+			apperrors.BadRequest(c, apperrors.AuthEmailNotVerified, "소셜 로그인 시 이메일 동의가 필요합니다")
+		case errors.Is(err, service.ErrOAuthEmailUnverified):
+			apperrors.BadRequest(c, apperrors.AuthEmailNotVerified, err.Error())
+		case errors.Is(err, service.ErrOAuthRejected):
+			apperrors.Unauthorized(c, "로그인 제공자 인증에 실패했습니다. 다시 시도해주세요")
+		default:
+			log.Error("Failed to link identity", err, map[string]interface{}{
+				"user_id":  userID,
+				"provider": provider,
+			})
+			apperrors.InternalError(c, "로그인 연결에 실패했습니다")
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message":  "Identity linked successfully",
+		"identity": identity,
+	})
+}
+
+// UnlinkIdentity removes a linked social login account
+// DELETE /api/v1/auth/me/identities/:provider
+func (ctrl *AuthController) UnlinkIdentity(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	provider := model.IdentityProvider(c.Param("provider"))
+	if err := ctrl.authService.UnlinkIdentity(c.Request.Context(), userID, provider); err != nil {
+		switch {
+		case errors.Is(err, service.ErrIdentityNotFound):
+			apperrors.NotFound(c, apperrors.ResourceNotFound, err.Error())
+		case errors.Is(err, service.ErrLastLoginMethod):
+			apperrors.Conflict(c, apperrors.ResourceConflict, err.Error())
+		default:
+			log.Error("Failed to unlink identity", err, map[string]interface{}{
+				"user_id":  userID,
+				"provider": provider,
+			})
+			apperrors.InternalError(c, "로그인 연결 해제에 실패했습니다")
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Identity unlinked successfully",
+	})
+}
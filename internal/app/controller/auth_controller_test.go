@@ -2,6 +2,7 @@ package controller
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -28,20 +29,28 @@ func setupAuthControllerTest(t *testing.T) (*gin.Engine, *AuthController, servic
 	passwordResetRepo := repository.NewPasswordResetRepository(testDB)
 	authService := service.NewAuthService(
 		userRepo,
-		"test-secret",
+		repository.NewUserSessionRepository(testDB),
+		repository.NewExternalIdentityRepository(testDB),
+		util.NewHMACTokenManager("test-secret"),
 		15*time.Minute,
 		7*24*time.Hour,
-		"test-kakao-client-id",
-		"test-kakao-client-secret",
-		"http://localhost:8080/api/v1/auth/kakao/callback",
-		"test-google-client-id",
-		"test-google-client-secret",
-		"http://localhost:8080/api/v1/auth/google/callback",
+		util.NewMemoryVerificationCodeStore(util.DefaultVerificationPolicy()),
+		util.NewMemoryOAuthStateStore(),
+		service.NewKakaoOAuthProvider(service.OAuthClientConfig{
+			ClientID:     "test-kakao-client-id",
+			ClientSecret: "test-kakao-client-secret",
+			RedirectURI:  "http://localhost:8080/api/v1/auth/kakao/callback",
+		}),
+		service.NewGoogleOAuthProvider(service.OAuthClientConfig{
+			ClientID:     "test-google-client-id",
+			ClientSecret: "test-google-client-secret",
+			RedirectURI:  "http://localhost:8080/api/v1/auth/google/callback",
+		}),
 	)
 	passwordResetService := service.NewPasswordResetService(passwordResetRepo, userRepo)
 
 	ctrl := NewAuthController(authService, passwordResetService)
-	authMiddleware := middleware.NewAuthMiddleware("test-secret")
+	authMiddleware := middleware.NewAuthMiddleware(util.NewHMACTokenManager("test-secret"), nil)
 
 	router := gin.New()
 	router.POST("/register", ctrl.Register)
@@ -103,7 +112,7 @@ func TestAuthController_Register_DuplicateEmail(t *testing.T) {
 	router, _, authService := setupAuthControllerTest(t)
 
 	// Register first user
-	_, _, err := authService.Register("test@example.com", "password123", "Test User", "", "010-1234-5678", false, false, false, false)
+	_, _, err := authService.Register(context.Background(), "test@example.com", "password123", "Test User", "", "010-1234-5678", false, false, false, false)
 	require.NoError(t, err)
 
 	// Try to register with same email
@@ -131,7 +140,7 @@ func TestAuthController_Login_Success(t *testing.T) {
 	// Register a user first
 	email := "test@example.com"
 	password := "password123"
-	_, _, err := authService.Register(email, password, "Test User", "", "010-1234-5678", false, false, false, false)
+	_, _, err := authService.Register(context.Background(), email, password, "Test User", "", "010-1234-5678", false, false, false, false)
 	require.NoError(t, err)
 
 	// Login
@@ -162,7 +171,7 @@ func TestAuthController_Login_WrongPassword(t *testing.T) {
 	router, _, authService := setupAuthControllerTest(t)
 
 	// Register a user
-	_, _, err := authService.Register("test@example.com", "password123", "Test User", "", "010-1234-5678", false, false, false, false)
+	_, _, err := authService.Register(context.Background(), "test@example.com", "password123", "Test User", "", "010-1234-5678", false, false, false, false)
 	require.NoError(t, err)
 
 	// Login with wrong password
@@ -186,7 +195,7 @@ func TestAuthController_GetMe_Success(t *testing.T) {
 	router, _, authService := setupAuthControllerTest(t)
 
 	// Register and get token
-	user, tokens, err := authService.Register("test@example.com", "password123", "Test User", "", "010-1234-5678", false, false, false, false)
+	user, tokens, err := authService.Register(context.Background(), "test@example.com", "password123", "Test User", "", "010-1234-5678", false, false, false, false)
 	require.NoError(t, err)
 
 	// Get user info
@@ -3,7 +3,6 @@ package controller
 import (
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -31,14 +30,16 @@ var upgrader = websocket.Upgrader{
 }
 
 type ChatController struct {
-	chatService service.ChatService
-	hub         *ws.Hub
+	chatService   service.ChatService
+	uploadService service.UploadService
+	hub           *ws.Hub
 }
 
-func NewChatController(chatService service.ChatService, hub *ws.Hub) *ChatController {
+func NewChatController(chatService service.ChatService, uploadService service.UploadService, hub *ws.Hub) *ChatController {
 	return &ChatController{
-		chatService: chatService,
-		hub:         hub,
+		chatService:   chatService,
+		uploadService: uploadService,
+		hub:           hub,
 	}
 }
 
@@ -58,6 +59,16 @@ type SendMessageRequest struct {
 	FileName    string `json:"file_name,omitempty"`    // 원본 파일명
 }
 
+// MarkAsReadRequest 읽음 처리 요청 (본문 생략 시 전체 읽음)
+type MarkAsReadRequest struct {
+	UpToSeq uint64 `json:"up_to_seq"` // 이 순번까지 읽음
+}
+
+// MarkAsDeliveredRequest 수신 확인 요청
+type MarkAsDeliveredRequest struct {
+	UpToSeq uint64 `json:"up_to_seq" binding:"required"` // 이 순번까지 수신함
+}
+
 // CreateChatRoom 채팅방 생성 또는 기존 채팅방 가져오기
 // POST /api/v1/chats/rooms
 func (ctrl *ChatController) CreateChatRoom(c *gin.Context) {
@@ -90,7 +101,7 @@ func (ctrl *ChatController) CreateChatRoom(c *gin.Context) {
 	}
 
 	// 채팅방 생성 또는 가져오기
-	room, isNew, err := ctrl.chatService.CreateOrGetChatRoom(userID, req.TargetUserID, req.Type, resourceID)
+	room, isNew, err := ctrl.chatService.CreateOrGetChatRoom(c.Request.Context(), userID, req.TargetUserID, req.Type, resourceID)
 	if err != nil {
 		log.Error("Failed to create chat room", err)
 		errors.InternalError(c, "채팅방 생성에 실패했습니다")
@@ -121,7 +132,7 @@ func (ctrl *ChatController) GetChatRooms(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
 
-	rooms, total, err := ctrl.chatService.GetUserChatRooms(userID, page, pageSize)
+	rooms, total, err := ctrl.chatService.GetUserChatRooms(c.Request.Context(), userID, page, pageSize)
 	if err != nil {
 		log.Error("Failed to get chat rooms", err)
 		errors.InternalError(c, "채팅방 목록 조회에 실패했습니다")
@@ -153,7 +164,7 @@ func (ctrl *ChatController) GetChatRoom(c *gin.Context) {
 		return
 	}
 
-	room, err := ctrl.chatService.GetChatRoom(uint(roomID), userID)
+	room, err := ctrl.chatService.GetChatRoom(c.Request.Context(), uint(roomID), userID)
 	if err != nil {
 		if err.Error() == "unauthorized access to chat room" {
 			errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
@@ -171,6 +182,7 @@ func (ctrl *ChatController) GetChatRoom(c *gin.Context) {
 
 // GetMessages 채팅방의 메시지 목록 조회
 // GET /api/v1/chats/rooms/:id/messages
+// after_seq가 있으면 해당 순번 이후 메시지를 page_size개까지 반환 (재연결 후 누락분 동기화)
 func (ctrl *ChatController) GetMessages(c *gin.Context) {
 	log := middleware.GetLoggerFromContext(c)
 	userID, ok := middleware.GetUserID(c)
@@ -188,7 +200,36 @@ func (ctrl *ChatController) GetMessages(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "50"))
 
-	messages, total, err := ctrl.chatService.GetChatRoomMessages(uint(roomID), userID, page, pageSize)
+	if afterSeqParam := c.Query("after_seq"); afterSeqParam != "" {
+		afterSeq, err := strconv.ParseUint(afterSeqParam, 10, 64)
+		if err != nil {
+			errors.BadRequest(c, errors.ValidationInvalidInput, "잘못된 메시지 순번입니다")
+			return
+		}
+		if pageSize <= 0 || pageSize > 200 {
+			pageSize = 50
+		}
+
+		messages, err := ctrl.chatService.GetMessagesAfterSeq(c.Request.Context(), uint(roomID), userID, afterSeq, pageSize)
+		if err != nil {
+			if err.Error() == "unauthorized access to chat room" {
+				errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
+				return
+			}
+			log.Error("Failed to get messages after seq", err)
+			errors.InternalError(c, "메시지 조회에 실패했습니다")
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"messages":  messages,
+			"after_seq": afterSeq,
+			"has_more":  len(messages) == pageSize,
+		})
+		return
+	}
+
+	messages, total, err := ctrl.chatService.GetChatRoomMessages(c.Request.Context(), uint(roomID), userID, page, pageSize)
 	if err != nil {
 		if err.Error() == "unauthorized access to chat room" {
 			errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
@@ -231,7 +272,11 @@ func (ctrl *ChatController) SendMessage(c *gin.Context) {
 		return
 	}
 
-	message, err := ctrl.chatService.SendMessageWithFile(uint(roomID), userID, req.Content, req.MessageType, req.FileURL, req.FileName)
+	if req.FileURL != "" && !verifyAttachments(c, ctrl.uploadService, service.ChatFileUploadPolicy, req.FileURL) {
+		return
+	}
+
+	message, err := ctrl.chatService.SendMessageWithFile(c.Request.Context(), uint(roomID), userID, req.Content, req.MessageType, req.FileURL, req.FileName)
 	if err != nil {
 		if err.Error() == "unauthorized access to chat room" {
 			errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
@@ -254,6 +299,7 @@ func (ctrl *ChatController) SendMessage(c *gin.Context) {
 
 // MarkAsRead 채팅방을 읽음 처리
 // POST /api/v1/chats/rooms/:id/read
+// 본문에 up_to_seq가 있으면 해당 순번까지만 읽음 처리
 func (ctrl *ChatController) MarkAsRead(c *gin.Context) {
 	log := middleware.GetLoggerFromContext(c)
 	userID, ok := middleware.GetUserID(c)
@@ -268,7 +314,15 @@ func (ctrl *ChatController) MarkAsRead(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.chatService.MarkChatRoomAsRead(uint(roomID), userID); err != nil {
+	var req MarkAsReadRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			errors.BadRequest(c, errors.ValidationInvalidInput, "입력값이 올바르지 않습니다")
+			return
+		}
+	}
+
+	if err := ctrl.chatService.MarkChatRoomAsRead(c.Request.Context(), uint(roomID), userID, req.UpToSeq); err != nil {
 		if err.Error() == "unauthorized access to chat room" {
 			errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
 			return
@@ -287,6 +341,43 @@ func (ctrl *ChatController) MarkAsRead(c *gin.Context) {
 	})
 }
 
+// MarkAsDelivered 메시지 수신 확인 (WebSocket으로 받지 못한 경우, 예: 푸시 알림)
+// POST /api/v1/chats/rooms/:id/delivered
+func (ctrl *ChatController) MarkAsDelivered(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		errors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		errors.BadRequest(c, errors.ValidationInvalidID, "잘못된 채팅방 ID입니다")
+		return
+	}
+
+	var req MarkAsDeliveredRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		errors.BadRequest(c, errors.ValidationInvalidInput, "입력값이 올바르지 않습니다")
+		return
+	}
+
+	if err := ctrl.chatService.MarkMessagesDelivered(c.Request.Context(), uint(roomID), userID, req.UpToSeq); err != nil {
+		if err.Error() == "unauthorized access to chat room" {
+			errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
+			return
+		}
+		log.Error("Failed to mark as delivered", err)
+		errors.InternalError(c, "수신 확인 처리에 실패했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+	})
+}
+
 // WebSocketHandler WebSocket 연결 처리
 // GET /api/v1/chats/ws
 // 쿼리 파라미터로 토큰을 받지만, 로깅하지 않음 (보안)
@@ -306,14 +397,7 @@ func (ctrl *ChatController) WebSocketHandler(c *gin.Context) {
 		return
 	}
 
-	client := &ws.Client{
-		Hub:           ctrl.hub,
-		Conn:          &ws.Conn{Conn: conn},
-		UserID:        userID,
-		Send:          make(chan []byte, 2048), // 256 → 2048 (8배 증가, 네트워크 느린 클라이언트 대응)
-		ChatRooms:     make(map[uint]bool),
-		LastResetTime: time.Now(),
-	}
+	client := ws.NewClient(c.Request.Context(), ctrl.hub, &ws.Conn{Conn: conn}, userID)
 
 	ctrl.hub.Register(client)
 
@@ -342,7 +426,7 @@ func (ctrl *ChatController) JoinRoom(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.chatService.JoinChatRoom(userID, uint(roomID)); err != nil {
+	if err := ctrl.chatService.JoinChatRoom(c.Request.Context(), userID, uint(roomID)); err != nil {
 		if err.Error() == "unauthorized access to chat room" {
 			errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
 			return
@@ -377,7 +461,7 @@ func (ctrl *ChatController) LeaveRoom(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.chatService.LeaveChatRoom(userID, uint(roomID)); err != nil {
+	if err := ctrl.chatService.LeaveChatRoom(c.Request.Context(), userID, uint(roomID)); err != nil {
 		log.Error("Failed to leave room", err)
 		errors.InternalError(c, "채팅방 나가기에 실패했습니다")
 		return
@@ -411,7 +495,7 @@ func (ctrl *ChatController) SearchMessages(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
 
-	messages, total, err := ctrl.chatService.SearchMessages(userID, keyword, page, pageSize)
+	messages, total, err := ctrl.chatService.SearchMessages(c.Request.Context(), userID, keyword, page, pageSize)
 	if err != nil {
 		log.Error("Failed to search messages", err)
 		errors.InternalError(c, "메시지 검색에 실패했습니다")
@@ -460,7 +544,7 @@ func (ctrl *ChatController) UpdateMessage(c *gin.Context) {
 		return
 	}
 
-	message, err := ctrl.chatService.UpdateMessage(uint(messageID), userID, req.Content)
+	message, err := ctrl.chatService.UpdateMessage(c.Request.Context(), uint(messageID), userID, req.Content)
 	if err != nil {
 		if err.Error() == "unauthorized to update this message" {
 			errors.Forbidden(c, "해당 메시지를 수정할 권한이 없습니다")
@@ -484,6 +568,63 @@ func (ctrl *ChatController) UpdateMessage(c *gin.Context) {
 	})
 }
 
+// GetMessageFile 메시지 첨부 파일을 내려받을 URL 발급 (채팅방 참여자만, 비공개 파일은 짧은 유효 시간)
+// GET /api/v1/chats/rooms/:id/messages/:messageId/file
+func (ctrl *ChatController) GetMessageFile(c *gin.Context) {
+	log := middleware.GetLoggerFromContext(c)
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		errors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	roomID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		errors.BadRequest(c, errors.ValidationInvalidID, "잘못된 채팅방 ID입니다")
+		return
+	}
+	messageID, err := strconv.ParseUint(c.Param("messageId"), 10, 32)
+	if err != nil {
+		errors.BadRequest(c, errors.ValidationInvalidID, "잘못된 메시지 ID입니다")
+		return
+	}
+
+	message, err := ctrl.chatService.GetMessage(c.Request.Context(), uint(roomID), uint(messageID), userID)
+	switch err {
+	case nil:
+	case service.ErrChatAccessDenied:
+		errors.Forbidden(c, "해당 채팅방에 접근할 권한이 없습니다")
+		return
+	case service.ErrChatMessageNotFound:
+		errors.NotFound(c, errors.ChatMessageNotFound, "메시지를 찾을 수 없습니다")
+		return
+	default:
+		log.Error("Failed to get message", err)
+		errors.InternalError(c, "메시지 조회에 실패했습니다")
+		return
+	}
+
+	if message.IsDeleted || message.FileURL == "" {
+		errors.NotFound(c, errors.ChatMessageNotFound, "첨부 파일이 없습니다")
+		return
+	}
+
+	access, err := ctrl.uploadService.AccessURL(message.FileURL)
+	if err != nil {
+		log.Error("Failed to sign chat file url", err, map[string]interface{}{
+			"message_id": messageID,
+		})
+		errors.InternalError(c, "파일 URL 발급에 실패했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"url":        access.URL,
+		"expires_at": access.ExpiresAt,
+		"file_name":  message.FileName,
+	})
+}
+
 // DeleteMessage 메시지 삭제
 // DELETE /api/v1/chats/rooms/:id/messages/:messageId
 func (ctrl *ChatController) DeleteMessage(c *gin.Context) {
@@ -500,7 +641,7 @@ func (ctrl *ChatController) DeleteMessage(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.chatService.DeleteMessage(uint(messageID), userID); err != nil {
+	if err := ctrl.chatService.DeleteMessage(c.Request.Context(), uint(messageID), userID); err != nil {
 		if err.Error() == "unauthorized to delete this message" {
 			errors.Forbidden(c, "해당 메시지를 삭제할 권한이 없습니다")
 			return
@@ -14,15 +14,17 @@ import (
 
 // CommunityController 커뮤니티 컨트롤러
 type CommunityController struct {
-	service   service.CommunityService
-	aiService service.AIService
+	service       service.CommunityService
+	aiService     service.AIService
+	uploadService service.UploadService
 }
 
 // NewCommunityController 커뮤니티 컨트롤러 생성자
-func NewCommunityController(service service.CommunityService, aiService service.AIService) *CommunityController {
+func NewCommunityController(service service.CommunityService, aiService service.AIService, uploadService service.UploadService) *CommunityController {
 	return &CommunityController{
-		service:   service,
-		aiService: aiService,
+		service:       service,
+		aiService:     aiService,
+		uploadService: uploadService,
 	}
 }
 
@@ -60,7 +62,11 @@ func (c *CommunityController) CreatePost(ctx *gin.Context) {
 		return
 	}
 
-	post, err := c.service.CreatePost(&req, userID.(uint), userRole.(model.UserRole))
+	if !verifyAttachments(ctx, c.uploadService, service.ImageUploadPolicy, req.ImageURLs...) {
+		return
+	}
+
+	post, err := c.service.CreatePost(ctx.Request.Context(), &req, userID.(uint), userRole.(model.UserRole))
 	if err != nil {
 		apperrors.BadRequest(ctx, apperrors.PostEditFailed, "게시글 작성에 실패했습니다")
 		return
@@ -93,7 +99,7 @@ func (c *CommunityController) GetPost(ctx *gin.Context) {
 		userID = &u
 	}
 
-	post, isLiked, err := c.service.GetPost(uint(id), userID)
+	post, isLiked, err := c.service.GetPost(ctx.Request.Context(), uint(id), userID)
 	if err != nil {
 		apperrors.NotFound(ctx, apperrors.PostNotFound, "게시글을 찾을 수 없습니다")
 		return
@@ -138,7 +144,7 @@ func (c *CommunityController) GetPosts(ctx *gin.Context) {
 		userID = &u
 	}
 
-	posts, total, err := c.service.GetPosts(&query, userID)
+	posts, total, err := c.service.GetPosts(ctx.Request.Context(), &query, userID)
 	if err != nil {
 		apperrors.InternalError(ctx, "게시글 목록 조회에 실패했습니다")
 		return
@@ -200,7 +206,16 @@ func (c *CommunityController) UpdatePost(ctx *gin.Context) {
 		return
 	}
 
-	post, err := c.service.UpdatePost(uint(id), &req, userID.(uint), userRole.(model.UserRole))
+	// 게시글이 없으면 UpdatePost에서 처리
+	var existing []string
+	if post, err := c.service.GetPostByID(ctx.Request.Context(), uint(id)); err == nil {
+		existing = post.ImageURLs
+	}
+	if !verifyAttachments(ctx, c.uploadService, service.ImageUploadPolicy, addedURLs(req.ImageURLs, existing...)...) {
+		return
+	}
+
+	post, err := c.service.UpdatePost(ctx.Request.Context(), uint(id), &req, userID.(uint), userRole.(model.UserRole))
 	if err != nil {
 		if err.Error() == "permission denied" {
 			apperrors.Forbidden(ctx, "게시글 수정 권한이 없습니다")
@@ -244,7 +259,7 @@ func (c *CommunityController) DeletePost(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.DeletePost(uint(id), userID.(uint), userRole.(model.UserRole)); err != nil {
+	if err := c.service.DeletePost(ctx.Request.Context(), uint(id), userID.(uint), userRole.(model.UserRole)); err != nil {
 		logger.Warn("Failed to delete post", map[string]interface{}{
 			"post_id": uint(id),
 			"user_id": userID.(uint),
@@ -290,7 +305,7 @@ func (c *CommunityController) CreateComment(ctx *gin.Context) {
 		return
 	}
 
-	comment, err := c.service.CreateComment(&req, userID.(uint))
+	comment, err := c.service.CreateComment(ctx.Request.Context(), &req, userID.(uint))
 	if err != nil {
 		apperrors.BadRequest(ctx, apperrors.CommentDeleteFailed, "댓글 작성에 실패했습니다")
 		return
@@ -327,7 +342,7 @@ func (c *CommunityController) GetComments(ctx *gin.Context) {
 		userID = &u
 	}
 
-	comments, total, err := c.service.GetComments(&query, userID)
+	comments, total, err := c.service.GetComments(ctx.Request.Context(), &query, userID)
 	if err != nil {
 		apperrors.InternalError(ctx, "댓글 목록 조회에 실패했습니다")
 		return
@@ -389,7 +404,7 @@ func (c *CommunityController) UpdateComment(ctx *gin.Context) {
 		return
 	}
 
-	comment, err := c.service.UpdateComment(uint(id), &req, userID.(uint), userRole.(model.UserRole))
+	comment, err := c.service.UpdateComment(ctx.Request.Context(), uint(id), &req, userID.(uint), userRole.(model.UserRole))
 	if err != nil {
 		if err.Error() == "permission denied" {
 			apperrors.Forbidden(ctx, "댓글 수정 권한이 없습니다")
@@ -433,7 +448,7 @@ func (c *CommunityController) DeleteComment(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.DeleteComment(uint(id), userID.(uint), userRole.(model.UserRole)); err != nil {
+	if err := c.service.DeleteComment(ctx.Request.Context(), uint(id), userID.(uint), userRole.(model.UserRole)); err != nil {
 		logger.Warn("Failed to delete comment", map[string]interface{}{
 			"comment_id": uint(id),
 			"user_id":    userID.(uint),
@@ -478,7 +493,7 @@ func (c *CommunityController) TogglePostLike(ctx *gin.Context) {
 		return
 	}
 
-	isLiked, err := c.service.TogglePostLike(uint(id), userID.(uint))
+	isLiked, err := c.service.TogglePostLike(ctx.Request.Context(), uint(id), userID.(uint))
 	if err != nil {
 		apperrors.BadRequest(ctx, apperrors.PostNotFound, "게시글 좋아요 처리에 실패했습니다")
 		return
@@ -503,7 +518,7 @@ func (c *CommunityController) GetUserLikedPosts(ctx *gin.Context) {
 		return
 	}
 
-	posts, err := c.service.GetUserLikedPosts(userID.(uint))
+	posts, err := c.service.GetUserLikedPosts(ctx.Request.Context(), userID.(uint))
 	if err != nil {
 		apperrors.InternalError(ctx, "관심글 목록 조회에 실패했습니다")
 		return
@@ -541,7 +556,7 @@ func (c *CommunityController) ToggleCommentLike(ctx *gin.Context) {
 		return
 	}
 
-	isLiked, err := c.service.ToggleCommentLike(uint(id), userID.(uint))
+	isLiked, err := c.service.ToggleCommentLike(ctx.Request.Context(), uint(id), userID.(uint))
 	if err != nil {
 		apperrors.BadRequest(ctx, apperrors.CommentNotFound, "댓글 좋아요 처리에 실패했습니다")
 		return
@@ -585,7 +600,7 @@ func (c *CommunityController) AcceptAnswer(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.AcceptAnswer(uint(postID), uint(commentID), userID.(uint)); err != nil {
+	if err := c.service.AcceptAnswer(ctx.Request.Context(), uint(postID), uint(commentID), userID.(uint)); err != nil {
 		logger.Warn("Failed to accept answer", map[string]interface{}{
 			"post_id":    uint(postID),
 			"comment_id": uint(commentID),
@@ -631,7 +646,7 @@ func (c *CommunityController) PinPost(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.PinPost(uint(id), userID.(uint)); err != nil {
+	if err := c.service.PinPost(ctx.Request.Context(), uint(id), userID.(uint)); err != nil {
 		logger.Warn("Failed to pin post", map[string]interface{}{
 			"post_id": uint(id),
 			"user_id": userID.(uint),
@@ -680,7 +695,7 @@ func (c *CommunityController) UnpinPost(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.UnpinPost(uint(id), userID.(uint)); err != nil {
+	if err := c.service.UnpinPost(ctx.Request.Context(), uint(id), userID.(uint)); err != nil {
 		logger.Warn("Failed to unpin post", map[string]interface{}{
 			"post_id": uint(id),
 			"user_id": userID.(uint),
@@ -725,7 +740,7 @@ func (c *CommunityController) GetStoreGallery(ctx *gin.Context) {
 		pageSize = 20
 	}
 
-	gallery, total, err := c.service.GetStoreGallery(uint(storeID), page, pageSize)
+	gallery, total, err := c.service.GetStoreGallery(ctx.Request.Context(), uint(storeID), page, pageSize)
 	if err != nil {
 		apperrors.InternalError(ctx, "매장 갤러리 조회에 실패했습니다")
 		return
@@ -766,7 +781,7 @@ func (c *CommunityController) GenerateContent(ctx *gin.Context) {
 	}
 
 	// AI 서비스 호출
-	versions, err := c.aiService.GenerateContent(&req)
+	versions, err := c.aiService.GenerateContent(ctx.Request.Context(), &req)
 	if err != nil {
 		apperrors.InternalError(ctx, "AI 컨텐츠 생성에 실패했습니다")
 		return
@@ -816,7 +831,7 @@ func (c *CommunityController) ReservePost(ctx *gin.Context) {
 	}
 
 	// 예약 처리
-	if err := c.service.ReservePost(uint(postID), req.ReservedByUserID, userID.(uint)); err != nil {
+	if err := c.service.ReservePost(ctx.Request.Context(), uint(postID), req.ReservedByUserID, userID.(uint)); err != nil {
 		logger.Warn("Failed to reserve post", map[string]interface{}{
 			"post_id":             uint(postID),
 			"reserved_by_user_id": req.ReservedByUserID,
@@ -860,7 +875,7 @@ func (c *CommunityController) CancelReservation(ctx *gin.Context) {
 	}
 
 	// 예약 취소 처리
-	if err := c.service.CancelReservation(uint(postID), userID.(uint)); err != nil {
+	if err := c.service.CancelReservation(ctx.Request.Context(), uint(postID), userID.(uint)); err != nil {
 		logger.Warn("Failed to cancel reservation", map[string]interface{}{
 			"post_id": uint(postID),
 			"user_id": userID.(uint),
@@ -903,7 +918,7 @@ func (c *CommunityController) CompleteTransaction(ctx *gin.Context) {
 	}
 
 	// 거래 완료 처리
-	if err := c.service.CompleteTransaction(uint(postID), userID.(uint)); err != nil {
+	if err := c.service.CompleteTransaction(ctx.Request.Context(), uint(postID), userID.(uint)); err != nil {
 		logger.Warn("Failed to complete transaction", map[string]interface{}{
 			"post_id": uint(postID),
 			"user_id": userID.(uint),
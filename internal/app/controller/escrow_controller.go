@@ -0,0 +1,254 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	apperrors "github.com/ikkim/udonggeum-backend/internal/errors"
+	"github.com/ikkim/udonggeum-backend/internal/middleware"
+)
+
+// EscrowController 금 판매글 안전거래 컨트롤러
+type EscrowController struct {
+	service service.EscrowService
+}
+
+// NewEscrowController 안전거래 컨트롤러 생성자
+func NewEscrowController(service service.EscrowService) *EscrowController {
+	return &EscrowController{
+		service: service,
+	}
+}
+
+// GetEscrow godoc
+// @Summary 안전거래 조회
+// @Description 게시글의 가장 최근 안전거래와 상태 변경 이력을 조회합니다 (구매자, 판매자, 마스터만)
+// @Tags escrow
+// @Produce json
+// @Param id path int true "게시글 ID"
+// @Success 200 {object} gin.H{data=model.Escrow}
+// @Failure 403 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/community/posts/{id}/escrow [get]
+func (c *EscrowController) GetEscrow(ctx *gin.Context) {
+	postID, ok := parseEscrowPostID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+	userRole, _ := middleware.GetUserRole(ctx)
+
+	escrow, err := c.service.GetByPostID(ctx.Request.Context(), postID, userID, userRole)
+	if err != nil {
+		respondEscrowError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"data": escrow})
+}
+
+// StartEscrow godoc
+// @Summary 안전거래 시작
+// @Description 구매자가 금 판매글에 대해 안전거래를 시작합니다. 결제 준비 후 게시글이 예약 상태가 되며, 응답의 redirect URL로 결제를 진행합니다
+// @Tags escrow
+// @Accept json
+// @Produce json
+// @Param id path int true "게시글 ID"
+// @Param request body model.CreateEscrowRequest true "안전거래 요청"
+// @Success 201 {object} model.EscrowReadyResponse
+// @Failure 400 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/community/posts/{id}/escrow [post]
+func (c *EscrowController) StartEscrow(ctx *gin.Context) {
+	log := middleware.GetLoggerFromContext(ctx)
+
+	postID, ok := parseEscrowPostID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	var req model.CreateEscrowRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidInput, "잘못된 요청 형식입니다")
+		return
+	}
+
+	resp, err := c.service.Start(ctx.Request.Context(), postID, userID, &req)
+	if err != nil {
+		log.Error("Failed to start escrow", err, map[string]interface{}{
+			"post_id": postID,
+			"user_id": userID,
+		})
+		respondEscrowError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+// ConfirmHandover godoc
+// @Summary 판매자 인계 확인
+// @Description 판매자가 구매자에게 금을 인계했음을 확인합니다 (결제 완료 상태에서만 가능)
+// @Tags escrow
+// @Produce json
+// @Param id path int true "게시글 ID"
+// @Success 200 {object} gin.H{data=model.Escrow}
+// @Failure 403 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/community/posts/{id}/escrow/handover [post]
+func (c *EscrowController) ConfirmHandover(ctx *gin.Context) {
+	postID, ok := parseEscrowPostID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	escrow, err := c.service.ConfirmHandover(ctx.Request.Context(), postID, userID)
+	if err != nil {
+		respondEscrowError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "인계가 확인되었습니다",
+		"data":    escrow,
+	})
+}
+
+// ConfirmReceipt godoc
+// @Summary 구매자 수령 확인
+// @Description 구매자가 금 수령을 확인하면 보관 중인 대금이 판매자에게 지급되고 거래가 완료됩니다
+// @Tags escrow
+// @Produce json
+// @Param id path int true "게시글 ID"
+// @Success 200 {object} gin.H{data=model.Escrow}
+// @Failure 403 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/community/posts/{id}/escrow/receipt [post]
+func (c *EscrowController) ConfirmReceipt(ctx *gin.Context) {
+	postID, ok := parseEscrowPostID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	escrow, err := c.service.ConfirmReceipt(ctx.Request.Context(), postID, userID)
+	if err != nil {
+		respondEscrowError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "거래가 완료되었습니다",
+		"data":    escrow,
+	})
+}
+
+// CancelEscrow godoc
+// @Summary 안전거래 취소
+// @Description 진행 중인 안전거래를 취소합니다. 결제 완료 건은 전액 환불되며, 인계 이후에는 판매자 또는 마스터만 취소할 수 있습니다
+// @Tags escrow
+// @Accept json
+// @Produce json
+// @Param id path int true "게시글 ID"
+// @Param request body model.CancelEscrowRequest false "취소 사유"
+// @Success 200 {object} gin.H{data=model.Escrow}
+// @Failure 403 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/community/posts/{id}/escrow/cancel [post]
+func (c *EscrowController) CancelEscrow(ctx *gin.Context) {
+	log := middleware.GetLoggerFromContext(ctx)
+
+	postID, ok := parseEscrowPostID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+	userRole, _ := middleware.GetUserRole(ctx)
+
+	// 본문은 선택 사항
+	var req model.CancelEscrowRequest
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			apperrors.BadRequest(ctx, apperrors.ValidationInvalidInput, "잘못된 요청 형식입니다")
+			return
+		}
+	}
+
+	escrow, err := c.service.Cancel(ctx.Request.Context(), postID, userID, userRole, req.Reason)
+	if err != nil {
+		log.Error("Failed to cancel escrow", err, map[string]interface{}{
+			"post_id": postID,
+			"user_id": userID,
+		})
+		respondEscrowError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "안전거래가 취소되었습니다",
+		"data":    escrow,
+	})
+}
+
+func parseEscrowPostID(ctx *gin.Context) (uint, bool) {
+	postID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidID, "잘못된 게시글 ID입니다")
+		return 0, false
+	}
+	return uint(postID), true
+}
+
+// respondEscrowError 안전거래 서비스 에러를 응답으로 변환 (결제 에러는 결제 응답으로 위임)
+func respondEscrowError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrEscrowNotFound):
+		apperrors.NotFound(ctx, apperrors.EscrowNotFound, "안전거래 정보를 찾을 수 없습니다")
+	case errors.Is(err, service.ErrEscrowAccessDenied):
+		apperrors.Forbidden(ctx, "안전거래 접근 권한이 없습니다")
+	case errors.Is(err, service.ErrEscrowInvalidState):
+		apperrors.Conflict(ctx, apperrors.EscrowInvalidState, "현재 안전거래 상태에서는 처리할 수 없습니다")
+	case errors.Is(err, service.ErrEscrowInvalidPost):
+		apperrors.BadRequest(ctx, apperrors.EscrowInvalidPost, "안전거래를 진행할 수 없는 게시글입니다")
+	case errors.Is(err, service.ErrEscrowPostUnavailable):
+		apperrors.Conflict(ctx, apperrors.EscrowPostUnavailable, "이미 예약되었거나 거래가 완료된 게시글입니다")
+	default:
+		respondPaymentError(ctx, err)
+	}
+}
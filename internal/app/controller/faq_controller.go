@@ -22,7 +22,7 @@ func (c *FAQController) GetFAQs(ctx *gin.Context) {
 	target := ctx.Query("target")
 
 	if target == "user" || target == "owner" {
-		faqs, err := c.faqService.GetByTarget(model.FAQTarget(target))
+		faqs, err := c.faqService.GetByTarget(ctx.Request.Context(), model.FAQTarget(target))
 		if err != nil {
 			ctx.JSON(http.StatusInternalServerError, gin.H{"error": "FAQ 조회에 실패했습니다"})
 			return
@@ -31,7 +31,7 @@ func (c *FAQController) GetFAQs(ctx *gin.Context) {
 		return
 	}
 
-	faqs, err := c.faqService.GetAll()
+	faqs, err := c.faqService.GetAll(ctx.Request.Context())
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "FAQ 조회에 실패했습니다"})
 		return
@@ -61,7 +61,7 @@ func (c *FAQController) CreateFAQ(ctx *gin.Context) {
 		SortOrder: req.SortOrder,
 	}
 
-	if err := c.faqService.Create(faq); err != nil {
+	if err := c.faqService.Create(ctx.Request.Context(), faq); err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "FAQ 생성에 실패했습니다"})
 		return
 	}
@@ -89,7 +89,7 @@ func (c *FAQController) UpdateFAQ(ctx *gin.Context) {
 		return
 	}
 
-	faq, err := c.faqService.Update(uint(id), req.Question, req.Answer, req.SortOrder)
+	faq, err := c.faqService.Update(ctx.Request.Context(), uint(id), req.Question, req.Answer, req.SortOrder)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "FAQ 수정에 실패했습니다"})
 		return
@@ -106,7 +106,7 @@ func (c *FAQController) DeleteFAQ(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.faqService.Delete(uint(id)); err != nil {
+	if err := c.faqService.Delete(ctx.Request.Context(), uint(id)); err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": "FAQ 삭제에 실패했습니다"})
 		return
 	}
@@ -1,6 +1,7 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -50,7 +51,7 @@ type UpdateGoldPriceRequest struct {
 // @Failure 500 {object} map[string]interface{}
 // @Router /api/gold-prices/latest [get]
 func (ctrl *GoldPriceController) GetLatestPrices(c *gin.Context) {
-	prices, err := ctrl.goldPriceService.GetLatestPrices()
+	prices, err := ctrl.goldPriceService.GetLatestPrices(c.Request.Context())
 	if err != nil {
 		apperrors.InternalError(c, "금 시세 정보를 가져오는데 실패했습니다")
 		return
@@ -83,7 +84,7 @@ func (ctrl *GoldPriceController) GetPriceByType(c *gin.Context) {
 		return
 	}
 
-	price, err := ctrl.goldPriceService.GetPriceByType(priceType)
+	price, err := ctrl.goldPriceService.GetPriceByType(c.Request.Context(), priceType)
 	if err != nil {
 		logger.Warn("Failed to get gold price", map[string]interface{}{
 			"price_type": priceType,
@@ -126,7 +127,7 @@ func (ctrl *GoldPriceController) GetPriceHistory(c *gin.Context) {
 		return
 	}
 
-	history, err := ctrl.goldPriceService.GetPriceHistory(priceType, period)
+	history, err := ctrl.goldPriceService.GetPriceHistory(c.Request.Context(), priceType, period)
 	if err != nil {
 		apperrors.InternalError(c, "금 시세 이력을 가져오는데 실패했습니다")
 		return
@@ -138,6 +139,47 @@ func (ctrl *GoldPriceController) GetPriceHistory(c *gin.Context) {
 	})
 }
 
+// GetCandles 구간별 시세 캔들 조회
+// @Summary 금 시세 캔들 (OHLC)
+// @Description 일/주/월 구간별 매입가·매도가 시가/고가/저가/종가와 5·20 구간 종가 이동평균을 조회합니다 (KST 기준)
+// @Tags gold-price
+// @Accept json
+// @Produce json
+// @Param type path string true "금 유형 (24K, 18K, 14K, Platinum, Silver)"
+// @Param interval query string false "구간 (1d, 1w, 1M)" default(1d)
+// @Param from query string false "시작일 (YYYY-MM-DD, 기본값: 구간별 90일/1년/5년 전)"
+// @Param to query string false "종료일 (YYYY-MM-DD, 포함, 기본값: 오늘)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /api/gold-prices/candles/{type} [get]
+func (ctrl *GoldPriceController) GetCandles(c *gin.Context) {
+	priceType := model.GoldPriceType(c.Param("type"))
+	interval := model.GoldPriceCandleInterval(c.DefaultQuery("interval", string(model.CandleIntervalDay)))
+
+	// 유효한 금 유형인지 확인
+	if !isValidGoldPriceType(priceType) {
+		apperrors.BadRequest(c, apperrors.GoldInvalidType, "잘못된 금 종류입니다")
+		return
+	}
+
+	candles, err := ctrl.goldPriceService.GetCandles(c.Request.Context(), priceType, interval, c.Query("from"), c.Query("to"))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidCandleInterval) || errors.Is(err, service.ErrInvalidDateRange) {
+			apperrors.BadRequest(c, apperrors.ValidationInvalidInput, err.Error())
+			return
+		}
+		apperrors.InternalError(c, "금 시세 캔들을 가져오는데 실패했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success":  true,
+		"data":     candles,
+		"interval": interval,
+	})
+}
+
 // UpdateFromExternalAPI 외부 API에서 금 시세 업데이트
 // @Summary 외부 API로부터 금 시세 업데이트
 // @Description 외부 금 시세 API를 호출하여 최신 시세로 업데이트합니다 (관리자 전용)
@@ -150,7 +192,7 @@ func (ctrl *GoldPriceController) GetPriceHistory(c *gin.Context) {
 // @Failure 500 {object} map[string]interface{}
 // @Router /api/gold-prices/update [post]
 func (ctrl *GoldPriceController) UpdateFromExternalAPI(c *gin.Context) {
-	err := ctrl.goldPriceService.UpdatePricesFromExternalAPI()
+	count, err := ctrl.goldPriceService.UpdatePricesFromExternalAPI(c.Request.Context())
 	if err != nil {
 		apperrors.RespondWithError(c, http.StatusInternalServerError, apperrors.InternalExternalAPI, "외부 API에서 금 시세를 업데이트하는데 실패했습니다")
 		return
@@ -159,6 +201,38 @@ func (ctrl *GoldPriceController) UpdateFromExternalAPI(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"success": true,
 		"message": "금 시세가 성공적으로 업데이트되었습니다",
+		"data": gin.H{
+			"updated_count": count,
+		},
+	})
+}
+
+// ListUpdateRuns 금 시세 자동 수집 실행 기록 조회 (관리자 전용)
+// @Summary 금 시세 자동 수집 실행 기록
+// @Description 스케줄러의 실행 시각, 시도 횟수, 저장된 시세 수, 오류를 최신순으로 조회합니다 (관리자 전용)
+// @Tags gold-price
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "페이지" default(1)
+// @Param page_size query int false "페이지 크기 (최대 100)" default(20)
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/admin/gold-prices/runs [get]
+func (ctrl *GoldPriceController) ListUpdateRuns(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+
+	runs, total, err := ctrl.goldPriceService.ListUpdateRuns(c.Request.Context(), page, pageSize)
+	if err != nil {
+		apperrors.InternalError(c, "금 시세 수집 기록을 가져오는데 실패했습니다")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"success": true,
+		"data":    runs,
+		"total":   total,
 	})
 }
 
@@ -196,7 +270,7 @@ func (ctrl *GoldPriceController) CreatePrice(c *gin.Context) {
 		Description: req.Description,
 	}
 
-	if err := ctrl.goldPriceService.CreatePrice(goldPrice); err != nil {
+	if err := ctrl.goldPriceService.CreatePrice(c.Request.Context(), goldPrice); err != nil {
 		apperrors.InternalError(c, "금 시세를 생성하는데 실패했습니다")
 		return
 	}
@@ -236,7 +310,7 @@ func (ctrl *GoldPriceController) UpdatePrice(c *gin.Context) {
 	}
 
 	// 기존 데이터 조회
-	goldPrice, err := ctrl.goldPriceService.GetPriceByID(uint(id))
+	goldPrice, err := ctrl.goldPriceService.GetPriceByID(c.Request.Context(), uint(id))
 	if err != nil {
 		apperrors.NotFound(c, "GOLD_PRICE_NOT_FOUND", "금 시세를 찾을 수 없습니다")
 		return
@@ -256,7 +330,7 @@ func (ctrl *GoldPriceController) UpdatePrice(c *gin.Context) {
 		goldPrice.Description = *req.Description
 	}
 
-	if err := ctrl.goldPriceService.UpdatePrice(goldPrice); err != nil {
+	if err := ctrl.goldPriceService.UpdatePrice(c.Request.Context(), goldPrice); err != nil {
 		apperrors.InternalError(c, "금 시세를 업데이트하는데 실패했습니다")
 		return
 	}
@@ -299,7 +373,7 @@ func (ctrl *GoldPriceController) ImportHistoricalData(c *gin.Context) {
 		return
 	}
 
-	count, err := ctrl.goldPriceService.ImportHistoricalDataFromKRX(req.StartDate, req.EndDate)
+	count, err := ctrl.goldPriceService.ImportHistoricalDataFromKRX(c.Request.Context(), req.StartDate, req.EndDate)
 	if err != nil {
 		apperrors.RespondWithError(c, http.StatusInternalServerError, apperrors.InternalExternalAPI,
 			"KRX API에서 과거 데이터를 가져오는데 실패했습니다: "+err.Error())
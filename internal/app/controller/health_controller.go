@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/health"
+)
+
+type HealthController struct {
+	liveness  *health.Checker
+	readiness *health.Checker
+}
+
+// NewHealthController liveness는 프로세스가 응답하는지, readiness는 트래픽을 받을 수 있는지 점검
+func NewHealthController(liveness, readiness *health.Checker) *HealthController {
+	return &HealthController{liveness: liveness, readiness: readiness}
+}
+
+// Live GET /health/live (실패하면 재시작 대상)
+func (c *HealthController) Live(ctx *gin.Context) {
+	respondHealth(ctx, c.liveness.Run(ctx.Request.Context()))
+}
+
+// Ready GET /health/ready (실패하면 트래픽 제외 대상)
+func (c *HealthController) Ready(ctx *gin.Context) {
+	respondHealth(ctx, c.readiness.Run(ctx.Request.Context()))
+}
+
+// respondHealth 필수 점검이 실패한 경우에만 503 (degraded는 200)
+func respondHealth(ctx *gin.Context, report health.Report) {
+	status := http.StatusOK
+	if report.Status == health.StatusFail {
+		status = http.StatusServiceUnavailable
+	}
+	ctx.Header("Cache-Control", "no-store")
+	ctx.JSON(status, report)
+}
@@ -32,7 +32,7 @@ func NewNotificationController(service service.NotificationService) *Notificatio
 // @Produce json
 // @Param page query int false "페이지 번호" default(1)
 // @Param page_size query int false "페이지 크기" default(20)
-// @Param type query string false "알림 타입 (new_sell_post, post_comment, store_liked)"
+// @Param type query string false "알림 타입 (new_sell_post, post_comment, store_liked, price_alert)"
 // @Param is_read query bool false "읽음 상태"
 // @Success 200 {object} gin.H{data=[]model.Notification,total=int,page=int,page_size=int,unread_count=int}
 // @Failure 401 {object} gin.H
@@ -67,6 +67,7 @@ func (c *NotificationController) GetNotifications(ctx *gin.Context) {
 	}
 
 	notifications, total, unreadCount, err := c.service.GetNotifications(
+		ctx.Request.Context(),
 		userID.(uint),
 		notifType,
 		isRead,
@@ -104,7 +105,7 @@ func (c *NotificationController) GetUnreadCount(ctx *gin.Context) {
 		return
 	}
 
-	count, err := c.service.GetUnreadCount(userID.(uint))
+	count, err := c.service.GetUnreadCount(ctx.Request.Context(), userID.(uint))
 	if err != nil {
 		apperrors.InternalError(ctx, "안읽은 알림 개수를 조회하는 중 오류가 발생했습니다")
 		return
@@ -141,7 +142,7 @@ func (c *NotificationController) MarkAsRead(ctx *gin.Context) {
 		return
 	}
 
-	notification, err := c.service.MarkAsRead(uint(id), userID.(uint))
+	notification, err := c.service.MarkAsRead(ctx.Request.Context(), uint(id), userID.(uint))
 	if err != nil {
 		if err.Error() == "unauthorized" {
 			apperrors.Forbidden(ctx, "해당 알림에 대한 권한이 없습니다")
@@ -173,7 +174,7 @@ func (c *NotificationController) MarkAllAsRead(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.MarkAllAsRead(userID.(uint)); err != nil {
+	if err := c.service.MarkAllAsRead(ctx.Request.Context(), userID.(uint)); err != nil {
 		logger.Warn("Failed to mark all notifications as read", map[string]interface{}{
 			"user_id": userID.(uint),
 			"error":   err.Error(),
@@ -214,7 +215,7 @@ func (c *NotificationController) DeleteNotification(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.DeleteNotification(uint(id), userID.(uint)); err != nil {
+	if err := c.service.DeleteNotification(ctx.Request.Context(), uint(id), userID.(uint)); err != nil {
 		logger.Warn("Failed to delete notification", map[string]interface{}{
 			"notification_id": uint(id),
 			"user_id":         userID.(uint),
@@ -251,7 +252,7 @@ func (c *NotificationController) GetNotificationSettings(ctx *gin.Context) {
 		return
 	}
 
-	settings, err := c.service.GetNotificationSettings(userID.(uint))
+	settings, err := c.service.GetNotificationSettings(ctx.Request.Context(), userID.(uint))
 	if err != nil {
 		apperrors.InternalError(ctx, "알림 설정을 조회하는 중 오류가 발생했습니다")
 		return
@@ -287,7 +288,7 @@ func (c *NotificationController) UpdateNotificationSettings(ctx *gin.Context) {
 		return
 	}
 
-	settings, err := c.service.UpdateNotificationSettings(userID.(uint), &req)
+	settings, err := c.service.UpdateNotificationSettings(ctx.Request.Context(), userID.(uint), &req)
 	if err != nil {
 		apperrors.InternalError(ctx, "알림 설정을 수정하는 중 오류가 발생했습니다")
 		return
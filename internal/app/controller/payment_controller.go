@@ -0,0 +1,285 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	apperrors "github.com/ikkim/udonggeum-backend/internal/errors"
+	"github.com/ikkim/udonggeum-backend/internal/middleware"
+)
+
+// PaymentController 결제 컨트롤러
+type PaymentController struct {
+	service service.PaymentService
+}
+
+// NewPaymentController 결제 컨트롤러 생성자
+func NewPaymentController(service service.PaymentService) *PaymentController {
+	return &PaymentController{
+		service: service,
+	}
+}
+
+// ReadyPayment godoc
+// @Summary 결제 준비
+// @Description 주문을 생성하고 카카오페이 결제 준비를 요청합니다. 응답의 redirect URL로 사용자를 이동시킵니다
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param request body model.CreatePaymentRequest true "결제 준비 요청"
+// @Success 201 {object} model.PaymentReadyResponse
+// @Failure 400 {object} gin.H
+// @Failure 401 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/payments/ready [post]
+func (c *PaymentController) ReadyPayment(ctx *gin.Context) {
+	log := middleware.GetLoggerFromContext(ctx)
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	var req model.CreatePaymentRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidInput, "잘못된 요청 형식입니다")
+		return
+	}
+
+	resp, err := c.service.Ready(ctx.Request.Context(), userID, &req)
+	if err != nil {
+		log.Error("Failed to ready payment", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		respondPaymentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, resp)
+}
+
+// KakaoPaySuccess godoc
+// @Summary 카카오페이 결제 승인 콜백
+// @Description 카카오페이 결제 완료 후 리다이렉트되는 URL입니다. pg_token으로 결제를 승인합니다
+// @Tags payments
+// @Produce json
+// @Param order_id query string true "주문번호"
+// @Param pg_token query string true "결제 승인 토큰"
+// @Success 200 {object} gin.H{data=model.Payment}
+// @Failure 400 {object} gin.H
+// @Router /api/v1/payments/kakao/success [get]
+func (c *PaymentController) KakaoPaySuccess(ctx *gin.Context) {
+	log := middleware.GetLoggerFromContext(ctx)
+
+	orderID := ctx.Query("order_id")
+	pgToken := ctx.Query("pg_token")
+	if orderID == "" || pgToken == "" {
+		apperrors.BadRequest(ctx, apperrors.ValidationRequired, "주문번호와 결제 승인 토큰이 필요합니다")
+		return
+	}
+
+	payment, err := c.service.Approve(ctx.Request.Context(), orderID, pgToken)
+	if err != nil {
+		log.Error("Failed to approve payment", err, map[string]interface{}{
+			"order_id": orderID,
+		})
+		respondPaymentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "결제가 완료되었습니다",
+		"data":    payment,
+	})
+}
+
+// KakaoPayFail godoc
+// @Summary 카카오페이 결제 실패 콜백
+// @Tags payments
+// @Produce json
+// @Param order_id query string true "주문번호"
+// @Success 200 {object} gin.H{data=model.Payment}
+// @Router /api/v1/payments/kakao/fail [get]
+func (c *PaymentController) KakaoPayFail(ctx *gin.Context) {
+	orderID := ctx.Query("order_id")
+	if orderID == "" {
+		apperrors.BadRequest(ctx, apperrors.ValidationRequired, "주문번호가 필요합니다")
+		return
+	}
+
+	payment, err := c.service.Fail(ctx.Request.Context(), orderID, "kakaopay fail callback")
+	if err != nil {
+		respondPaymentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "결제에 실패했습니다",
+		"data":    payment,
+	})
+}
+
+// KakaoPayCancel godoc
+// @Summary 카카오페이 결제 취소 콜백
+// @Description 사용자가 카카오페이 결제창에서 결제를 취소한 경우 호출됩니다
+// @Tags payments
+// @Produce json
+// @Param order_id query string true "주문번호"
+// @Success 200 {object} gin.H{data=model.Payment}
+// @Router /api/v1/payments/kakao/cancel [get]
+func (c *PaymentController) KakaoPayCancel(ctx *gin.Context) {
+	orderID := ctx.Query("order_id")
+	if orderID == "" {
+		apperrors.BadRequest(ctx, apperrors.ValidationRequired, "주문번호가 필요합니다")
+		return
+	}
+
+	payment, err := c.service.Abort(ctx.Request.Context(), orderID)
+	if err != nil {
+		respondPaymentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "결제가 취소되었습니다",
+		"data":    payment,
+	})
+}
+
+// GetMyPayments godoc
+// @Summary 내 결제 목록
+// @Tags payments
+// @Produce json
+// @Param page query int false "페이지 번호" default(1)
+// @Param page_size query int false "페이지 크기" default(20)
+// @Success 200 {object} gin.H{data=[]model.Payment,total=int,page=int,page_size=int}
+// @Security BearerAuth
+// @Router /api/v1/payments [get]
+func (c *PaymentController) GetMyPayments(ctx *gin.Context) {
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "20"))
+
+	payments, total, err := c.service.GetUserPayments(ctx.Request.Context(), userID, page, pageSize)
+	if err != nil {
+		apperrors.InternalError(ctx, "결제 목록 조회에 실패했습니다")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"data":      payments,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// GetPayment godoc
+// @Summary 결제 상세
+// @Tags payments
+// @Produce json
+// @Param id path int true "결제 ID"
+// @Success 200 {object} gin.H{data=model.Payment}
+// @Failure 403 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/payments/{id} [get]
+func (c *PaymentController) GetPayment(ctx *gin.Context) {
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+	userRole, _ := middleware.GetUserRole(ctx)
+
+	paymentID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidID, "잘못된 결제 ID입니다")
+		return
+	}
+
+	payment, err := c.service.GetPayment(ctx.Request.Context(), uint(paymentID), userID, userRole)
+	if err != nil {
+		respondPaymentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"data": payment})
+}
+
+// CancelPayment godoc
+// @Summary 결제 취소
+// @Description 승인 전 결제는 취소 처리하고, 승인 완료된 결제는 전액 환불합니다
+// @Tags payments
+// @Produce json
+// @Param id path int true "결제 ID"
+// @Success 200 {object} gin.H{data=model.Payment}
+// @Failure 400 {object} gin.H
+// @Failure 403 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/payments/{id}/cancel [post]
+func (c *PaymentController) CancelPayment(ctx *gin.Context) {
+	log := middleware.GetLoggerFromContext(ctx)
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+	userRole, _ := middleware.GetUserRole(ctx)
+
+	paymentID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidID, "잘못된 결제 ID입니다")
+		return
+	}
+
+	payment, err := c.service.Cancel(ctx.Request.Context(), uint(paymentID), userID, userRole)
+	if err != nil {
+		log.Error("Failed to cancel payment", err, map[string]interface{}{
+			"payment_id": paymentID,
+			"user_id":    userID,
+		})
+		respondPaymentError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message": "결제가 취소되었습니다",
+		"data":    payment,
+	})
+}
+
+// respondPaymentError 결제 서비스 에러를 응답으로 변환
+func respondPaymentError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPaymentNotFound):
+		apperrors.NotFound(ctx, apperrors.PaymentNotFound, "결제 정보를 찾을 수 없습니다")
+	case errors.Is(err, service.ErrPaymentAccessDenied):
+		apperrors.Forbidden(ctx, "결제 접근 권한이 없습니다")
+	case errors.Is(err, service.ErrPaymentInvalidState):
+		apperrors.Conflict(ctx, apperrors.PaymentInvalidState, "현재 결제 상태에서는 처리할 수 없습니다")
+	case errors.Is(err, service.ErrPaymentEscrowManaged):
+		apperrors.Conflict(ctx, apperrors.PaymentInvalidState, "안전거래 결제는 안전거래 화면에서 취소해주세요")
+	case errors.Is(err, service.ErrPaymentInvalidTarget):
+		apperrors.BadRequest(ctx, apperrors.PaymentInvalidTarget, "결제 대상이 올바르지 않습니다")
+	case errors.Is(err, service.ErrStoreNotFound):
+		apperrors.NotFound(ctx, apperrors.StoreNotFound, "매장을 찾을 수 없습니다")
+	case errors.Is(err, service.ErrPaymentUnavailable):
+		apperrors.RespondWithError(ctx, http.StatusServiceUnavailable, apperrors.PaymentUnavailable, "결제 서비스를 사용할 수 없습니다")
+	case errors.Is(err, service.ErrPaymentGatewayFailed), errors.Is(err, service.ErrPaymentAmountMismatch):
+		apperrors.RespondWithError(ctx, http.StatusBadGateway, apperrors.PaymentFailed, "결제 처리에 실패했습니다")
+	default:
+		apperrors.InternalError(ctx, "결제 처리 중 오류가 발생했습니다")
+	}
+}
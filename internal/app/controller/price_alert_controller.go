@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	apperrors "github.com/ikkim/udonggeum-backend/internal/errors"
+	"github.com/ikkim/udonggeum-backend/internal/middleware"
+)
+
+// PriceAlertController 금 시세 알림 컨트롤러
+type PriceAlertController struct {
+	service service.PriceAlertService
+}
+
+// NewPriceAlertController 금 시세 알림 컨트롤러 생성자
+func NewPriceAlertController(service service.PriceAlertService) *PriceAlertController {
+	return &PriceAlertController{
+		service: service,
+	}
+}
+
+// ListPriceAlerts godoc
+// @Summary 내 시세 알림 목록
+// @Description 등록한 금 시세 알림 규칙 목록을 조회합니다
+// @Tags price-alerts
+// @Produce json
+// @Success 200 {object} gin.H{data=[]model.PriceAlert}
+// @Failure 401 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/users/me/price-alerts [get]
+func (c *PriceAlertController) ListPriceAlerts(ctx *gin.Context) {
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	alerts, err := c.service.ListAlerts(ctx.Request.Context(), userID)
+	if err != nil {
+		apperrors.InternalError(ctx, "시세 알림 목록을 조회하는 중 오류가 발생했습니다")
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"data": alerts})
+}
+
+// CreatePriceAlert godoc
+// @Summary 시세 알림 등록
+// @Description 금 종류별로 기준 가격 돌파(threshold) 또는 전일 대비 변동률(percent_change) 알림을 등록합니다
+// @Tags price-alerts
+// @Accept json
+// @Produce json
+// @Param request body model.CreatePriceAlertRequest true "시세 알림 규칙"
+// @Success 201 {object} gin.H{data=model.PriceAlert}
+// @Failure 400 {object} gin.H
+// @Failure 409 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/users/me/price-alerts [post]
+func (c *PriceAlertController) CreatePriceAlert(ctx *gin.Context) {
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	var req model.CreatePriceAlertRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidInput, "잘못된 요청 형식입니다")
+		return
+	}
+
+	alert, err := c.service.CreateAlert(ctx.Request.Context(), userID, &req)
+	if err != nil {
+		respondPriceAlertError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{"data": alert})
+}
+
+// UpdatePriceAlert godoc
+// @Summary 시세 알림 수정
+// @Description 방향, 기준값, 활성화 여부를 수정합니다
+// @Tags price-alerts
+// @Accept json
+// @Produce json
+// @Param id path int true "알림 ID"
+// @Param request body model.UpdatePriceAlertRequest true "수정할 항목"
+// @Success 200 {object} gin.H{data=model.PriceAlert}
+// @Failure 404 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/users/me/price-alerts/{id} [patch]
+func (c *PriceAlertController) UpdatePriceAlert(ctx *gin.Context) {
+	alertID, ok := parsePriceAlertID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	var req model.UpdatePriceAlertRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidInput, "잘못된 요청 형식입니다")
+		return
+	}
+
+	alert, err := c.service.UpdateAlert(ctx.Request.Context(), alertID, userID, &req)
+	if err != nil {
+		respondPriceAlertError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"data": alert})
+}
+
+// DeletePriceAlert godoc
+// @Summary 시세 알림 삭제
+// @Tags price-alerts
+// @Produce json
+// @Param id path int true "알림 ID"
+// @Success 200 {object} gin.H
+// @Failure 404 {object} gin.H
+// @Security BearerAuth
+// @Router /api/v1/users/me/price-alerts/{id} [delete]
+func (c *PriceAlertController) DeletePriceAlert(ctx *gin.Context) {
+	alertID, ok := parsePriceAlertID(ctx)
+	if !ok {
+		return
+	}
+
+	userID, exists := middleware.GetUserID(ctx)
+	if !exists {
+		apperrors.Unauthorized(ctx, "로그인이 필요합니다")
+		return
+	}
+
+	if err := c.service.DeleteAlert(ctx.Request.Context(), alertID, userID); err != nil {
+		respondPriceAlertError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "시세 알림이 삭제되었습니다"})
+}
+
+func parsePriceAlertID(ctx *gin.Context) (uint, bool) {
+	alertID, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		apperrors.BadRequest(ctx, apperrors.ValidationInvalidID, "잘못된 알림 ID입니다")
+		return 0, false
+	}
+	return uint(alertID), true
+}
+
+// respondPriceAlertError 시세 알림 서비스 에러를 응답으로 변환
+func respondPriceAlertError(ctx *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPriceAlertNotFound):
+		apperrors.NotFound(ctx, apperrors.PriceAlertNotFound, "시세 알림을 찾을 수 없습니다")
+	case errors.Is(err, service.ErrPriceAlertLimitExceeded):
+		apperrors.Conflict(ctx, apperrors.PriceAlertLimitExceeded, err.Error())
+	default:
+		apperrors.InternalError(ctx, "시세 알림 처리 중 오류가 발생했습니다")
+	}
+}
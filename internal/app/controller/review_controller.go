@@ -12,11 +12,13 @@ import (
 
 type ReviewController struct {
 	reviewService *service.ReviewService
+	uploadService service.UploadService
 }
 
-func NewReviewController(reviewService *service.ReviewService) *ReviewController {
+func NewReviewController(reviewService *service.ReviewService, uploadService service.UploadService) *ReviewController {
 	return &ReviewController{
 		reviewService: reviewService,
+		uploadService: uploadService,
 	}
 }
 
@@ -49,7 +51,11 @@ func (ctrl *ReviewController) CreateReview(c *gin.Context) {
 		return
 	}
 
-	review, err := ctrl.reviewService.CreateReview(userID.(uint), input)
+	if !verifyAttachments(c, ctrl.uploadService, service.ImageUploadPolicy, input.ImageURLs...) {
+		return
+	}
+
+	review, err := ctrl.reviewService.CreateReview(c.Request.Context(), userID.(uint), input)
 	if err != nil {
 		logger.Warn("Failed to create review", map[string]interface{}{
 			"user_id": userID.(uint),
@@ -86,7 +92,7 @@ func (ctrl *ReviewController) GetStoreReviews(c *gin.Context) {
 	sortBy := c.DefaultQuery("sort_by", "created_at")
 	sortOrder := c.DefaultQuery("sort_order", "desc")
 
-	reviews, total, err := ctrl.reviewService.GetStoreReviews(uint(storeID), page, pageSize, sortBy, sortOrder)
+	reviews, total, err := ctrl.reviewService.GetStoreReviews(c.Request.Context(), uint(storeID), page, pageSize, sortBy, sortOrder)
 	if err != nil {
 		logger.Warn("Failed to get store reviews", map[string]interface{}{
 			"store_id": uint(storeID),
@@ -125,7 +131,7 @@ func (ctrl *ReviewController) GetUserReviews(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
 
-	reviews, total, err := ctrl.reviewService.GetUserReviews(userID.(uint), page, pageSize)
+	reviews, total, err := ctrl.reviewService.GetUserReviews(c.Request.Context(), userID.(uint), page, pageSize)
 	if err != nil {
 		logger.Warn("Failed to get user reviews", map[string]interface{}{
 			"user_id": userID.(uint),
@@ -180,7 +186,16 @@ func (ctrl *ReviewController) UpdateReview(c *gin.Context) {
 		return
 	}
 
-	review, err := ctrl.reviewService.UpdateReview(uint(reviewID), userID.(uint), input)
+	// 리뷰가 없으면 UpdateReview에서 처리
+	var existing []string
+	if review, err := ctrl.reviewService.GetReview(c.Request.Context(), uint(reviewID)); err == nil {
+		existing = review.ImageURLs
+	}
+	if !verifyAttachments(c, ctrl.uploadService, service.ImageUploadPolicy, addedURLs(input.ImageURLs, existing...)...) {
+		return
+	}
+
+	review, err := ctrl.reviewService.UpdateReview(c.Request.Context(), uint(reviewID), userID.(uint), input)
 	if err != nil {
 		logger.Warn("Failed to update review", map[string]interface{}{
 			"review_id": uint(reviewID),
@@ -219,7 +234,7 @@ func (ctrl *ReviewController) DeleteReview(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.reviewService.DeleteReview(uint(reviewID), userID.(uint), isAdmin); err != nil {
+	if err := ctrl.reviewService.DeleteReview(c.Request.Context(), uint(reviewID), userID.(uint), isAdmin); err != nil {
 		logger.Warn("Failed to delete review", map[string]interface{}{
 			"review_id": uint(reviewID),
 			"user_id":   userID.(uint),
@@ -254,7 +269,7 @@ func (ctrl *ReviewController) ToggleReviewLike(c *gin.Context) {
 		return
 	}
 
-	isLiked, err := ctrl.reviewService.ToggleReviewLike(uint(reviewID), userID.(uint))
+	isLiked, err := ctrl.reviewService.ToggleReviewLike(c.Request.Context(), uint(reviewID), userID.(uint))
 	if err != nil {
 		logger.Warn("Failed to toggle review like", map[string]interface{}{
 			"review_id": uint(reviewID),
@@ -283,7 +298,7 @@ func (ctrl *ReviewController) GetStoreStatistics(c *gin.Context) {
 		return
 	}
 
-	stats, err := ctrl.reviewService.GetStoreStatistics(uint(storeID))
+	stats, err := ctrl.reviewService.GetStoreStatistics(c.Request.Context(), uint(storeID))
 	if err != nil {
 		logger.Warn("Failed to get store statistics", map[string]interface{}{
 			"store_id": uint(storeID),
@@ -316,7 +331,7 @@ func (ctrl *ReviewController) GetStoreGallery(c *gin.Context) {
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
 
-	gallery, total, err := ctrl.reviewService.GetStoreGallery(uint(storeID), page, pageSize)
+	gallery, total, err := ctrl.reviewService.GetStoreGallery(c.Request.Context(), uint(storeID), page, pageSize)
 	if err != nil {
 		logger.Warn("Failed to get store gallery", map[string]interface{}{
 			"store_id": uint(storeID),
@@ -18,13 +18,15 @@ type StoreController struct {
 	storeService  service.StoreService
 	authService   service.AuthService
 	reviewService *service.ReviewService
+	uploadService service.UploadService
 }
 
-func NewStoreController(storeService service.StoreService, authService service.AuthService, reviewService *service.ReviewService) *StoreController {
+func NewStoreController(storeService service.StoreService, authService service.AuthService, reviewService *service.ReviewService, uploadService service.UploadService) *StoreController {
 	return &StoreController{
 		storeService:  storeService,
 		authService:   authService,
 		reviewService: reviewService,
+		uploadService: uploadService,
 	}
 }
 
@@ -138,7 +140,7 @@ func (ctrl *StoreController) ListStores(c *gin.Context) {
 		PageSize:   pageSize,
 	}
 
-	result, err := ctrl.storeService.ListStores(opts)
+	result, err := ctrl.storeService.ListStores(c.Request.Context(), opts)
 	if err != nil {
 		log.Error("Failed to list stores", err, nil)
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -154,7 +156,7 @@ func (ctrl *StoreController) ListStores(c *gin.Context) {
 	}
 
 	if userID, exists := middleware.GetUserID(c); exists {
-		likedStoreIDs, err := ctrl.storeService.GetUserLikedStoreIDs(userID)
+		likedStoreIDs, err := ctrl.storeService.GetUserLikedStoreIDs(c.Request.Context(), userID)
 		if err == nil {
 			// 좋아요한 매장 ID를 맵으로 변환
 			likedMap := make(map[uint]bool)
@@ -224,7 +226,7 @@ func (ctrl *StoreController) GetStoreByID(c *gin.Context) {
 		return
 	}
 
-	store, err := ctrl.storeService.GetStoreByID(uint(id))
+	store, err := ctrl.storeService.GetStoreByID(c.Request.Context(), uint(id))
 	if err != nil {
 		if err == service.ErrStoreNotFound {
 			log.Warn("Store not found", map[string]interface{}{
@@ -241,7 +243,7 @@ func (ctrl *StoreController) GetStoreByID(c *gin.Context) {
 	}
 
 	// 리뷰 통계 가져오기
-	reviewStats, err := ctrl.reviewService.GetStoreStatistics(uint(id))
+	reviewStats, err := ctrl.reviewService.GetStoreStatistics(c.Request.Context(), uint(id))
 	if err != nil {
 		log.Warn("Failed to fetch review statistics", map[string]interface{}{
 			"store_id": id,
@@ -262,7 +264,7 @@ func (ctrl *StoreController) GetStoreByID(c *gin.Context) {
 
 	// 선택적으로 사용자 좋아요 상태 포함
 	if userID, exists := middleware.GetUserID(c); exists {
-		isLiked, err := ctrl.storeService.IsStoreLiked(uint(id), userID)
+		isLiked, err := ctrl.storeService.IsStoreLiked(c.Request.Context(), uint(id), userID)
 		if err == nil {
 			response["is_liked"] = isLiked
 		}
@@ -286,7 +288,7 @@ func (ctrl *StoreController) CreateStore(c *gin.Context) {
 	}
 
 	// 휴대폰 인증 확인
-	user, err := ctrl.authService.GetUserByID(userID)
+	user, err := ctrl.authService.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
 		log.Error("Failed to get user", err, map[string]interface{}{
 			"user_id": userID,
@@ -304,7 +306,7 @@ func (ctrl *StoreController) CreateStore(c *gin.Context) {
 	}
 
 	// 한 사용자당 하나의 매장만 허용
-	existingStores, err := ctrl.storeService.GetStoresByUserID(userID)
+	existingStores, err := ctrl.storeService.GetStoresByUserID(c.Request.Context(), userID)
 	if err == nil && len(existingStores) > 0 {
 		log.Warn("User already owns a store", map[string]interface{}{
 			"user_id":         userID,
@@ -323,8 +325,12 @@ func (ctrl *StoreController) CreateStore(c *gin.Context) {
 		return
 	}
 
+	if req.ImageURL != "" && !verifyAttachments(c, ctrl.uploadService, service.ImageUploadPolicy, req.ImageURL) {
+		return
+	}
+
 	// 1. 사업자등록번호 중복 확인
-	existingStore, err := ctrl.storeService.GetStoreByBusinessNumber(req.BusinessNumber)
+	existingStore, err := ctrl.storeService.GetStoreByBusinessNumber(c.Request.Context(), req.BusinessNumber)
 	if err != nil {
 		log.Error("Failed to check business number duplication", err, map[string]interface{}{
 			"business_number": req.BusinessNumber,
@@ -349,6 +355,7 @@ func (ctrl *StoreController) CreateStore(c *gin.Context) {
 	})
 
 	verificationResult, err := util.VerifyBusinessNumber(
+		c.Request.Context(),
 		req.BusinessNumber,
 		req.BusinessStartDate,
 		req.RepresentativeName,
@@ -412,7 +419,7 @@ func (ctrl *StoreController) CreateStore(c *gin.Context) {
 		},
 	}
 
-	created, err := ctrl.storeService.CreateStore(store)
+	created, err := ctrl.storeService.CreateStore(c.Request.Context(), store)
 	if err != nil {
 		log.Error("Failed to create store", err, map[string]interface{}{
 			"user_id": userID,
@@ -423,7 +430,7 @@ func (ctrl *StoreController) CreateStore(c *gin.Context) {
 	}
 
 	// 4. 사용자를 admin으로 승격 (필수)
-	err = ctrl.storeService.PromoteUserToAdmin(userID)
+	err = ctrl.storeService.PromoteUserToAdmin(c.Request.Context(), userID)
 	if err != nil {
 		log.Error("Failed to promote user to admin", err, map[string]interface{}{
 			"user_id":  userID,
@@ -431,7 +438,7 @@ func (ctrl *StoreController) CreateStore(c *gin.Context) {
 		})
 
 		// 권한 승격 실패 시 생성된 매장 삭제 (롤백)
-		if deleteErr := ctrl.storeService.DeleteStore(userID, created.ID); deleteErr != nil {
+		if deleteErr := ctrl.storeService.DeleteStore(c.Request.Context(), userID, created.ID); deleteErr != nil {
 			log.Error("Failed to rollback store creation", deleteErr, map[string]interface{}{
 				"user_id":  userID,
 				"store_id": created.ID,
@@ -485,7 +492,18 @@ func (ctrl *StoreController) UpdateStore(c *gin.Context) {
 		return
 	}
 
-	updated, err := ctrl.storeService.UpdateStore(userID, uint(storeID), service.StoreMutation{
+	if req.ImageURL != nil {
+		// 매장이 없으면 UpdateStore에서 처리
+		var existing string
+		if store, err := ctrl.storeService.GetStoreByID(c.Request.Context(), uint(storeID)); err == nil {
+			existing = store.ImageURL
+		}
+		if !verifyAttachments(c, ctrl.uploadService, service.ImageUploadPolicy, addedURLs([]string{*req.ImageURL}, existing)...) {
+			return
+		}
+	}
+
+	updated, err := ctrl.storeService.UpdateStore(c.Request.Context(), userID, uint(storeID), service.StoreMutation{
 		Name:        req.Name,
 		Region:      req.Region,
 		District:    req.District,
@@ -556,7 +574,7 @@ func (ctrl *StoreController) DeleteStore(c *gin.Context) {
 		return
 	}
 
-	if err := ctrl.storeService.DeleteStore(userID, uint(storeID)); err != nil {
+	if err := ctrl.storeService.DeleteStore(c.Request.Context(), userID, uint(storeID)); err != nil {
 		switch err {
 		case service.ErrStoreNotFound:
 			log.Warn("Cannot delete store: not found", map[string]interface{}{
@@ -593,7 +611,7 @@ func (ctrl *StoreController) DeleteStore(c *gin.Context) {
 func (ctrl *StoreController) ListLocations(c *gin.Context) {
 	log := middleware.GetLoggerFromContext(c)
 
-	locations, err := ctrl.storeService.ListLocations()
+	locations, err := ctrl.storeService.ListLocations(c.Request.Context())
 	if err != nil {
 		log.Error("Failed to list store locations", err)
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -641,7 +659,7 @@ func (ctrl *StoreController) ToggleStoreLike(c *gin.Context) {
 	}
 
 	// 좋아요 토글
-	isLiked, err := ctrl.storeService.ToggleStoreLike(uint(storeID), userID)
+	isLiked, err := ctrl.storeService.ToggleStoreLike(c.Request.Context(), uint(storeID), userID)
 	if err != nil {
 		if err == service.ErrStoreNotFound {
 			log.Warn("Store not found for like toggle", map[string]interface{}{
@@ -684,7 +702,7 @@ func (ctrl *StoreController) GetUserLikedStores(c *gin.Context) {
 		return
 	}
 
-	stores, err := ctrl.storeService.GetUserLikedStores(userID)
+	stores, err := ctrl.storeService.GetUserLikedStores(c.Request.Context(), userID)
 	if err != nil {
 		log.Error("Failed to get user liked stores", err, map[string]interface{}{
 			"user_id": userID,
@@ -732,7 +750,7 @@ func (ctrl *StoreController) RequestStoreRegistration(c *gin.Context) {
 	}
 
 	// 매장등록 요청
-	requestCount, hasRequested, err := ctrl.storeService.RequestStoreRegistration(uint(storeID), userID)
+	requestCount, hasRequested, err := ctrl.storeService.RequestStoreRegistration(c.Request.Context(), uint(storeID), userID)
 	if err != nil {
 		if err == service.ErrStoreNotFound {
 			log.Warn("Store not found for registration request", map[string]interface{}{
@@ -786,7 +804,7 @@ func (ctrl *StoreController) GetStoreRegistrationStatus(c *gin.Context) {
 	}
 
 	// 요청 수 조회
-	requestCount, err := ctrl.storeService.GetStoreRegistrationRequestCount(uint(storeID))
+	requestCount, err := ctrl.storeService.GetStoreRegistrationRequestCount(c.Request.Context(), uint(storeID))
 	if err != nil {
 		log.Error("Failed to get registration request count", err, map[string]interface{}{
 			"store_id": storeID,
@@ -798,7 +816,7 @@ func (ctrl *StoreController) GetStoreRegistrationStatus(c *gin.Context) {
 	// 사용자가 로그인한 경우 본인 요청 여부 확인
 	hasRequested := false
 	if userID, exists := middleware.GetUserID(c); exists {
-		hasRequested, _ = ctrl.storeService.HasUserRequestedRegistration(uint(storeID), userID)
+		hasRequested, _ = ctrl.storeService.HasUserRequestedRegistration(c.Request.Context(), uint(storeID), userID)
 	}
 
 	c.JSON(http.StatusOK, gin.H{
@@ -818,7 +836,7 @@ func (ctrl *StoreController) GetMyStore(c *gin.Context) {
 		return
 	}
 
-	stores, err := ctrl.storeService.GetStoresByUserID(userID)
+	stores, err := ctrl.storeService.GetStoresByUserID(c.Request.Context(), userID)
 	if err != nil {
 		log.Error("Failed to get my store", err, map[string]interface{}{
 			"user_id": userID,
@@ -858,7 +876,7 @@ func (ctrl *StoreController) UpdateMyStore(c *gin.Context) {
 	}
 
 	// 사용자의 매장 찾기
-	stores, err := ctrl.storeService.GetStoresByUserID(userID)
+	stores, err := ctrl.storeService.GetStoresByUserID(c.Request.Context(), userID)
 	if err != nil {
 		log.Error("Failed to get my store for update", err, map[string]interface{}{
 			"user_id": userID,
@@ -887,7 +905,11 @@ func (ctrl *StoreController) UpdateMyStore(c *gin.Context) {
 		return
 	}
 
-	updated, err := ctrl.storeService.UpdateStore(userID, storeID, service.StoreMutation{
+	if req.ImageURL != nil && !verifyAttachments(c, ctrl.uploadService, service.ImageUploadPolicy, addedURLs([]string{*req.ImageURL}, stores[0].ImageURL)...) {
+		return
+	}
+
+	updated, err := ctrl.storeService.UpdateStore(c.Request.Context(), userID, storeID, service.StoreMutation{
 		Name:        req.Name,
 		Region:      req.Region,
 		District:    req.District,
@@ -956,7 +978,7 @@ func (ctrl *StoreController) ClaimStore(c *gin.Context) {
 	}
 
 	// 휴대폰 인증 확인
-	user, err := ctrl.authService.GetUserByID(userID)
+	user, err := ctrl.authService.GetUserByID(c.Request.Context(), userID)
 	if err != nil {
 		log.Error("Failed to get user", err, map[string]interface{}{
 			"user_id": userID,
@@ -974,7 +996,7 @@ func (ctrl *StoreController) ClaimStore(c *gin.Context) {
 	}
 
 	// 한 사용자당 하나의 매장만 허용
-	existingStores, err := ctrl.storeService.GetStoresByUserID(userID)
+	existingStores, err := ctrl.storeService.GetStoresByUserID(c.Request.Context(), userID)
 	if err == nil && len(existingStores) > 0 {
 		log.Warn("User already owns a store", map[string]interface{}{
 			"user_id":         userID,
@@ -1005,7 +1027,7 @@ func (ctrl *StoreController) ClaimStore(c *gin.Context) {
 	}
 
 	// 1. 매장 존재 확인 및 이미 관리 중인지 확인
-	store, err := ctrl.storeService.GetStoreByID(uint(storeID))
+	store, err := ctrl.storeService.GetStoreByID(c.Request.Context(), uint(storeID))
 	if err != nil {
 		log.Warn("Store not found for claim", map[string]interface{}{
 			"store_id": storeID,
@@ -1045,7 +1067,7 @@ func (ctrl *StoreController) ClaimStore(c *gin.Context) {
 	}
 
 	// 2. 사업자등록번호 중복 확인
-	existingStore, err := ctrl.storeService.GetStoreByBusinessNumber(req.BusinessNumber)
+	existingStore, err := ctrl.storeService.GetStoreByBusinessNumber(c.Request.Context(), req.BusinessNumber)
 	if err != nil {
 		log.Error("Failed to check business number duplication", err, map[string]interface{}{
 			"business_number": req.BusinessNumber,
@@ -1073,6 +1095,7 @@ func (ctrl *StoreController) ClaimStore(c *gin.Context) {
 	})
 
 	verificationResult, err := util.VerifyBusinessNumber(
+		c.Request.Context(),
 		req.BusinessNumber,
 		req.BusinessStartDate,
 		req.RepresentativeName,
@@ -1124,7 +1147,7 @@ func (ctrl *StoreController) ClaimStore(c *gin.Context) {
 	}
 
 	// Store 업데이트 + User 승격을 하나의 트랜잭션으로 처리
-	updated, err := ctrl.storeService.ClaimStoreTransaction(store, userID)
+	updated, err := ctrl.storeService.ClaimStoreTransaction(c.Request.Context(), store, userID)
 	if err != nil {
 		log.Error("Failed to claim store in transaction", err, map[string]interface{}{
 			"store_id": storeID,
@@ -1151,7 +1174,7 @@ func (ctrl *StoreController) ClaimStore(c *gin.Context) {
 
 // SubmitVerificationRequest 매장 인증 신청 요청 (2단계)
 type SubmitVerificationRequest struct {
-	BusinessLicenseURL string `json:"business_license_url" binding:"required"` // 사업자등록증 이미지 URL (S3)
+	BusinessLicenseURL string `json:"business_license_url" binding:"required"` // 사업자등록증 파일 URL (/upload/license/presigned-url로 업로드)
 }
 
 // SubmitVerification 매장 인증 신청 (2단계 검증)
@@ -1174,8 +1197,13 @@ func (ctrl *StoreController) SubmitVerification(c *gin.Context) {
 		return
 	}
 
+	// 사업자등록증은 비공개 폴더에 업로드한 파일만 허용
+	if !verifyAttachments(c, ctrl.uploadService, service.BusinessLicenseUploadPolicy, req.BusinessLicenseURL) {
+		return
+	}
+
 	// 1. 사용자의 매장 확인
-	store, err := ctrl.storeService.GetStoreByUserID(userID)
+	store, err := ctrl.storeService.GetStoreByUserID(c.Request.Context(), userID)
 	if err != nil {
 		log.Warn("User does not have a store", map[string]interface{}{
 			"user_id": userID,
@@ -1200,7 +1228,7 @@ func (ctrl *StoreController) SubmitVerification(c *gin.Context) {
 
 	// 3. 기존 인증 확인 및 처리
 	now := time.Now()
-	existingVerification, _ := ctrl.storeService.GetVerificationByStoreID(store.ID)
+	existingVerification, _ := ctrl.storeService.GetVerificationByStoreID(c.Request.Context(), store.ID)
 
 	if existingVerification != nil {
 		// 이미 인증이 있는 경우
@@ -1232,7 +1260,7 @@ func (ctrl *StoreController) SubmitVerification(c *gin.Context) {
 			existingVerification.IPAddress = c.ClientIP()
 			existingVerification.UserAgent = c.Request.UserAgent()
 
-			if err := ctrl.storeService.UpdateVerification(existingVerification); err != nil {
+			if err := ctrl.storeService.UpdateVerification(c.Request.Context(), existingVerification); err != nil {
 				log.Error("Failed to resubmit verification", err, map[string]interface{}{
 					"store_id":        store.ID,
 					"verification_id": existingVerification.ID,
@@ -1273,7 +1301,7 @@ func (ctrl *StoreController) SubmitVerification(c *gin.Context) {
 		UserAgent:          c.Request.UserAgent(),
 	}
 
-	created, err := ctrl.storeService.CreateVerification(verification)
+	created, err := ctrl.storeService.CreateVerification(c.Request.Context(), verification)
 	if err != nil {
 		log.Error("Failed to create verification", err, map[string]interface{}{
 			"store_id": store.ID,
@@ -1310,7 +1338,7 @@ func (ctrl *StoreController) GetMyVerificationStatus(c *gin.Context) {
 	}
 
 	// 사용자의 매장 확인
-	store, err := ctrl.storeService.GetStoreByUserID(userID)
+	store, err := ctrl.storeService.GetStoreByUserID(c.Request.Context(), userID)
 	if err != nil {
 		log.Warn("User does not have a store", map[string]interface{}{
 			"user_id": userID,
@@ -1322,7 +1350,7 @@ func (ctrl *StoreController) GetMyVerificationStatus(c *gin.Context) {
 	}
 
 	// 인증 정보 조회
-	verification, err := ctrl.storeService.GetVerificationByStoreID(store.ID)
+	verification, err := ctrl.storeService.GetVerificationByStoreID(c.Request.Context(), store.ID)
 	if err != nil {
 		// 인증 신청이 없는 경우
 		c.JSON(http.StatusOK, gin.H{
@@ -1345,7 +1373,7 @@ func (ctrl *StoreController) ListPendingVerifications(c *gin.Context) {
 	// 상태 필터 (기본값: pending)
 	status := c.DefaultQuery("status", model.VerificationStatusPending)
 
-	verifications, err := ctrl.storeService.ListVerificationsByStatus(status)
+	verifications, err := ctrl.storeService.ListVerificationsByStatus(c.Request.Context(), status)
 	if err != nil {
 		log.Error("Failed to list verifications", err, map[string]interface{}{
 			"status": status,
@@ -1356,6 +1384,19 @@ func (ctrl *StoreController) ListPendingVerifications(c *gin.Context) {
 		return
 	}
 
+	// 사업자등록증은 비공개 파일이므로 심사하는 관리자에게만 짧은 유효 시간의 URL 발급
+	// 비공개 폴더 도입 전에 올린 등록증은 공개 경로에 남아 있어 URL을 그대로 반환
+	for _, verification := range verifications {
+		access, err := ctrl.uploadService.AccessURL(verification.BusinessLicenseURL)
+		if err != nil {
+			log.Error("Failed to sign business license url", err, map[string]interface{}{
+				"verification_id": verification.ID,
+			})
+			continue
+		}
+		verification.BusinessLicenseURL = access.URL
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"verifications": verifications,
 		"count":         len(verifications),
@@ -1409,7 +1450,7 @@ func (ctrl *StoreController) ReviewVerification(c *gin.Context) {
 	}
 
 	// 인증 정보 조회
-	verification, err := ctrl.storeService.GetVerificationByID(uint(verificationID))
+	verification, err := ctrl.storeService.GetVerificationByID(c.Request.Context(), uint(verificationID))
 	if err != nil {
 		log.Warn("Verification not found", map[string]interface{}{
 			"verification_id": verificationID,
@@ -1441,7 +1482,7 @@ func (ctrl *StoreController) ReviewVerification(c *gin.Context) {
 		verification.Status = model.VerificationStatusApproved
 
 		// 매장 인증 상태 업데이트
-		if err := ctrl.storeService.ApproveStoreVerification(verification.StoreID, &now); err != nil {
+		if err := ctrl.storeService.ApproveStoreVerification(c.Request.Context(), verification.StoreID, &now); err != nil {
 			log.Error("Failed to approve store verification", err, map[string]interface{}{
 				"store_id":        verification.StoreID,
 				"verification_id": verificationID,
@@ -1471,7 +1512,7 @@ func (ctrl *StoreController) ReviewVerification(c *gin.Context) {
 	}
 
 	// 인증 정보 업데이트
-	if err := ctrl.storeService.UpdateVerification(verification); err != nil {
+	if err := ctrl.storeService.UpdateVerification(c.Request.Context(), verification); err != nil {
 		log.Error("Failed to update verification", err, map[string]interface{}{
 			"verification_id": verificationID,
 		})
@@ -1,33 +1,46 @@
 package controller
 
 import (
+	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
 	apperrors "github.com/ikkim/udonggeum-backend/internal/errors"
+	"github.com/ikkim/udonggeum-backend/internal/middleware"
 	"github.com/ikkim/udonggeum-backend/internal/storage"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
 )
 
 type UploadController struct {
-	storage *storage.S3Storage
+	storage       storage.Storage
+	uploadService service.UploadService
 }
 
-func NewUploadController(storage *storage.S3Storage) *UploadController {
+func NewUploadController(storage storage.Storage, uploadService service.UploadService) *UploadController {
 	return &UploadController{
-		storage: storage,
+		storage:       storage,
+		uploadService: uploadService,
 	}
 }
 
 type GeneratePresignedURLRequest struct {
 	Filename    string `json:"filename" binding:"required"`
 	ContentType string `json:"content_type" binding:"required"`
-	Folder      string `json:"folder"` // Optional: defaults to "uploads"
+	Folder      string `json:"folder"` // Optional: defaults to the policy folder
 }
 
-// GeneratePresignedURL generates a presigned URL for uploading files to S3
+// GeneratePresignedURL generates a presigned POST for uploading images
+// The policy limits the file size and Content-Type, so the form fields must be sent as returned
 // POST /api/v1/upload/presigned-url
 func (ctrl *UploadController) GeneratePresignedURL(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
 	var req GeneratePresignedURLRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		logger.Warn("Invalid presigned URL request", map[string]interface{}{
@@ -38,14 +51,7 @@ func (ctrl *UploadController) GeneratePresignedURL(c *gin.Context) {
 	}
 
 	// Validate content type (only allow images)
-	allowedTypes := []string{
-		"image/jpeg",
-		"image/jpg",
-		"image/png",
-		"image/gif",
-		"image/webp",
-	}
-	if err := ctrl.storage.ValidateContentType(req.ContentType, allowedTypes); err != nil {
+	if !service.ImageUploadPolicy.Allows(req.ContentType) {
 		logger.Warn("Invalid content type", map[string]interface{}{
 			"content_type": req.ContentType,
 		})
@@ -53,14 +59,21 @@ func (ctrl *UploadController) GeneratePresignedURL(c *gin.Context) {
 		return
 	}
 
-	// Set default folder if not provided
+	// Set default folder if not provided (chat folder is reserved for chat files)
 	folder := req.Folder
 	if folder == "" {
-		folder = "community"
+		folder = service.ImageUploadPolicy.Folder
 	}
+	// 채팅/사업자등록증 등 비공개 폴더는 각 용도의 업로드 URL로만 발급
+	if storage.IsPrivate(folder) || service.UploadPolicyForKey(folder).Name != service.ImageUploadPolicy.Name {
+		apperrors.BadRequest(c, apperrors.ValidationInvalidInput, "사용할 수 없는 폴더입니다")
+		return
+	}
+
+	maxSize := service.ImageUploadPolicy.MaxSize
 
-	// Generate presigned URL
-	response, err := ctrl.storage.GeneratePresignedURLWithFolder(req.Filename, req.ContentType, folder)
+	// Generate presigned POST (registered so that unused uploads can be cleaned up)
+	response, err := ctrl.uploadService.PresignUpload(c.Request.Context(), userID, req.Filename, req.ContentType, folder, service.ImageUploadPolicy)
 	if err != nil {
 		logger.Error("Failed to generate presigned URL", err, map[string]interface{}{
 			"filename":     req.Filename,
@@ -79,15 +92,24 @@ func (ctrl *UploadController) GeneratePresignedURL(c *gin.Context) {
 	})
 
 	c.JSON(http.StatusOK, gin.H{
-		"upload_url": response.UploadURL,
-		"file_url":   response.FileURL,
-		"key":        response.Key,
+		"upload_url":    response.UploadURL,
+		"upload_method": http.MethodPost,
+		"fields":        response.Fields,
+		"file_url":      response.FileURL,
+		"key":           response.Key,
+		"max_size":      maxSize,
 	})
 }
 
-// GenerateChatFilePresignedURL generates a presigned URL for uploading chat files
+// GenerateChatFilePresignedURL generates a presigned POST for uploading chat files
 // POST /api/v1/upload/chat/presigned-url
 func (ctrl *UploadController) GenerateChatFilePresignedURL(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
 	var req GeneratePresignedURLRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		logger.Warn("Invalid presigned URL request", map[string]interface{}{
@@ -98,26 +120,7 @@ func (ctrl *UploadController) GenerateChatFilePresignedURL(c *gin.Context) {
 	}
 
 	// Validate content type (allow images and common file types)
-	allowedTypes := []string{
-		// Images
-		"image/jpeg",
-		"image/jpg",
-		"image/png",
-		"image/gif",
-		"image/webp",
-		// Documents
-		"application/pdf",
-		"application/msword",
-		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
-		"application/vnd.ms-excel",
-		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
-		// Archives
-		"application/zip",
-		"application/x-rar-compressed",
-		// Text
-		"text/plain",
-	}
-	if err := ctrl.storage.ValidateContentType(req.ContentType, allowedTypes); err != nil {
+	if !service.ChatFileUploadPolicy.Allows(req.ContentType) {
 		logger.Warn("Invalid content type for chat file", map[string]interface{}{
 			"content_type": req.ContentType,
 		})
@@ -125,22 +128,18 @@ func (ctrl *UploadController) GenerateChatFilePresignedURL(c *gin.Context) {
 		return
 	}
 
-	// Validate file size (max 10MB for chat files)
-	maxSize := int64(10 * 1024 * 1024) // 10MB
-	if req.Folder != "" {
-		// Folder field can be used to pass file size for validation
-		// This is a workaround since we can't get file size before upload
-	}
+	// Max 10MB for chat files (enforced by the storage upload policy)
+	maxSize := service.ChatFileUploadPolicy.MaxSize
 
-	// Set folder for chat files
-	folder := "chat"
-	if req.Folder != "" {
-		// Allow subfolder specification (e.g., "chat/room_123")
-		folder = req.Folder
+	// Set folder for chat files (private, e.g. "chat/room_123" → "private/chat/room_123")
+	folder, ok := service.ChatUploadFolder(req.Folder)
+	if !ok {
+		apperrors.BadRequest(c, apperrors.ValidationInvalidInput, "채팅 파일은 chat 폴더에만 업로드할 수 있습니다")
+		return
 	}
 
-	// Generate presigned URL
-	response, err := ctrl.storage.GeneratePresignedURLWithFolder(req.Filename, req.ContentType, folder)
+	// Generate presigned POST (registered so that unused uploads can be cleaned up)
+	response, err := ctrl.uploadService.PresignUpload(c.Request.Context(), userID, req.Filename, req.ContentType, folder, service.ChatFileUploadPolicy)
 	if err != nil {
 		logger.Error("Failed to generate presigned URL for chat file", err, map[string]interface{}{
 			"filename":     req.Filename,
@@ -160,9 +159,178 @@ func (ctrl *UploadController) GenerateChatFilePresignedURL(c *gin.Context) {
 	})
 
 	c.JSON(http.StatusOK, gin.H{
-		"upload_url": response.UploadURL,
-		"file_url":   response.FileURL,
-		"key":        response.Key,
-		"max_size":   maxSize,
+		"upload_url":    response.UploadURL,
+		"upload_method": http.MethodPost,
+		"fields":        response.Fields,
+		"file_url":      response.FileURL,
+		"key":           response.Key,
+		"max_size":      maxSize,
+	})
+}
+
+// GenerateLicensePresignedURL generates a presigned POST for uploading a business license
+// The file is stored in a private folder and only masters reviewing verifications can view it
+// POST /api/v1/upload/license/presigned-url
+func (ctrl *UploadController) GenerateLicensePresignedURL(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	var req GeneratePresignedURLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		logger.Warn("Invalid presigned URL request", map[string]interface{}{
+			"error": err.Error(),
+		})
+		apperrors.BadRequest(c, apperrors.ValidationInvalidInput, "잘못된 요청입니다")
+		return
+	}
+
+	if !service.BusinessLicenseUploadPolicy.Allows(req.ContentType) {
+		logger.Warn("Invalid content type for business license", map[string]interface{}{
+			"content_type": req.ContentType,
+		})
+		apperrors.BadRequest(c, apperrors.UploadInvalidFileType, "이미지 또는 PDF 파일만 업로드 가능합니다")
+		return
+	}
+
+	// Folder is fixed for business licenses
+	policy := service.BusinessLicenseUploadPolicy
+	response, err := ctrl.uploadService.PresignUpload(c.Request.Context(), userID, req.Filename, req.ContentType, policy.Folder, policy)
+	if err != nil {
+		logger.Error("Failed to generate presigned URL for business license", err, map[string]interface{}{
+			"filename":     req.Filename,
+			"content_type": req.ContentType,
+		})
+		apperrors.InternalError(c, "업로드 URL 생성에 실패했습니다")
+		return
+	}
+
+	logger.Info("Business license presigned URL generated successfully", map[string]interface{}{
+		"content_type": req.ContentType,
+		"key":          response.Key,
 	})
+
+	c.JSON(http.StatusOK, gin.H{
+		"upload_url":    response.UploadURL,
+		"upload_method": http.MethodPost,
+		"fields":        response.Fields,
+		"file_url":      response.FileURL,
+		"key":           response.Key,
+		"max_size":      policy.MaxSize,
+	})
+}
+
+type ConfirmUploadRequest struct {
+	FileURL string `json:"file_url" binding:"required"`
+	Purpose string `json:"purpose"` // image (default), chat or license
+}
+
+// ConfirmUpload checks that an uploaded file exists and matches the size/type limits
+// POST /api/v1/upload/confirm
+func (ctrl *UploadController) ConfirmUpload(c *gin.Context) {
+	userID, exists := middleware.GetUserID(c)
+	if !exists {
+		apperrors.Unauthorized(c, "로그인이 필요합니다")
+		return
+	}
+
+	var req ConfirmUploadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperrors.BadRequest(c, apperrors.ValidationInvalidInput, "잘못된 요청입니다")
+		return
+	}
+
+	var policy service.UploadPolicy
+	switch req.Purpose {
+	case "", "image":
+		policy = service.ImageUploadPolicy
+	case "chat":
+		policy = service.ChatFileUploadPolicy
+	case "license":
+		policy = service.BusinessLicenseUploadPolicy
+	default:
+		apperrors.BadRequest(c, apperrors.ValidationInvalidInput, "purpose는 image, chat 또는 license여야 합니다")
+		return
+	}
+
+	info, err := ctrl.uploadService.ConfirmUpload(c.Request.Context(), userID, req.FileURL, policy)
+	if err != nil {
+		respondUploadError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"file_url":     req.FileURL,
+		"key":          info.Key,
+		"size":         info.Size,
+		"content_type": info.ContentType,
+	})
+}
+
+// LocalUpload receives presigned POST uploads for the local storage driver
+// POST /storage/upload
+func (ctrl *UploadController) LocalUpload(c *gin.Context) {
+	local, ok := ctrl.storage.(*storage.LocalStorage)
+	if !ok {
+		apperrors.NotFound(c, apperrors.UploadNotFound, "업로드 경로를 찾을 수 없습니다")
+		return
+	}
+	local.HandleUpload(c.Writer, c.Request)
+}
+
+// LocalFile serves files stored by the local storage driver
+// GET /storage/files/*key
+func (ctrl *UploadController) LocalFile(c *gin.Context) {
+	local, ok := ctrl.storage.(*storage.LocalStorage)
+	if !ok {
+		apperrors.NotFound(c, apperrors.UploadNotFound, "파일을 찾을 수 없습니다")
+		return
+	}
+	local.ServeFile(c.Writer, c.Request, strings.TrimPrefix(c.Param("key"), "/"))
+}
+
+// verifyAttachments 요청한 사용자가 첨부할 파일 URL이 업로드 확인을 통과하는지 검사 (실패 시 응답 후 false)
+func verifyAttachments(c *gin.Context, uploadService service.UploadService, policy service.UploadPolicy, fileURLs ...string) bool {
+	userID, _ := middleware.GetUserID(c)
+	if err := uploadService.VerifyAttachments(c.Request.Context(), userID, policy, fileURLs...); err != nil {
+		respondUploadError(c, err)
+		return false
+	}
+	return true
+}
+
+// addedURLs submitted 중 existing에 없는 URL (수정 시에는 새로 첨부한 파일만 확인)
+// 기존 첨부는 업로드 확인 도입 전 파일이나 외부 URL일 수 있으므로 다시 검사하지 않음
+func addedURLs(submitted []string, existing ...string) []string {
+	attached := make(map[string]bool, len(existing))
+	for _, url := range existing {
+		attached[url] = true
+	}
+	var added []string
+	for _, url := range submitted {
+		if url != "" && !attached[url] {
+			added = append(added, url)
+		}
+	}
+	return added
+}
+
+func respondUploadError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrUploadForeignURL), errors.Is(err, service.ErrUploadFolder):
+		apperrors.BadRequest(c, apperrors.UploadInvalidURL, err.Error())
+	case errors.Is(err, service.ErrUploadNotFound):
+		apperrors.BadRequest(c, apperrors.UploadNotFound, err.Error())
+	case errors.Is(err, service.ErrUploadTooLarge):
+		apperrors.BadRequest(c, apperrors.UploadFileTooLarge, service.ErrUploadTooLarge.Error())
+	case errors.Is(err, service.ErrUploadContentType):
+		apperrors.BadRequest(c, apperrors.UploadInvalidFileType, service.ErrUploadContentType.Error())
+	case errors.Is(err, service.ErrUploadLocation):
+		apperrors.BadRequest(c, apperrors.UploadInvalidFileType, service.ErrUploadLocation.Error())
+	default:
+		logger.Error("Failed to confirm upload", err)
+		apperrors.InternalError(c, "업로드 확인에 실패했습니다")
+	}
 }
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+)
+
+// AccountDeletionStatus 회원 탈퇴 요청 상태
+type AccountDeletionStatus string
+
+const (
+	AccountDeletionPending   AccountDeletionStatus = "pending"   // 유예 기간 중 (다시 로그인하면 취소)
+	AccountDeletionCancelled AccountDeletionStatus = "cancelled" // 유예 기간 중 취소됨
+	AccountDeletionCompleted AccountDeletionStatus = "completed" // 개인정보 익명화 완료
+)
+
+// AccountDeletion 회원 탈퇴 요청 (유예 기간이 지나면 개인정보를 익명화)
+type AccountDeletion struct {
+	ID     uint                  `gorm:"primarykey" json:"id"`
+	UserID uint                  `gorm:"not null;index" json:"user_id"`
+	Status AccountDeletionStatus `gorm:"type:varchar(20);not null;index" json:"status"`
+
+	RequestedAt time.Time  `gorm:"not null" json:"requested_at"`
+	ScheduledAt time.Time  `gorm:"not null;index" json:"scheduled_at"` // 익명화 예정 시각
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// 소유 매장을 넘겨받을 사용자. 그 사용자가 수락해야 이전되고, 수락하지 않으면 비관리매장으로 전환
+	StoreTransferUserID     *uint      `gorm:"index" json:"store_transfer_user_id,omitempty"`
+	StoreTransferAcceptedAt *time.Time `json:"store_transfer_accepted_at,omitempty"`
+
+	// 넘겨받을 사용자에게 보여줄 이전 대상 매장 (DB 컬럼 아님)
+	Stores []Store `gorm:"-" json:"stores,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+// UserDataExport 사용자 ID에 연결된 개인정보 전체 (개인정보 열람/이동 요청)
+type UserDataExport struct {
+	ExportedAt time.Time `json:"exported_at"`
+	User       *User     `json:"user"`
+
+	Sessions             []UserSession              `json:"sessions"`
+	Identities           []ExternalIdentity         `json:"external_identities"`
+	Stores               []Store                    `json:"stores"`
+	StoreLikes           []StoreLike                `json:"store_likes"`
+	StoreRequests        []StoreRegistrationRequest `json:"store_registration_requests"`
+	Posts                []CommunityPost            `json:"posts"`
+	PostLikes            []PostLike                 `json:"post_likes"`
+	Comments             []CommunityComment         `json:"comments"`
+	CommentLikes         []CommentLike              `json:"comment_likes"`
+	Reviews              []StoreReview              `json:"reviews"`
+	ReviewLikes          []ReviewLike               `json:"review_likes"`
+	ChatRooms            []ChatRoom                 `json:"chat_rooms"`
+	Messages             []Message                  `json:"messages"` // 본인이 보낸 메시지
+	Notifications        []Notification             `json:"notifications"`
+	NotificationSettings *NotificationSettings      `json:"notification_settings,omitempty"`
+	PriceAlerts          []PriceAlert               `json:"price_alerts"`
+	Payments             []Payment                  `json:"payments"`
+	Escrows              []Escrow                   `json:"escrows"`
+	Uploads              []Upload                   `json:"uploads"`
+	AccountDeletions     []AccountDeletion          `json:"account_deletions"`
+}
@@ -16,6 +16,15 @@ const (
 	ChatRoomTypeSale     ChatRoomType = "SALE"      // Deprecated: SELL_GOLD 또는 BUY_GOLD 사용
 )
 
+// MessageStatus 메시지 전달 상태 (수신자 기준)
+type MessageStatus string
+
+const (
+	MessageStatusSent      MessageStatus = "SENT"      // 서버 저장 완료
+	MessageStatusDelivered MessageStatus = "DELIVERED" // 수신자 기기에 전달됨
+	MessageStatusRead      MessageStatus = "READ"      // 수신자가 읽음
+)
+
 // ChatRoom 채팅방 모델
 // 1:1 채팅방을 나타냄 (판매글 또는 매장 기반)
 type ChatRoom struct {
@@ -38,6 +47,7 @@ type ChatRoom struct {
 	LastMessageID      *uint      `json:"last_message_id,omitempty"`
 	LastMessageContent string     `gorm:"type:text" json:"last_message_content,omitempty"`
 	LastMessageAt      *time.Time `gorm:"index:idx_user1_last_msg,priority:2;index:idx_user2_last_msg,priority:2" json:"last_message_at,omitempty"` // 목록 정렬 최적화
+	LastSeq            uint64     `gorm:"not null;default:0" json:"last_seq"`                                                                   // 마지막으로 발급된 메시지 순번
 
 	// 읽지 않은 메시지 수 (각 사용자별)
 	User1UnreadCount int `gorm:"default:0" json:"user1_unread_count"`
@@ -68,12 +78,17 @@ type Message struct {
 	SenderID   uint           `gorm:"not null;index:idx_room_unread,priority:3;index" json:"sender_id"`
 	Sender     User           `gorm:"constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"sender,omitempty"`
 
+	// 채팅방 내 메시지 순번 (1부터 단조 증가, 재연결 시 누락 메시지 재전송 기준)
+	Seq        uint64         `gorm:"not null;default:0" json:"seq"`
+
 	Content    string         `gorm:"type:text;not null" json:"content"` // 메시지 내용
 
 	// 메시지 타입 (확장성)
 	MessageType string        `gorm:"type:varchar(20);default:'TEXT'" json:"message_type"` // TEXT, IMAGE, FILE 등
 	FileURL     string        `gorm:"type:text" json:"file_url,omitempty"`                 // 파일/이미지 URL (IMAGE, FILE 타입일 때)
 	FileName    string        `gorm:"type:varchar(255)" json:"file_name,omitempty"`        // 원본 파일명
+	// 비공개 첨부 파일은 응답할 때 짧은 유효 시간의 URL로 바꾸고 만료 시간을 함께 전달 (DB 컬럼 아님)
+	FileURLExpiresAt *time.Time `gorm:"-" json:"file_url_expires_at,omitempty"`
 
 	// 수정/삭제 정보
 	IsEdited   bool       `gorm:"default:false" json:"is_edited"`        // 수정 여부
@@ -81,9 +96,11 @@ type Message struct {
 	IsDeleted  bool       `gorm:"default:false" json:"is_deleted"`       // 삭제 여부 (soft delete)
 	DeletedBy  *uint      `json:"deleted_by,omitempty"`                  // 삭제한 사용자 ID
 
-	// 읽음 처리
+	// 전달/읽음 처리
+	DeliveredAt *time.Time    `json:"delivered_at,omitempty"` // 수신자 기기에 전달된 시간
 	IsRead     bool           `gorm:"default:false;index:idx_room_unread,priority:2;index" json:"is_read"`
 	ReadAt     *time.Time     `json:"read_at,omitempty"`
+	Status     MessageStatus  `gorm:"-" json:"status"` // DeliveredAt/IsRead로 계산 (DB 컬럼 아님)
 
 	CreatedAt  time.Time      `gorm:"index:idx_room_created,priority:2" json:"created_at"` // 메시지 목록 정렬 최적화
 	UpdatedAt  time.Time      `json:"updated_at"`
@@ -94,6 +111,19 @@ func (Message) TableName() string {
 	return "messages"
 }
 
+// AfterFind 조회 시 전달 상태 계산
+func (m *Message) AfterFind(tx *gorm.DB) error {
+	switch {
+	case m.IsRead:
+		m.Status = MessageStatusRead
+	case m.DeliveredAt != nil:
+		m.Status = MessageStatusDelivered
+	default:
+		m.Status = MessageStatusSent
+	}
+	return nil
+}
+
 // ChatRoomWithUnread 채팅방 + 현재 사용자의 읽지 않은 메시지 수
 type ChatRoomWithUnread struct {
 	ChatRoom
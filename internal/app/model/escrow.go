@@ -0,0 +1,104 @@
+package model
+
+import (
+	"time"
+)
+
+// EscrowStatus 에스크로 거래 상태
+type EscrowStatus string
+
+const (
+	EscrowStatusAwaitingPayment EscrowStatus = "awaiting_payment" // 구매자 결제 대기
+	EscrowStatusHeld            EscrowStatus = "held"             // 결제 완료, 대금 보관 중 (판매자 인계 대기)
+	EscrowStatusHandedOver      EscrowStatus = "handed_over"      // 판매자 인계 완료 (구매자 수령 확인 대기)
+	EscrowStatusRefunding       EscrowStatus = "refunding"        // 환불 진행 중 (인계/지급으로 전환 불가)
+	EscrowStatusReleased        EscrowStatus = "released"         // 구매자 수령 확인, 판매자에게 대금 지급
+	EscrowStatusCancelled       EscrowStatus = "cancelled"        // 결제 전 취소
+	EscrowStatusRefunded        EscrowStatus = "refunded"         // 결제 후 구매자에게 환불
+)
+
+// ActiveEscrowStatuses 진행 중인 에스크로 상태 (게시글당 하나만 허용)
+var ActiveEscrowStatuses = []EscrowStatus{
+	EscrowStatusAwaitingPayment,
+	EscrowStatusHeld,
+	EscrowStatusHandedOver,
+	EscrowStatusRefunding,
+}
+
+// IsActive 진행 중인 거래인지 여부
+func (s EscrowStatus) IsActive() bool {
+	for _, active := range ActiveEscrowStatuses {
+		if s == active {
+			return true
+		}
+	}
+	return false
+}
+
+// Escrow 금 판매글(sell_gold) 안전거래
+// 구매자 결제 → 대금 보관 → 판매자 인계 확인 → 구매자 수령 확인 → 대금 지급 순으로 진행
+// 각 단계의 기한(ExpiresAt)이 지나면 자동으로 취소/환불 또는 지급 처리됨
+type Escrow struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	PostID uint           `gorm:"not null;index" json:"post_id"`
+	Post   *CommunityPost `gorm:"foreignKey:PostID" json:"post,omitempty"`
+
+	SellerID uint  `gorm:"not null;index" json:"seller_id"` // 게시글 작성자
+	Seller   *User `gorm:"foreignKey:SellerID" json:"seller,omitempty"`
+	BuyerID  uint  `gorm:"not null;index" json:"buyer_id"` // 결제자
+	Buyer    *User `gorm:"foreignKey:BuyerID" json:"buyer,omitempty"`
+
+	PaymentID uint     `gorm:"not null;uniqueIndex" json:"payment_id"`
+	Payment   *Payment `gorm:"foreignKey:PaymentID" json:"payment,omitempty"`
+	Amount    int64    `gorm:"not null" json:"amount"`
+
+	Status    EscrowStatus `gorm:"type:varchar(20);not null;index" json:"status"`
+	ExpiresAt *time.Time   `gorm:"index" json:"expires_at,omitempty"` // 현재 단계 기한
+
+	PaidAt       *time.Time `json:"paid_at,omitempty"`
+	HandedOverAt *time.Time `json:"handed_over_at,omitempty"`
+	ReleasedAt   *time.Time `json:"released_at,omitempty"`
+	CancelledAt  *time.Time `json:"cancelled_at,omitempty"`
+	CancelReason string     `gorm:"type:text" json:"cancel_reason,omitempty"`
+
+	Events []EscrowEvent `gorm:"foreignKey:EscrowID" json:"events,omitempty"`
+}
+
+func (Escrow) TableName() string {
+	return "escrows"
+}
+
+// EscrowEvent 에스크로 상태 변경 이력 (감사 로그)
+type EscrowEvent struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	EscrowID   uint         `gorm:"not null;index" json:"escrow_id"`
+	FromStatus EscrowStatus `gorm:"type:varchar(20)" json:"from_status,omitempty"` // 생성 이벤트는 빈 값
+	ToStatus   EscrowStatus `gorm:"type:varchar(20);not null" json:"to_status"`
+	ActorID    *uint        `gorm:"index" json:"actor_id,omitempty"` // nil이면 시스템(결제 콜백, 기한 만료)
+	Reason     string       `gorm:"type:text" json:"reason,omitempty"`
+}
+
+func (EscrowEvent) TableName() string {
+	return "escrow_events"
+}
+
+// CreateEscrowRequest 안전거래 시작 요청
+type CreateEscrowRequest struct {
+	Amount int64 `json:"amount" binding:"required,min=100"` // 합의된 거래 금액 (원)
+}
+
+// CancelEscrowRequest 안전거래 취소 요청
+type CancelEscrowRequest struct {
+	Reason string `json:"reason" binding:"omitempty,max=500"`
+}
+
+// EscrowReadyResponse 안전거래 시작 응답 (결제 리다이렉트 정보 포함)
+type EscrowReadyResponse struct {
+	Escrow  *Escrow               `json:"escrow"`
+	Payment *PaymentReadyResponse `json:"payment"`
+}
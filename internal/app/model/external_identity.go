@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+)
+
+// IdentityProvider 외부 로그인 제공자
+type IdentityProvider string
+
+const (
+	IdentityProviderKakao  IdentityProvider = "kakao"  // 카카오
+	IdentityProviderGoogle IdentityProvider = "google" // 구글
+	IdentityProviderApple  IdentityProvider = "apple"  // 애플
+	IdentityProviderNaver  IdentityProvider = "naver"  // 네이버
+)
+
+// ExternalIdentity 계정에 연결된 외부 로그인 (제공자별 사용자 식별자)
+// 같은 제공자 계정은 한 사용자에게만, 한 사용자에게는 제공자별로 하나만 연결
+type ExternalIdentity struct {
+	ID       uint             `gorm:"primarykey" json:"id"`
+	UserID   uint             `gorm:"not null;index;uniqueIndex:idx_external_identities_user_provider" json:"user_id"`                                                                  // 사용자 ID
+	Provider IdentityProvider `gorm:"type:varchar(20);not null;uniqueIndex:idx_external_identities_provider_subject;uniqueIndex:idx_external_identities_user_provider" json:"provider"` // 제공자
+	Subject  string           `gorm:"type:varchar(255);not null;uniqueIndex:idx_external_identities_provider_subject" json:"-"`                                                         // 제공자의 사용자 ID (노출 금지)
+	Email    string           `gorm:"type:varchar(255)" json:"email"`                                                                                                                   // 연결 당시 제공자 계정 이메일
+	LinkedAt time.Time        `gorm:"not null" json:"linked_at"`                                                                                                                        // 연결 시각
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (ExternalIdentity) TableName() string {
+	return "external_identities"
+}
@@ -25,6 +25,7 @@ type GoldPrice struct {
 	SellPrice   float64       `gorm:"not null" json:"sell_price"`             // 매도가 (원/g)
 	Source      string        `gorm:"type:varchar(100)" json:"source"`        // 시세 출처
 	SourceDate  time.Time     `json:"source_date"`                            // 시세 기준 시각
+	SourceMismatch bool       `gorm:"default:false" json:"source_mismatch"`   // 공급자 간 시세 차이가 허용 범위 초과
 	Description string        `gorm:"type:text" json:"description,omitempty"` // 추가 설명
 	CreatedAt   time.Time     `json:"created_at"`                             // 생성 시각
 	UpdatedAt   time.Time     `json:"updated_at"`                             // 수정 시각
@@ -42,6 +43,7 @@ type GoldPriceResponse struct {
 	SellPrice         float64       `json:"sell_price"`                    // 매도가 (원/g)
 	Source            string        `json:"source"`                        // 시세 출처
 	SourceDate        string        `json:"source_date"`                   // 시세 기준 시각
+	SourceMismatch    bool          `json:"source_mismatch,omitempty"`     // 공급자 간 시세 차이가 허용 범위 초과
 	Description       string        `json:"description,omitempty"`         // 추가 설명
 	UpdatedAt         string        `json:"updated_at"`                    // 업데이트 시각
 
@@ -57,3 +59,61 @@ type GoldPriceHistoryItem struct {
 	SellPrice float64 `json:"sell_price"` // 매도가 (원/g)
 	BuyPrice  float64 `json:"buy_price"`  // 매입가 (원/g)
 }
+
+// GoldPriceUpdateRunStatus 금 시세 자동 수집 실행 상태
+type GoldPriceUpdateRunStatus string
+
+const (
+	GoldPriceUpdateRunRunning   GoldPriceUpdateRunStatus = "running"   // 수집 중
+	GoldPriceUpdateRunSucceeded GoldPriceUpdateRunStatus = "succeeded" // 성공
+	GoldPriceUpdateRunFailed    GoldPriceUpdateRunStatus = "failed"    // 재시도 후에도 실패
+)
+
+// GoldPriceUpdateRun 금 시세 자동 수집 실행 기록 (관리자 조회용)
+type GoldPriceUpdateRun struct {
+	ID          uint                     `gorm:"primarykey" json:"id"`
+	StartedAt   time.Time                `gorm:"not null;index" json:"started_at"`       // 시작 시각
+	FinishedAt  *time.Time               `json:"finished_at,omitempty"`                  // 종료 시각
+	Status      GoldPriceUpdateRunStatus `gorm:"type:varchar(20);not null" json:"status"` // 실행 상태
+	Attempts    int                      `gorm:"not null;default:0" json:"attempts"`     // 외부 API 시도 횟수
+	RowsWritten int                      `gorm:"not null;default:0" json:"rows_written"` // 저장된 시세 수
+	Error       string                   `gorm:"type:text" json:"error,omitempty"`       // 마지막 오류
+	CreatedAt   time.Time                `json:"created_at"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+func (GoldPriceUpdateRun) TableName() string {
+	return "gold_price_update_runs"
+}
+
+// GoldPriceCandleInterval 시세 캔들 구간 단위
+type GoldPriceCandleInterval string
+
+const (
+	CandleIntervalDay   GoldPriceCandleInterval = "1d" // 일봉
+	CandleIntervalWeek  GoldPriceCandleInterval = "1w" // 주봉 (월요일 시작)
+	CandleIntervalMonth GoldPriceCandleInterval = "1M" // 월봉
+)
+
+// GoldPriceCandle 구간별 시세 캔들 (OHLC, 구간 경계는 KST 기준)
+type GoldPriceCandle struct {
+	Time time.Time `gorm:"column:time" json:"time"` // 구간 시작 시각
+
+	BuyOpen  float64 `gorm:"column:buy_open" json:"buy_open"`   // 매입가 시가
+	BuyHigh  float64 `gorm:"column:buy_high" json:"buy_high"`   // 매입가 고가
+	BuyLow   float64 `gorm:"column:buy_low" json:"buy_low"`     // 매입가 저가
+	BuyClose float64 `gorm:"column:buy_close" json:"buy_close"` // 매입가 종가
+
+	SellOpen  float64 `gorm:"column:sell_open" json:"sell_open"`   // 매도가 시가
+	SellHigh  float64 `gorm:"column:sell_high" json:"sell_high"`   // 매도가 고가
+	SellLow   float64 `gorm:"column:sell_low" json:"sell_low"`     // 매도가 저가
+	SellClose float64 `gorm:"column:sell_close" json:"sell_close"` // 매도가 종가
+
+	// 종가 이동평균 (이전 구간이 부족하면 null)
+	BuyMA5   *float64 `gorm:"column:buy_ma5" json:"buy_ma5"`
+	BuyMA20  *float64 `gorm:"column:buy_ma20" json:"buy_ma20"`
+	SellMA5  *float64 `gorm:"column:sell_ma5" json:"sell_ma5"`
+	SellMA20 *float64 `gorm:"column:sell_ma20" json:"sell_ma20"`
+
+	Samples int `gorm:"column:samples" json:"samples"` // 구간 내 시세 수
+}
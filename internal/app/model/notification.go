@@ -13,6 +13,7 @@ const (
 	NotificationTypeNewSellPost  NotificationType = "new_sell_post"
 	NotificationTypePostComment  NotificationType = "post_comment"
 	NotificationTypeStoreLiked   NotificationType = "store_liked"
+	NotificationTypePriceAlert   NotificationType = "price_alert"
 )
 
 type NotificationRange string
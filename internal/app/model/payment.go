@@ -0,0 +1,93 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PaymentProvider 결제 수단 제공자
+type PaymentProvider string
+
+const (
+	PaymentProviderKakaoPay PaymentProvider = "kakaopay" // 카카오페이
+)
+
+// PaymentStatus 결제 상태
+type PaymentStatus string
+
+const (
+	PaymentStatusPending   PaymentStatus = "pending"   // 주문 생성됨 (결제 준비 요청 전)
+	PaymentStatusReady     PaymentStatus = "ready"     // 결제 준비 완료 (TID 발급, 사용자 결제 대기)
+	PaymentStatusApproved  PaymentStatus = "approved"  // 결제 승인 완료
+	PaymentStatusFailed    PaymentStatus = "failed"    // 결제 실패
+	PaymentStatusCancelled PaymentStatus = "cancelled" // 결제 취소 (승인 전 취소 또는 승인 후 전액 환불)
+)
+
+// Payment 결제(주문) 기록
+// 금거래 계약금 등 매장/게시글과 연결된 결제 1건을 나타냄
+type Payment struct {
+	ID        uint           `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// 주문 정보
+	OrderID  string `gorm:"type:varchar(64);uniqueIndex;not null" json:"order_id"` // 가맹점 주문번호 (partner_order_id)
+	ItemName string `gorm:"type:varchar(100);not null" json:"item_name"`           // 상품명
+	Quantity int    `gorm:"not null;default:1" json:"quantity"`                    // 수량
+
+	// 결제자
+	UserID uint  `gorm:"not null;index" json:"user_id"`
+	User   *User `gorm:"foreignKey:UserID" json:"user,omitempty"`
+
+	// 결제 대상 (둘 다 nullable)
+	StoreID *uint          `gorm:"index" json:"store_id,omitempty"` // 대금을 받는 매장
+	Store   *Store         `gorm:"foreignKey:StoreID" json:"store,omitempty"`
+	PostID  *uint          `gorm:"index" json:"post_id,omitempty"` // 관련 금거래 게시글
+	Post    *CommunityPost `gorm:"foreignKey:PostID" json:"post,omitempty"`
+
+	// 안전거래(에스크로) 결제 여부: 에스크로 절차를 통해서만 취소/환불 가능
+	Escrow bool `gorm:"not null;default:false" json:"escrow"`
+
+	// 금액 (원)
+	TotalAmount    int64 `gorm:"not null" json:"total_amount"`
+	TaxFreeAmount  int64 `gorm:"not null;default:0" json:"tax_free_amount"`
+	CanceledAmount int64 `gorm:"not null;default:0" json:"canceled_amount"`
+
+	// PG 정보
+	Provider          PaymentProvider `gorm:"type:varchar(20);not null" json:"provider"`
+	Status            PaymentStatus   `gorm:"type:varchar(20);not null;index" json:"status"`
+	TID               string          `gorm:"type:varchar(50);index" json:"tid,omitempty"`           // PG 거래 고유번호
+	AID               string          `gorm:"type:varchar(50)" json:"aid,omitempty"`                 // 승인 요청 고유번호
+	PaymentMethodType string          `gorm:"type:varchar(20)" json:"payment_method_type,omitempty"` // CARD, MONEY
+	FailReason        string          `gorm:"type:text" json:"fail_reason,omitempty"`
+
+	ApprovedAt *time.Time `json:"approved_at,omitempty"`
+	CanceledAt *time.Time `json:"canceled_at,omitempty"`
+	FailedAt   *time.Time `json:"failed_at,omitempty"`
+}
+
+func (Payment) TableName() string {
+	return "payments"
+}
+
+// CreatePaymentRequest 결제 준비 요청
+type CreatePaymentRequest struct {
+	ItemName    string `json:"item_name" binding:"required,max=100"`
+	Quantity    int    `json:"quantity" binding:"omitempty,min=1"`
+	TotalAmount int64  `json:"total_amount" binding:"required,min=100"`
+	StoreID     *uint  `json:"store_id,omitempty"`
+	PostID      *uint  `json:"post_id,omitempty"`
+	Escrow      bool   `json:"-"` // 에스크로 서비스 내부에서만 설정
+}
+
+// PaymentReadyResponse 결제 준비 응답 (클라이언트 리다이렉트용)
+type PaymentReadyResponse struct {
+	Payment               *Payment `json:"payment"`
+	NextRedirectAppURL    string   `json:"next_redirect_app_url"`
+	NextRedirectMobileURL string   `json:"next_redirect_mobile_url"`
+	NextRedirectPCURL     string   `json:"next_redirect_pc_url"`
+	AndroidAppScheme      string   `json:"android_app_scheme,omitempty"`
+	IOSAppScheme          string   `json:"ios_app_scheme,omitempty"`
+}
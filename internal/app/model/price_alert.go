@@ -0,0 +1,65 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PriceAlertCondition 시세 알림 조건 종류
+type PriceAlertCondition string
+
+const (
+	PriceAlertConditionThreshold     PriceAlertCondition = "threshold"      // 지정 가격(원/g)을 넘어서거나 밑돌 때
+	PriceAlertConditionPercentChange PriceAlertCondition = "percent_change" // 전일 대비 변동률(%)이 기준 이상일 때
+)
+
+// PriceAlertDirection 시세 알림 방향
+type PriceAlertDirection string
+
+const (
+	PriceAlertDirectionAbove PriceAlertDirection = "above" // 상승
+	PriceAlertDirectionBelow PriceAlertDirection = "below" // 하락
+)
+
+// PriceAlert 사용자별 금 시세 알림 규칙
+// 시세 비교는 매도가(SellPrice) 기준
+type PriceAlert struct {
+	ID        uint           `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+
+	UserID uint  `gorm:"not null;index" json:"user_id"`
+	User   *User `gorm:"foreignKey:UserID" json:"-"`
+
+	Type      GoldPriceType       `gorm:"type:varchar(10);not null;index" json:"type"`
+	Condition PriceAlertCondition `gorm:"type:varchar(20);not null" json:"condition"`
+	Direction PriceAlertDirection `gorm:"type:varchar(10);not null" json:"direction"`
+	// threshold: 기준 가격 (원/g), percent_change: 기준 변동률 (%, 양수)
+	Threshold float64 `gorm:"not null" json:"threshold"`
+	IsActive  bool    `gorm:"default:true;index" json:"is_active"`
+
+	// 마지막 알림 발송 정보 (변동률 알림은 하루 한 번만 발송)
+	LastTriggeredAt    *time.Time `json:"last_triggered_at,omitempty"`
+	LastTriggeredPrice *float64   `json:"last_triggered_price,omitempty"`
+}
+
+func (PriceAlert) TableName() string {
+	return "price_alerts"
+}
+
+// CreatePriceAlertRequest 시세 알림 생성 요청
+type CreatePriceAlertRequest struct {
+	Type      GoldPriceType       `json:"type" binding:"required,oneof=24K 18K 14K Platinum Silver"`
+	Condition PriceAlertCondition `json:"condition" binding:"required,oneof=threshold percent_change"`
+	Direction PriceAlertDirection `json:"direction" binding:"required,oneof=above below"`
+	Threshold float64             `json:"threshold" binding:"required,gt=0"`
+}
+
+// UpdatePriceAlertRequest 시세 알림 수정 요청 (전달된 필드만 수정)
+type UpdatePriceAlertRequest struct {
+	Direction *PriceAlertDirection `json:"direction" binding:"omitempty,oneof=above below"`
+	Threshold *float64             `json:"threshold" binding:"omitempty,gt=0"`
+	IsActive  *bool                `json:"is_active"`
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// ProcessedImageStatus 업로드 이미지 처리 상태
+type ProcessedImageStatus string
+
+const (
+	ProcessedImagePending ProcessedImageStatus = "pending" // 처리 대기
+	ProcessedImageDone    ProcessedImageStatus = "done"    // 변환본 생성 완료
+	ProcessedImageFailed  ProcessedImageStatus = "failed"  // 처리 실패 (원본 사용)
+)
+
+// ProcessedImage 업로드된 원본 이미지와 변환본(썸네일, 웹용) 기록
+type ProcessedImage struct {
+	ID           uint                 `gorm:"primarykey" json:"id"`
+	OriginalKey  string               `gorm:"type:varchar(512);not null;uniqueIndex" json:"original_key"`
+	OriginalURL  string               `gorm:"type:text;not null;index" json:"original_url"`
+	Status       ProcessedImageStatus `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	ThumbnailURL string               `gorm:"type:text" json:"thumbnail_url,omitempty"`
+	WebURL       string               `gorm:"type:text" json:"web_url,omitempty"`
+	Width        int                  `json:"width"`                             // 방향 보정 후 원본 크기
+	Height       int                  `json:"height"`                            // 방향 보정 후 원본 크기
+	GPSStripped  bool                 `gorm:"default:false" json:"gps_stripped"` // 원본에서 GPS 정보 제거 여부
+	Error        string               `gorm:"type:text" json:"error,omitempty"`
+	CreatedAt    time.Time            `json:"created_at"`
+	UpdatedAt    time.Time            `json:"updated_at"`
+}
+
+func (ProcessedImage) TableName() string {
+	return "processed_images"
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Upload presigned URL로 발급한 업로드 기록
+// 게시글/리뷰/매장 등에서 참조하지 않는 파일을 찾아 정리하는 데 사용
+type Upload struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	Key         string    `gorm:"type:varchar(512);not null;uniqueIndex" json:"key"`
+	FileURL     string    `gorm:"type:text;not null" json:"file_url"`
+	Folder      string    `gorm:"type:varchar(255);not null" json:"folder"`
+	UserID      uint      `gorm:"not null;index" json:"user_id"`                   // 업로드 URL을 발급받은 사용자
+	ContentType string    `gorm:"type:varchar(100)" json:"content_type,omitempty"` // 발급 시 선언한 형식
+	CreatedAt   time.Time `gorm:"index" json:"created_at"`
+}
+
+func (Upload) TableName() string {
+	return "uploads"
+}
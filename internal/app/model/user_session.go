@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+)
+
+// 세션 폐기 사유
+const (
+	SessionRevokedLogout          = "logout"           // 로그아웃
+	SessionRevokedByUser          = "revoked_by_user"  // 다른 기기에서 로그아웃
+	SessionRevokedReuseDetected   = "reuse_detected"   // 이미 회전된 refresh token 재사용 감지
+	SessionRevokedAccountDeletion = "account_deletion" // 회원 탈퇴 요청
+)
+
+// UserSession 로그인 기기별 서버 세션 (refresh token 회전 단위)
+type UserSession struct {
+	ID            string     `gorm:"type:varchar(36);primaryKey" json:"id"`            // 세션 ID (토큰의 sid)
+	UserID        uint       `gorm:"not null;index" json:"user_id"`                    // 사용자 ID
+	TokenHash     string     `gorm:"type:varchar(64);not null" json:"-"`               // 현재 유효한 refresh token 해시 (노출 금지)
+	DeviceName    string     `gorm:"type:varchar(100)" json:"device_name"`             // 기기 이름
+	IPAddress     string     `gorm:"type:varchar(45)" json:"ip_address"`               // 마지막 접속 IP
+	UserAgent     string     `gorm:"type:varchar(255)" json:"user_agent"`              // 마지막 User-Agent
+	LastUsedAt    time.Time  `gorm:"not null" json:"last_used_at"`                     // 마지막 사용(갱신) 시각
+	ExpiresAt     time.Time  `gorm:"not null" json:"expires_at"`                       // 만료 시각
+	RevokedAt     *time.Time `json:"revoked_at,omitempty"`                             // 폐기 시각
+	RevokedReason string     `gorm:"type:varchar(30)" json:"revoked_reason,omitempty"` // 폐기 사유
+	CreatedAt     time.Time  `json:"created_at"`                                       // 생성 시각
+	UpdatedAt     time.Time  `json:"updated_at"`                                       // 수정 시각
+}
+
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
@@ -0,0 +1,416 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// 탈퇴 회원의 작성물/프로필을 대신하는 문구
+const (
+	anonymizedUserName       = "탈퇴한 사용자"
+	anonymizedPostTitle      = "탈퇴한 사용자의 게시글"
+	anonymizedPostContent    = "탈퇴한 사용자의 게시글입니다."
+	anonymizedCommentContent = "탈퇴한 사용자의 댓글입니다."
+	anonymizedReviewContent  = "탈퇴한 사용자의 리뷰입니다."
+	anonymizedMessageContent = "탈퇴한 사용자의 메시지입니다."
+)
+
+// ErrActiveEscrows 익명화 시점에 진행 중인 안전거래가 있음 (아무것도 바꾸지 않음)
+var ErrActiveEscrows = errors.New("user has active escrows")
+
+// AccountRepository 회원 탈퇴(익명화)와 개인정보 내보내기
+type AccountRepository interface {
+	CreateDeletion(ctx context.Context, deletion *model.AccountDeletion) error
+	FindPendingDeletion(ctx context.Context, userID uint) (*model.AccountDeletion, error)
+	// CancelDeletion 진행 중인 요청만 취소. 취소된 요청이 없으면 false
+	CancelDeletion(ctx context.Context, id uint, now time.Time) (bool, error)
+	// FindDueDeletions 유예 기간이 지난 진행 중 요청 (예정 시각 순)
+	FindDueDeletions(ctx context.Context, now time.Time, limit int) ([]model.AccountDeletion, error)
+	// PostponeDeletion 진행 중인 요청의 익명화 예정 시각을 미룸
+	PostponeDeletion(ctx context.Context, id uint, until time.Time) error
+	// CountActiveEscrows 구매자/판매자로 진행 중인 안전거래 수
+	CountActiveEscrows(ctx context.Context, userID uint) (int64, error)
+	// FindStoreTransferOffers recipientID에게 매장 이전을 제안한 진행 중 요청 (수락 전, 이전 대상 매장 포함)
+	FindStoreTransferOffers(ctx context.Context, recipientID uint) ([]model.AccountDeletion, error)
+	// AcceptStoreTransfer 매장 이전 수락. 수락할 제안이 없으면 false
+	AcceptStoreTransfer(ctx context.Context, id, recipientID uint, now time.Time) (bool, error)
+	// DeclineStoreTransfer 매장 이전 거절 (매장은 비관리매장으로 전환됨). 거절할 제안이 없으면 false
+	DeclineStoreTransfer(ctx context.Context, id, recipientID uint) (bool, error)
+	// Anonymize 개인정보를 익명화하고 요청을 완료 처리 (한 트랜잭션)
+	// 그 사이 요청이 취소되었으면 아무것도 바꾸지 않고 false
+	// 진행 중인 안전거래가 생겼으면 아무것도 바꾸지 않고 ErrActiveEscrows
+	Anonymize(ctx context.Context, deletion *model.AccountDeletion, now time.Time) (bool, error)
+	ExportUserData(ctx context.Context, userID uint) (*model.UserDataExport, error)
+}
+
+type accountRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountRepository(db *gorm.DB) AccountRepository {
+	return &accountRepository{db: db}
+}
+
+func (r *accountRepository) CreateDeletion(ctx context.Context, deletion *model.AccountDeletion) error {
+	log := logger.FromContext(ctx)
+
+	if err := r.db.WithContext(ctx).Create(deletion).Error; err != nil {
+		log.Error("Failed to create account deletion in database", err, map[string]interface{}{
+			"user_id": deletion.UserID,
+		})
+		return err
+	}
+	return nil
+}
+
+func (r *accountRepository) FindPendingDeletion(ctx context.Context, userID uint) (*model.AccountDeletion, error) {
+	var deletion model.AccountDeletion
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND status = ?", userID, model.AccountDeletionPending).
+		First(&deletion).Error; err != nil {
+		return nil, err
+	}
+	return &deletion, nil
+}
+
+func (r *accountRepository) CancelDeletion(ctx context.Context, id uint, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.AccountDeletion{}).
+		Where("id = ? AND status = ?", id, model.AccountDeletionPending).
+		Updates(map[string]interface{}{
+			"status":       model.AccountDeletionCancelled,
+			"cancelled_at": now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *accountRepository) FindDueDeletions(ctx context.Context, now time.Time, limit int) ([]model.AccountDeletion, error) {
+	var deletions []model.AccountDeletion
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND scheduled_at <= ?", model.AccountDeletionPending, now).
+		Order("scheduled_at ASC").
+		Limit(limit).
+		Find(&deletions).Error; err != nil {
+		return nil, err
+	}
+	return deletions, nil
+}
+
+func (r *accountRepository) PostponeDeletion(ctx context.Context, id uint, until time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.AccountDeletion{}).
+		Where("id = ? AND status = ?", id, model.AccountDeletionPending).
+		Update("scheduled_at", until).Error
+}
+
+func (r *accountRepository) CountActiveEscrows(ctx context.Context, userID uint) (int64, error) {
+	return countActiveEscrows(r.db.WithContext(ctx), userID)
+}
+
+func countActiveEscrows(db *gorm.DB, userID uint) (int64, error) {
+	var count int64
+	if err := db.Model(&model.Escrow{}).
+		Where("(buyer_id = ? OR seller_id = ?) AND status IN ?", userID, userID, model.ActiveEscrowStatuses).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *accountRepository) FindStoreTransferOffers(ctx context.Context, recipientID uint) ([]model.AccountDeletion, error) {
+	db := r.db.WithContext(ctx)
+
+	var offers []model.AccountDeletion
+	if err := db.
+		Where("store_transfer_user_id = ? AND store_transfer_accepted_at IS NULL AND status = ?", recipientID, model.AccountDeletionPending).
+		Order("scheduled_at ASC").
+		Find(&offers).Error; err != nil {
+		return nil, err
+	}
+	for i := range offers {
+		if err := db.Where("user_id = ?", offers[i].UserID).Order("id ASC").Find(&offers[i].Stores).Error; err != nil {
+			return nil, err
+		}
+	}
+	return offers, nil
+}
+
+func (r *accountRepository) AcceptStoreTransfer(ctx context.Context, id, recipientID uint, now time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.AccountDeletion{}).
+		Where("id = ? AND store_transfer_user_id = ? AND store_transfer_accepted_at IS NULL AND status = ?",
+			id, recipientID, model.AccountDeletionPending).
+		Update("store_transfer_accepted_at", now)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *accountRepository) DeclineStoreTransfer(ctx context.Context, id, recipientID uint) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.AccountDeletion{}).
+		Where("id = ? AND store_transfer_user_id = ? AND status = ?", id, recipientID, model.AccountDeletionPending).
+		Updates(map[string]interface{}{
+			"store_transfer_user_id":     nil,
+			"store_transfer_accepted_at": nil,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Anonymize 탈퇴 회원 익명화
+// - 게시글/댓글/리뷰/보낸 메시지는 스레드가 깨지지 않도록 남기고 내용만 대체
+// - 소유 매장은 이전을 수락한 사용자에게 넘기거나 비관리매장으로 전환 (사업자/인증 정보는 삭제)
+// - 알림, 알림 설정, 시세 알림, 로그인 세션은 삭제
+// - 결제/안전거래 기록은 전자상거래법 보존 의무로 남김 (사용자 정보만 익명화됨)
+// 참조가 사라진 업로드 파일은 업로드 정리 스케줄러가 삭제
+func (r *accountRepository) Anonymize(ctx context.Context, deletion *model.AccountDeletion, now time.Time) (bool, error) {
+	log := logger.FromContext(ctx)
+	userID := deletion.UserID
+	completed := false
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 로그인으로 취소된 요청과 겹치지 않도록 상태를 먼저 바꿈
+		result := tx.Model(&model.AccountDeletion{}).
+			Where("id = ? AND status = ?", deletion.ID, model.AccountDeletionPending).
+			Updates(map[string]interface{}{
+				"status":       model.AccountDeletionCompleted,
+				"completed_at": now,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		// 탈퇴 요청 후 시작된 안전거래가 있으면 되돌리고 다음 실행으로 미룸
+		activeEscrows, err := countActiveEscrows(tx, userID)
+		if err != nil {
+			return fmt.Errorf("count active escrows: %w", err)
+		}
+		if activeEscrows > 0 {
+			return ErrActiveEscrows
+		}
+
+		// 목록 조회 이후 수락/거절된 매장 이전을 반영
+		var current model.AccountDeletion
+		if err := tx.First(&current, deletion.ID).Error; err != nil {
+			return err
+		}
+		if err := r.releaseStores(tx, &current); err != nil {
+			return fmt.Errorf("release stores: %w", err)
+		}
+
+		updates := []struct {
+			name   string
+			model  interface{}
+			where  string
+			values map[string]interface{}
+		}{
+			{"posts", &model.CommunityPost{}, "user_id = ?", map[string]interface{}{
+				"title":      anonymizedPostTitle,
+				"content":    anonymizedPostContent,
+				"image_urls": nil,
+				"location":   nil,
+			}},
+			{"comments", &model.CommunityComment{}, "user_id = ?", map[string]interface{}{
+				"content": anonymizedCommentContent,
+			}},
+			{"reviews", &model.StoreReview{}, "user_id = ?", map[string]interface{}{
+				"content":    anonymizedReviewContent,
+				"image_urls": nil,
+			}},
+			{"messages", &model.Message{}, "sender_id = ?", map[string]interface{}{
+				"content":    anonymizedMessageContent,
+				"file_url":   "",
+				"file_name":  "",
+				"is_deleted": true,
+				"deleted_by": userID,
+			}},
+		}
+		for _, update := range updates {
+			if err := tx.Unscoped().Model(update.model).Where(update.where, userID).
+				Updates(update.values).Error; err != nil {
+				return fmt.Errorf("anonymize %s: %w", update.name, err)
+			}
+		}
+
+		// 채팅방 목록의 마지막 메시지 미리보기와 참여 상태
+		if err := tx.Unscoped().Model(&model.ChatRoom{}).
+			Where("last_message_id IN (?)", tx.Unscoped().Model(&model.Message{}).Select("id").Where("sender_id = ?", userID)).
+			Update("last_message_content", anonymizedMessageContent).Error; err != nil {
+			return fmt.Errorf("anonymize chat rooms: %w", err)
+		}
+		for _, side := range []string{"user1", "user2"} {
+			if err := tx.Model(&model.ChatRoom{}).
+				Where(side+"_id = ? AND "+side+"_left_at IS NULL", userID).
+				Update(side+"_left_at", now).Error; err != nil {
+				return fmt.Errorf("leave chat rooms: %w", err)
+			}
+		}
+
+		for name, table := range map[string]interface{}{
+			"notifications":         &model.Notification{},
+			"notification settings": &model.NotificationSettings{},
+			"price alerts":          &model.PriceAlert{},
+			"sessions":              &model.UserSession{},
+			"external identities":   &model.ExternalIdentity{},
+		} {
+			if err := tx.Unscoped().Where("user_id = ?", userID).Delete(table).Error; err != nil {
+				return fmt.Errorf("delete %s: %w", name, err)
+			}
+		}
+
+		if err := tx.Model(&model.User{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"email":               fmt.Sprintf("deleted-%d@deleted.invalid", userID),
+			"password_hash":       "",
+			"name":                anonymizedUserName,
+			"nickname":            fmt.Sprintf("탈퇴회원%d", userID),
+			"phone":               "",
+			"email_verified":      false,
+			"email_verified_at":   nil,
+			"phone_verified":      false,
+			"phone_verified_at":   nil,
+			"marketing_agreed":    false,
+			"marketing_agreed_at": nil,
+			"marketing_sms":       false,
+			"marketing_email":     false,
+			"marketing_push":      false,
+			"profile_image":       "",
+			"address":             "",
+			"latitude":            nil,
+			"longitude":           nil,
+			"role":                model.RoleUser,
+			"store_id":            nil,
+		}).Error; err != nil {
+			return fmt.Errorf("anonymize user: %w", err)
+		}
+
+		completed = true
+		return nil
+	})
+	if errors.Is(err, ErrActiveEscrows) {
+		return false, err
+	}
+	if err != nil {
+		log.Error("Failed to anonymize user in database", err, map[string]interface{}{
+			"user_id":     userID,
+			"deletion_id": deletion.ID,
+		})
+		return false, err
+	}
+	return completed, nil
+}
+
+// releaseStores 소유 매장을 이전을 수락한 사용자에게 넘기거나 비관리매장으로 전환
+func (r *accountRepository) releaseStores(tx *gorm.DB, deletion *model.AccountDeletion) error {
+	var storeIDs []uint
+	if err := tx.Model(&model.Store{}).Where("user_id = ?", deletion.UserID).
+		Order("id ASC").Pluck("id", &storeIDs).Error; err != nil {
+		return err
+	}
+	if len(storeIDs) == 0 {
+		return nil
+	}
+
+	// 사업자등록/인증 서류는 탈퇴 회원의 개인정보이므로 이전 여부와 관계없이 삭제 (새 소유자가 다시 인증)
+	for _, table := range []interface{}{&model.BusinessRegistration{}, &model.StoreVerification{}} {
+		if err := tx.Unscoped().Where("store_id IN ?", storeIDs).Delete(table).Error; err != nil {
+			return err
+		}
+	}
+	storeUpdates := map[string]interface{}{
+		"is_verified": false,
+		"verified_at": nil,
+	}
+
+	// 넘겨받을 사용자가 수락하지 않은 이전은 진행하지 않음
+	if deletion.StoreTransferUserID == nil || deletion.StoreTransferAcceptedAt == nil {
+		storeUpdates["user_id"] = nil
+		storeUpdates["is_managed"] = false
+		return tx.Model(&model.Store{}).Where("id IN ?", storeIDs).Updates(storeUpdates).Error
+	}
+
+	recipientID := *deletion.StoreTransferUserID
+	storeUpdates["user_id"] = recipientID
+	if err := tx.Model(&model.Store{}).Where("id IN ?", storeIDs).Updates(storeUpdates).Error; err != nil {
+		return err
+	}
+
+	// 넘겨받은 사용자는 사장님 권한과 대표 매장을 갖게 됨
+	if err := tx.Model(&model.User{}).Where("id = ? AND role = ?", recipientID, model.RoleUser).
+		Update("role", model.RoleAdmin).Error; err != nil {
+		return err
+	}
+	return tx.Model(&model.User{}).Where("id = ? AND store_id IS NULL", recipientID).
+		Update("store_id", storeIDs[0]).Error
+}
+
+func (r *accountRepository) ExportUserData(ctx context.Context, userID uint) (*model.UserDataExport, error) {
+	log := logger.FromContext(ctx)
+	db := r.db.WithContext(ctx)
+
+	var user model.User
+	if err := db.First(&user, userID).Error; err != nil {
+		return nil, err
+	}
+	export := &model.UserDataExport{
+		ExportedAt: time.Now(),
+		User:       &user,
+	}
+
+	queries := []struct {
+		name  string
+		dest  interface{}
+		where string
+	}{
+		{"sessions", &export.Sessions, "user_id = @id"},
+		{"external identities", &export.Identities, "user_id = @id"},
+		{"stores", &export.Stores, "user_id = @id"},
+		{"store likes", &export.StoreLikes, "user_id = @id"},
+		{"store requests", &export.StoreRequests, "user_id = @id"},
+		{"posts", &export.Posts, "user_id = @id"},
+		{"post likes", &export.PostLikes, "user_id = @id"},
+		{"comments", &export.Comments, "user_id = @id"},
+		{"comment likes", &export.CommentLikes, "user_id = @id"},
+		{"reviews", &export.Reviews, "user_id = @id"},
+		{"review likes", &export.ReviewLikes, "user_id = @id"},
+		{"chat rooms", &export.ChatRooms, "user1_id = @id OR user2_id = @id"},
+		{"messages", &export.Messages, "sender_id = @id"},
+		{"notifications", &export.Notifications, "user_id = @id"},
+		{"price alerts", &export.PriceAlerts, "user_id = @id"},
+		{"payments", &export.Payments, "user_id = @id"},
+		{"escrows", &export.Escrows, "buyer_id = @id OR seller_id = @id"},
+		{"uploads", &export.Uploads, "user_id = @id"},
+		{"account deletions", &export.AccountDeletions, "user_id = @id"},
+	}
+	for _, query := range queries {
+		if err := db.Where(query.where, sql.Named("id", userID)).Order("id ASC").Find(query.dest).Error; err != nil {
+			log.Error("Failed to export user data from database", err, map[string]interface{}{
+				"user_id": userID,
+				"table":   query.name,
+			})
+			return nil, err
+		}
+	}
+
+	var settings model.NotificationSettings
+	if err := db.Where("user_id = ?", userID).Limit(1).Find(&settings).Error; err != nil {
+		return nil, err
+	}
+	if settings.ID != 0 {
+		export.NotificationSettings = &settings
+	}
+
+	return export, nil
+}
@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func setupAccountTest(t *testing.T) (*gorm.DB, AccountRepository) {
+	testDB, err := db.SetupTestDB(t)
+	require.NoError(t, err)
+
+	repo := NewAccountRepository(testDB)
+	return testDB, repo
+}
+
+func createAccountTestUser(t *testing.T, testDB *gorm.DB, name string) *model.User {
+	user := &model.User{
+		Email:        name + "@example.com",
+		PasswordHash: "hashedpassword",
+		Name:         name,
+		Nickname:     name,
+		Role:         model.RoleUser,
+	}
+	require.NoError(t, testDB.Create(user).Error)
+	return user
+}
+
+// createOwnedStore 인증까지 마친 관리매장
+func createOwnedStore(t *testing.T, testDB *gorm.DB, owner *model.User) *model.Store {
+	verifiedAt := time.Now()
+	store := &model.Store{
+		UserID:     &owner.ID,
+		Name:       fmt.Sprintf("%s 금은방", owner.Name),
+		Region:     "서울특별시",
+		District:   "강남구",
+		IsManaged:  true,
+		IsVerified: true,
+		VerifiedAt: &verifiedAt,
+	}
+	require.NoError(t, testDB.Create(store).Error)
+	require.NoError(t, testDB.Create(&model.BusinessRegistration{
+		StoreID:            store.ID,
+		BusinessNumber:     "1234567890",
+		BusinessStartDate:  "20200101",
+		RepresentativeName: owner.Name,
+	}).Error)
+	require.NoError(t, testDB.Model(owner).Updates(map[string]interface{}{
+		"role":     model.RoleAdmin,
+		"store_id": store.ID,
+	}).Error)
+	return store
+}
+
+func createDueDeletion(t *testing.T, testDB *gorm.DB, userID uint, transferTo *uint, now time.Time) *model.AccountDeletion {
+	deletion := &model.AccountDeletion{
+		UserID:              userID,
+		Status:              model.AccountDeletionPending,
+		RequestedAt:         now.Add(-14 * 24 * time.Hour),
+		ScheduledAt:         now.Add(-time.Minute),
+		StoreTransferUserID: transferTo,
+	}
+	require.NoError(t, testDB.Create(deletion).Error)
+	return deletion
+}
+
+func TestAccountRepository_Anonymize(t *testing.T) {
+	testDB, repo := setupAccountTest(t)
+	defer db.CleanupTestDB(t, testDB)
+	ctx := context.Background()
+	now := time.Now()
+
+	owner := createAccountTestUser(t, testDB, "owner")
+	store := createOwnedStore(t, testDB, owner)
+	post := &model.CommunityPost{
+		Title:    "금 팝니다",
+		Content:  "연락주세요",
+		Category: model.CategoryGoldTrade,
+		Type:     model.TypeSellGold,
+		UserID:   owner.ID,
+	}
+	require.NoError(t, testDB.Create(post).Error)
+	require.NoError(t, testDB.Create(&model.UserSession{
+		ID:         "owner-session",
+		UserID:     owner.ID,
+		TokenHash:  "hash",
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(time.Hour),
+	}).Error)
+	deletion := createDueDeletion(t, testDB, owner.ID, nil, now)
+
+	completed, err := repo.Anonymize(ctx, deletion, now)
+	require.NoError(t, err)
+	assert.True(t, completed)
+
+	var user model.User
+	require.NoError(t, testDB.First(&user, owner.ID).Error)
+	assert.Equal(t, fmt.Sprintf("deleted-%d@deleted.invalid", owner.ID), user.Email)
+	assert.Equal(t, anonymizedUserName, user.Name)
+	assert.Equal(t, model.RoleUser, user.Role)
+	assert.Nil(t, user.StoreID)
+
+	var anonymizedPost model.CommunityPost
+	require.NoError(t, testDB.First(&anonymizedPost, post.ID).Error)
+	assert.Equal(t, anonymizedPostTitle, anonymizedPost.Title)
+	assert.Equal(t, anonymizedPostContent, anonymizedPost.Content)
+
+	var sessions int64
+	require.NoError(t, testDB.Model(&model.UserSession{}).Where("user_id = ?", owner.ID).Count(&sessions).Error)
+	assert.Zero(t, sessions)
+
+	// 넘겨받을 사용자가 없으면 비관리매장으로 전환되고 사업자 정보는 삭제
+	var released model.Store
+	require.NoError(t, testDB.First(&released, store.ID).Error)
+	assert.Nil(t, released.UserID)
+	assert.False(t, released.IsManaged)
+	assert.False(t, released.IsVerified)
+	var registrations int64
+	require.NoError(t, testDB.Unscoped().Model(&model.BusinessRegistration{}).Where("store_id = ?", store.ID).Count(&registrations).Error)
+	assert.Zero(t, registrations)
+
+	var stored model.AccountDeletion
+	require.NoError(t, testDB.First(&stored, deletion.ID).Error)
+	assert.Equal(t, model.AccountDeletionCompleted, stored.Status)
+
+	// 이미 처리된 요청은 다시 익명화하지 않음
+	completed, err = repo.Anonymize(ctx, deletion, now)
+	require.NoError(t, err)
+	assert.False(t, completed)
+}
+
+func TestAccountRepository_AnonymizeActiveEscrow(t *testing.T) {
+	testDB, repo := setupAccountTest(t)
+	defer db.CleanupTestDB(t, testDB)
+	ctx := context.Background()
+	now := time.Now()
+
+	seller := createAccountTestUser(t, testDB, "seller")
+	buyer := createAccountTestUser(t, testDB, "buyer")
+	post := &model.CommunityPost{
+		Title:    "금 팝니다",
+		Content:  "연락주세요",
+		Category: model.CategoryGoldTrade,
+		Type:     model.TypeSellGold,
+		UserID:   seller.ID,
+	}
+	require.NoError(t, testDB.Create(post).Error)
+	deletion := createDueDeletion(t, testDB, seller.ID, nil, now)
+
+	// 탈퇴 요청 후 시작된 안전거래
+	payment := &model.Payment{
+		OrderID:     "order-1",
+		ItemName:    post.Title,
+		UserID:      buyer.ID,
+		Escrow:      true,
+		TotalAmount: 100000,
+		Provider:    model.PaymentProviderKakaoPay,
+		Status:      model.PaymentStatusApproved,
+	}
+	require.NoError(t, testDB.Create(payment).Error)
+	require.NoError(t, testDB.Create(&model.Escrow{
+		PostID:    post.ID,
+		SellerID:  seller.ID,
+		BuyerID:   buyer.ID,
+		PaymentID: payment.ID,
+		Amount:    payment.TotalAmount,
+		Status:    model.EscrowStatusHeld,
+	}).Error)
+
+	completed, err := repo.Anonymize(ctx, deletion, now)
+	assert.ErrorIs(t, err, ErrActiveEscrows)
+	assert.False(t, completed)
+
+	// 아무것도 바뀌지 않고 요청은 진행 중으로 남음
+	var user model.User
+	require.NoError(t, testDB.First(&user, seller.ID).Error)
+	assert.Equal(t, "seller@example.com", user.Email)
+	_, err = repo.FindPendingDeletion(ctx, seller.ID)
+	require.NoError(t, err)
+
+	retryAt := now.Add(24 * time.Hour)
+	require.NoError(t, repo.PostponeDeletion(ctx, deletion.ID, retryAt))
+	due, err := repo.FindDueDeletions(ctx, now, 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+}
+
+func TestAccountRepository_StoreTransfer(t *testing.T) {
+	testDB, repo := setupAccountTest(t)
+	defer db.CleanupTestDB(t, testDB)
+	ctx := context.Background()
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		accept   bool
+		wantUser bool
+	}{
+		{name: "Accepted transfer", accept: true, wantUser: true},
+		{name: "Unaccepted transfer", accept: false, wantUser: false},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner := createAccountTestUser(t, testDB, fmt.Sprintf("owner%d", i))
+			recipient := createAccountTestUser(t, testDB, fmt.Sprintf("recipient%d", i))
+			store := createOwnedStore(t, testDB, owner)
+			deletion := createDueDeletion(t, testDB, owner.ID, &recipient.ID, now)
+
+			offers, err := repo.FindStoreTransferOffers(ctx, recipient.ID)
+			require.NoError(t, err)
+			require.Len(t, offers, 1)
+			require.Len(t, offers[0].Stores, 1)
+			assert.Equal(t, store.ID, offers[0].Stores[0].ID)
+
+			if tt.accept {
+				// 다른 사용자는 수락할 수 없음
+				accepted, err := repo.AcceptStoreTransfer(ctx, deletion.ID, owner.ID, now)
+				require.NoError(t, err)
+				assert.False(t, accepted)
+
+				accepted, err = repo.AcceptStoreTransfer(ctx, deletion.ID, recipient.ID, now)
+				require.NoError(t, err)
+				assert.True(t, accepted)
+
+				offers, err = repo.FindStoreTransferOffers(ctx, recipient.ID)
+				require.NoError(t, err)
+				assert.Empty(t, offers)
+			}
+
+			// 목록 조회 이후의 수락 여부는 익명화 시점에 다시 읽음
+			completed, err := repo.Anonymize(ctx, deletion, now)
+			require.NoError(t, err)
+			assert.True(t, completed)
+
+			var transferred model.Store
+			require.NoError(t, testDB.First(&transferred, store.ID).Error)
+			assert.False(t, transferred.IsVerified)
+
+			var user model.User
+			require.NoError(t, testDB.First(&user, recipient.ID).Error)
+			if tt.wantUser {
+				require.NotNil(t, transferred.UserID)
+				assert.Equal(t, recipient.ID, *transferred.UserID)
+				assert.True(t, transferred.IsManaged)
+				assert.Equal(t, model.RoleAdmin, user.Role)
+				require.NotNil(t, user.StoreID)
+				assert.Equal(t, store.ID, *user.StoreID)
+			} else {
+				assert.Nil(t, transferred.UserID)
+				assert.False(t, transferred.IsManaged)
+				assert.Equal(t, model.RoleUser, user.Role)
+				assert.Nil(t, user.StoreID)
+			}
+		})
+	}
+}
+
+func TestAccountRepository_DeclineStoreTransfer(t *testing.T) {
+	testDB, repo := setupAccountTest(t)
+	defer db.CleanupTestDB(t, testDB)
+	ctx := context.Background()
+	now := time.Now()
+
+	owner := createAccountTestUser(t, testDB, "owner")
+	recipient := createAccountTestUser(t, testDB, "recipient")
+	createOwnedStore(t, testDB, owner)
+	deletion := createDueDeletion(t, testDB, owner.ID, &recipient.ID, now)
+
+	declined, err := repo.DeclineStoreTransfer(ctx, deletion.ID, recipient.ID)
+	require.NoError(t, err)
+	assert.True(t, declined)
+
+	// 거절한 제안은 수락할 수 없음
+	accepted, err := repo.AcceptStoreTransfer(ctx, deletion.ID, recipient.ID, now)
+	require.NoError(t, err)
+	assert.False(t, accepted)
+
+	var stored model.AccountDeletion
+	require.NoError(t, testDB.First(&stored, deletion.ID).Error)
+	assert.Nil(t, stored.StoreTransferUserID)
+}
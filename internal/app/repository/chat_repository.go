@@ -1,35 +1,40 @@
 package repository
 
 import (
+	"context"
 	"time"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ChatRepository interface {
 	// ChatRoom operations
-	CreateChatRoom(room *model.ChatRoom) error
-	GetChatRoomByID(id uint) (*model.ChatRoom, error)
-	GetChatRoomByIDWithUsers(id uint) (*model.ChatRoom, error)
-	FindExistingChatRoom(user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, error)
-	GetUserChatRooms(userID uint, limit, offset int) ([]model.ChatRoom, int64, error)
-	UpdateChatRoomLastMessage(roomID uint, messageID uint, content string, timestamp time.Time) error
-	IncrementUnreadCount(roomID uint, recipientID uint) error
-	ResetUnreadCount(roomID uint, userID uint) error
-	LeaveChatRoom(roomID uint, userID uint) error        // 채팅방 나가기
-	RejoinChatRoom(roomID uint, userID uint) error       // 채팅방 다시 참여
-	DeleteChatRoomIfBothLeft(roomID uint) error          // 양쪽 모두 나간 경우 삭제
+	CreateChatRoom(ctx context.Context, room *model.ChatRoom) error
+	GetChatRoomByID(ctx context.Context, id uint) (*model.ChatRoom, error)
+	GetChatRoomByIDWithUsers(ctx context.Context, id uint) (*model.ChatRoom, error)
+	FindExistingChatRoom(ctx context.Context, user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, error)
+	GetUserChatRooms(ctx context.Context, userID uint, limit, offset int) ([]model.ChatRoom, int64, error)
+	UpdateChatRoomLastMessage(ctx context.Context, roomID uint, messageID uint, content string, timestamp time.Time) error
+	IncrementUnreadCount(ctx context.Context, roomID uint, recipientID uint) error
+	ResetUnreadCount(ctx context.Context, roomID uint, userID uint) error
+	SetUnreadCount(ctx context.Context, roomID uint, userID uint, count int64) error
+	LeaveChatRoom(ctx context.Context, roomID uint, userID uint) error        // 채팅방 나가기
+	RejoinChatRoom(ctx context.Context, roomID uint, userID uint) error       // 채팅방 다시 참여
+	DeleteChatRoomIfBothLeft(ctx context.Context, roomID uint) error          // 양쪽 모두 나간 경우 삭제
 
 	// Message operations
-	CreateMessage(message *model.Message) error
-	GetMessageByID(id uint) (*model.Message, error)
-	GetChatRoomMessages(roomID uint, limit, offset int) ([]model.Message, int64, error)
-	MarkMessagesAsRead(roomID uint, recipientID uint) error
-	GetUnreadMessageCount(roomID uint, userID uint) (int64, error)
-	SearchMessages(userID uint, keyword string, limit, offset int) ([]model.Message, int64, error) // 메시지 검색
-	UpdateMessage(messageID uint, content string) error                                              // 메시지 수정
-	DeleteMessage(messageID uint, deletedBy uint) error                                              // 메시지 삭제
+	CreateMessage(ctx context.Context, message *model.Message) error
+	GetMessageByID(ctx context.Context, id uint) (*model.Message, error)
+	GetChatRoomMessages(ctx context.Context, roomID uint, limit, offset int) ([]model.Message, int64, error)
+	GetMessagesAfterSeq(ctx context.Context, roomID uint, afterSeq uint64, limit int) ([]model.Message, error) // 순번 이후 메시지 (재연결 복구용)
+	MarkMessagesAsRead(ctx context.Context, roomID uint, recipientID uint, upToSeq uint64) ([]model.Message, error)      // upToSeq가 0이면 전체
+	MarkMessagesDelivered(ctx context.Context, roomID uint, recipientID uint, upToSeq uint64) ([]model.Message, error) // 전달 처리
+	GetUnreadMessageCount(ctx context.Context, roomID uint, userID uint) (int64, error)
+	SearchMessages(ctx context.Context, userID uint, keyword string, limit, offset int) ([]model.Message, int64, error) // 메시지 검색
+	UpdateMessage(ctx context.Context, messageID uint, content string) error                                              // 메시지 수정
+	DeleteMessage(ctx context.Context, messageID uint, deletedBy uint) error                                              // 메시지 삭제
 }
 
 type chatRepository struct {
@@ -41,23 +46,23 @@ func NewChatRepository(db *gorm.DB) ChatRepository {
 }
 
 // CreateChatRoom 채팅방 생성
-func (r *chatRepository) CreateChatRoom(room *model.ChatRoom) error {
-	return r.db.Create(room).Error
+func (r *chatRepository) CreateChatRoom(ctx context.Context, room *model.ChatRoom) error {
+	return r.db.WithContext(ctx).Create(room).Error
 }
 
 // GetChatRoomByID 채팅방 ID로 조회
-func (r *chatRepository) GetChatRoomByID(id uint) (*model.ChatRoom, error) {
+func (r *chatRepository) GetChatRoomByID(ctx context.Context, id uint) (*model.ChatRoom, error) {
 	var room model.ChatRoom
-	if err := r.db.First(&room, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&room, id).Error; err != nil {
 		return nil, err
 	}
 	return &room, nil
 }
 
 // GetChatRoomByIDWithUsers 채팅방 ID로 조회 (사용자 정보 포함)
-func (r *chatRepository) GetChatRoomByIDWithUsers(id uint) (*model.ChatRoom, error) {
+func (r *chatRepository) GetChatRoomByIDWithUsers(ctx context.Context, id uint) (*model.ChatRoom, error) {
 	var room model.ChatRoom
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Preload("User1").
 		Preload("User1.Store").
 		Preload("User2").
@@ -71,9 +76,9 @@ func (r *chatRepository) GetChatRoomByIDWithUsers(id uint) (*model.ChatRoom, err
 }
 
 // FindExistingChatRoom 기존 채팅방 찾기 (중복 생성 방지)
-func (r *chatRepository) FindExistingChatRoom(user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, error) {
+func (r *chatRepository) FindExistingChatRoom(ctx context.Context, user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, error) {
 	var room model.ChatRoom
-	query := r.db.Where("type = ?", roomType)
+	query := r.db.WithContext(ctx).Where("type = ?", roomType)
 
 	// 두 사용자의 조합으로 찾기 (순서 무관)
 	query = query.Where(
@@ -99,11 +104,11 @@ func (r *chatRepository) FindExistingChatRoom(user1ID, user2ID uint, roomType mo
 }
 
 // GetUserChatRooms 사용자의 채팅방 목록 조회 (나간 방 제외)
-func (r *chatRepository) GetUserChatRooms(userID uint, limit, offset int) ([]model.ChatRoom, int64, error) {
+func (r *chatRepository) GetUserChatRooms(ctx context.Context, userID uint, limit, offset int) ([]model.ChatRoom, int64, error) {
 	var rooms []model.ChatRoom
 	var total int64
 
-	query := r.db.Model(&model.ChatRoom{}).
+	query := r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 		Where("(user1_id = ? AND user1_left_at IS NULL) OR (user2_id = ? AND user2_left_at IS NULL)", userID, userID).
 		Preload("User1").
 		Preload("User1.Store").
@@ -130,8 +135,8 @@ func (r *chatRepository) GetUserChatRooms(userID uint, limit, offset int) ([]mod
 }
 
 // UpdateChatRoomLastMessage 채팅방의 마지막 메시지 정보 업데이트
-func (r *chatRepository) UpdateChatRoomLastMessage(roomID uint, messageID uint, content string, timestamp time.Time) error {
-	return r.db.Model(&model.ChatRoom{}).
+func (r *chatRepository) UpdateChatRoomLastMessage(ctx context.Context, roomID uint, messageID uint, content string, timestamp time.Time) error {
+	return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 		Where("id = ?", roomID).
 		Updates(map[string]interface{}{
 			"last_message_id":      messageID,
@@ -141,19 +146,19 @@ func (r *chatRepository) UpdateChatRoomLastMessage(roomID uint, messageID uint,
 }
 
 // IncrementUnreadCount 읽지 않은 메시지 수 증가
-func (r *chatRepository) IncrementUnreadCount(roomID uint, recipientID uint) error {
+func (r *chatRepository) IncrementUnreadCount(ctx context.Context, roomID uint, recipientID uint) error {
 	var room model.ChatRoom
-	if err := r.db.First(&room, roomID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&room, roomID).Error; err != nil {
 		return err
 	}
 
 	// recipientID가 user1이면 user1_unread_count 증가, user2이면 user2_unread_count 증가
 	if room.User1ID == recipientID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user1_unread_count", gorm.Expr("user1_unread_count + 1")).Error
 	} else if room.User2ID == recipientID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user2_unread_count", gorm.Expr("user2_unread_count + 1")).Error
 	}
@@ -162,18 +167,18 @@ func (r *chatRepository) IncrementUnreadCount(roomID uint, recipientID uint) err
 }
 
 // ResetUnreadCount 읽지 않은 메시지 수 초기화
-func (r *chatRepository) ResetUnreadCount(roomID uint, userID uint) error {
+func (r *chatRepository) ResetUnreadCount(ctx context.Context, roomID uint, userID uint) error {
 	var room model.ChatRoom
-	if err := r.db.First(&room, roomID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&room, roomID).Error; err != nil {
 		return err
 	}
 
 	if room.User1ID == userID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user1_unread_count", 0).Error
 	} else if room.User2ID == userID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user2_unread_count", 0).Error
 	}
@@ -181,26 +186,46 @@ func (r *chatRepository) ResetUnreadCount(roomID uint, userID uint) error {
 	return nil
 }
 
+// SetUnreadCount 읽지 않은 메시지 수 설정 (일부만 읽음 처리한 경우)
+func (r *chatRepository) SetUnreadCount(ctx context.Context, roomID uint, userID uint, count int64) error {
+	var room model.ChatRoom
+	if err := r.db.WithContext(ctx).First(&room, roomID).Error; err != nil {
+		return err
+	}
+
+	if room.User1ID == userID {
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
+			Where("id = ?", roomID).
+			Update("user1_unread_count", count).Error
+	} else if room.User2ID == userID {
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
+			Where("id = ?", roomID).
+			Update("user2_unread_count", count).Error
+	}
+
+	return nil
+}
+
 // CreateMessage 메시지 생성
-func (r *chatRepository) CreateMessage(message *model.Message) error {
-	return r.db.Create(message).Error
+func (r *chatRepository) CreateMessage(ctx context.Context, message *model.Message) error {
+	return r.db.WithContext(ctx).Create(message).Error
 }
 
 // GetMessageByID 메시지 ID로 조회
-func (r *chatRepository) GetMessageByID(id uint) (*model.Message, error) {
+func (r *chatRepository) GetMessageByID(ctx context.Context, id uint) (*model.Message, error) {
 	var message model.Message
-	if err := r.db.Preload("Sender").First(&message, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("Sender").First(&message, id).Error; err != nil {
 		return nil, err
 	}
 	return &message, nil
 }
 
 // GetChatRoomMessages 채팅방의 메시지 목록 조회
-func (r *chatRepository) GetChatRoomMessages(roomID uint, limit, offset int) ([]model.Message, int64, error) {
+func (r *chatRepository) GetChatRoomMessages(ctx context.Context, roomID uint, limit, offset int) ([]model.Message, int64, error) {
 	var messages []model.Message
 	var total int64
 
-	query := r.db.Model(&model.Message{}).
+	query := r.db.WithContext(ctx).Model(&model.Message{}).
 		Where("chat_room_id = ?", roomID).
 		Preload("Sender")
 
@@ -221,21 +246,59 @@ func (r *chatRepository) GetChatRoomMessages(roomID uint, limit, offset int) ([]
 	return messages, total, nil
 }
 
-// MarkMessagesAsRead 채팅방의 메시지를 읽음 처리
-func (r *chatRepository) MarkMessagesAsRead(roomID uint, recipientID uint) error {
+// GetMessagesAfterSeq afterSeq보다 큰 순번의 메시지를 순번 순으로 조회
+func (r *chatRepository) GetMessagesAfterSeq(ctx context.Context, roomID uint, afterSeq uint64, limit int) ([]model.Message, error) {
+	var messages []model.Message
+	if err := r.db.WithContext(ctx).
+		Where("chat_room_id = ? AND seq > ?", roomID, afterSeq).
+		Preload("Sender").
+		Order("seq ASC").
+		Limit(limit).
+		Find(&messages).Error; err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// MarkMessagesAsRead 채팅방의 메시지를 읽음 처리 (upToSeq 이하만, 0이면 전체)
+// 읽은 메시지는 전달된 것으로도 처리하며, 이번에 상태가 바뀐 메시지를 반환
+func (r *chatRepository) MarkMessagesAsRead(ctx context.Context, roomID uint, recipientID uint, upToSeq uint64) ([]model.Message, error) {
+	var messages []model.Message
 	now := time.Now()
-	return r.db.Model(&model.Message{}).
-		Where("chat_room_id = ? AND sender_id != ? AND is_read = ?", roomID, recipientID, false).
-		Updates(map[string]interface{}{
-			"is_read": true,
-			"read_at": now,
-		}).Error
+
+	query := r.db.WithContext(ctx).Model(&messages).
+		Clauses(clause.Returning{}).
+		Where("chat_room_id = ? AND sender_id != ? AND is_read = ?", roomID, recipientID, false)
+	if upToSeq > 0 {
+		query = query.Where("seq <= ?", upToSeq)
+	}
+
+	if err := query.Updates(map[string]interface{}{
+		"is_read":      true,
+		"read_at":      now,
+		"delivered_at": gorm.Expr("COALESCE(delivered_at, ?)", now),
+	}).Error; err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// MarkMessagesDelivered upToSeq 이하의 아직 전달되지 않은 메시지를 전달 처리하고 반환
+func (r *chatRepository) MarkMessagesDelivered(ctx context.Context, roomID uint, recipientID uint, upToSeq uint64) ([]model.Message, error) {
+	var messages []model.Message
+	if err := r.db.WithContext(ctx).Model(&messages).
+		Clauses(clause.Returning{}).
+		Where("chat_room_id = ? AND sender_id != ? AND seq <= ? AND delivered_at IS NULL", roomID, recipientID, upToSeq).
+		Update("delivered_at", time.Now()).Error; err != nil {
+		return nil, err
+	}
+	return messages, nil
 }
 
 // GetUnreadMessageCount 읽지 않은 메시지 수 조회
-func (r *chatRepository) GetUnreadMessageCount(roomID uint, userID uint) (int64, error) {
+func (r *chatRepository) GetUnreadMessageCount(ctx context.Context, roomID uint, userID uint) (int64, error) {
 	var count int64
-	if err := r.db.Model(&model.Message{}).
+	if err := r.db.WithContext(ctx).Model(&model.Message{}).
 		Where("chat_room_id = ? AND sender_id != ? AND is_read = ?", roomID, userID, false).
 		Count(&count).Error; err != nil {
 		return 0, err
@@ -244,19 +307,19 @@ func (r *chatRepository) GetUnreadMessageCount(roomID uint, userID uint) (int64,
 }
 
 // LeaveChatRoom 채팅방 나가기 (soft delete for specific user)
-func (r *chatRepository) LeaveChatRoom(roomID uint, userID uint) error {
+func (r *chatRepository) LeaveChatRoom(ctx context.Context, roomID uint, userID uint) error {
 	var room model.ChatRoom
-	if err := r.db.First(&room, roomID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&room, roomID).Error; err != nil {
 		return err
 	}
 
 	now := time.Now()
 	if room.User1ID == userID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user1_left_at", now).Error
 	} else if room.User2ID == userID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user2_left_at", now).Error
 	}
@@ -265,18 +328,18 @@ func (r *chatRepository) LeaveChatRoom(roomID uint, userID uint) error {
 }
 
 // RejoinChatRoom 채팅방 다시 참여 (나간 상태 해제)
-func (r *chatRepository) RejoinChatRoom(roomID uint, userID uint) error {
+func (r *chatRepository) RejoinChatRoom(ctx context.Context, roomID uint, userID uint) error {
 	var room model.ChatRoom
-	if err := r.db.First(&room, roomID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&room, roomID).Error; err != nil {
 		return err
 	}
 
 	if room.User1ID == userID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user1_left_at", nil).Error
 	} else if room.User2ID == userID {
-		return r.db.Model(&model.ChatRoom{}).
+		return r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 			Where("id = ?", roomID).
 			Update("user2_left_at", nil).Error
 	}
@@ -285,28 +348,28 @@ func (r *chatRepository) RejoinChatRoom(roomID uint, userID uint) error {
 }
 
 // DeleteChatRoomIfBothLeft 양쪽 사용자 모두 나간 경우 채팅방 삭제
-func (r *chatRepository) DeleteChatRoomIfBothLeft(roomID uint) error {
+func (r *chatRepository) DeleteChatRoomIfBothLeft(ctx context.Context, roomID uint) error {
 	var room model.ChatRoom
-	if err := r.db.First(&room, roomID).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&room, roomID).Error; err != nil {
 		return err
 	}
 
 	// 양쪽 모두 나갔으면 실제 삭제 (hard delete)
 	if room.User1LeftAt != nil && room.User2LeftAt != nil {
-		return r.db.Unscoped().Delete(&model.ChatRoom{}, roomID).Error
+		return r.db.WithContext(ctx).Unscoped().Delete(&model.ChatRoom{}, roomID).Error
 	}
 
 	return nil
 }
 
 // SearchMessages 사용자의 모든 채팅방에서 메시지 검색
-func (r *chatRepository) SearchMessages(userID uint, keyword string, limit, offset int) ([]model.Message, int64, error) {
+func (r *chatRepository) SearchMessages(ctx context.Context, userID uint, keyword string, limit, offset int) ([]model.Message, int64, error) {
 	var messages []model.Message
 	var total int64
 
 	// 사용자가 참여한 채팅방 ID 목록 가져오기
 	var roomIDs []uint
-	if err := r.db.Model(&model.ChatRoom{}).
+	if err := r.db.WithContext(ctx).Model(&model.ChatRoom{}).
 		Where("(user1_id = ? AND user1_left_at IS NULL) OR (user2_id = ? AND user2_left_at IS NULL)", userID, userID).
 		Pluck("id", &roomIDs).Error; err != nil {
 		return nil, 0, err
@@ -317,7 +380,7 @@ func (r *chatRepository) SearchMessages(userID uint, keyword string, limit, offs
 	}
 
 	// 키워드로 메시지 검색
-	query := r.db.Model(&model.Message{}).
+	query := r.db.WithContext(ctx).Model(&model.Message{}).
 		Where("chat_room_id IN ?", roomIDs).
 		Where("content LIKE ?", "%"+keyword+"%").
 		Preload("Sender").
@@ -341,9 +404,9 @@ func (r *chatRepository) SearchMessages(userID uint, keyword string, limit, offs
 }
 
 // UpdateMessage 메시지 수정
-func (r *chatRepository) UpdateMessage(messageID uint, content string) error {
+func (r *chatRepository) UpdateMessage(ctx context.Context, messageID uint, content string) error {
 	now := time.Now()
-	return r.db.Model(&model.Message{}).
+	return r.db.WithContext(ctx).Model(&model.Message{}).
 		Where("id = ?", messageID).
 		Updates(map[string]interface{}{
 			"content":    content,
@@ -354,8 +417,8 @@ func (r *chatRepository) UpdateMessage(messageID uint, content string) error {
 }
 
 // DeleteMessage 메시지 삭제 (soft delete)
-func (r *chatRepository) DeleteMessage(messageID uint, deletedBy uint) error {
-	return r.db.Model(&model.Message{}).
+func (r *chatRepository) DeleteMessage(ctx context.Context, messageID uint, deletedBy uint) error {
+	return r.db.WithContext(ctx).Model(&model.Message{}).
 		Where("id = ?", messageID).
 		Updates(map[string]interface{}{
 			"is_deleted": true,
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
@@ -10,41 +11,42 @@ import (
 // CommunityRepository 커뮤니티 저장소 인터페이스
 type CommunityRepository interface {
 	// Post operations
-	CreatePost(post *model.CommunityPost) error
-	GetPostByID(id uint, preload bool) (*model.CommunityPost, error)
-	GetPosts(query *model.PostListQuery) ([]model.CommunityPost, int64, error)
-	UpdatePost(post *model.CommunityPost) error
-	DeletePost(id uint) error
-	IncrementViewCount(id uint) error
+	CreatePost(ctx context.Context, post *model.CommunityPost) error
+	GetPostByID(ctx context.Context, id uint, preload bool) (*model.CommunityPost, error)
+	GetPosts(ctx context.Context, query *model.PostListQuery) ([]model.CommunityPost, int64, error)
+	UpdatePost(ctx context.Context, post *model.CommunityPost) error
+	DeletePost(ctx context.Context, id uint) error
+	IncrementViewCount(ctx context.Context, id uint) error
 
 	// Comment operations
-	CreateComment(comment *model.CommunityComment) error
-	GetCommentByID(id uint) (*model.CommunityComment, error)
-	GetComments(query *model.CommentListQuery) ([]model.CommunityComment, int64, error)
-	UpdateComment(comment *model.CommunityComment) error
-	DeleteComment(id uint) error
-	GetCommentCountByPostID(postID uint) (int64, error)
+	CreateComment(ctx context.Context, comment *model.CommunityComment) error
+	GetCommentByID(ctx context.Context, id uint) (*model.CommunityComment, error)
+	GetComments(ctx context.Context, query *model.CommentListQuery) ([]model.CommunityComment, int64, error)
+	UpdateComment(ctx context.Context, comment *model.CommunityComment) error
+	DeleteComment(ctx context.Context, id uint) error
+	GetCommentCountByPostID(ctx context.Context, postID uint) (int64, error)
 
 	// Like operations
-	LikePost(postID, userID uint) error
-	UnlikePost(postID, userID uint) error
-	IsPostLiked(postID, userID uint) (bool, error)
-	GetUserLikedPosts(userID uint) ([]model.CommunityPost, error)
-	LikeComment(commentID, userID uint) error
-	UnlikeComment(commentID, userID uint) error
-	IsCommentLiked(commentID, userID uint) (bool, error)
+	LikePost(ctx context.Context, postID, userID uint) error
+	UnlikePost(ctx context.Context, postID, userID uint) error
+	IsPostLiked(ctx context.Context, postID, userID uint) (bool, error)
+	GetUserLikedPosts(ctx context.Context, userID uint) ([]model.CommunityPost, error)
+	LikeComment(ctx context.Context, commentID, userID uint) error
+	UnlikeComment(ctx context.Context, commentID, userID uint) error
+	IsCommentLiked(ctx context.Context, commentID, userID uint) (bool, error)
 
 	// QnA operations
-	AcceptAnswer(postID, commentID uint) error
+	AcceptAnswer(ctx context.Context, postID, commentID uint) error
 
 	// Store post management
-	UpdatePostPin(postID uint, isPinned bool) error
-	GetPostsWithImages(storeID uint, limit, offset int) ([]model.CommunityPost, int64, error)
+	UpdatePostPin(ctx context.Context, postID uint, isPinned bool) error
+	GetPostsWithImages(ctx context.Context, storeID uint, limit, offset int) ([]model.CommunityPost, int64, error)
 
 	// Reservation and transaction operations
-	ReservePost(postID, reservedByUserID uint) error
-	CancelReservation(postID uint) error
-	CompleteTransaction(postID uint) error
+	ReservePost(ctx context.Context, postID, reservedByUserID uint) error
+	CancelReservation(ctx context.Context, postID uint) error
+	CompleteTransaction(ctx context.Context, postID uint) error
+	HasActiveEscrow(ctx context.Context, postID uint) (bool, error)
 }
 
 type communityRepository struct {
@@ -57,14 +59,14 @@ func NewCommunityRepository(db *gorm.DB) CommunityRepository {
 }
 
 // CreatePost 게시글 생성
-func (r *communityRepository) CreatePost(post *model.CommunityPost) error {
+func (r *communityRepository) CreatePost(ctx context.Context, post *model.CommunityPost) error {
 	// 게시글 생성
-	if err := r.db.Create(post).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(post).Error; err != nil {
 		return err
 	}
 
 	// User와 Store 정보를 Preload하여 다시 조회
-	if err := r.db.Preload("User.Store").Preload("Store").First(post, post.ID).Error; err != nil {
+	if err := r.db.WithContext(ctx).Preload("User.Store").Preload("Store").First(post, post.ID).Error; err != nil {
 		return err
 	}
 
@@ -72,9 +74,9 @@ func (r *communityRepository) CreatePost(post *model.CommunityPost) error {
 }
 
 // GetPostByID 게시글 ID로 조회
-func (r *communityRepository) GetPostByID(id uint, preload bool) (*model.CommunityPost, error) {
+func (r *communityRepository) GetPostByID(ctx context.Context, id uint, preload bool) (*model.CommunityPost, error) {
 	var post model.CommunityPost
-	query := r.db.Where("id = ?", id)
+	query := r.db.WithContext(ctx).Where("id = ?", id)
 
 	if preload {
 		query = query.
@@ -97,12 +99,12 @@ func (r *communityRepository) GetPostByID(id uint, preload bool) (*model.Communi
 }
 
 // GetPosts 게시글 목록 조회
-func (r *communityRepository) GetPosts(query *model.PostListQuery) ([]model.CommunityPost, int64, error) {
+func (r *communityRepository) GetPosts(ctx context.Context, query *model.PostListQuery) ([]model.CommunityPost, int64, error) {
 	var posts []model.CommunityPost
 	var total int64
 
 	// 기본 쿼리 구성
-	db := r.db.Model(&model.CommunityPost{}).
+	db := r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Preload("User.Store").
 		Preload("Store")
 
@@ -228,26 +230,26 @@ func (r *communityRepository) GetPosts(query *model.PostListQuery) ([]model.Comm
 }
 
 // UpdatePost 게시글 수정
-func (r *communityRepository) UpdatePost(post *model.CommunityPost) error {
-	return r.db.Save(post).Error
+func (r *communityRepository) UpdatePost(ctx context.Context, post *model.CommunityPost) error {
+	return r.db.WithContext(ctx).Save(post).Error
 }
 
 // DeletePost 게시글 삭제 (소프트 삭제)
-func (r *communityRepository) DeletePost(id uint) error {
-	return r.db.Delete(&model.CommunityPost{}, id).Error
+func (r *communityRepository) DeletePost(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&model.CommunityPost{}, id).Error
 }
 
 // IncrementViewCount 조회수 증가
-func (r *communityRepository) IncrementViewCount(id uint) error {
-	return r.db.Model(&model.CommunityPost{}).
+func (r *communityRepository) IncrementViewCount(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("id = ?", id).
 		UpdateColumn("view_count", gorm.Expr("view_count + ?", 1)).
 		Error
 }
 
 // CreateComment 댓글 생성
-func (r *communityRepository) CreateComment(comment *model.CommunityComment) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *communityRepository) CreateComment(ctx context.Context, comment *model.CommunityComment) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 댓글 생성
 		if err := tx.Create(comment).Error; err != nil {
 			return err
@@ -271,9 +273,9 @@ func (r *communityRepository) CreateComment(comment *model.CommunityComment) err
 }
 
 // GetCommentByID 댓글 ID로 조회
-func (r *communityRepository) GetCommentByID(id uint) (*model.CommunityComment, error) {
+func (r *communityRepository) GetCommentByID(ctx context.Context, id uint) (*model.CommunityComment, error) {
 	var comment model.CommunityComment
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Preload("User.Store").
 		Preload("Replies").
 		Preload("Replies.User.Store").
@@ -284,11 +286,11 @@ func (r *communityRepository) GetCommentByID(id uint) (*model.CommunityComment,
 }
 
 // GetComments 댓글 목록 조회
-func (r *communityRepository) GetComments(query *model.CommentListQuery) ([]model.CommunityComment, int64, error) {
+func (r *communityRepository) GetComments(ctx context.Context, query *model.CommentListQuery) ([]model.CommunityComment, int64, error) {
 	var comments []model.CommunityComment
 	var total int64
 
-	db := r.db.Model(&model.CommunityComment{}).
+	db := r.db.WithContext(ctx).Model(&model.CommunityComment{}).
 		Preload("User.Store").
 		Preload("Replies", func(db *gorm.DB) *gorm.DB {
 			return db.Order("created_at ASC")
@@ -340,13 +342,13 @@ func (r *communityRepository) GetComments(query *model.CommentListQuery) ([]mode
 }
 
 // UpdateComment 댓글 수정
-func (r *communityRepository) UpdateComment(comment *model.CommunityComment) error {
-	return r.db.Save(comment).Error
+func (r *communityRepository) UpdateComment(ctx context.Context, comment *model.CommunityComment) error {
+	return r.db.WithContext(ctx).Save(comment).Error
 }
 
 // DeleteComment 댓글 삭제 (소프트 삭제)
-func (r *communityRepository) DeleteComment(id uint) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *communityRepository) DeleteComment(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var comment model.CommunityComment
 		if err := tx.First(&comment, id).Error; err != nil {
 			return err
@@ -370,17 +372,17 @@ func (r *communityRepository) DeleteComment(id uint) error {
 }
 
 // GetCommentCountByPostID 게시글의 댓글 수 조회
-func (r *communityRepository) GetCommentCountByPostID(postID uint) (int64, error) {
+func (r *communityRepository) GetCommentCountByPostID(ctx context.Context, postID uint) (int64, error) {
 	var count int64
-	err := r.db.Model(&model.CommunityComment{}).
+	err := r.db.WithContext(ctx).Model(&model.CommunityComment{}).
 		Where("post_id = ?", postID).
 		Count(&count).Error
 	return count, err
 }
 
 // LikePost 게시글 좋아요
-func (r *communityRepository) LikePost(postID, userID uint) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *communityRepository) LikePost(ctx context.Context, postID, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 중복 확인
 		var count int64
 		if err := tx.Model(&model.PostLike{}).
@@ -414,8 +416,8 @@ func (r *communityRepository) LikePost(postID, userID uint) error {
 }
 
 // UnlikePost 게시글 좋아요 취소
-func (r *communityRepository) UnlikePost(postID, userID uint) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *communityRepository) UnlikePost(ctx context.Context, postID, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 좋아요 삭제
 		result := tx.Where("post_id = ? AND user_id = ?", postID, userID).
 			Delete(&model.PostLike{})
@@ -439,17 +441,17 @@ func (r *communityRepository) UnlikePost(postID, userID uint) error {
 }
 
 // IsPostLiked 게시글 좋아요 여부 확인
-func (r *communityRepository) IsPostLiked(postID, userID uint) (bool, error) {
+func (r *communityRepository) IsPostLiked(ctx context.Context, postID, userID uint) (bool, error) {
 	var count int64
-	err := r.db.Model(&model.PostLike{}).
+	err := r.db.WithContext(ctx).Model(&model.PostLike{}).
 		Where("post_id = ? AND user_id = ?", postID, userID).
 		Count(&count).Error
 	return count > 0, err
 }
 
 // LikeComment 댓글 좋아요
-func (r *communityRepository) LikeComment(commentID, userID uint) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *communityRepository) LikeComment(ctx context.Context, commentID, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 중복 확인
 		var count int64
 		if err := tx.Model(&model.CommentLike{}).
@@ -483,8 +485,8 @@ func (r *communityRepository) LikeComment(commentID, userID uint) error {
 }
 
 // UnlikeComment 댓글 좋아요 취소
-func (r *communityRepository) UnlikeComment(commentID, userID uint) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *communityRepository) UnlikeComment(ctx context.Context, commentID, userID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 좋아요 삭제
 		result := tx.Where("comment_id = ? AND user_id = ?", commentID, userID).
 			Delete(&model.CommentLike{})
@@ -508,17 +510,17 @@ func (r *communityRepository) UnlikeComment(commentID, userID uint) error {
 }
 
 // IsCommentLiked 댓글 좋아요 여부 확인
-func (r *communityRepository) IsCommentLiked(commentID, userID uint) (bool, error) {
+func (r *communityRepository) IsCommentLiked(ctx context.Context, commentID, userID uint) (bool, error) {
 	var count int64
-	err := r.db.Model(&model.CommentLike{}).
+	err := r.db.WithContext(ctx).Model(&model.CommentLike{}).
 		Where("comment_id = ? AND user_id = ?", commentID, userID).
 		Count(&count).Error
 	return count > 0, err
 }
 
 // AcceptAnswer QnA 답변 채택
-func (r *communityRepository) AcceptAnswer(postID, commentID uint) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
+func (r *communityRepository) AcceptAnswer(ctx context.Context, postID, commentID uint) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 게시글 업데이트
 		if err := tx.Model(&model.CommunityPost{}).
 			Where("id = ?", postID).
@@ -542,9 +544,9 @@ func (r *communityRepository) AcceptAnswer(postID, commentID uint) error {
 }
 
 // UpdatePostPin 게시글 고정/해제
-func (r *communityRepository) UpdatePostPin(postID uint, isPinned bool) error {
+func (r *communityRepository) UpdatePostPin(ctx context.Context, postID uint, isPinned bool) error {
 	// ID를 지정하여 BeforeUpdate 훅이 올바른 레코드를 조회할 수 있도록 함
-	result := r.db.Model(&model.CommunityPost{ID: postID}).
+	result := r.db.WithContext(ctx).Model(&model.CommunityPost{ID: postID}).
 		Update("is_pinned", isPinned)
 
 	if result.Error != nil {
@@ -559,11 +561,11 @@ func (r *communityRepository) UpdatePostPin(postID uint, isPinned bool) error {
 }
 
 // GetPostsWithImages 이미지가 있는 매장 게시글 조회
-func (r *communityRepository) GetPostsWithImages(storeID uint, limit, offset int) ([]model.CommunityPost, int64, error) {
+func (r *communityRepository) GetPostsWithImages(ctx context.Context, storeID uint, limit, offset int) ([]model.CommunityPost, int64, error) {
 	var posts []model.CommunityPost
 	var total int64
 
-	query := r.db.Model(&model.CommunityPost{}).
+	query := r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("store_id = ?", storeID).
 		Where("status = ?", model.StatusActive).
 		Where("array_length(image_urls, 1) > 0") // 이미지가 있는 게시글만
@@ -588,11 +590,11 @@ func (r *communityRepository) GetPostsWithImages(storeID uint, limit, offset int
 }
 
 // ReservePost 게시글 예약하기
-func (r *communityRepository) ReservePost(postID, reservedByUserID uint) error {
+func (r *communityRepository) ReservePost(ctx context.Context, postID, reservedByUserID uint) error {
 	now := gorm.Expr("NOW()")
 	status := "reserved"
 
-	return r.db.Model(&model.CommunityPost{}).
+	return r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("id = ?", postID).
 		Updates(map[string]interface{}{
 			"reservation_status":  status,
@@ -602,8 +604,8 @@ func (r *communityRepository) ReservePost(postID, reservedByUserID uint) error {
 }
 
 // CancelReservation 예약 취소
-func (r *communityRepository) CancelReservation(postID uint) error {
-	return r.db.Model(&model.CommunityPost{}).
+func (r *communityRepository) CancelReservation(ctx context.Context, postID uint) error {
+	return r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("id = ?", postID).
 		Updates(map[string]interface{}{
 			"reservation_status":  nil,
@@ -613,9 +615,9 @@ func (r *communityRepository) CancelReservation(postID uint) error {
 }
 
 // CompleteTransaction 거래 완료
-func (r *communityRepository) GetUserLikedPosts(userID uint) ([]model.CommunityPost, error) {
+func (r *communityRepository) GetUserLikedPosts(ctx context.Context, userID uint) ([]model.CommunityPost, error) {
 	var posts []model.CommunityPost
-	err := r.db.
+	err := r.db.WithContext(ctx).
 		Joins("JOIN post_likes ON post_likes.post_id = community_posts.id").
 		Where("post_likes.user_id = ? AND community_posts.deleted_at IS NULL", userID).
 		Preload("User.Store").
@@ -625,14 +627,23 @@ func (r *communityRepository) GetUserLikedPosts(userID uint) ([]model.CommunityP
 	return posts, err
 }
 
-func (r *communityRepository) CompleteTransaction(postID uint) error {
+func (r *communityRepository) CompleteTransaction(ctx context.Context, postID uint) error {
 	now := gorm.Expr("NOW()")
 	status := "completed"
 
-	return r.db.Model(&model.CommunityPost{}).
+	return r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("id = ?", postID).
 		Updates(map[string]interface{}{
 			"reservation_status": status,
 			"completed_at":       now,
 		}).Error
 }
+
+// HasActiveEscrow 게시글에 진행 중인 안전거래가 있는지 확인
+func (r *communityRepository) HasActiveEscrow(ctx context.Context, postID uint) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&model.Escrow{}).
+		Where("post_id = ? AND status IN ?", postID, model.ActiveEscrowStatuses).
+		Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"gorm.io/gorm"
+)
+
+// EscrowRepository 안전거래 저장소 인터페이스
+type EscrowRepository interface {
+	// Create 에스크로와 생성 이벤트를 함께 저장
+	Create(ctx context.Context, escrow *model.Escrow, event *model.EscrowEvent) error
+	FindByID(ctx context.Context, id uint) (*model.Escrow, error)
+	FindByPaymentID(ctx context.Context, paymentID uint) (*model.Escrow, error)
+	// FindLatestByPostID 게시글의 가장 최근 에스크로 (이력 포함)
+	FindLatestByPostID(ctx context.Context, postID uint) (*model.Escrow, error)
+	// FindActiveByPostID 게시글의 진행 중인 에스크로
+	FindActiveByPostID(ctx context.Context, postID uint) (*model.Escrow, error)
+	// FindExpired 기한이 지난 진행 중 에스크로 목록
+	FindExpired(ctx context.Context, now time.Time, limit int) ([]model.Escrow, error)
+	// Transition 현재 상태가 from일 때만 상태를 변경하고 이력을 남김
+	// 변경된 행이 없으면 false 반환 (이력도 남기지 않음)
+	Transition(ctx context.Context, id uint, from model.EscrowStatus, updates map[string]interface{}, event *model.EscrowEvent) (bool, error)
+}
+
+type escrowRepository struct {
+	db *gorm.DB
+}
+
+// NewEscrowRepository 안전거래 저장소 생성자
+func NewEscrowRepository(db *gorm.DB) EscrowRepository {
+	return &escrowRepository{db: db}
+}
+
+func (r *escrowRepository) Create(ctx context.Context, escrow *model.Escrow, event *model.EscrowEvent) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(escrow).Error; err != nil {
+			return err
+		}
+		event.EscrowID = escrow.ID
+		return tx.Create(event).Error
+	})
+}
+
+func (r *escrowRepository) FindByID(ctx context.Context, id uint) (*model.Escrow, error) {
+	var escrow model.Escrow
+	if err := r.withEvents(ctx).First(&escrow, id).Error; err != nil {
+		return nil, err
+	}
+	return &escrow, nil
+}
+
+func (r *escrowRepository) FindByPaymentID(ctx context.Context, paymentID uint) (*model.Escrow, error) {
+	var escrow model.Escrow
+	if err := r.db.WithContext(ctx).Where("payment_id = ?", paymentID).First(&escrow).Error; err != nil {
+		return nil, err
+	}
+	return &escrow, nil
+}
+
+func (r *escrowRepository) FindLatestByPostID(ctx context.Context, postID uint) (*model.Escrow, error) {
+	var escrow model.Escrow
+	err := r.withEvents(ctx).
+		Preload("Payment").
+		Where("post_id = ?", postID).
+		Order("created_at DESC").
+		First(&escrow).Error
+	if err != nil {
+		return nil, err
+	}
+	return &escrow, nil
+}
+
+func (r *escrowRepository) FindActiveByPostID(ctx context.Context, postID uint) (*model.Escrow, error) {
+	var escrow model.Escrow
+	err := r.db.WithContext(ctx).
+		Where("post_id = ? AND status IN ?", postID, model.ActiveEscrowStatuses).
+		First(&escrow).Error
+	if err != nil {
+		return nil, err
+	}
+	return &escrow, nil
+}
+
+func (r *escrowRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]model.Escrow, error) {
+	var escrows []model.Escrow
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND expires_at IS NOT NULL AND expires_at <= ?", model.ActiveEscrowStatuses, now).
+		Order("expires_at ASC").
+		Limit(limit).
+		Find(&escrows).Error
+	return escrows, err
+}
+
+func (r *escrowRepository) Transition(ctx context.Context, id uint, from model.EscrowStatus, updates map[string]interface{}, event *model.EscrowEvent) (bool, error) {
+	changed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Escrow{}).
+			Where("id = ? AND status = ?", id, from).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		event.EscrowID = id
+		if err := tx.Create(event).Error; err != nil {
+			return err
+		}
+		changed = true
+		return nil
+	})
+	return changed, err
+}
+
+// withEvents 상태 변경 이력을 시간순으로 함께 조회
+func (r *escrowRepository) withEvents(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at ASC, id ASC")
+	})
+}
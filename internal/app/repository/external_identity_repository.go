@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExternalIdentityRepository interface {
+	Create(ctx context.Context, identity *model.ExternalIdentity) error
+	FindByProviderSubject(ctx context.Context, provider model.IdentityProvider, subject string) (*model.ExternalIdentity, error)
+	FindByUserID(ctx context.Context, userID uint) ([]model.ExternalIdentity, error)
+	// DeleteUnlessLast 연결을 해제. 비밀번호가 없고 마지막 연결이면 해제하지 않고 false
+	DeleteUnlessLast(ctx context.Context, userID uint, provider model.IdentityProvider) (bool, error)
+}
+
+type externalIdentityRepository struct {
+	db *gorm.DB
+}
+
+func NewExternalIdentityRepository(db *gorm.DB) ExternalIdentityRepository {
+	return &externalIdentityRepository{db: db}
+}
+
+func (r *externalIdentityRepository) Create(ctx context.Context, identity *model.ExternalIdentity) error {
+	log := logger.FromContext(ctx)
+	log.Debug("Creating external identity in database", map[string]interface{}{
+		"user_id":  identity.UserID,
+		"provider": identity.Provider,
+	})
+
+	if err := r.db.WithContext(ctx).Create(identity).Error; err != nil {
+		log.Error("Failed to create external identity in database", err, map[string]interface{}{
+			"user_id":  identity.UserID,
+			"provider": identity.Provider,
+		})
+		return err
+	}
+	return nil
+}
+
+func (r *externalIdentityRepository) FindByProviderSubject(ctx context.Context, provider model.IdentityProvider, subject string) (*model.ExternalIdentity, error) {
+	log := logger.FromContext(ctx)
+
+	var identity model.ExternalIdentity
+	if err := r.db.WithContext(ctx).
+		Where("provider = ? AND subject = ?", provider, subject).
+		First(&identity).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			log.Error("Failed to find external identity in database", err, map[string]interface{}{
+				"provider": provider,
+			})
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+func (r *externalIdentityRepository) FindByUserID(ctx context.Context, userID uint) ([]model.ExternalIdentity, error) {
+	log := logger.FromContext(ctx)
+
+	var identities []model.ExternalIdentity
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("linked_at ASC").
+		Find(&identities).Error; err != nil {
+		log.Error("Failed to find external identities in database", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, err
+	}
+	return identities, nil
+}
+
+func (r *externalIdentityRepository) DeleteUnlessLast(ctx context.Context, userID uint, provider model.IdentityProvider) (bool, error) {
+	log := logger.FromContext(ctx)
+
+	deleted := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 동시에 여러 연결을 해제해 로그인 수단이 모두 사라지지 않도록 사용자 행을 잠금
+		var user model.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "password_hash").
+			Where("id = ?", userID).
+			First(&user).Error; err != nil {
+			return err
+		}
+
+		if user.PasswordHash == "" {
+			var others int64
+			if err := tx.Model(&model.ExternalIdentity{}).
+				Where("user_id = ? AND provider <> ?", userID, provider).
+				Count(&others).Error; err != nil {
+				return err
+			}
+			if others == 0 {
+				return nil
+			}
+		}
+
+		result := tx.Where("user_id = ? AND provider = ?", userID, provider).
+			Delete(&model.ExternalIdentity{})
+		if result.Error != nil {
+			return result.Error
+		}
+		deleted = result.RowsAffected > 0
+		return nil
+	})
+	if err != nil {
+		log.Error("Failed to delete external identity in database", err, map[string]interface{}{
+			"user_id":  userID,
+			"provider": provider,
+		})
+		return false, err
+	}
+	return deleted, nil
+}
@@ -1,17 +1,18 @@
 package repository
 
 import (
+	"context"
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"gorm.io/gorm"
 )
 
 type FAQRepository interface {
-	FindAll() ([]model.FAQ, error)
-	FindByTarget(target model.FAQTarget) ([]model.FAQ, error)
-	FindByID(id uint) (*model.FAQ, error)
-	Create(faq *model.FAQ) error
-	Update(faq *model.FAQ) error
-	Delete(id uint) error
+	FindAll(ctx context.Context) ([]model.FAQ, error)
+	FindByTarget(ctx context.Context, target model.FAQTarget) ([]model.FAQ, error)
+	FindByID(ctx context.Context, id uint) (*model.FAQ, error)
+	Create(ctx context.Context, faq *model.FAQ) error
+	Update(ctx context.Context, faq *model.FAQ) error
+	Delete(ctx context.Context, id uint) error
 }
 
 type faqRepository struct {
@@ -22,35 +23,35 @@ func NewFAQRepository(db *gorm.DB) FAQRepository {
 	return &faqRepository{db: db}
 }
 
-func (r *faqRepository) FindAll() ([]model.FAQ, error) {
+func (r *faqRepository) FindAll(ctx context.Context) ([]model.FAQ, error) {
 	var faqs []model.FAQ
-	err := r.db.Order("target, sort_order, id").Find(&faqs).Error
+	err := r.db.WithContext(ctx).Order("target, sort_order, id").Find(&faqs).Error
 	return faqs, err
 }
 
-func (r *faqRepository) FindByTarget(target model.FAQTarget) ([]model.FAQ, error) {
+func (r *faqRepository) FindByTarget(ctx context.Context, target model.FAQTarget) ([]model.FAQ, error) {
 	var faqs []model.FAQ
-	err := r.db.Where("target = ?", target).Order("sort_order, id").Find(&faqs).Error
+	err := r.db.WithContext(ctx).Where("target = ?", target).Order("sort_order, id").Find(&faqs).Error
 	return faqs, err
 }
 
-func (r *faqRepository) FindByID(id uint) (*model.FAQ, error) {
+func (r *faqRepository) FindByID(ctx context.Context, id uint) (*model.FAQ, error) {
 	var faq model.FAQ
-	err := r.db.First(&faq, id).Error
+	err := r.db.WithContext(ctx).First(&faq, id).Error
 	if err != nil {
 		return nil, err
 	}
 	return &faq, nil
 }
 
-func (r *faqRepository) Create(faq *model.FAQ) error {
-	return r.db.Create(faq).Error
+func (r *faqRepository) Create(ctx context.Context, faq *model.FAQ) error {
+	return r.db.WithContext(ctx).Create(faq).Error
 }
 
-func (r *faqRepository) Update(faq *model.FAQ) error {
-	return r.db.Save(faq).Error
+func (r *faqRepository) Update(ctx context.Context, faq *model.FAQ) error {
+	return r.db.WithContext(ctx).Save(faq).Error
 }
 
-func (r *faqRepository) Delete(id uint) error {
-	return r.db.Delete(&model.FAQ{}, id).Error
+func (r *faqRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&model.FAQ{}, id).Error
 }
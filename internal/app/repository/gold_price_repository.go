@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
@@ -10,15 +12,19 @@ import (
 
 // GoldPriceRepository 금 시세 저장소 인터페이스
 type GoldPriceRepository interface {
-	Create(goldPrice *model.GoldPrice) error
-	FindAll() ([]model.GoldPrice, error)
-	FindByID(id uint) (*model.GoldPrice, error)
-	FindByType(priceType model.GoldPriceType) (*model.GoldPrice, error)
-	FindLatest() ([]model.GoldPrice, error)
-	FindByTypeAndDate(priceType model.GoldPriceType, date time.Time) (*model.GoldPrice, error)
-	FindByTypeAndDateRange(priceType model.GoldPriceType, startDate, endDate time.Time) ([]model.GoldPrice, error)
-	Update(goldPrice *model.GoldPrice) error
-	Delete(id uint) error
+	Create(ctx context.Context, goldPrice *model.GoldPrice) error
+	FindAll(ctx context.Context) ([]model.GoldPrice, error)
+	FindByID(ctx context.Context, id uint) (*model.GoldPrice, error)
+	FindByType(ctx context.Context, priceType model.GoldPriceType) (*model.GoldPrice, error)
+	FindLatest(ctx context.Context) ([]model.GoldPrice, error)
+	FindByTypeAndDate(ctx context.Context, priceType model.GoldPriceType, date time.Time) (*model.GoldPrice, error)
+	// FindLatestBefore before 이전의 가장 최근 시세 (없으면 nil)
+	FindLatestBefore(ctx context.Context, priceType model.GoldPriceType, before time.Time) (*model.GoldPrice, error)
+	FindByTypeAndDateRange(ctx context.Context, priceType model.GoldPriceType, startDate, endDate time.Time) ([]model.GoldPrice, error)
+	// FindCandles [from, to) 구간의 OHLC 캔들과 종가 이동평균을 SQL로 집계
+	FindCandles(ctx context.Context, priceType model.GoldPriceType, interval model.GoldPriceCandleInterval, from, to time.Time) ([]model.GoldPriceCandle, error)
+	Update(ctx context.Context, goldPrice *model.GoldPrice) error
+	Delete(ctx context.Context, id uint) error
 }
 
 type goldPriceRepository struct {
@@ -31,66 +37,66 @@ func NewGoldPriceRepository(db *gorm.DB) GoldPriceRepository {
 }
 
 // Create 금 시세 생성
-func (r *goldPriceRepository) Create(goldPrice *model.GoldPrice) error {
-	if err := r.db.Create(goldPrice).Error; err != nil {
-		logger.Error("Failed to create gold price", err)
+func (r *goldPriceRepository) Create(ctx context.Context, goldPrice *model.GoldPrice) error {
+	if err := r.db.WithContext(ctx).Create(goldPrice).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to create gold price", err)
 		return err
 	}
 	return nil
 }
 
 // FindAll 모든 금 시세 조회
-func (r *goldPriceRepository) FindAll() ([]model.GoldPrice, error) {
+func (r *goldPriceRepository) FindAll(ctx context.Context) ([]model.GoldPrice, error) {
 	var goldPrices []model.GoldPrice
-	if err := r.db.Order("source_date DESC").Find(&goldPrices).Error; err != nil {
-		logger.Error("Failed to find all gold prices", err)
+	if err := r.db.WithContext(ctx).Order("source_date DESC").Find(&goldPrices).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to find all gold prices", err)
 		return nil, err
 	}
 	return goldPrices, nil
 }
 
 // FindByID ID로 금 시세 조회
-func (r *goldPriceRepository) FindByID(id uint) (*model.GoldPrice, error) {
+func (r *goldPriceRepository) FindByID(ctx context.Context, id uint) (*model.GoldPrice, error) {
 	var goldPrice model.GoldPrice
-	if err := r.db.First(&goldPrice, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&goldPrice, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
-		logger.Error("Failed to find gold price by ID", err)
+		logger.FromContext(ctx).Error("Failed to find gold price by ID", err)
 		return nil, err
 	}
 	return &goldPrice, nil
 }
 
 // FindByType 특정 유형의 최신 금 시세 조회
-func (r *goldPriceRepository) FindByType(priceType model.GoldPriceType) (*model.GoldPrice, error) {
+func (r *goldPriceRepository) FindByType(ctx context.Context, priceType model.GoldPriceType) (*model.GoldPrice, error) {
 	var goldPrice model.GoldPrice
-	if err := r.db.Where("type = ?", priceType).
+	if err := r.db.WithContext(ctx).Where("type = ?", priceType).
 		Order("source_date DESC").
 		First(&goldPrice).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
-		logger.Error("Failed to find gold price by type", err)
+		logger.FromContext(ctx).Error("Failed to find gold price by type", err)
 		return nil, err
 	}
 	return &goldPrice, nil
 }
 
 // FindLatest 각 유형별 최신 금 시세 조회
-func (r *goldPriceRepository) FindLatest() ([]model.GoldPrice, error) {
+func (r *goldPriceRepository) FindLatest(ctx context.Context) ([]model.GoldPrice, error) {
 	var goldPrices []model.GoldPrice
 
 	// 각 타입별 최신 레코드를 조회하는 서브쿼리
-	subQuery := r.db.Model(&model.GoldPrice{}).
+	subQuery := r.db.WithContext(ctx).Model(&model.GoldPrice{}).
 		Select("type, MAX(source_date) as max_date").
 		Group("type")
 
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Joins("JOIN (?) as latest ON gold_prices.type = latest.type AND gold_prices.source_date = latest.max_date", subQuery).
 		Order("type").
 		Find(&goldPrices).Error; err != nil {
-		logger.Error("Failed to find latest gold prices", err)
+		logger.FromContext(ctx).Error("Failed to find latest gold prices", err)
 		return nil, err
 	}
 
@@ -98,48 +104,147 @@ func (r *goldPriceRepository) FindLatest() ([]model.GoldPrice, error) {
 }
 
 // Update 금 시세 업데이트
-func (r *goldPriceRepository) Update(goldPrice *model.GoldPrice) error {
-	if err := r.db.Save(goldPrice).Error; err != nil {
-		logger.Error("Failed to update gold price", err)
+func (r *goldPriceRepository) Update(ctx context.Context, goldPrice *model.GoldPrice) error {
+	if err := r.db.WithContext(ctx).Save(goldPrice).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to update gold price", err)
 		return err
 	}
 	return nil
 }
 
 // FindByTypeAndDate 특정 유형의 특정 날짜 금 시세 조회
-func (r *goldPriceRepository) FindByTypeAndDate(priceType model.GoldPriceType, date time.Time) (*model.GoldPrice, error) {
+func (r *goldPriceRepository) FindByTypeAndDate(ctx context.Context, priceType model.GoldPriceType, date time.Time) (*model.GoldPrice, error) {
 	var goldPrice model.GoldPrice
 	startOfDay := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	endOfDay := startOfDay.Add(24 * time.Hour)
 
-	if err := r.db.Where("type = ? AND source_date >= ? AND source_date < ?", priceType, startOfDay, endOfDay).
+	if err := r.db.WithContext(ctx).Where("type = ? AND source_date >= ? AND source_date < ?", priceType, startOfDay, endOfDay).
 		Order("source_date DESC").
 		First(&goldPrice).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
-		logger.Error("Failed to find gold price by type and date", err)
+		logger.FromContext(ctx).Error("Failed to find gold price by type and date", err)
+		return nil, err
+	}
+	return &goldPrice, nil
+}
+
+// FindLatestBefore 특정 유형의 before 이전 가장 최근 시세 조회
+func (r *goldPriceRepository) FindLatestBefore(ctx context.Context, priceType model.GoldPriceType, before time.Time) (*model.GoldPrice, error) {
+	var goldPrice model.GoldPrice
+	if err := r.db.WithContext(ctx).Where("type = ? AND source_date < ?", priceType, before).
+		Order("source_date DESC").
+		First(&goldPrice).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		logger.FromContext(ctx).Error("Failed to find latest gold price before date", err)
 		return nil, err
 	}
 	return &goldPrice, nil
 }
 
 // FindByTypeAndDateRange 특정 유형의 기간별 금 시세 조회
-func (r *goldPriceRepository) FindByTypeAndDateRange(priceType model.GoldPriceType, startDate, endDate time.Time) ([]model.GoldPrice, error) {
+func (r *goldPriceRepository) FindByTypeAndDateRange(ctx context.Context, priceType model.GoldPriceType, startDate, endDate time.Time) ([]model.GoldPrice, error) {
 	var goldPrices []model.GoldPrice
-	if err := r.db.Where("type = ? AND source_date >= ? AND source_date <= ?", priceType, startDate, endDate).
+	if err := r.db.WithContext(ctx).Where("type = ? AND source_date >= ? AND source_date <= ?", priceType, startDate, endDate).
 		Order("source_date ASC").
 		Find(&goldPrices).Error; err != nil {
-		logger.Error("Failed to find gold prices by type and date range", err)
+		logger.FromContext(ctx).Error("Failed to find gold prices by type and date range", err)
 		return nil, err
 	}
 	return goldPrices, nil
 }
 
+// candleTruncUnits 캔들 구간별 date_trunc 단위
+var candleTruncUnits = map[model.GoldPriceCandleInterval]string{
+	model.CandleIntervalDay:   "day",
+	model.CandleIntervalWeek:  "week",
+	model.CandleIntervalMonth: "month",
+}
+
+// candleQuery 구간별 OHLC와 5/20 구간 이동평균
+// 첫 구간부터 이동평균을 채우기 위해 조회 시작 이전 구간(warmup)까지 집계한 뒤 결과에서 제외
+const candleQuery = `
+WITH candles AS (
+	SELECT
+		date_trunc(@unit, source_date AT TIME ZONE 'Asia/Seoul') AS bucket,
+		(array_agg(buy_price ORDER BY source_date, id))[1] AS buy_open,
+		MAX(buy_price) AS buy_high,
+		MIN(buy_price) AS buy_low,
+		(array_agg(buy_price ORDER BY source_date DESC, id DESC))[1] AS buy_close,
+		(array_agg(sell_price ORDER BY source_date, id))[1] AS sell_open,
+		MAX(sell_price) AS sell_high,
+		MIN(sell_price) AS sell_low,
+		(array_agg(sell_price ORDER BY source_date DESC, id DESC))[1] AS sell_close,
+		COUNT(*) AS samples
+	FROM gold_prices
+	WHERE type = @type
+		AND source_date >= @warmup
+		AND source_date < @to
+		AND deleted_at IS NULL
+	GROUP BY 1
+), averaged AS (
+	SELECT
+		*,
+		CASE WHEN COUNT(*) OVER w5 = 5 THEN AVG(buy_close) OVER w5 END AS buy_ma5,
+		CASE WHEN COUNT(*) OVER w20 = 20 THEN AVG(buy_close) OVER w20 END AS buy_ma20,
+		CASE WHEN COUNT(*) OVER w5 = 5 THEN AVG(sell_close) OVER w5 END AS sell_ma5,
+		CASE WHEN COUNT(*) OVER w20 = 20 THEN AVG(sell_close) OVER w20 END AS sell_ma20
+	FROM candles
+	WINDOW
+		w5 AS (ORDER BY bucket ROWS BETWEEN 4 PRECEDING AND CURRENT ROW),
+		w20 AS (ORDER BY bucket ROWS BETWEEN 19 PRECEDING AND CURRENT ROW)
+)
+SELECT
+	bucket AT TIME ZONE 'Asia/Seoul' AS time,
+	buy_open, buy_high, buy_low, buy_close,
+	sell_open, sell_high, sell_low, sell_close,
+	buy_ma5, buy_ma20, sell_ma5, sell_ma20,
+	samples
+FROM averaged
+WHERE bucket >= date_trunc(@unit, CAST(@from AS timestamptz) AT TIME ZONE 'Asia/Seoul')
+ORDER BY bucket`
+
+// FindCandles 구간별 시세 캔들 조회
+func (r *goldPriceRepository) FindCandles(ctx context.Context, priceType model.GoldPriceType, interval model.GoldPriceCandleInterval, from, to time.Time) ([]model.GoldPriceCandle, error) {
+	unit, ok := candleTruncUnits[interval]
+	if !ok {
+		return nil, fmt.Errorf("unsupported candle interval: %s", interval)
+	}
+
+	candles := []model.GoldPriceCandle{}
+	if err := r.db.WithContext(ctx).Raw(candleQuery, map[string]interface{}{
+		"unit":   unit,
+		"type":   priceType,
+		"warmup": candleWarmupStart(interval, from),
+		"from":   from,
+		"to":     to,
+	}).Scan(&candles).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to find gold price candles", err)
+		return nil, err
+	}
+	return candles, nil
+}
+
+// candleWarmupStart 20구간 이동평균 계산에 필요한 만큼 앞당긴 집계 시작 시각
+// 일봉은 주말/휴장일로 빈 날이 있으므로 넉넉하게 잡음
+func candleWarmupStart(interval model.GoldPriceCandleInterval, from time.Time) time.Time {
+	switch interval {
+	case model.CandleIntervalWeek:
+		return from.AddDate(0, 0, -7*21)
+	case model.CandleIntervalMonth:
+		return from.AddDate(0, -21, 0)
+	default:
+		return from.AddDate(0, 0, -40)
+	}
+}
+
 // Delete 금 시세 삭제
-func (r *goldPriceRepository) Delete(id uint) error {
-	if err := r.db.Delete(&model.GoldPrice{}, id).Error; err != nil {
-		logger.Error("Failed to delete gold price", err)
+func (r *goldPriceRepository) Delete(ctx context.Context, id uint) error {
+	if err := r.db.WithContext(ctx).Delete(&model.GoldPrice{}, id).Error; err != nil {
+		logger.FromContext(ctx).Error("Failed to delete gold price", err)
 		return err
 	}
 	return nil
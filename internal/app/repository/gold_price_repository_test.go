@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoldPriceRepository_FindCandles(t *testing.T) {
+	testDB, err := db.SetupTestDB(t)
+	require.NoError(t, err)
+	defer db.CleanupTestDB(t, testDB)
+	repo := NewGoldPriceRepository(testDB)
+	ctx := context.Background()
+
+	kst := time.FixedZone("KST", 9*60*60)
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 0, 0, 0, 0, kst) }
+
+	// 3월 1일~25일 매일 10시 시세 (매도가 = 100,000 + 일자 × 1,000)
+	for d := 1; d <= 25; d++ {
+		price := 100000 + float64(d)*1000
+		require.NoError(t, repo.Create(ctx, &model.GoldPrice{
+			Type:       model.Gold24K,
+			BuyPrice:   price - 4000,
+			SellPrice:  price,
+			SourceDate: day(d).Add(10 * time.Hour),
+		}))
+	}
+	// 3월 24일 장중 시세 추가 (고가/저가/종가 확인용)
+	for _, intraday := range []struct {
+		hour  int
+		price float64
+	}{{11, 130000}, {13, 120000}, {15, 125500}} {
+		require.NoError(t, repo.Create(ctx, &model.GoldPrice{
+			Type:       model.Gold24K,
+			BuyPrice:   intraday.price - 4000,
+			SellPrice:  intraday.price,
+			SourceDate: day(24).Add(time.Duration(intraday.hour) * time.Hour),
+		}))
+	}
+	// 다른 종류는 집계 대상 아님
+	require.NoError(t, repo.Create(ctx, &model.GoldPrice{
+		Type:       model.Gold18K,
+		BuyPrice:   1,
+		SellPrice:  1,
+		SourceDate: day(24).Add(12 * time.Hour),
+	}))
+
+	t.Run("daily candles with moving averages", func(t *testing.T) {
+		candles, err := repo.FindCandles(ctx, model.Gold24K, model.CandleIntervalDay, day(21), day(26))
+		require.NoError(t, err)
+		require.Len(t, candles, 5)
+
+		first := candles[0]
+		assert.True(t, first.Time.Equal(day(21)))
+		assert.Equal(t, 121000.0, first.SellClose)
+		// 조회 시작 이전 구간까지 포함해 이동평균 계산
+		require.NotNil(t, first.SellMA5)
+		assert.InDelta(t, 119000.0, *first.SellMA5, 0.001) // 17~21일 평균
+		require.NotNil(t, first.SellMA20)
+		assert.InDelta(t, 111500.0, *first.SellMA20, 0.001) // 2~21일 평균
+
+		intraday := candles[3]
+		assert.True(t, intraday.Time.Equal(day(24)))
+		assert.Equal(t, 4, intraday.Samples)
+		assert.Equal(t, 124000.0, intraday.SellOpen)
+		assert.Equal(t, 130000.0, intraday.SellHigh)
+		assert.Equal(t, 120000.0, intraday.SellLow)
+		assert.Equal(t, 125500.0, intraday.SellClose)
+		assert.Equal(t, 121500.0, intraday.BuyClose)
+	})
+
+	t.Run("moving averages are null without enough history", func(t *testing.T) {
+		candles, err := repo.FindCandles(ctx, model.Gold24K, model.CandleIntervalDay, day(1), day(6))
+		require.NoError(t, err)
+		require.Len(t, candles, 5)
+		assert.Nil(t, candles[3].SellMA5)
+		assert.NotNil(t, candles[4].SellMA5)
+		assert.Nil(t, candles[4].SellMA20)
+	})
+
+	t.Run("weekly candles start on monday", func(t *testing.T) {
+		// 2026-03-02는 월요일
+		candles, err := repo.FindCandles(ctx, model.Gold24K, model.CandleIntervalWeek, day(2), day(9))
+		require.NoError(t, err)
+		require.Len(t, candles, 1)
+		assert.True(t, candles[0].Time.Equal(day(2)))
+		assert.Equal(t, 7, candles[0].Samples)
+		assert.Equal(t, 102000.0, candles[0].SellOpen)
+		assert.Equal(t, 108000.0, candles[0].SellClose)
+	})
+}
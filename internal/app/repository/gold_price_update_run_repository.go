@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"gorm.io/gorm"
+)
+
+// GoldPriceUpdateRunRepository 금 시세 자동 수집 실행 기록 저장소 인터페이스
+type GoldPriceUpdateRunRepository interface {
+	Create(ctx context.Context, run *model.GoldPriceUpdateRun) error
+	Update(ctx context.Context, run *model.GoldPriceUpdateRun) error
+	// FindRecent 최근 실행 기록 (시작 시각 역순)
+	FindRecent(ctx context.Context, limit, offset int) ([]model.GoldPriceUpdateRun, int64, error)
+}
+
+type goldPriceUpdateRunRepository struct {
+	db *gorm.DB
+}
+
+// NewGoldPriceUpdateRunRepository 금 시세 자동 수집 실행 기록 저장소 생성
+func NewGoldPriceUpdateRunRepository(db *gorm.DB) GoldPriceUpdateRunRepository {
+	return &goldPriceUpdateRunRepository{db: db}
+}
+
+func (r *goldPriceUpdateRunRepository) Create(ctx context.Context, run *model.GoldPriceUpdateRun) error {
+	return r.db.WithContext(ctx).Create(run).Error
+}
+
+func (r *goldPriceUpdateRunRepository) Update(ctx context.Context, run *model.GoldPriceUpdateRun) error {
+	return r.db.WithContext(ctx).Save(run).Error
+}
+
+func (r *goldPriceUpdateRunRepository) FindRecent(ctx context.Context, limit, offset int) ([]model.GoldPriceUpdateRun, int64, error) {
+	var total int64
+	if err := r.db.WithContext(ctx).Model(&model.GoldPriceUpdateRun{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var runs []model.GoldPriceUpdateRun
+	if err := r.db.WithContext(ctx).Order("started_at DESC").
+		Limit(limit).
+		Offset(offset).
+		Find(&runs).Error; err != nil {
+		return nil, 0, err
+	}
+	return runs, total, nil
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -10,21 +11,21 @@ import (
 // NotificationRepository 알림 저장소 인터페이스
 type NotificationRepository interface {
 	// Notification operations
-	CreateNotification(notification *model.Notification) error
-	GetNotificationByID(id uint) (*model.Notification, error)
-	GetNotifications(userID uint, notifType *model.NotificationType, isRead *bool, limit, offset int) ([]model.Notification, int64, error)
-	GetUnreadCount(userID uint) (int64, error)
-	MarkAsRead(id uint) error
-	MarkAllAsRead(userID uint) error
-	DeleteNotification(id uint) error
+	CreateNotification(ctx context.Context, notification *model.Notification) error
+	GetNotificationByID(ctx context.Context, id uint) (*model.Notification, error)
+	GetNotifications(ctx context.Context, userID uint, notifType *model.NotificationType, isRead *bool, limit, offset int) ([]model.Notification, int64, error)
+	GetUnreadCount(ctx context.Context, userID uint) (int64, error)
+	MarkAsRead(ctx context.Context, id uint) error
+	MarkAllAsRead(ctx context.Context, userID uint) error
+	DeleteNotification(ctx context.Context, id uint) error
 
 	// NotificationSettings operations
-	GetNotificationSettings(userID uint) (*model.NotificationSettings, error)
-	CreateNotificationSettings(settings *model.NotificationSettings) error
-	UpdateNotificationSettings(settings *model.NotificationSettings) error
+	GetNotificationSettings(ctx context.Context, userID uint) (*model.NotificationSettings, error)
+	CreateNotificationSettings(ctx context.Context, settings *model.NotificationSettings) error
+	UpdateNotificationSettings(ctx context.Context, settings *model.NotificationSettings) error
 
 	// Utility operations
-	GetAdminsForNewSellPost(region, district string) ([]uint, error)
+	GetAdminsForNewSellPost(ctx context.Context, region, district string) ([]uint, error)
 }
 
 type notificationRepository struct {
@@ -37,14 +38,14 @@ func NewNotificationRepository(db *gorm.DB) NotificationRepository {
 }
 
 // CreateNotification 알림 생성
-func (r *notificationRepository) CreateNotification(notification *model.Notification) error {
-	return r.db.Create(notification).Error
+func (r *notificationRepository) CreateNotification(ctx context.Context, notification *model.Notification) error {
+	return r.db.WithContext(ctx).Create(notification).Error
 }
 
 // GetNotificationByID 알림 ID로 조회
-func (r *notificationRepository) GetNotificationByID(id uint) (*model.Notification, error) {
+func (r *notificationRepository) GetNotificationByID(ctx context.Context, id uint) (*model.Notification, error) {
 	var notification model.Notification
-	if err := r.db.First(&notification, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).First(&notification, id).Error; err != nil {
 		return nil, err
 	}
 	return &notification, nil
@@ -52,6 +53,7 @@ func (r *notificationRepository) GetNotificationByID(id uint) (*model.Notificati
 
 // GetNotifications 알림 목록 조회
 func (r *notificationRepository) GetNotifications(
+	ctx context.Context,
 	userID uint,
 	notifType *model.NotificationType,
 	isRead *bool,
@@ -60,7 +62,7 @@ func (r *notificationRepository) GetNotifications(
 	var notifications []model.Notification
 	var total int64
 
-	query := r.db.Model(&model.Notification{}).Where("user_id = ?", userID)
+	query := r.db.WithContext(ctx).Model(&model.Notification{}).Where("user_id = ?", userID)
 
 	// 타입 필터
 	if notifType != nil {
@@ -94,37 +96,37 @@ func (r *notificationRepository) GetNotifications(
 }
 
 // GetUnreadCount 안읽은 알림 개수 조회
-func (r *notificationRepository) GetUnreadCount(userID uint) (int64, error) {
+func (r *notificationRepository) GetUnreadCount(ctx context.Context, userID uint) (int64, error) {
 	var count int64
-	err := r.db.Model(&model.Notification{}).
+	err := r.db.WithContext(ctx).Model(&model.Notification{}).
 		Where("user_id = ? AND is_read = ?", userID, false).
 		Count(&count).Error
 	return count, err
 }
 
 // MarkAsRead 알림 읽음 처리
-func (r *notificationRepository) MarkAsRead(id uint) error {
-	return r.db.Model(&model.Notification{}).
+func (r *notificationRepository) MarkAsRead(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&model.Notification{}).
 		Where("id = ?", id).
 		Update("is_read", true).Error
 }
 
 // MarkAllAsRead 모든 알림 읽음 처리
-func (r *notificationRepository) MarkAllAsRead(userID uint) error {
-	return r.db.Model(&model.Notification{}).
+func (r *notificationRepository) MarkAllAsRead(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Model(&model.Notification{}).
 		Where("user_id = ? AND is_read = ?", userID, false).
 		Update("is_read", true).Error
 }
 
 // DeleteNotification 알림 삭제
-func (r *notificationRepository) DeleteNotification(id uint) error {
-	return r.db.Delete(&model.Notification{}, id).Error
+func (r *notificationRepository) DeleteNotification(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&model.Notification{}, id).Error
 }
 
 // GetNotificationSettings 알림 설정 조회
-func (r *notificationRepository) GetNotificationSettings(userID uint) (*model.NotificationSettings, error) {
+func (r *notificationRepository) GetNotificationSettings(ctx context.Context, userID uint) (*model.NotificationSettings, error) {
 	var settings model.NotificationSettings
-	err := r.db.Where("user_id = ?", userID).First(&settings).Error
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&settings).Error
 	if err == gorm.ErrRecordNotFound {
 		// 설정이 없으면 기본값으로 생성
 		settings = model.NotificationSettings{
@@ -135,7 +137,7 @@ func (r *notificationRepository) GetNotificationSettings(userID uint) (*model.No
 			CommentNotification:  true,
 			LikeNotification:     true,
 		}
-		if err := r.CreateNotificationSettings(&settings); err != nil {
+		if err := r.CreateNotificationSettings(ctx, &settings); err != nil {
 			return nil, err
 		}
 		return &settings, nil
@@ -147,22 +149,22 @@ func (r *notificationRepository) GetNotificationSettings(userID uint) (*model.No
 }
 
 // CreateNotificationSettings 알림 설정 생성
-func (r *notificationRepository) CreateNotificationSettings(settings *model.NotificationSettings) error {
-	return r.db.Create(settings).Error
+func (r *notificationRepository) CreateNotificationSettings(ctx context.Context, settings *model.NotificationSettings) error {
+	return r.db.WithContext(ctx).Create(settings).Error
 }
 
 // UpdateNotificationSettings 알림 설정 수정
-func (r *notificationRepository) UpdateNotificationSettings(settings *model.NotificationSettings) error {
-	return r.db.Save(settings).Error
+func (r *notificationRepository) UpdateNotificationSettings(ctx context.Context, settings *model.NotificationSettings) error {
+	return r.db.WithContext(ctx).Save(settings).Error
 }
 
 // GetAdminsForNewSellPost 금 판매글에 대한 알림을 받을 관리자 목록 조회
-func (r *notificationRepository) GetAdminsForNewSellPost(region, district string) ([]uint, error) {
+func (r *notificationRepository) GetAdminsForNewSellPost(ctx context.Context, region, district string) ([]uint, error) {
 	var userIDs []uint
 
 	// 1. 알림을 활성화한 관리자 조회
 	var settings []model.NotificationSettings
-	err := r.db.Joins("JOIN users ON users.id = notification_settings.user_id").
+	err := r.db.WithContext(ctx).Joins("JOIN users ON users.id = notification_settings.user_id").
 		Where("users.role = ? AND notification_settings.sell_post_notification = ?",
 			model.RoleAdmin, true).
 		Find(&settings).Error
@@ -200,7 +202,7 @@ func (r *notificationRepository) GetAdminsForNewSellPost(region, district string
 			// 기존 방식: sell_post_range 기반 (하위 호환성)
 			// 관리자의 매장 조회
 			var store model.Store
-			err := r.db.Where("user_id = ?", setting.UserID).First(&store).Error
+			err := r.db.WithContext(ctx).Where("user_id = ?", setting.UserID).First(&store).Error
 			if err != nil {
 				fmt.Printf("[DEBUG]   No store found for admin %d, skipping\n", setting.UserID)
 				continue // 매장이 없으면 스킵
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"gorm.io/gorm"
+)
+
+// PaymentRepository 결제 저장소 인터페이스
+type PaymentRepository interface {
+	Create(ctx context.Context, payment *model.Payment) error
+	FindByID(ctx context.Context, id uint) (*model.Payment, error)
+	FindByOrderID(ctx context.Context, orderID string) (*model.Payment, error)
+	FindByUserID(ctx context.Context, userID uint, limit, offset int) ([]model.Payment, int64, error)
+	Update(ctx context.Context, payment *model.Payment) error
+	// TransitionStatus 현재 상태가 from일 때만 상태를 변경 (중복 승인/취소 방지)
+	// 변경된 행이 없으면 false 반환
+	TransitionStatus(ctx context.Context, id uint, from model.PaymentStatus, updates map[string]interface{}) (bool, error)
+}
+
+type paymentRepository struct {
+	db *gorm.DB
+}
+
+// NewPaymentRepository 결제 저장소 생성자
+func NewPaymentRepository(db *gorm.DB) PaymentRepository {
+	return &paymentRepository{db: db}
+}
+
+func (r *paymentRepository) Create(ctx context.Context, payment *model.Payment) error {
+	return r.db.WithContext(ctx).Create(payment).Error
+}
+
+func (r *paymentRepository) FindByID(ctx context.Context, id uint) (*model.Payment, error) {
+	var payment model.Payment
+	if err := r.db.WithContext(ctx).First(&payment, id).Error; err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (r *paymentRepository) FindByOrderID(ctx context.Context, orderID string) (*model.Payment, error) {
+	var payment model.Payment
+	if err := r.db.WithContext(ctx).Where("order_id = ?", orderID).First(&payment).Error; err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (r *paymentRepository) FindByUserID(ctx context.Context, userID uint, limit, offset int) ([]model.Payment, int64, error) {
+	var payments []model.Payment
+	var total int64
+
+	db := r.db.WithContext(ctx).Model(&model.Payment{}).Where("user_id = ?", userID)
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := db.Order("created_at DESC").Limit(limit).Offset(offset).Find(&payments).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return payments, total, nil
+}
+
+func (r *paymentRepository) Update(ctx context.Context, payment *model.Payment) error {
+	return r.db.WithContext(ctx).Save(payment).Error
+}
+
+func (r *paymentRepository) TransitionStatus(ctx context.Context, id uint, from model.PaymentStatus, updates map[string]interface{}) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&model.Payment{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(updates)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"gorm.io/gorm"
+)
+
+// PriceAlertRepository 금 시세 알림 저장소 인터페이스
+type PriceAlertRepository interface {
+	Create(ctx context.Context, alert *model.PriceAlert) error
+	FindByID(ctx context.Context, id uint) (*model.PriceAlert, error)
+	FindByUserID(ctx context.Context, userID uint) ([]model.PriceAlert, error)
+	CountByUserID(ctx context.Context, userID uint) (int64, error)
+	// FindActiveByType 해당 금 종류의 활성화된 알림 목록
+	FindActiveByType(ctx context.Context, priceType model.GoldPriceType) ([]model.PriceAlert, error)
+	Update(ctx context.Context, alert *model.PriceAlert) error
+	// MarkTriggered 알림 발송 시각과 가격 기록
+	MarkTriggered(ctx context.Context, id uint, at time.Time, price float64) error
+	Delete(ctx context.Context, id uint) error
+}
+
+type priceAlertRepository struct {
+	db *gorm.DB
+}
+
+// NewPriceAlertRepository 금 시세 알림 저장소 생성자
+func NewPriceAlertRepository(db *gorm.DB) PriceAlertRepository {
+	return &priceAlertRepository{db: db}
+}
+
+func (r *priceAlertRepository) Create(ctx context.Context, alert *model.PriceAlert) error {
+	return r.db.WithContext(ctx).Create(alert).Error
+}
+
+func (r *priceAlertRepository) FindByID(ctx context.Context, id uint) (*model.PriceAlert, error) {
+	var alert model.PriceAlert
+	if err := r.db.WithContext(ctx).First(&alert, id).Error; err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *priceAlertRepository) FindByUserID(ctx context.Context, userID uint) ([]model.PriceAlert, error) {
+	var alerts []model.PriceAlert
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&alerts).Error; err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+func (r *priceAlertRepository) CountByUserID(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.PriceAlert{}).
+		Where("user_id = ?", userID).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *priceAlertRepository) FindActiveByType(ctx context.Context, priceType model.GoldPriceType) ([]model.PriceAlert, error) {
+	var alerts []model.PriceAlert
+	if err := r.db.WithContext(ctx).Where("type = ? AND is_active = ?", priceType, true).
+		Find(&alerts).Error; err != nil {
+		return nil, err
+	}
+	return alerts, nil
+}
+
+func (r *priceAlertRepository) Update(ctx context.Context, alert *model.PriceAlert) error {
+	return r.db.WithContext(ctx).Save(alert).Error
+}
+
+func (r *priceAlertRepository) MarkTriggered(ctx context.Context, id uint, at time.Time, price float64) error {
+	return r.db.WithContext(ctx).Model(&model.PriceAlert{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"last_triggered_at":    at,
+			"last_triggered_price": price,
+		}).Error
+}
+
+func (r *priceAlertRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&model.PriceAlert{}, id).Error
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProcessedImageRepository 업로드 이미지 처리 기록 저장소 인터페이스
+type ProcessedImageRepository interface {
+	// CreateIfAbsent 같은 원본 key의 기록이 없을 때만 생성 (생성 여부 반환)
+	CreateIfAbsent(ctx context.Context, image *model.ProcessedImage) (bool, error)
+	Update(ctx context.Context, image *model.ProcessedImage) error
+	// FindPending 처리 대기 중인 기록 (오래된 순)
+	FindPending(ctx context.Context, limit int) ([]model.ProcessedImage, error)
+	// FindDoneByOriginalURLs 원본 URL별 처리 완료 기록
+	FindDoneByOriginalURLs(ctx context.Context, urls []string) ([]model.ProcessedImage, error)
+	DeleteByOriginalKey(ctx context.Context, key string) error
+}
+
+type processedImageRepository struct {
+	db *gorm.DB
+}
+
+// NewProcessedImageRepository 업로드 이미지 처리 기록 저장소 생성
+func NewProcessedImageRepository(db *gorm.DB) ProcessedImageRepository {
+	return &processedImageRepository{db: db}
+}
+
+func (r *processedImageRepository) CreateIfAbsent(ctx context.Context, image *model.ProcessedImage) (bool, error) {
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "original_key"}},
+		DoNothing: true,
+	}).Create(image)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *processedImageRepository) Update(ctx context.Context, image *model.ProcessedImage) error {
+	return r.db.WithContext(ctx).Save(image).Error
+}
+
+func (r *processedImageRepository) FindPending(ctx context.Context, limit int) ([]model.ProcessedImage, error) {
+	var images []model.ProcessedImage
+	if err := r.db.WithContext(ctx).Where("status = ?", model.ProcessedImagePending).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&images).Error; err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (r *processedImageRepository) FindDoneByOriginalURLs(ctx context.Context, urls []string) ([]model.ProcessedImage, error) {
+	if len(urls) == 0 {
+		return []model.ProcessedImage{}, nil
+	}
+
+	var images []model.ProcessedImage
+	if err := r.db.WithContext(ctx).Where("original_url IN ? AND status = ?", urls, model.ProcessedImageDone).
+		Find(&images).Error; err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+func (r *processedImageRepository) DeleteByOriginalKey(ctx context.Context, key string) error {
+	return r.db.WithContext(ctx).Where("original_key = ?", key).Delete(&model.ProcessedImage{}).Error
+}
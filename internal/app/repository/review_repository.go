@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 
 	"gorm.io/gorm"
@@ -15,14 +16,14 @@ func NewReviewRepository(db *gorm.DB) *ReviewRepository {
 }
 
 // CreateReview 리뷰 생성
-func (r *ReviewRepository) CreateReview(review *model.StoreReview) error {
-	return r.db.Create(review).Error
+func (r *ReviewRepository) CreateReview(ctx context.Context, review *model.StoreReview) error {
+	return r.db.WithContext(ctx).Create(review).Error
 }
 
 // GetReviewByID ID로 리뷰 조회
-func (r *ReviewRepository) GetReviewByID(id uint) (*model.StoreReview, error) {
+func (r *ReviewRepository) GetReviewByID(ctx context.Context, id uint) (*model.StoreReview, error) {
 	var review model.StoreReview
-	err := r.db.Preload("User").Preload("Store").First(&review, id).Error
+	err := r.db.WithContext(ctx).Preload("User").Preload("Store").First(&review, id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -30,11 +31,11 @@ func (r *ReviewRepository) GetReviewByID(id uint) (*model.StoreReview, error) {
 }
 
 // GetReviewsByStoreID 매장별 리뷰 목록 조회
-func (r *ReviewRepository) GetReviewsByStoreID(storeID uint, offset, limit int, sortBy, sortOrder string) ([]model.StoreReview, int64, error) {
+func (r *ReviewRepository) GetReviewsByStoreID(ctx context.Context, storeID uint, offset, limit int, sortBy, sortOrder string) ([]model.StoreReview, int64, error) {
 	var reviews []model.StoreReview
 	var total int64
 
-	query := r.db.Model(&model.StoreReview{}).Where("store_id = ?", storeID)
+	query := r.db.WithContext(ctx).Model(&model.StoreReview{}).Where("store_id = ?", storeID)
 
 	// 전체 개수
 	if err := query.Count(&total).Error; err != nil {
@@ -62,11 +63,11 @@ func (r *ReviewRepository) GetReviewsByStoreID(storeID uint, offset, limit int,
 }
 
 // GetReviewsByUserID 사용자별 리뷰 목록 조회
-func (r *ReviewRepository) GetReviewsByUserID(userID uint, offset, limit int) ([]model.StoreReview, int64, error) {
+func (r *ReviewRepository) GetReviewsByUserID(ctx context.Context, userID uint, offset, limit int) ([]model.StoreReview, int64, error) {
 	var reviews []model.StoreReview
 	var total int64
 
-	query := r.db.Model(&model.StoreReview{}).Where("user_id = ?", userID)
+	query := r.db.WithContext(ctx).Model(&model.StoreReview{}).Where("user_id = ?", userID)
 
 	// 전체 개수
 	if err := query.Count(&total).Error; err != nil {
@@ -88,22 +89,22 @@ func (r *ReviewRepository) GetReviewsByUserID(userID uint, offset, limit int) ([
 }
 
 // UpdateReview 리뷰 수정
-func (r *ReviewRepository) UpdateReview(review *model.StoreReview) error {
-	return r.db.Save(review).Error
+func (r *ReviewRepository) UpdateReview(ctx context.Context, review *model.StoreReview) error {
+	return r.db.WithContext(ctx).Save(review).Error
 }
 
 // DeleteReview 리뷰 삭제
-func (r *ReviewRepository) DeleteReview(id uint) error {
-	return r.db.Delete(&model.StoreReview{}, id).Error
+func (r *ReviewRepository) DeleteReview(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&model.StoreReview{}, id).Error
 }
 
 // GetStoreStatistics 매장 통계 조회
-func (r *ReviewRepository) GetStoreStatistics(storeID uint) (map[string]interface{}, error) {
+func (r *ReviewRepository) GetStoreStatistics(ctx context.Context, storeID uint) (map[string]interface{}, error) {
 	stats := make(map[string]interface{})
 
 	// 리뷰 개수
 	var reviewCount int64
-	if err := r.db.Model(&model.StoreReview{}).Where("store_id = ?", storeID).Count(&reviewCount).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&model.StoreReview{}).Where("store_id = ?", storeID).Count(&reviewCount).Error; err != nil {
 		return nil, err
 	}
 	stats["review_count"] = reviewCount
@@ -111,7 +112,7 @@ func (r *ReviewRepository) GetStoreStatistics(storeID uint) (map[string]interfac
 	// 평균 평점
 	var avgRating float64
 	if reviewCount > 0 {
-		r.db.Model(&model.StoreReview{}).
+		r.db.WithContext(ctx).Model(&model.StoreReview{}).
 			Where("store_id = ?", storeID).
 			Select("AVG(rating)").
 			Scan(&avgRating)
@@ -120,7 +121,7 @@ func (r *ReviewRepository) GetStoreStatistics(storeID uint) (map[string]interfac
 
 	// 방문자 리뷰 개수
 	var visitorReviewCount int64
-	if err := r.db.Model(&model.StoreReview{}).
+	if err := r.db.WithContext(ctx).Model(&model.StoreReview{}).
 		Where("store_id = ? AND is_visitor = ?", storeID, true).
 		Count(&visitorReviewCount).Error; err != nil {
 		return nil, err
@@ -129,7 +130,7 @@ func (r *ReviewRepository) GetStoreStatistics(storeID uint) (map[string]interfac
 
 	// 매장 포스트 개수
 	var postCount int64
-	if err := r.db.Model(&model.CommunityPost{}).
+	if err := r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("store_id = ?", storeID).
 		Count(&postCount).Error; err != nil {
 		return nil, err
@@ -139,7 +140,7 @@ func (r *ReviewRepository) GetStoreStatistics(storeID uint) (map[string]interfac
 	// 갤러리 이미지 개수 (커뮤니티 포스트의 이미지 개수)
 	var posts []model.CommunityPost
 	var imageCount int64
-	if err := r.db.Model(&model.CommunityPost{}).
+	if err := r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("store_id = ? AND array_length(image_urls, 1) > 0", storeID).
 		Select("image_urls").
 		Find(&posts).Error; err != nil {
@@ -154,9 +155,9 @@ func (r *ReviewRepository) GetStoreStatistics(storeID uint) (map[string]interfac
 }
 
 // ToggleLike 리뷰 좋아요 토글
-func (r *ReviewRepository) ToggleLike(reviewID, userID uint) (bool, error) {
+func (r *ReviewRepository) ToggleLike(ctx context.Context, reviewID, userID uint) (bool, error) {
 	var like model.ReviewLike
-	err := r.db.Where("review_id = ? AND user_id = ?", reviewID, userID).First(&like).Error
+	err := r.db.WithContext(ctx).Where("review_id = ? AND user_id = ?", reviewID, userID).First(&like).Error
 
 	if err == gorm.ErrRecordNotFound {
 		// 좋아요 추가
@@ -164,12 +165,12 @@ func (r *ReviewRepository) ToggleLike(reviewID, userID uint) (bool, error) {
 			ReviewID: reviewID,
 			UserID:   userID,
 		}
-		if err := r.db.Create(&like).Error; err != nil {
+		if err := r.db.WithContext(ctx).Create(&like).Error; err != nil {
 			return false, err
 		}
 
 		// 좋아요 수 증가
-		if err := r.db.Model(&model.StoreReview{}).
+		if err := r.db.WithContext(ctx).Model(&model.StoreReview{}).
 			Where("id = ?", reviewID).
 			UpdateColumn("like_count", gorm.Expr("like_count + ?", 1)).Error; err != nil {
 			return false, err
@@ -181,12 +182,12 @@ func (r *ReviewRepository) ToggleLike(reviewID, userID uint) (bool, error) {
 	}
 
 	// 좋아요 제거
-	if err := r.db.Delete(&like).Error; err != nil {
+	if err := r.db.WithContext(ctx).Delete(&like).Error; err != nil {
 		return false, err
 	}
 
 	// 좋아요 수 감소
-	if err := r.db.Model(&model.StoreReview{}).
+	if err := r.db.WithContext(ctx).Model(&model.StoreReview{}).
 		Where("id = ?", reviewID).
 		UpdateColumn("like_count", gorm.Expr("like_count - ?", 1)).Error; err != nil {
 		return false, err
@@ -196,9 +197,9 @@ func (r *ReviewRepository) ToggleLike(reviewID, userID uint) (bool, error) {
 }
 
 // IsLiked 사용자가 리뷰에 좋아요를 눌렀는지 확인
-func (r *ReviewRepository) IsLiked(reviewID, userID uint) (bool, error) {
+func (r *ReviewRepository) IsLiked(ctx context.Context, reviewID, userID uint) (bool, error) {
 	var count int64
-	err := r.db.Model(&model.ReviewLike{}).
+	err := r.db.WithContext(ctx).Model(&model.ReviewLike{}).
 		Where("review_id = ? AND user_id = ?", reviewID, userID).
 		Count(&count).Error
 	if err != nil {
@@ -209,20 +210,21 @@ func (r *ReviewRepository) IsLiked(reviewID, userID uint) (bool, error) {
 
 // GalleryImage 갤러리 이미지 정보
 type GalleryImage struct {
-	ImageURL  string `json:"image_url"`
-	PostID    uint   `json:"post_id"`
-	Caption   string `json:"caption"`
-	CreatedAt string `json:"created_at"`
+	ImageURL     string `json:"image_url"`
+	ThumbnailURL string `json:"thumbnail_url"` // 처리 전이면 원본 URL
+	PostID       uint   `json:"post_id"`
+	Caption      string `json:"caption"`
+	CreatedAt    string `json:"created_at"`
 }
 
 // GetStoreGallery 매장 갤러리 조회 (커뮤니티 포스트 이미지)
-func (r *ReviewRepository) GetStoreGallery(storeID uint, offset, limit int) ([]GalleryImage, int64, error) {
+func (r *ReviewRepository) GetStoreGallery(ctx context.Context, storeID uint, offset, limit int) ([]GalleryImage, int64, error) {
 	var posts []model.CommunityPost
 	var gallery []GalleryImage
 	var total int64
 
 	// 이미지가 있는 포스트만 조회
-	query := r.db.Model(&model.CommunityPost{}).
+	query := r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("store_id = ? AND array_length(image_urls, 1) > 0", storeID)
 
 	// 전체 이미지 개수 계산
@@ -235,7 +237,7 @@ func (r *ReviewRepository) GetStoreGallery(storeID uint, offset, limit int) ([]G
 
 	// 페이지네이션을 위한 데이터 조회
 	posts = []model.CommunityPost{} // 리셋
-	err := r.db.Model(&model.CommunityPost{}).
+	err := r.db.WithContext(ctx).Model(&model.CommunityPost{}).
 		Where("store_id = ? AND array_length(image_urls, 1) > 0", storeID).
 		Order("created_at DESC").
 		Find(&posts).Error
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
 	"gorm.io/gorm"
@@ -34,26 +35,26 @@ type StoreListResult struct {
 }
 
 type StoreRepository interface {
-	Create(store *model.Store) error
-	Update(store *model.Store) error
-	Delete(id uint) error
-	FindAll(filter StoreFilter) (*StoreListResult, error)
-	FindByID(id uint) (*model.Store, error)
-	FindByUserID(userID uint) ([]model.Store, error)
-	FindSingleByUserID(userID uint) (*model.Store, error)
-	FindByBusinessNumber(businessNumber string) (*model.Store, error)
-	ListLocations() ([]StoreLocation, error)
-	ToggleLike(storeID, userID uint) (bool, error)
-	IsLiked(storeID, userID uint) (bool, error)
-	GetUserLikedStores(userID uint) ([]model.Store, error)
-	GetUserLikedStoreIDs(userID uint) ([]uint, error)
-	BulkCreate(stores []model.Store, batchSize int) error
-	CreateBusinessRegistration(businessReg *model.BusinessRegistration) error
-	CreateVerification(verification *model.StoreVerification) error
-	FindVerificationByStoreID(storeID uint) (*model.StoreVerification, error)
-	FindVerificationByID(verificationID uint) (*model.StoreVerification, error)
-	FindVerificationsByStatus(status string) ([]*model.StoreVerification, error)
-	UpdateVerification(verification *model.StoreVerification) error
+	Create(ctx context.Context, store *model.Store) error
+	Update(ctx context.Context, store *model.Store) error
+	Delete(ctx context.Context, id uint) error
+	FindAll(ctx context.Context, filter StoreFilter) (*StoreListResult, error)
+	FindByID(ctx context.Context, id uint) (*model.Store, error)
+	FindByUserID(ctx context.Context, userID uint) ([]model.Store, error)
+	FindSingleByUserID(ctx context.Context, userID uint) (*model.Store, error)
+	FindByBusinessNumber(ctx context.Context, businessNumber string) (*model.Store, error)
+	ListLocations(ctx context.Context) ([]StoreLocation, error)
+	ToggleLike(ctx context.Context, storeID, userID uint) (bool, error)
+	IsLiked(ctx context.Context, storeID, userID uint) (bool, error)
+	GetUserLikedStores(ctx context.Context, userID uint) ([]model.Store, error)
+	GetUserLikedStoreIDs(ctx context.Context, userID uint) ([]uint, error)
+	BulkCreate(ctx context.Context, stores []model.Store, batchSize int) error
+	CreateBusinessRegistration(ctx context.Context, businessReg *model.BusinessRegistration) error
+	CreateVerification(ctx context.Context, verification *model.StoreVerification) error
+	FindVerificationByStoreID(ctx context.Context, storeID uint) (*model.StoreVerification, error)
+	FindVerificationByID(ctx context.Context, verificationID uint) (*model.StoreVerification, error)
+	FindVerificationsByStatus(ctx context.Context, status string) ([]*model.StoreVerification, error)
+	UpdateVerification(ctx context.Context, verification *model.StoreVerification) error
 }
 
 type storeRepository struct {
@@ -64,14 +65,14 @@ func NewStoreRepository(db *gorm.DB) StoreRepository {
 	return &storeRepository{db: db}
 }
 
-func (r *storeRepository) Create(store *model.Store) error {
+func (r *storeRepository) Create(ctx context.Context, store *model.Store) error {
 	logger.Debug("Creating store in database", map[string]interface{}{
 		"name":   store.Name,
 		"region": store.Region,
 		"userID": store.UserID,
 	})
 
-	if err := r.db.Create(store).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(store).Error; err != nil {
 		logger.Error("Failed to create store in database", err, map[string]interface{}{
 			"name":   store.Name,
 			"region": store.Region,
@@ -88,14 +89,14 @@ func (r *storeRepository) Create(store *model.Store) error {
 	return nil
 }
 
-func (r *storeRepository) Update(store *model.Store) error {
+func (r *storeRepository) Update(ctx context.Context, store *model.Store) error {
 	logger.Debug("Updating store in database", map[string]interface{}{
 		"store_id": store.ID,
 		"name":     store.Name,
 		"userID":   store.UserID,
 	})
 
-	if err := r.db.Save(store).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(store).Error; err != nil {
 		logger.Error("Failed to update store in database", err, map[string]interface{}{
 			"store_id": store.ID,
 			"name":     store.Name,
@@ -111,12 +112,12 @@ func (r *storeRepository) Update(store *model.Store) error {
 	return nil
 }
 
-func (r *storeRepository) Delete(id uint) error {
+func (r *storeRepository) Delete(ctx context.Context, id uint) error {
 	logger.Debug("Deleting store from database", map[string]interface{}{
 		"store_id": id,
 	})
 
-	if err := r.db.Delete(&model.Store{}, id).Error; err != nil {
+	if err := r.db.WithContext(ctx).Delete(&model.Store{}, id).Error; err != nil {
 		logger.Error("Failed to delete store from database", err, map[string]interface{}{
 			"store_id": id,
 		})
@@ -129,7 +130,7 @@ func (r *storeRepository) Delete(id uint) error {
 	return nil
 }
 
-func (r *storeRepository) FindAll(filter StoreFilter) (*StoreListResult, error) {
+func (r *storeRepository) FindAll(ctx context.Context, filter StoreFilter) (*StoreListResult, error) {
 	logger.Debug("Finding stores", map[string]interface{}{
 		"region":      filter.Region,
 		"district":    filter.District,
@@ -142,7 +143,7 @@ func (r *storeRepository) FindAll(filter StoreFilter) (*StoreListResult, error)
 		"user_lng":    filter.UserLng,
 	})
 
-	query := r.db.Model(&model.Store{}).Preload("Tags")
+	query := r.db.WithContext(ctx).Model(&model.Store{}).Preload("Tags")
 
 	// 기본 필터
 	if filter.Region != "" {
@@ -221,7 +222,7 @@ func (r *storeRepository) FindAll(filter StoreFilter) (*StoreListResult, error)
 			sortLat, sortLng, sortLat)
 
 		// 총 개수 조회 (SELECT distance 추가 전)
-		countQuery := r.db.Model(&model.Store{})
+		countQuery := r.db.WithContext(ctx).Model(&model.Store{})
 		if filter.Region != "" {
 			countQuery = countQuery.Where("region = ?", filter.Region)
 		}
@@ -283,7 +284,7 @@ func (r *storeRepository) FindAll(filter StoreFilter) (*StoreListResult, error)
 			return nil, err
 		}
 
-		if err := r.populateStoreStats(&stores); err != nil {
+		if err := r.populateStoreStats(ctx, &stores); err != nil {
 			logger.Error("Failed to populate store stats", err, nil)
 			return nil, err
 		}
@@ -327,7 +328,7 @@ func (r *storeRepository) FindAll(filter StoreFilter) (*StoreListResult, error)
 		return nil, err
 	}
 
-	if err := r.populateStoreStats(&stores); err != nil {
+	if err := r.populateStoreStats(ctx, &stores); err != nil {
 		logger.Error("Failed to populate store stats", err, nil)
 		return nil, err
 	}
@@ -343,12 +344,12 @@ func (r *storeRepository) FindAll(filter StoreFilter) (*StoreListResult, error)
 	}, nil
 }
 
-func (r *storeRepository) FindByID(id uint) (*model.Store, error) {
+func (r *storeRepository) FindByID(ctx context.Context, id uint) (*model.Store, error) {
 	logger.Debug("Finding store by ID", map[string]interface{}{
 		"store_id": id,
 	})
 
-	query := r.db.Model(&model.Store{}).Preload("Tags").Preload("BusinessRegistration")
+	query := r.db.WithContext(ctx).Model(&model.Store{}).Preload("Tags").Preload("BusinessRegistration")
 
 	var store model.Store
 	if err := query.First(&store, id).Error; err != nil {
@@ -360,7 +361,7 @@ func (r *storeRepository) FindByID(id uint) (*model.Store, error) {
 
 	// Populate category counts and total products
 	stores := []model.Store{store}
-	if err := r.populateStoreStats(&stores); err != nil {
+	if err := r.populateStoreStats(ctx, &stores); err != nil {
 		logger.Error("Failed to populate store stats", err, map[string]interface{}{
 			"store_id": id,
 		})
@@ -375,13 +376,13 @@ func (r *storeRepository) FindByID(id uint) (*model.Store, error) {
 	return &store, nil
 }
 
-func (r *storeRepository) FindByUserID(userID uint) ([]model.Store, error) {
+func (r *storeRepository) FindByUserID(ctx context.Context, userID uint) ([]model.Store, error) {
 	logger.Debug("Finding stores by user ID in database", map[string]interface{}{
 		"user_id": userID,
 	})
 
 	var stores []model.Store
-	if err := r.db.Preload("Tags").Where("user_id = ?", userID).
+	if err := r.db.WithContext(ctx).Preload("Tags").Where("user_id = ?", userID).
 		Order("created_at DESC").
 		Find(&stores).Error; err != nil {
 		logger.Error("Failed to find stores by user ID in database", err, map[string]interface{}{
@@ -390,7 +391,7 @@ func (r *storeRepository) FindByUserID(userID uint) ([]model.Store, error) {
 		return nil, err
 	}
 
-	if err := r.populateStoreStats(&stores); err != nil {
+	if err := r.populateStoreStats(ctx, &stores); err != nil {
 		logger.Error("Failed to populate store stats for user stores", err, map[string]interface{}{
 			"user_id": userID,
 		})
@@ -404,11 +405,11 @@ func (r *storeRepository) FindByUserID(userID uint) ([]model.Store, error) {
 	return stores, nil
 }
 
-func (r *storeRepository) ListLocations() ([]StoreLocation, error) {
+func (r *storeRepository) ListLocations(ctx context.Context) ([]StoreLocation, error) {
 	logger.Debug("Listing unique store locations")
 
 	var locations []StoreLocation
-	if err := r.db.Model(&model.Store{}).
+	if err := r.db.WithContext(ctx).Model(&model.Store{}).
 		Select("region, district, COUNT(*) as store_count").
 		Group("region, district").
 		Order("region ASC, district ASC").
@@ -423,20 +424,20 @@ func (r *storeRepository) ListLocations() ([]StoreLocation, error) {
 	return locations, nil
 }
 
-func (r *storeRepository) populateStoreStats(stores *[]model.Store) error {
+func (r *storeRepository) populateStoreStats(ctx context.Context, stores *[]model.Store) error {
 	// Product 관련 기능 제거됨 - 홍보 사이트로 전환
 	return nil
 }
 
 // ToggleLike 매장 좋아요 토글
-func (r *storeRepository) ToggleLike(storeID, userID uint) (bool, error) {
+func (r *storeRepository) ToggleLike(ctx context.Context, storeID, userID uint) (bool, error) {
 	logger.Debug("Toggling store like", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 	})
 
 	var like model.StoreLike
-	err := r.db.Where("store_id = ? AND user_id = ?", storeID, userID).First(&like).Error
+	err := r.db.WithContext(ctx).Where("store_id = ? AND user_id = ?", storeID, userID).First(&like).Error
 
 	if err == gorm.ErrRecordNotFound {
 		// 좋아요 추가
@@ -444,7 +445,7 @@ func (r *storeRepository) ToggleLike(storeID, userID uint) (bool, error) {
 			StoreID: storeID,
 			UserID:  userID,
 		}
-		if err := r.db.Create(&like).Error; err != nil {
+		if err := r.db.WithContext(ctx).Create(&like).Error; err != nil {
 			logger.Error("Failed to create store like", err, map[string]interface{}{
 				"store_id": storeID,
 				"user_id":  userID,
@@ -466,7 +467,7 @@ func (r *storeRepository) ToggleLike(storeID, userID uint) (bool, error) {
 	}
 
 	// 좋아요 제거
-	if err := r.db.Delete(&like).Error; err != nil {
+	if err := r.db.WithContext(ctx).Delete(&like).Error; err != nil {
 		logger.Error("Failed to delete store like", err, map[string]interface{}{
 			"store_id": storeID,
 			"user_id":  userID,
@@ -482,14 +483,14 @@ func (r *storeRepository) ToggleLike(storeID, userID uint) (bool, error) {
 }
 
 // IsLiked 사용자가 매장에 좋아요를 눌렀는지 확인
-func (r *storeRepository) IsLiked(storeID, userID uint) (bool, error) {
+func (r *storeRepository) IsLiked(ctx context.Context, storeID, userID uint) (bool, error) {
 	logger.Debug("Checking if store is liked", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 	})
 
 	var count int64
-	err := r.db.Model(&model.StoreLike{}).
+	err := r.db.WithContext(ctx).Model(&model.StoreLike{}).
 		Where("store_id = ? AND user_id = ?", storeID, userID).
 		Count(&count).Error
 	if err != nil {
@@ -509,13 +510,13 @@ func (r *storeRepository) IsLiked(storeID, userID uint) (bool, error) {
 }
 
 // GetUserLikedStores retrieves all stores liked by the user
-func (r *storeRepository) GetUserLikedStores(userID uint) ([]model.Store, error) {
+func (r *storeRepository) GetUserLikedStores(ctx context.Context, userID uint) ([]model.Store, error) {
 	logger.Debug("Getting user liked stores from repository", map[string]interface{}{
 		"user_id": userID,
 	})
 
 	var stores []model.Store
-	err := r.db.
+	err := r.db.WithContext(ctx).
 		Joins("JOIN store_likes ON store_likes.store_id = stores.id").
 		Where("store_likes.user_id = ?", userID).
 		Preload("Tags").
@@ -536,13 +537,13 @@ func (r *storeRepository) GetUserLikedStores(userID uint) ([]model.Store, error)
 }
 
 // GetUserLikedStoreIDs retrieves IDs of all stores liked by the user
-func (r *storeRepository) GetUserLikedStoreIDs(userID uint) ([]uint, error) {
+func (r *storeRepository) GetUserLikedStoreIDs(ctx context.Context, userID uint) ([]uint, error) {
 	logger.Debug("Getting user liked store IDs from repository", map[string]interface{}{
 		"user_id": userID,
 	})
 
 	var storeIDs []uint
-	err := r.db.Model(&model.StoreLike{}).
+	err := r.db.WithContext(ctx).Model(&model.StoreLike{}).
 		Where("user_id = ?", userID).
 		Pluck("store_id", &storeIDs).Error
 
@@ -561,13 +562,13 @@ func (r *storeRepository) GetUserLikedStoreIDs(userID uint) ([]uint, error) {
 }
 
 // CreateBusinessRegistration creates a new business registration
-func (r *storeRepository) CreateBusinessRegistration(businessReg *model.BusinessRegistration) error {
+func (r *storeRepository) CreateBusinessRegistration(ctx context.Context, businessReg *model.BusinessRegistration) error {
 	logger.Info("Creating business registration", map[string]interface{}{
 		"store_id":        businessReg.StoreID,
 		"business_number": businessReg.BusinessNumber,
 	})
 
-	if err := r.db.Create(businessReg).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(businessReg).Error; err != nil {
 		logger.Error("Failed to create business registration", err, map[string]interface{}{
 			"store_id": businessReg.StoreID,
 		})
@@ -581,9 +582,9 @@ func (r *storeRepository) CreateBusinessRegistration(businessReg *model.Business
 }
 
 // FindSingleByUserID finds a single store by user ID
-func (r *storeRepository) FindSingleByUserID(userID uint) (*model.Store, error) {
+func (r *storeRepository) FindSingleByUserID(ctx context.Context, userID uint) (*model.Store, error) {
 	var store model.Store
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Preload("BusinessRegistration").
 		Preload("Tags").
 		Preload("Verification").
@@ -595,13 +596,13 @@ func (r *storeRepository) FindSingleByUserID(userID uint) (*model.Store, error)
 }
 
 // FindByBusinessNumber finds a store by business number
-func (r *storeRepository) FindByBusinessNumber(businessNumber string) (*model.Store, error) {
+func (r *storeRepository) FindByBusinessNumber(ctx context.Context, businessNumber string) (*model.Store, error) {
 	logger.Debug("Finding store by business number", map[string]interface{}{
 		"business_number": businessNumber,
 	})
 
 	var businessReg model.BusinessRegistration
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Preload("Store").
 		Where("business_number = ?", businessNumber).
 		First(&businessReg).Error; err != nil {
@@ -619,12 +620,12 @@ func (r *storeRepository) FindByBusinessNumber(businessNumber string) (*model.St
 }
 
 // CreateVerification creates a new store verification request
-func (r *storeRepository) CreateVerification(verification *model.StoreVerification) error {
+func (r *storeRepository) CreateVerification(ctx context.Context, verification *model.StoreVerification) error {
 	logger.Info("Creating verification", map[string]interface{}{
 		"store_id": verification.StoreID,
 	})
 
-	if err := r.db.Create(verification).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(verification).Error; err != nil {
 		logger.Error("Failed to create verification", err, map[string]interface{}{
 			"store_id": verification.StoreID,
 		})
@@ -639,9 +640,9 @@ func (r *storeRepository) CreateVerification(verification *model.StoreVerificati
 }
 
 // FindVerificationByStoreID finds verification by store ID (latest one)
-func (r *storeRepository) FindVerificationByStoreID(storeID uint) (*model.StoreVerification, error) {
+func (r *storeRepository) FindVerificationByStoreID(ctx context.Context, storeID uint) (*model.StoreVerification, error) {
 	var verification model.StoreVerification
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Where("store_id = ?", storeID).
 		Order("created_at DESC").
 		First(&verification).Error; err != nil {
@@ -651,9 +652,9 @@ func (r *storeRepository) FindVerificationByStoreID(storeID uint) (*model.StoreV
 }
 
 // FindVerificationByID finds verification by ID
-func (r *storeRepository) FindVerificationByID(verificationID uint) (*model.StoreVerification, error) {
+func (r *storeRepository) FindVerificationByID(ctx context.Context, verificationID uint) (*model.StoreVerification, error) {
 	var verification model.StoreVerification
-	if err := r.db.
+	if err := r.db.WithContext(ctx).
 		Preload("Store").
 		First(&verification, verificationID).Error; err != nil {
 		return nil, err
@@ -662,9 +663,9 @@ func (r *storeRepository) FindVerificationByID(verificationID uint) (*model.Stor
 }
 
 // FindVerificationsByStatus finds verifications by status
-func (r *storeRepository) FindVerificationsByStatus(status string) ([]*model.StoreVerification, error) {
+func (r *storeRepository) FindVerificationsByStatus(ctx context.Context, status string) ([]*model.StoreVerification, error) {
 	var verifications []*model.StoreVerification
-	query := r.db.Preload("Store").Where("status = ?", status).Order("created_at DESC")
+	query := r.db.WithContext(ctx).Preload("Store").Where("status = ?", status).Order("created_at DESC")
 
 	if err := query.Find(&verifications).Error; err != nil {
 		logger.Error("Failed to find verifications by status", err, map[string]interface{}{
@@ -681,12 +682,12 @@ func (r *storeRepository) FindVerificationsByStatus(status string) ([]*model.Sto
 }
 
 // UpdateVerification updates a verification record
-func (r *storeRepository) UpdateVerification(verification *model.StoreVerification) error {
+func (r *storeRepository) UpdateVerification(ctx context.Context, verification *model.StoreVerification) error {
 	logger.Info("Updating verification", map[string]interface{}{
 		"verification_id": verification.ID,
 	})
 
-	if err := r.db.Save(verification).Error; err != nil {
+	if err := r.db.WithContext(ctx).Save(verification).Error; err != nil {
 		logger.Error("Failed to update verification", err, map[string]interface{}{
 			"verification_id": verification.ID,
 		})
@@ -700,14 +701,14 @@ func (r *storeRepository) UpdateVerification(verification *model.StoreVerificati
 }
 
 // BulkCreate creates or updates multiple stores in batches (UPSERT)
-func (r *storeRepository) BulkCreate(stores []model.Store, batchSize int) error {
+func (r *storeRepository) BulkCreate(ctx context.Context, stores []model.Store, batchSize int) error {
 	logger.Info("Bulk creating/updating stores", map[string]interface{}{
 		"total_count": len(stores),
 		"batch_size":  batchSize,
 	})
 
 	// UPSERT: business_number가 중복되면 업데이트
-	if err := r.db.Clauses(clause.OnConflict{
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "business_number"}},
 		DoUpdates: clause.AssignmentColumns([]string{
 			"name", "branch_name", "slug", "region", "district", "dong",
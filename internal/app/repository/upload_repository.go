@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"gorm.io/gorm"
+)
+
+// UploadRepository 업로드 기록 저장소 인터페이스
+type UploadRepository interface {
+	Create(ctx context.Context, upload *model.Upload) error
+	// FindByKey 저장소 key로 업로드 기록 조회 (없으면 nil)
+	FindByKey(ctx context.Context, key string) (*model.Upload, error)
+	// FindCreatedBefore before 이전에 발급된 업로드 (ID 순, afterID 다음부터)
+	FindCreatedBefore(ctx context.Context, before time.Time, afterID uint, limit int) ([]model.Upload, error)
+	// FindReferencedURLs urls 중 매장/리뷰/게시글/메시지/프로필/사업자등록증에서 사용 중인 URL
+	FindReferencedURLs(ctx context.Context, urls []string) ([]string, error)
+	Delete(ctx context.Context, id uint) error
+}
+
+type uploadRepository struct {
+	db *gorm.DB
+}
+
+// NewUploadRepository 업로드 기록 저장소 생성
+func NewUploadRepository(db *gorm.DB) UploadRepository {
+	return &uploadRepository{db: db}
+}
+
+func (r *uploadRepository) Create(ctx context.Context, upload *model.Upload) error {
+	return r.db.WithContext(ctx).Create(upload).Error
+}
+
+func (r *uploadRepository) FindByKey(ctx context.Context, key string) (*model.Upload, error) {
+	var upload model.Upload
+	if err := r.db.WithContext(ctx).Where("key = ?", key).First(&upload).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &upload, nil
+}
+
+func (r *uploadRepository) FindCreatedBefore(ctx context.Context, before time.Time, afterID uint, limit int) ([]model.Upload, error) {
+	var uploads []model.Upload
+	if err := r.db.WithContext(ctx).Where("created_at < ? AND id > ?", before, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&uploads).Error; err != nil {
+		return nil, err
+	}
+	return uploads, nil
+}
+
+// referencedURLsQuery 파일 URL을 저장하는 컬럼 전체 (삭제 처리된 행도 복구될 수 있으므로 포함)
+const referencedURLsQuery = `
+SELECT image_url AS url FROM stores WHERE image_url IN @urls
+UNION SELECT background->>'value' FROM stores WHERE background->>'value' IN @urls
+UNION SELECT url FROM store_reviews, unnest(image_urls) AS url WHERE url IN @urls
+UNION SELECT url FROM community_posts, unnest(image_urls) AS url WHERE url IN @urls
+UNION SELECT file_url FROM messages WHERE file_url IN @urls
+UNION SELECT profile_image FROM users WHERE profile_image IN @urls
+UNION SELECT business_license_url FROM store_verifications WHERE business_license_url IN @urls`
+
+func (r *uploadRepository) FindReferencedURLs(ctx context.Context, urls []string) ([]string, error) {
+	if len(urls) == 0 {
+		return []string{}, nil
+	}
+
+	var referenced []string
+	if err := r.db.WithContext(ctx).Raw(referencedURLsQuery, sql.Named("urls", urls)).
+		Scan(&referenced).Error; err != nil {
+		return nil, err
+	}
+	return referenced, nil
+}
+
+func (r *uploadRepository) Delete(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&model.Upload{}, id).Error
+}
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"testing"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
@@ -53,7 +54,7 @@ func TestUserRepository_Create(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := repo.Create(tt.user)
+			err := repo.Create(context.Background(), tt.user)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -77,7 +78,7 @@ func TestUserRepository_FindByID(t *testing.T) {
 		Phone:        "010-1234-5678",
 		Role:         model.RoleUser,
 	}
-	err := repo.Create(user)
+	err := repo.Create(context.Background(), user)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -99,7 +100,7 @@ func TestUserRepository_FindByID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			found, err := repo.FindByID(tt.id)
+			found, err := repo.FindByID(context.Background(), tt.id)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -126,7 +127,7 @@ func TestUserRepository_FindByEmail(t *testing.T) {
 		Phone:        "010-1234-5678",
 		Role:         model.RoleUser,
 	}
-	err := repo.Create(user)
+	err := repo.Create(context.Background(), user)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -148,7 +149,7 @@ func TestUserRepository_FindByEmail(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			found, err := repo.FindByEmail(tt.email)
+			found, err := repo.FindByEmail(context.Background(), tt.email)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -175,18 +176,18 @@ func TestUserRepository_Update(t *testing.T) {
 		Phone:        "010-1234-5678",
 		Role:         model.RoleUser,
 	}
-	err := repo.Create(user)
+	err := repo.Create(context.Background(), user)
 	require.NoError(t, err)
 
 	// Update user
 	user.Name = "Updated Name"
 	user.Phone = "010-9999-9999"
 
-	err = repo.Update(user)
+	err = repo.Update(context.Background(), user)
 	assert.NoError(t, err)
 
 	// Verify update
-	updated, err := repo.FindByID(user.ID)
+	updated, err := repo.FindByID(context.Background(), user.ID)
 	require.NoError(t, err)
 	assert.Equal(t, "Updated Name", updated.Name)
 	assert.Equal(t, "010-9999-9999", updated.Phone)
@@ -204,14 +205,14 @@ func TestUserRepository_Delete(t *testing.T) {
 		Phone:        "010-1234-5678",
 		Role:         model.RoleUser,
 	}
-	err := repo.Create(user)
+	err := repo.Create(context.Background(), user)
 	require.NoError(t, err)
 
 	// Delete user
-	err = repo.Delete(user.ID)
+	err = repo.Delete(context.Background(), user.ID)
 	assert.NoError(t, err)
 
 	// Verify deletion (soft delete)
-	_, err = repo.FindByID(user.ID)
+	_, err = repo.FindByID(context.Background(), user.ID)
 	assert.Error(t, err)
 }
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"gorm.io/gorm"
+)
+
+type UserSessionRepository interface {
+	Create(ctx context.Context, session *model.UserSession) error
+	FindByID(ctx context.Context, id string) (*model.UserSession, error)
+	FindActiveByUserID(ctx context.Context, userID uint) ([]model.UserSession, error)
+	Rotate(ctx context.Context, session *model.UserSession, currentHash string) (bool, error)
+	Revoke(ctx context.Context, id, reason string) error
+	RevokeByUserID(ctx context.Context, userID uint, exceptID, reason string) (int64, error)
+}
+
+type userSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewUserSessionRepository(db *gorm.DB) UserSessionRepository {
+	return &userSessionRepository{db: db}
+}
+
+func (r *userSessionRepository) Create(ctx context.Context, session *model.UserSession) error {
+	log := logger.FromContext(ctx)
+	log.Debug("Creating user session in database", map[string]interface{}{
+		"session_id": session.ID,
+		"user_id":    session.UserID,
+	})
+
+	if err := r.db.WithContext(ctx).Create(session).Error; err != nil {
+		log.Error("Failed to create user session in database", err, map[string]interface{}{
+			"session_id": session.ID,
+			"user_id":    session.UserID,
+		})
+		return err
+	}
+	return nil
+}
+
+func (r *userSessionRepository) FindByID(ctx context.Context, id string) (*model.UserSession, error) {
+	log := logger.FromContext(ctx)
+
+	var session model.UserSession
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&session).Error; err != nil {
+		if err != gorm.ErrRecordNotFound {
+			log.Error("Failed to find user session in database", err, map[string]interface{}{
+				"session_id": id,
+			})
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+// FindActiveByUserID 폐기되지 않고 만료되지 않은 세션을 최근 사용 순으로 조회
+func (r *userSessionRepository) FindActiveByUserID(ctx context.Context, userID uint) ([]model.UserSession, error) {
+	log := logger.FromContext(ctx)
+
+	var sessions []model.UserSession
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error; err != nil {
+		log.Error("Failed to find active user sessions in database", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Rotate 현재 refresh token 해시가 currentHash일 때만 새 토큰 정보로 교체 (동시 갱신 시 하나만 성공)
+func (r *userSessionRepository) Rotate(ctx context.Context, session *model.UserSession, currentHash string) (bool, error) {
+	log := logger.FromContext(ctx)
+
+	result := r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("id = ? AND token_hash = ? AND revoked_at IS NULL", session.ID, currentHash).
+		Updates(map[string]interface{}{
+			"token_hash":   session.TokenHash,
+			"ip_address":   session.IPAddress,
+			"user_agent":   session.UserAgent,
+			"last_used_at": session.LastUsedAt,
+			"expires_at":   session.ExpiresAt,
+		})
+	if result.Error != nil {
+		log.Error("Failed to rotate user session in database", result.Error, map[string]interface{}{
+			"session_id": session.ID,
+		})
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *userSessionRepository) Revoke(ctx context.Context, id, reason string) error {
+	log := logger.FromContext(ctx)
+
+	if err := r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Updates(map[string]interface{}{
+			"revoked_at":     time.Now(),
+			"revoked_reason": reason,
+		}).Error; err != nil {
+		log.Error("Failed to revoke user session in database", err, map[string]interface{}{
+			"session_id": id,
+			"reason":     reason,
+		})
+		return err
+	}
+	return nil
+}
+
+// RevokeByUserID 사용자의 활성 세션을 모두 폐기 (exceptID가 있으면 해당 세션은 유지)
+func (r *userSessionRepository) RevokeByUserID(ctx context.Context, userID uint, exceptID, reason string) (int64, error) {
+	log := logger.FromContext(ctx)
+
+	query := r.db.WithContext(ctx).Model(&model.UserSession{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID)
+	if exceptID != "" {
+		query = query.Where("id <> ?", exceptID)
+	}
+	result := query.Updates(map[string]interface{}{
+		"revoked_at":     time.Now(),
+		"revoked_reason": reason,
+	})
+	if result.Error != nil {
+		log.Error("Failed to revoke user sessions in database", result.Error, map[string]interface{}{
+			"user_id": userID,
+			"reason":  reason,
+		})
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
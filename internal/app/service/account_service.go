@@ -0,0 +1,332 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/util"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAccountDeletionPending   = errors.New("이미 탈퇴가 요청된 계정입니다")
+	ErrAccountDeletionBlocked   = errors.New("진행 중인 안전거래가 있어 탈퇴할 수 없습니다")
+	ErrReauthenticationRequired = errors.New("본인 확인을 위해 다시 로그인한 뒤 시도해주세요")
+	ErrStoreTransferTarget      = errors.New("매장을 넘겨받을 사용자를 찾을 수 없습니다")
+	ErrStoreTransferNotFound    = errors.New("매장 이전 제안을 찾을 수 없습니다")
+)
+
+const (
+	// reauthenticationWindow 비밀번호가 없는 소셜 계정은 이 시간 안에 로그인한 세션에서만 탈퇴 가능
+	reauthenticationWindow = 5 * time.Minute
+	// deletionBatchSize 한 번에 익명화하는 탈퇴 요청 수
+	deletionBatchSize = 50
+	// deletionRetryDelay 익명화 시점에 진행 중인 안전거래가 있으면 이만큼 미룬 뒤 다시 시도
+	deletionRetryDelay = 24 * time.Hour
+)
+
+// DeleteAccountInput 회원 탈퇴 요청 정보
+type DeleteAccountInput struct {
+	Password         string // 비밀번호 계정의 재인증
+	SessionID        string // 소셜 계정 재인증 (최근 로그인 세션)
+	TransferStoresTo string // 소유 매장 이전을 제안할 사용자 이메일 (수락하지 않으면 비관리매장으로 전환)
+}
+
+// AccountService 회원 탈퇴와 개인정보 내보내기
+type AccountService interface {
+	// RequestDeletion 재인증 후 탈퇴를 예약하고 모든 기기에서 로그아웃 (유예 기간 중 다시 로그인하면 취소)
+	RequestDeletion(ctx context.Context, userID uint, input DeleteAccountInput) (*model.AccountDeletion, error)
+	// ProcessDueDeletions 유예 기간이 지난 탈퇴 요청을 익명화하고 처리한 수를 반환
+	// 그 사이 안전거래가 시작된 요청은 deletionRetryDelay만큼 미룸
+	ProcessDueDeletions(ctx context.Context) (int, error)
+	// ListStoreTransferOffers 사용자에게 온 매장 이전 제안 (수락 전)
+	ListStoreTransferOffers(ctx context.Context, userID uint) ([]model.AccountDeletion, error)
+	// AcceptStoreTransfer 매장 이전 수락 (탈퇴가 처리될 때 매장과 사장님 권한을 넘겨받음)
+	AcceptStoreTransfer(ctx context.Context, userID, deletionID uint) error
+	// DeclineStoreTransfer 매장 이전 거절 (탈퇴가 처리될 때 매장은 비관리매장으로 전환)
+	DeclineStoreTransfer(ctx context.Context, userID, deletionID uint) error
+	// ExportData 사용자 ID에 연결된 개인정보 전체
+	ExportData(ctx context.Context, userID uint) (*model.UserDataExport, error)
+}
+
+type accountService struct {
+	repo        repository.AccountRepository
+	userRepo    repository.UserRepository
+	sessionRepo repository.UserSessionRepository
+	gracePeriod time.Duration
+	now         func() time.Time
+}
+
+// NewAccountService 회원 탈퇴 서비스 생성자 (로그인 수신자로 등록되어 유예 중 로그인 시 탈퇴를 취소)
+func NewAccountService(
+	repo repository.AccountRepository,
+	userRepo repository.UserRepository,
+	sessionRepo repository.UserSessionRepository,
+	authService AuthService,
+	gracePeriod time.Duration,
+) AccountService {
+	s := &accountService{
+		repo:        repo,
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+		gracePeriod: gracePeriod,
+		now:         time.Now,
+	}
+	authService.AddListener(s)
+	return s
+}
+
+func (s *accountService) RequestDeletion(ctx context.Context, userID uint, input DeleteAccountInput) (*model.AccountDeletion, error) {
+	log := logger.FromContext(ctx)
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	if err := s.reauthenticate(ctx, user, input); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.FindPendingDeletion(ctx, userID); err == nil {
+		return nil, ErrAccountDeletionPending
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	activeEscrows, err := s.repo.CountActiveEscrows(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if activeEscrows > 0 {
+		return nil, ErrAccountDeletionBlocked
+	}
+
+	now := s.now()
+	deletion := &model.AccountDeletion{
+		UserID:      userID,
+		Status:      model.AccountDeletionPending,
+		RequestedAt: now,
+		ScheduledAt: now.Add(s.gracePeriod),
+	}
+	if input.TransferStoresTo != "" {
+		recipient, err := s.userRepo.FindByEmail(ctx, input.TransferStoresTo)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		if recipient == nil || recipient.ID == userID {
+			return nil, ErrStoreTransferTarget
+		}
+		deletion.StoreTransferUserID = &recipient.ID
+	}
+
+	if err := s.repo.CreateDeletion(ctx, deletion); err != nil {
+		return nil, err
+	}
+	if _, err := s.sessionRepo.RevokeByUserID(ctx, userID, "", model.SessionRevokedAccountDeletion); err != nil {
+		return nil, err
+	}
+
+	log.Info("Account deletion requested", map[string]interface{}{
+		"user_id":      userID,
+		"deletion_id":  deletion.ID,
+		"scheduled_at": deletion.ScheduledAt,
+	})
+	return deletion, nil
+}
+
+// reauthenticate 비밀번호 계정은 비밀번호, 소셜 계정은 최근 로그인한 세션으로 본인 확인
+func (s *accountService) reauthenticate(ctx context.Context, user *model.User, input DeleteAccountInput) error {
+	if user.PasswordHash != "" {
+		if !util.VerifyPassword(user.PasswordHash, input.Password) {
+			return ErrInvalidCredentials
+		}
+		return nil
+	}
+
+	if input.SessionID == "" {
+		return ErrReauthenticationRequired
+	}
+	session, err := s.sessionRepo.FindByID(ctx, input.SessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrReauthenticationRequired
+		}
+		return err
+	}
+	if session.UserID != user.ID || session.RevokedAt != nil || s.now().Sub(session.CreatedAt) > reauthenticationWindow {
+		return ErrReauthenticationRequired
+	}
+	return nil
+}
+
+// OnLogin 탈퇴 유예 기간 중 다시 로그인하면 탈퇴를 취소
+func (s *accountService) OnLogin(ctx context.Context, user *model.User) {
+	log := logger.FromContext(ctx)
+
+	deletion, err := s.repo.FindPendingDeletion(ctx, user.ID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error("Failed to check pending account deletion", err, map[string]interface{}{
+				"user_id": user.ID,
+			})
+		}
+		return
+	}
+
+	cancelled, err := s.repo.CancelDeletion(ctx, deletion.ID, s.now())
+	if err != nil {
+		log.Error("Failed to cancel account deletion", err, map[string]interface{}{
+			"user_id":     user.ID,
+			"deletion_id": deletion.ID,
+		})
+		return
+	}
+	if cancelled {
+		log.Info("Account deletion cancelled by login", map[string]interface{}{
+			"user_id":     user.ID,
+			"deletion_id": deletion.ID,
+		})
+	}
+}
+
+func (s *accountService) ProcessDueDeletions(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
+
+	deletions, err := s.repo.FindDueDeletions(ctx, s.now(), deletionBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find due account deletions: %w", err)
+	}
+
+	processed := 0
+	for i := range deletions {
+		deletion := &deletions[i]
+		// 다른 인스턴스가 먼저 처리했거나 로그인으로 취소된 경우 completed=false
+		completed, err := s.repo.Anonymize(ctx, deletion, s.now())
+		if errors.Is(err, repository.ErrActiveEscrows) {
+			retryAt := s.now().Add(deletionRetryDelay)
+			if err := s.repo.PostponeDeletion(ctx, deletion.ID, retryAt); err != nil {
+				log.Error("Failed to postpone account deletion", err, map[string]interface{}{
+					"user_id":     deletion.UserID,
+					"deletion_id": deletion.ID,
+				})
+				continue
+			}
+			log.Info("Account deletion postponed by active escrows", map[string]interface{}{
+				"user_id":      deletion.UserID,
+				"deletion_id":  deletion.ID,
+				"scheduled_at": retryAt,
+			})
+			continue
+		}
+		if err != nil {
+			// 다음 실행에서 다시 시도
+			log.Error("Failed to anonymize account", err, map[string]interface{}{
+				"user_id":     deletion.UserID,
+				"deletion_id": deletion.ID,
+			})
+			continue
+		}
+		if completed {
+			processed++
+		}
+	}
+	return processed, nil
+}
+
+func (s *accountService) ListStoreTransferOffers(ctx context.Context, userID uint) ([]model.AccountDeletion, error) {
+	return s.repo.FindStoreTransferOffers(ctx, userID)
+}
+
+func (s *accountService) AcceptStoreTransfer(ctx context.Context, userID, deletionID uint) error {
+	accepted, err := s.repo.AcceptStoreTransfer(ctx, deletionID, userID, s.now())
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		return ErrStoreTransferNotFound
+	}
+
+	logger.FromContext(ctx).Info("Store transfer accepted", map[string]interface{}{
+		"user_id":     userID,
+		"deletion_id": deletionID,
+	})
+	return nil
+}
+
+func (s *accountService) DeclineStoreTransfer(ctx context.Context, userID, deletionID uint) error {
+	declined, err := s.repo.DeclineStoreTransfer(ctx, deletionID, userID)
+	if err != nil {
+		return err
+	}
+	if !declined {
+		return ErrStoreTransferNotFound
+	}
+
+	logger.FromContext(ctx).Info("Store transfer declined", map[string]interface{}{
+		"user_id":     userID,
+		"deletion_id": deletionID,
+	})
+	return nil
+}
+
+func (s *accountService) ExportData(ctx context.Context, userID uint) (*model.UserDataExport, error) {
+	export, err := s.repo.ExportUserData(ctx, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return export, nil
+}
+
+// WriteExportArchive 내보내기 항목별 JSON 파일을 ZIP으로 작성 (예: user.json, posts.json)
+func WriteExportArchive(w io.Writer, export *model.UserDataExport) error {
+	data, err := json.Marshal(export)
+	if err != nil {
+		return err
+	}
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(data, &sections); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(sections))
+	for name := range sections {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	archive := zip.NewWriter(w)
+	for _, name := range names {
+		file, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     name + ".json",
+			Method:   zip.Deflate,
+			Modified: export.ExportedAt,
+		})
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, sections[name], "", "  "); err != nil {
+			return err
+		}
+		if _, err := buf.WriteTo(file); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
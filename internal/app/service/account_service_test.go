@@ -0,0 +1,250 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeAccountRepository struct {
+	repository.AccountRepository
+	deletions     []*model.AccountDeletion
+	activeEscrows map[uint]bool // 익명화 시점에 안전거래가 진행 중인 사용자
+}
+
+func (r *fakeAccountRepository) CreateDeletion(ctx context.Context, deletion *model.AccountDeletion) error {
+	deletion.ID = uint(len(r.deletions) + 1)
+	stored := *deletion
+	r.deletions = append(r.deletions, &stored)
+	return nil
+}
+
+func (r *fakeAccountRepository) FindPendingDeletion(ctx context.Context, userID uint) (*model.AccountDeletion, error) {
+	for _, deletion := range r.deletions {
+		if deletion.UserID == userID && deletion.Status == model.AccountDeletionPending {
+			found := *deletion
+			return &found, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeAccountRepository) CancelDeletion(ctx context.Context, id uint, now time.Time) (bool, error) {
+	for _, deletion := range r.deletions {
+		if deletion.ID == id && deletion.Status == model.AccountDeletionPending {
+			deletion.Status = model.AccountDeletionCancelled
+			deletion.CancelledAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeAccountRepository) CountActiveEscrows(ctx context.Context, userID uint) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeAccountRepository) FindDueDeletions(ctx context.Context, now time.Time, limit int) ([]model.AccountDeletion, error) {
+	var due []model.AccountDeletion
+	for _, deletion := range r.deletions {
+		if deletion.Status == model.AccountDeletionPending && !deletion.ScheduledAt.After(now) {
+			due = append(due, *deletion)
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeAccountRepository) PostponeDeletion(ctx context.Context, id uint, until time.Time) error {
+	for _, deletion := range r.deletions {
+		if deletion.ID == id && deletion.Status == model.AccountDeletionPending {
+			deletion.ScheduledAt = until
+		}
+	}
+	return nil
+}
+
+func (r *fakeAccountRepository) AcceptStoreTransfer(ctx context.Context, id, recipientID uint, now time.Time) (bool, error) {
+	for _, deletion := range r.deletions {
+		if deletion.ID == id && deletion.Status == model.AccountDeletionPending &&
+			deletion.StoreTransferUserID != nil && *deletion.StoreTransferUserID == recipientID &&
+			deletion.StoreTransferAcceptedAt == nil {
+			deletion.StoreTransferAcceptedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeAccountRepository) Anonymize(ctx context.Context, deletion *model.AccountDeletion, now time.Time) (bool, error) {
+	if r.activeEscrows[deletion.UserID] {
+		return false, repository.ErrActiveEscrows
+	}
+	for _, stored := range r.deletions {
+		if stored.ID == deletion.ID && stored.Status == model.AccountDeletionPending {
+			stored.Status = model.AccountDeletionCompleted
+			stored.CompletedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type fakeUserRepository struct {
+	repository.UserRepository
+	users map[uint]*model.User
+}
+
+func (r *fakeUserRepository) FindByID(ctx context.Context, id uint) (*model.User, error) {
+	user, ok := r.users[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+func (r *fakeUserSessionRepository) RevokeByUserID(ctx context.Context, userID uint, exceptID, reason string) (int64, error) {
+	var revoked int64
+	for id, session := range r.sessions {
+		if session.UserID == userID && id != exceptID && session.RevokedAt == nil {
+			now := time.Now()
+			session.RevokedAt = &now
+			session.RevokedReason = reason
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+func TestAccountService_DeletionCancelledByLogin(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	repo := &fakeAccountRepository{}
+	sessions := &fakeUserSessionRepository{sessions: map[string]*model.UserSession{
+		"fresh": {ID: "fresh", UserID: 1, CreatedAt: now.Add(-time.Minute)},
+		"stale": {ID: "stale", UserID: 1, CreatedAt: now.Add(-time.Hour)},
+	}}
+	svc := &accountService{
+		repo:        repo,
+		userRepo:    &fakeUserRepository{users: map[uint]*model.User{1: {ID: 1, Email: "kakao@example.com"}}},
+		sessionRepo: sessions,
+		gracePeriod: 14 * 24 * time.Hour,
+		now:         func() time.Time { return now },
+	}
+	ctx := context.Background()
+
+	// 비밀번호가 없는 소셜 계정은 최근 로그인한 세션에서만 탈퇴 가능
+	_, err := svc.RequestDeletion(ctx, 1, DeleteAccountInput{SessionID: "stale"})
+	assert.ErrorIs(t, err, ErrReauthenticationRequired)
+
+	deletion, err := svc.RequestDeletion(ctx, 1, DeleteAccountInput{SessionID: "fresh"})
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(14*24*time.Hour), deletion.ScheduledAt)
+	assert.Equal(t, model.SessionRevokedAccountDeletion, sessions.sessions["fresh"].RevokedReason)
+	assert.Equal(t, model.SessionRevokedAccountDeletion, sessions.sessions["stale"].RevokedReason)
+
+	// 유예 기간 중 다시 로그인하면 탈퇴 취소
+	svc.OnLogin(ctx, &model.User{ID: 1})
+	assert.Equal(t, model.AccountDeletionCancelled, repo.deletions[0].Status)
+	_, err = repo.FindPendingDeletion(ctx, 1)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestAccountService_DeletionPostponedByActiveEscrow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	repo := &fakeAccountRepository{
+		deletions: []*model.AccountDeletion{
+			{ID: 1, UserID: 1, Status: model.AccountDeletionPending, ScheduledAt: now.Add(-time.Minute)},
+			{ID: 2, UserID: 2, Status: model.AccountDeletionPending, ScheduledAt: now.Add(-time.Minute)},
+		},
+		activeEscrows: map[uint]bool{2: true},
+	}
+	svc := &accountService{repo: repo, now: func() time.Time { return now }}
+
+	processed, err := svc.ProcessDueDeletions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, model.AccountDeletionCompleted, repo.deletions[0].Status)
+
+	// 탈퇴 요청 후 안전거래가 시작된 계정은 익명화하지 않고 미룸
+	assert.Equal(t, model.AccountDeletionPending, repo.deletions[1].Status)
+	assert.Equal(t, now.Add(deletionRetryDelay), repo.deletions[1].ScheduledAt)
+
+	processed, err = svc.ProcessDueDeletions(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, processed)
+}
+
+func TestAccountService_StoreTransferRequiresAcceptance(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	repo := &fakeAccountRepository{}
+	svc := &accountService{
+		repo: repo,
+		userRepo: &fakeUserRepository{users: map[uint]*model.User{
+			1: {ID: 1, Email: "owner@example.com"},
+			2: {ID: 2, Email: "recipient@example.com"},
+		}},
+		sessionRepo: &fakeUserSessionRepository{sessions: map[string]*model.UserSession{
+			"fresh": {ID: "fresh", UserID: 1, CreatedAt: now.Add(-time.Minute)},
+		}},
+		gracePeriod: 14 * 24 * time.Hour,
+		now:         func() time.Time { return now },
+	}
+
+	deletion, err := svc.RequestDeletion(ctx, 1, DeleteAccountInput{
+		SessionID:        "fresh",
+		TransferStoresTo: "recipient@example.com",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, deletion.StoreTransferUserID)
+	assert.Nil(t, deletion.StoreTransferAcceptedAt)
+
+	// 제안받은 사용자만 수락할 수 있음
+	assert.ErrorIs(t, svc.AcceptStoreTransfer(ctx, 1, deletion.ID), ErrStoreTransferNotFound)
+	require.NoError(t, svc.AcceptStoreTransfer(ctx, 2, deletion.ID))
+	require.NotNil(t, repo.deletions[0].StoreTransferAcceptedAt)
+	assert.Equal(t, now, *repo.deletions[0].StoreTransferAcceptedAt)
+
+	// 이미 수락한 제안은 다시 수락할 수 없음
+	assert.ErrorIs(t, svc.AcceptStoreTransfer(ctx, 2, deletion.ID), ErrStoreTransferNotFound)
+}
+
+func TestWriteExportArchive(t *testing.T) {
+	export := &model.UserDataExport{
+		ExportedAt: time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC),
+		User:       &model.User{ID: 1, Email: "test@example.com"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteExportArchive(&buf, export))
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := map[string]*zip.File{}
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+	require.Contains(t, files, "user.json")
+	assert.Contains(t, files, "posts.json")
+
+	reader, err := files["user.json"].Open()
+	require.NoError(t, err)
+	defer reader.Close()
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+
+	var user model.User
+	require.NoError(t, json.Unmarshal(data, &user))
+	assert.Equal(t, "test@example.com", user.Email)
+}
@@ -2,28 +2,35 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/ikkim/udonggeum-backend/config"
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 )
 
 // AIService AI 서비스 인터페이스
 type AIService interface {
-	GenerateContent(req *model.GenerateContentRequest) ([]string, error)
+	GenerateContent(ctx context.Context, req *model.GenerateContentRequest) ([]string, error)
 }
 
 type aiService struct {
 	config *config.Config
+	client *http.Client
 }
 
 // NewAIService AI 서비스 생성자
 func NewAIService(cfg *config.Config) AIService {
-	return &aiService{config: cfg}
+	return &aiService{
+		config: cfg,
+		client: tracing.NewClient("openai", 60*time.Second),
+	}
 }
 
 // OpenAI API 요청 구조체
@@ -50,7 +57,7 @@ type openAIResponse struct {
 }
 
 // GenerateContent AI로 게시글 내용 3가지 버전 생성
-func (s *aiService) GenerateContent(req *model.GenerateContentRequest) ([]string, error) {
+func (s *aiService) GenerateContent(ctx context.Context, req *model.GenerateContentRequest) ([]string, error) {
 	if s.config.OpenAI.APIKey == "" {
 		return nil, fmt.Errorf("OpenAI API key is not configured")
 	}
@@ -58,7 +65,7 @@ func (s *aiService) GenerateContent(req *model.GenerateContentRequest) ([]string
 	systemPrompt := s.buildSystemPrompt(req)
 	userPrompt := s.buildUserPrompt(req)
 
-	raw, err := s.callOpenAI(systemPrompt, userPrompt)
+	raw, err := s.callOpenAI(ctx, systemPrompt, userPrompt)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call OpenAI API: %v", err)
 	}
@@ -198,7 +205,7 @@ func (s *aiService) buildUserPrompt(req *model.GenerateContentRequest) string {
 }
 
 // callOpenAI OpenAI API 호출
-func (s *aiService) callOpenAI(systemPrompt, userPrompt string) (string, error) {
+func (s *aiService) callOpenAI(ctx context.Context, systemPrompt, userPrompt string) (string, error) {
 	reqData := openAIRequest{
 		Model: s.config.OpenAI.Model,
 		Messages: []openAIMessage{
@@ -214,7 +221,7 @@ func (s *aiService) callOpenAI(systemPrompt, userPrompt string) (string, error)
 		return "", fmt.Errorf("failed to marshal request: %v", err)
 	}
 
-	req, err := http.NewRequest("POST", "https://api.openai.com/v1/chat/completions", bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.openai.com/v1/chat/completions", bytes.NewBuffer(jsonData))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %v", err)
 	}
@@ -222,8 +229,7 @@ func (s *aiService) callOpenAI(systemPrompt, userPrompt string) (string, error)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.config.OpenAI.APIKey))
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := s.client.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to send request: %v", err)
 	}
@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// resolveSocialUser 외부 로그인에 연결된 사용자를 찾고, 없으면 새로 가입
+// 같은 이메일의 계정이 이미 있으면 자동으로 합치지 않고 ErrIdentityNotLinked
+// (연결 기록을 남기기 전에 소셜 로그인으로 가입한 계정은 제공자가 확인한 이메일일 때만 처음 로그인할 때 연결)
+func (s *authService) resolveSocialUser(ctx context.Context, profile *OAuthProfile) (*model.User, error) {
+	log := logger.FromContext(ctx)
+
+	identity, err := s.identityRepo.FindByProviderSubject(ctx, profile.Provider, profile.Subject)
+	if err == nil {
+		user, err := s.userRepo.FindByID(ctx, identity.UserID)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Existing user found for social login", map[string]interface{}{
+			"user_id":  user.ID,
+			"provider": profile.Provider,
+		})
+		return user, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, profile.Email)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error("Failed to check existing user", err, map[string]interface{}{
+			"email": profile.Email,
+		})
+		return nil, err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Info("Creating new user from social login", map[string]interface{}{
+			"email":    profile.Email,
+			"provider": profile.Provider,
+		})
+
+		nickname, err := s.generateUniqueNickname(ctx)
+		if err != nil {
+			log.Error("Failed to generate unique nickname", err, nil)
+			return nil, err
+		}
+
+		// 이름을 주지 않는 제공자(애플)는 닉네임으로 대신함
+		name := profile.Name
+		if name == "" {
+			name = nickname
+		}
+
+		user = &model.User{
+			Email:        profile.Email,
+			PasswordHash: "", // 소셜 로그인 사용자는 비밀번호 없음
+			Name:         name,
+			Nickname:     nickname,
+			Phone:        profile.Phone,
+			ProfileImage: profile.ProfileImage,
+			Role:         model.RoleUser,
+		}
+		if err := s.userRepo.Create(ctx, user); err != nil {
+			log.Error("Failed to create social login user", err, map[string]interface{}{
+				"email":    profile.Email,
+				"provider": profile.Provider,
+			})
+			return nil, err
+		}
+
+		log.Info("New user created from social login", map[string]interface{}{
+			"user_id":  user.ID,
+			"email":    user.Email,
+			"provider": profile.Provider,
+		})
+	} else {
+		identities, err := s.identityRepo.FindByUserID(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if user.PasswordHash != "" || len(identities) > 0 || !profile.EmailVerified {
+			log.Warn("Social login matches an account without this identity", map[string]interface{}{
+				"user_id":        user.ID,
+				"provider":       profile.Provider,
+				"email_verified": profile.EmailVerified,
+			})
+			return nil, ErrIdentityNotLinked
+		}
+	}
+
+	if err := s.identityRepo.Create(ctx, &model.ExternalIdentity{
+		UserID:   user.ID,
+		Provider: profile.Provider,
+		Subject:  profile.Subject,
+		Email:    profile.Email,
+		LinkedAt: time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (s *authService) ListIdentities(ctx context.Context, userID uint) ([]model.ExternalIdentity, error) {
+	return s.identityRepo.FindByUserID(ctx, userID)
+}
+
+// LinkIdentity 로그인한 사용자에게 외부 로그인을 연결 (이메일이 달라도 연결 가능)
+// state는 같은 사용자가 GetOAuthLinkURL로 발급받은 것만 허용
+func (s *authService) LinkIdentity(ctx context.Context, userID uint, provider model.IdentityProvider, code, state string) (*model.ExternalIdentity, error) {
+	log := logger.FromContext(ctx)
+
+	p, err := s.oauthProvider(provider)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.consumeOAuthState(ctx, provider, state, linkStateBinding(userID)); err != nil {
+		return nil, err
+	}
+	profile, err := p.Exchange(ctx, code, state)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.identityRepo.FindByProviderSubject(ctx, profile.Provider, profile.Subject)
+	if err == nil {
+		if existing.UserID == userID {
+			return nil, ErrIdentityAlreadyLinked
+		}
+		return nil, ErrIdentityInUse
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	identities, err := s.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		if identity.Provider == profile.Provider {
+			return nil, ErrIdentityAlreadyLinked
+		}
+	}
+
+	identity := &model.ExternalIdentity{
+		UserID:   userID,
+		Provider: profile.Provider,
+		Subject:  profile.Subject,
+		Email:    profile.Email,
+		LinkedAt: time.Now(),
+	}
+	if err := s.identityRepo.Create(ctx, identity); err != nil {
+		return nil, err
+	}
+
+	log.Info("External identity linked", map[string]interface{}{
+		"user_id":  userID,
+		"provider": provider,
+	})
+	return identity, nil
+}
+
+// UnlinkIdentity 외부 로그인 연결 해제 (비밀번호나 다른 연결이 남아 있어야 함)
+func (s *authService) UnlinkIdentity(ctx context.Context, userID uint, provider model.IdentityProvider) error {
+	log := logger.FromContext(ctx)
+
+	identities, err := s.identityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	linked := false
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return ErrIdentityNotFound
+	}
+
+	deleted, err := s.identityRepo.DeleteUnlessLast(ctx, userID, provider)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrLastLoginMethod
+	}
+
+	log.Info("External identity unlinked", map[string]interface{}{
+		"user_id":  userID,
+		"provider": provider,
+	})
+	return nil
+}
@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func (r *fakeUserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeUserRepository) FindByNickname(ctx context.Context, nickname string) (*model.User, error) {
+	for _, user := range r.users {
+		if user.Nickname == nickname {
+			return user, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeUserRepository) Create(ctx context.Context, user *model.User) error {
+	user.ID = uint(len(r.users) + 1)
+	r.users[user.ID] = user
+	return nil
+}
+
+type fakeExternalIdentityRepository struct {
+	repository.ExternalIdentityRepository
+	users      *fakeUserRepository
+	identities []model.ExternalIdentity
+}
+
+func (r *fakeExternalIdentityRepository) Create(ctx context.Context, identity *model.ExternalIdentity) error {
+	identity.ID = uint(len(r.identities) + 1)
+	r.identities = append(r.identities, *identity)
+	return nil
+}
+
+func (r *fakeExternalIdentityRepository) FindByProviderSubject(ctx context.Context, provider model.IdentityProvider, subject string) (*model.ExternalIdentity, error) {
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			found := identity
+			return &found, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeExternalIdentityRepository) FindByUserID(ctx context.Context, userID uint) ([]model.ExternalIdentity, error) {
+	var found []model.ExternalIdentity
+	for _, identity := range r.identities {
+		if identity.UserID == userID {
+			found = append(found, identity)
+		}
+	}
+	return found, nil
+}
+
+func (r *fakeExternalIdentityRepository) DeleteUnlessLast(ctx context.Context, userID uint, provider model.IdentityProvider) (bool, error) {
+	identities, _ := r.FindByUserID(ctx, userID)
+	if r.users.users[userID].PasswordHash == "" && len(identities) <= 1 {
+		return false, nil
+	}
+	for i, identity := range r.identities {
+		if identity.UserID == userID && identity.Provider == provider {
+			r.identities = append(r.identities[:i], r.identities[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fakeOAuthProvider 인가 코드와 관계없이 정해진 프로필을 돌려주는 제공자
+type fakeOAuthProvider struct {
+	profile OAuthProfile
+}
+
+func (p *fakeOAuthProvider) Name() model.IdentityProvider {
+	return p.profile.Provider
+}
+
+func (p *fakeOAuthProvider) AuthURL(state string) string {
+	return "https://provider.example.com/authorize?" + url.Values{"state": {state}}.Encode()
+}
+
+func (p *fakeOAuthProvider) Exchange(ctx context.Context, code, state string) (*OAuthProfile, error) {
+	profile := p.profile
+	return &profile, nil
+}
+
+func oauthURLState(t *testing.T, loginURL string) string {
+	parsed, err := url.Parse(loginURL)
+	require.NoError(t, err)
+	state := parsed.Query().Get("state")
+	require.NotEmpty(t, state)
+	return state
+}
+
+func TestAuthService_LinkIdentityRequiresIssuedState(t *testing.T) {
+	users := &fakeUserRepository{users: map[uint]*model.User{
+		1: {ID: 1, Email: "email@example.com", PasswordHash: "hash"},
+	}}
+	identities := &fakeExternalIdentityRepository{users: users}
+	provider := &fakeOAuthProvider{profile: OAuthProfile{Provider: model.IdentityProviderNaver, Subject: "n1", Email: "naver@example.com"}}
+	svc := &authService{
+		userRepo:       users,
+		identityRepo:   identities,
+		stateStore:     util.NewMemoryOAuthStateStore(),
+		oauthProviders: map[model.IdentityProvider]OAuthProvider{provider.Name(): provider},
+	}
+	ctx := context.Background()
+
+	// 서버가 발급하지 않은 state는 거부
+	_, err := svc.LinkIdentity(ctx, 1, model.IdentityProviderNaver, "code", "forged-state")
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+	_, err = svc.LinkIdentity(ctx, 1, model.IdentityProviderNaver, "code", "")
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+
+	// 로그인용 state나 다른 사용자에게 발급한 state로는 연결할 수 없음
+	loginURL, _, err := svc.GetOAuthLoginURL(ctx, model.IdentityProviderNaver)
+	require.NoError(t, err)
+	_, err = svc.LinkIdentity(ctx, 1, model.IdentityProviderNaver, "code", oauthURLState(t, loginURL))
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+
+	otherURL, err := svc.GetOAuthLinkURL(ctx, 2, model.IdentityProviderNaver)
+	require.NoError(t, err)
+	_, err = svc.LinkIdentity(ctx, 1, model.IdentityProviderNaver, "code", oauthURLState(t, otherURL))
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+
+	linkURL, err := svc.GetOAuthLinkURL(ctx, 1, model.IdentityProviderNaver)
+	require.NoError(t, err)
+	state := oauthURLState(t, linkURL)
+
+	identity, err := svc.LinkIdentity(ctx, 1, model.IdentityProviderNaver, "code", state)
+	require.NoError(t, err)
+	assert.Equal(t, "n1", identity.Subject)
+
+	// 한 번 사용한 state는 다시 쓸 수 없음
+	_, err = svc.LinkIdentity(ctx, 1, model.IdentityProviderNaver, "code", state)
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+}
+
+func TestAuthService_OAuthLoginRequiresStartingBrowser(t *testing.T) {
+	provider := &fakeOAuthProvider{profile: OAuthProfile{Provider: model.IdentityProviderNaver, Subject: "n1", Email: "naver@example.com"}}
+	svc := &authService{
+		stateStore:     util.NewMemoryOAuthStateStore(),
+		oauthProviders: map[model.IdentityProvider]OAuthProvider{provider.Name(): provider},
+	}
+	ctx := context.Background()
+
+	// 다른 브라우저(쿠키가 없거나 다른 nonce)에서 온 콜백은 거부
+	loginURL, nonce, err := svc.GetOAuthLoginURL(ctx, model.IdentityProviderNaver)
+	require.NoError(t, err)
+	require.NotEmpty(t, nonce)
+	_, _, err = svc.OAuthLogin(ctx, model.IdentityProviderNaver, "code", oauthURLState(t, loginURL), "")
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+
+	loginURL, _, err = svc.GetOAuthLoginURL(ctx, model.IdentityProviderNaver)
+	require.NoError(t, err)
+	_, _, err = svc.OAuthLogin(ctx, model.IdentityProviderNaver, "code", oauthURLState(t, loginURL), nonce)
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+
+	// 연결용 state는 로그인에 사용할 수 없음
+	linkURL, err := svc.GetOAuthLinkURL(ctx, 1, model.IdentityProviderNaver)
+	require.NoError(t, err)
+	_, _, err = svc.OAuthLogin(ctx, model.IdentityProviderNaver, "code", oauthURLState(t, linkURL), nonce)
+	assert.ErrorIs(t, err, ErrOAuthStateInvalid)
+}
+
+func TestAuthService_ResolveSocialUser(t *testing.T) {
+	users := &fakeUserRepository{users: map[uint]*model.User{
+		1: {ID: 1, Email: "email@example.com", PasswordHash: "hash"},
+		2: {ID: 2, Email: "legacy@example.com"},
+		3: {ID: 3, Email: "unverified@example.com"},
+	}}
+	identities := &fakeExternalIdentityRepository{users: users}
+	svc := &authService{userRepo: users, identityRepo: identities}
+	ctx := context.Background()
+
+	// 이메일로 가입한 계정은 자동으로 합치지 않음
+	_, err := svc.resolveSocialUser(ctx, &OAuthProfile{Provider: model.IdentityProviderKakao, Subject: "k1", Email: "email@example.com", EmailVerified: true})
+	assert.ErrorIs(t, err, ErrIdentityNotLinked)
+
+	// 연결 기록 이전에 소셜 로그인으로 가입한 계정은 첫 로그인에서 연결
+	user, err := svc.resolveSocialUser(ctx, &OAuthProfile{Provider: model.IdentityProviderKakao, Subject: "k2", Email: "legacy@example.com", EmailVerified: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), user.ID)
+
+	// 이후에는 이메일이 달라져도 연결된 계정으로 로그인
+	user, err = svc.resolveSocialUser(ctx, &OAuthProfile{Provider: model.IdentityProviderKakao, Subject: "k2", Email: "changed@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, uint(2), user.ID)
+
+	// 제공자가 확인하지 않은 이메일로는 자동 연결하지 않음
+	_, err = svc.resolveSocialUser(ctx, &OAuthProfile{Provider: model.IdentityProviderKakao, Subject: "k3", Email: "unverified@example.com"})
+	assert.ErrorIs(t, err, ErrIdentityNotLinked)
+	_, err = identities.FindByProviderSubject(ctx, model.IdentityProviderKakao, "k3")
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	// 연결된 로그인이 있는 계정에 다른 제공자로 로그인하면 연결이 필요
+	_, err = svc.resolveSocialUser(ctx, &OAuthProfile{Provider: model.IdentityProviderGoogle, Subject: "g2", Email: "legacy@example.com"})
+	assert.ErrorIs(t, err, ErrIdentityNotLinked)
+
+	// 처음 보는 이메일은 새로 가입하고 연결
+	user, err = svc.resolveSocialUser(ctx, &OAuthProfile{Provider: model.IdentityProviderGoogle, Subject: "g3", Email: "new@example.com", Name: "새 사용자"})
+	require.NoError(t, err)
+	assert.Equal(t, "new@example.com", user.Email)
+	linked, err := identities.FindByProviderSubject(ctx, model.IdentityProviderGoogle, "g3")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, linked.UserID)
+}
+
+func TestAuthService_UnlinkIdentity(t *testing.T) {
+	users := &fakeUserRepository{users: map[uint]*model.User{
+		1: {ID: 1, Email: "social@example.com"},
+	}}
+	identities := &fakeExternalIdentityRepository{users: users, identities: []model.ExternalIdentity{
+		{ID: 1, UserID: 1, Provider: model.IdentityProviderKakao, Subject: "k1"},
+		{ID: 2, UserID: 1, Provider: model.IdentityProviderGoogle, Subject: "g1"},
+	}}
+	svc := &authService{userRepo: users, identityRepo: identities}
+	ctx := context.Background()
+
+	require.NoError(t, svc.UnlinkIdentity(ctx, 1, model.IdentityProviderGoogle))
+	assert.ErrorIs(t, svc.UnlinkIdentity(ctx, 1, model.IdentityProviderGoogle), ErrIdentityNotFound)
+
+	// 비밀번호가 없으면 마지막 로그인 수단은 해제할 수 없음
+	assert.ErrorIs(t, svc.UnlinkIdentity(ctx, 1, model.IdentityProviderKakao), ErrLastLoginMethod)
+
+	users.users[1].PasswordHash = "hash"
+	assert.NoError(t, svc.UnlinkIdentity(ctx, 1, model.IdentityProviderKakao))
+}
@@ -2,15 +2,14 @@ package service
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/subtle"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
@@ -26,84 +25,124 @@ var (
 	ErrInvalidToken             = errors.New("유효하지 않은 토큰입니다")
 	ErrExpiredToken             = errors.New("토큰이 만료되었습니다")
 	ErrTokenRevoked             = errors.New("토큰이 폐기되었습니다")
+	ErrTokenReused              = errors.New("이미 사용된 토큰입니다. 보안을 위해 해당 기기에서 로그아웃되었습니다")
+	ErrSessionNotFound          = errors.New("로그인 세션을 찾을 수 없습니다")
 	ErrNicknameAlreadyExists    = errors.New("이미 사용 중인 닉네임입니다")
 	ErrInvalidVerificationCode  = errors.New("유효하지 않거나 만료된 인증 코드입니다")
 	ErrEmailAlreadyVerified     = errors.New("이미 인증된 이메일입니다")
 	ErrPhoneAlreadyVerified     = errors.New("이미 인증된 휴대폰입니다")
+	ErrVerificationCooldown     = errors.New("인증 코드는 잠시 후 다시 요청할 수 있습니다")
+	ErrVerificationLocked       = errors.New("인증 시도 횟수를 초과했습니다. 잠시 후 다시 시도해주세요")
+	ErrIdentityNotLinked        = errors.New("이미 가입된 이메일입니다. 기존 방법으로 로그인한 뒤 로그인 연결에서 추가해주세요")
+	ErrIdentityInUse            = errors.New("다른 계정에 연결된 로그인입니다")
+	ErrIdentityAlreadyLinked    = errors.New("이미 연결된 로그인 제공자입니다")
+	ErrIdentityNotFound         = errors.New("연결된 로그인을 찾을 수 없습니다")
+	ErrLastLoginMethod          = errors.New("마지막 로그인 수단은 해제할 수 없습니다. 비밀번호를 설정하거나 다른 로그인을 먼저 연결해주세요")
+	ErrUnsupportedProvider      = errors.New("지원하지 않는 로그인 제공자입니다")
 )
 
+// AuthListener 로그인 수신자 (탈퇴 유예 중 로그인 시 탈퇴 취소 등)
+type AuthListener interface {
+	// OnLogin 비밀번호/소셜 로그인에 성공했을 때 호출
+	OnLogin(ctx context.Context, user *model.User)
+}
+
 type AuthService interface {
-	Register(email, password, name, nickname, phone string, marketingAgreed, marketingSMS, marketingEmail, marketingPush bool) (*model.User, *util.TokenPair, error)
-	Login(email, password string) (*model.User, *util.TokenPair, error)
-	GetUserByID(id uint) (*model.User, error)
-	UpdateProfile(userID uint, name, phone, nickname, address, profileImage *string) (*model.User, error)
-	CheckNickname(nickname string) (bool, error)
-	CheckEmailAvailability(email string) (bool, error)
-	RefreshToken(refreshToken string) (*util.TokenPair, error)
-	RevokeToken(refreshToken string) error
-	GetKakaoLoginURL() string
-	KakaoLogin(code string) (*model.User, *util.TokenPair, error)
-	GetGoogleLoginURL() string
-	GoogleLogin(code string) (*model.User, *util.TokenPair, error)
+	Register(ctx context.Context, email, password, name, nickname, phone string, marketingAgreed, marketingSMS, marketingEmail, marketingPush bool) (*model.User, *util.TokenPair, error)
+	Login(ctx context.Context, email, password string) (*model.User, *util.TokenPair, error)
+	GetUserByID(ctx context.Context, id uint) (*model.User, error)
+	UpdateProfile(ctx context.Context, userID uint, name, phone, nickname, address, profileImage *string) (*model.User, error)
+	CheckNickname(ctx context.Context, nickname string) (bool, error)
+	CheckEmailAvailability(ctx context.Context, email string) (bool, error)
+	RefreshToken(ctx context.Context, refreshToken string) (*util.TokenPair, error)
+	RevokeToken(ctx context.Context, refreshToken string) error
+
+	// 소셜 로그인 (설정된 OAuth 제공자)
+	// GetOAuthLoginURL 로그인 주소와 함께 로그인을 시작한 브라우저에 저장할 nonce를 반환
+	GetOAuthLoginURL(ctx context.Context, provider model.IdentityProvider) (loginURL, nonce string, err error)
+	OAuthLogin(ctx context.Context, provider model.IdentityProvider, code, state, nonce string) (*model.User, *util.TokenPair, error)
+
+	// 로그인 세션 (기기별)
+	IsSessionActive(ctx context.Context, sessionID string) (bool, error)
+	ListSessions(ctx context.Context, userID uint) ([]model.UserSession, error)
+	RevokeSession(ctx context.Context, userID uint, sessionID string) error
+	RevokeOtherSessions(ctx context.Context, userID uint, currentSessionID string) (int64, error)
+
+	// 외부 로그인 연결
+	ListIdentities(ctx context.Context, userID uint) ([]model.ExternalIdentity, error)
+	GetOAuthLinkURL(ctx context.Context, userID uint, provider model.IdentityProvider) (string, error)
+	LinkIdentity(ctx context.Context, userID uint, provider model.IdentityProvider, code, state string) (*model.ExternalIdentity, error)
+	UnlinkIdentity(ctx context.Context, userID uint, provider model.IdentityProvider) error
+
+	// AddListener 로그인 수신자 등록
+	AddListener(listener AuthListener)
 
 	// 이메일/휴대폰 인증
-	SendEmailVerification(email string) error
-	VerifyEmail(email, code string) error
-	SendPhoneVerification(userID uint, phone string) error
-	VerifyPhone(userID uint, phone, code string) error
+	SendEmailVerification(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, email, code string) error
+	SendPhoneVerification(ctx context.Context, userID uint, phone string) error
+	VerifyPhone(ctx context.Context, userID uint, phone, code string) error
 }
 
 type authService struct {
-	userRepo           repository.UserRepository
-	jwtSecret          string
-	accessExpiry       time.Duration
-	refreshExpiry      time.Duration
-	kakaoClientID      string
-	kakaoClientSecret  string
-	kakaoRedirectURI   string
-	googleClientID     string
-	googleClientSecret string
-	googleRedirectURI  string
+	userRepo          repository.UserRepository
+	sessionRepo       repository.UserSessionRepository
+	identityRepo      repository.ExternalIdentityRepository
+	tokenManager      *util.TokenManager
+	accessExpiry      time.Duration
+	refreshExpiry     time.Duration
+	verificationStore util.VerificationCodeStore
+	stateStore        util.OAuthStateStore
+	oauthProviders    map[model.IdentityProvider]OAuthProvider
+	listeners         []AuthListener
 }
 
 func NewAuthService(
 	userRepo repository.UserRepository,
-	jwtSecret string,
+	sessionRepo repository.UserSessionRepository,
+	identityRepo repository.ExternalIdentityRepository,
+	tokenManager *util.TokenManager,
 	accessExpiry, refreshExpiry time.Duration,
-	kakaoClientID, kakaoClientSecret, kakaoRedirectURI string,
-	googleClientID, googleClientSecret, googleRedirectURI string,
+	verificationStore util.VerificationCodeStore,
+	stateStore util.OAuthStateStore,
+	oauthProviders ...OAuthProvider,
 ) AuthService {
+	providers := make(map[model.IdentityProvider]OAuthProvider, len(oauthProviders))
+	for _, provider := range oauthProviders {
+		providers[provider.Name()] = provider
+	}
+
 	return &authService{
-		userRepo:           userRepo,
-		jwtSecret:          jwtSecret,
-		accessExpiry:       accessExpiry,
-		refreshExpiry:      refreshExpiry,
-		kakaoClientID:      kakaoClientID,
-		kakaoClientSecret:  kakaoClientSecret,
-		kakaoRedirectURI:   kakaoRedirectURI,
-		googleClientID:     googleClientID,
-		googleClientSecret: googleClientSecret,
-		googleRedirectURI:  googleRedirectURI,
+		userRepo:          userRepo,
+		sessionRepo:       sessionRepo,
+		identityRepo:      identityRepo,
+		tokenManager:      tokenManager,
+		accessExpiry:      accessExpiry,
+		refreshExpiry:     refreshExpiry,
+		verificationStore: verificationStore,
+		stateStore:        stateStore,
+		oauthProviders:    providers,
 	}
 }
 
-func (s *authService) Register(email, password, name, nickname, phone string, marketingAgreed, marketingSMS, marketingEmail, marketingPush bool) (*model.User, *util.TokenPair, error) {
-	logger.Info("Attempting user registration", map[string]interface{}{
+func (s *authService) Register(ctx context.Context, email, password, name, nickname, phone string, marketingAgreed, marketingSMS, marketingEmail, marketingPush bool) (*model.User, *util.TokenPair, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Attempting user registration", map[string]interface{}{
 		"email":    email,
 		"name":     name,
 		"nickname": nickname,
 	})
 
 	// Check if user already exists
-	existingUser, err := s.userRepo.FindByEmail(email)
+	existingUser, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Error("Failed to check existing user", err, map[string]interface{}{
+		log.Error("Failed to check existing user", err, map[string]interface{}{
 			"email": email,
 		})
 		return nil, nil, err
 	}
 	if existingUser != nil {
-		logger.Warn("Registration failed: email already exists", map[string]interface{}{
+		log.Warn("Registration failed: email already exists", map[string]interface{}{
 			"email": email,
 		})
 		return nil, nil, ErrEmailAlreadyExists
@@ -112,7 +151,7 @@ func (s *authService) Register(email, password, name, nickname, phone string, ma
 	// Hash password
 	hashedPassword, err := util.HashPassword(password)
 	if err != nil {
-		logger.Error("Failed to hash password", err, map[string]interface{}{
+		log.Error("Failed to hash password", err, map[string]interface{}{
 			"email": email,
 		})
 		return nil, nil, err
@@ -121,34 +160,34 @@ func (s *authService) Register(email, password, name, nickname, phone string, ma
 	// Use provided nickname or generate unique one
 	var finalNickname string
 	if nickname == "" {
-		generatedNickname, err := s.generateUniqueNickname()
+		generatedNickname, err := s.generateUniqueNickname(ctx)
 		if err != nil {
-			logger.Error("Failed to generate unique nickname", err, map[string]interface{}{
+			log.Error("Failed to generate unique nickname", err, map[string]interface{}{
 				"email": email,
 			})
 			return nil, nil, err
 		}
 		finalNickname = generatedNickname
-		logger.Debug("Generated unique nickname", map[string]interface{}{
+		log.Debug("Generated unique nickname", map[string]interface{}{
 			"nickname": finalNickname,
 		})
 	} else {
 		// Check if provided nickname already exists
-		existingNicknameUser, err := s.userRepo.FindByNickname(nickname)
+		existingNicknameUser, err := s.userRepo.FindByNickname(ctx, nickname)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Error("Failed to check existing nickname", err, map[string]interface{}{
+			log.Error("Failed to check existing nickname", err, map[string]interface{}{
 				"nickname": nickname,
 			})
 			return nil, nil, err
 		}
 		if existingNicknameUser != nil {
-			logger.Warn("Registration failed: nickname already exists", map[string]interface{}{
+			log.Warn("Registration failed: nickname already exists", map[string]interface{}{
 				"nickname": nickname,
 			})
 			return nil, nil, ErrNicknameAlreadyExists
 		}
 		finalNickname = nickname
-		logger.Debug("Using provided nickname", map[string]interface{}{
+		log.Debug("Using provided nickname", map[string]interface{}{
 			"nickname": finalNickname,
 		})
 	}
@@ -174,31 +213,24 @@ func (s *authService) Register(email, password, name, nickname, phone string, ma
 		MarketingPush:     marketingPush,
 	}
 
-	if err := s.userRepo.Create(user); err != nil {
-		logger.Error("Failed to create user in database", err, map[string]interface{}{
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		log.Error("Failed to create user in database", err, map[string]interface{}{
 			"email": email,
 		})
 		return nil, nil, err
 	}
 
 	// Generate tokens
-	tokens, err := util.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-		s.jwtSecret,
-		s.accessExpiry,
-		s.refreshExpiry,
-	)
+	tokens, err := s.startSession(ctx, user)
 	if err != nil {
-		logger.Error("Failed to generate tokens", err, map[string]interface{}{
+		log.Error("Failed to generate tokens", err, map[string]interface{}{
 			"user_id": user.ID,
 			"email":   email,
 		})
 		return nil, nil, err
 	}
 
-	logger.Info("User registered successfully", map[string]interface{}{
+	log.Info("User registered successfully", map[string]interface{}{
 		"user_id": user.ID,
 		"email":   email,
 		"role":    user.Role,
@@ -207,21 +239,22 @@ func (s *authService) Register(email, password, name, nickname, phone string, ma
 	return user, tokens, nil
 }
 
-func (s *authService) Login(email, password string) (*model.User, *util.TokenPair, error) {
-	logger.Info("Login attempt", map[string]interface{}{
+func (s *authService) Login(ctx context.Context, email, password string) (*model.User, *util.TokenPair, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Login attempt", map[string]interface{}{
 		"email": email,
 	})
 
 	// Find user by email
-	user, err := s.userRepo.FindByEmail(email)
+	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("Login failed: user not found", map[string]interface{}{
+			log.Warn("Login failed: user not found", map[string]interface{}{
 				"email": email,
 			})
 			return nil, nil, ErrInvalidCredentials
 		}
-		logger.Error("Failed to find user", err, map[string]interface{}{
+		log.Error("Failed to find user", err, map[string]interface{}{
 			"email": email,
 		})
 		return nil, nil, err
@@ -229,7 +262,7 @@ func (s *authService) Login(email, password string) (*model.User, *util.TokenPai
 
 	// Verify password
 	if !util.VerifyPassword(user.PasswordHash, password) {
-		logger.Warn("Login failed: invalid password", map[string]interface{}{
+		log.Warn("Login failed: invalid password", map[string]interface{}{
 			"email":   email,
 			"user_id": user.ID,
 		})
@@ -237,51 +270,46 @@ func (s *authService) Login(email, password string) (*model.User, *util.TokenPai
 	}
 
 	// Generate tokens
-	tokens, err := util.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-		s.jwtSecret,
-		s.accessExpiry,
-		s.refreshExpiry,
-	)
+	tokens, err := s.startSession(ctx, user)
 	if err != nil {
-		logger.Error("Failed to generate tokens", err, map[string]interface{}{
+		log.Error("Failed to generate tokens", err, map[string]interface{}{
 			"user_id": user.ID,
 			"email":   email,
 		})
 		return nil, nil, err
 	}
 
-	logger.Info("User logged in successfully", map[string]interface{}{
+	log.Info("User logged in successfully", map[string]interface{}{
 		"user_id": user.ID,
 		"email":   email,
 		"role":    user.Role,
 	})
+	s.notifyLogin(ctx, user)
 
 	return user, tokens, nil
 }
 
-func (s *authService) GetUserByID(id uint) (*model.User, error) {
-	logger.Debug("Fetching user by ID", map[string]interface{}{
+func (s *authService) GetUserByID(ctx context.Context, id uint) (*model.User, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Fetching user by ID", map[string]interface{}{
 		"user_id": id,
 	})
 
-	user, err := s.userRepo.FindByID(id)
+	user, err := s.userRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("User not found", map[string]interface{}{
+			log.Warn("User not found", map[string]interface{}{
 				"user_id": id,
 			})
 			return nil, ErrUserNotFound
 		}
-		logger.Error("Failed to fetch user", err, map[string]interface{}{
+		log.Error("Failed to fetch user", err, map[string]interface{}{
 			"user_id": id,
 		})
 		return nil, err
 	}
 
-	logger.Debug("User fetched successfully", map[string]interface{}{
+	log.Debug("User fetched successfully", map[string]interface{}{
 		"user_id": user.ID,
 		"email":   user.Email,
 	})
@@ -289,21 +317,22 @@ func (s *authService) GetUserByID(id uint) (*model.User, error) {
 	return user, nil
 }
 
-func (s *authService) UpdateProfile(userID uint, name, phone, nickname, address, profileImage *string) (*model.User, error) {
-	logger.Info("Updating user profile", map[string]interface{}{
+func (s *authService) UpdateProfile(ctx context.Context, userID uint, name, phone, nickname, address, profileImage *string) (*model.User, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Updating user profile", map[string]interface{}{
 		"user_id": userID,
 	})
 
 	// Fetch existing user
-	user, err := s.userRepo.FindByID(userID)
+	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("User not found for profile update", map[string]interface{}{
+			log.Warn("User not found for profile update", map[string]interface{}{
 				"user_id": userID,
 			})
 			return nil, ErrUserNotFound
 		}
-		logger.Error("Failed to fetch user for profile update", err, map[string]interface{}{
+		log.Error("Failed to fetch user for profile update", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return nil, err
@@ -322,22 +351,22 @@ func (s *authService) UpdateProfile(userID uint, name, phone, nickname, address,
 	if nickname != nil && *nickname != "" && *nickname != user.Nickname {
 		// Admin 사용자는 닉네임을 직접 수정할 수 없음 (매장 이름과 자동 동기화됨)
 		if user.Role == model.RoleAdmin {
-			logger.Warn("Admin users cannot update nickname directly", map[string]interface{}{
+			log.Warn("Admin users cannot update nickname directly", map[string]interface{}{
 				"user_id": userID,
 			})
 			return nil, errors.New("관리자는 닉네임을 직접 수정할 수 없습니다 - 매장 이름과 자동으로 동기화됩니다")
 		}
 
 		// Check if nickname already exists
-		existingUser, err := s.userRepo.FindByNickname(*nickname)
+		existingUser, err := s.userRepo.FindByNickname(ctx, *nickname)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Error("Failed to check existing nickname", err, map[string]interface{}{
+			log.Error("Failed to check existing nickname", err, map[string]interface{}{
 				"nickname": *nickname,
 			})
 			return nil, err
 		}
 		if existingUser != nil && existingUser.ID != userID {
-			logger.Warn("Nickname already exists", map[string]interface{}{
+			log.Warn("Nickname already exists", map[string]interface{}{
 				"nickname": *nickname,
 			})
 			return nil, ErrNicknameAlreadyExists
@@ -352,9 +381,9 @@ func (s *authService) UpdateProfile(userID uint, name, phone, nickname, address,
 
 		// Geocode the address to get latitude and longitude
 		if *address != "" {
-			lat, lng, err := util.GeocodeAddress(*address)
+			lat, lng, err := util.GeocodeAddress(ctx, *address)
 			if err != nil {
-				logger.Warn("Failed to geocode address, continuing without coordinates", map[string]interface{}{
+				log.Warn("Failed to geocode address, continuing without coordinates", map[string]interface{}{
 					"address": *address,
 					"error":   err.Error(),
 				})
@@ -363,7 +392,7 @@ func (s *authService) UpdateProfile(userID uint, name, phone, nickname, address,
 			} else {
 				user.Latitude = lat
 				user.Longitude = lng
-				logger.Info("Successfully geocoded address", map[string]interface{}{
+				log.Info("Successfully geocoded address", map[string]interface{}{
 					"address":   *address,
 					"latitude":  lat,
 					"longitude": lng,
@@ -383,21 +412,21 @@ func (s *authService) UpdateProfile(userID uint, name, phone, nickname, address,
 
 	// Only update if there are changes
 	if !updated {
-		logger.Debug("No changes detected for user profile", map[string]interface{}{
+		log.Debug("No changes detected for user profile", map[string]interface{}{
 			"user_id": userID,
 		})
 		return user, nil
 	}
 
 	// Save changes
-	if err := s.userRepo.Update(user); err != nil {
-		logger.Error("Failed to update user profile", err, map[string]interface{}{
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		log.Error("Failed to update user profile", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return nil, err
 	}
 
-	logger.Info("User profile updated successfully", map[string]interface{}{
+	log.Info("User profile updated successfully", map[string]interface{}{
 		"user_id":  user.ID,
 		"name":     user.Name,
 		"phone":    user.Phone,
@@ -409,15 +438,16 @@ func (s *authService) UpdateProfile(userID uint, name, phone, nickname, address,
 }
 
 // CheckNickname checks if a nickname is available
-func (s *authService) CheckNickname(nickname string) (bool, error) {
-	logger.Debug("Checking nickname availability", map[string]interface{}{
+func (s *authService) CheckNickname(ctx context.Context, nickname string) (bool, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Checking nickname availability", map[string]interface{}{
 		"nickname": nickname,
 	})
 
 	// Check if nickname already exists
-	existingUser, err := s.userRepo.FindByNickname(nickname)
+	existingUser, err := s.userRepo.FindByNickname(ctx, nickname)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Error("Failed to check nickname availability", err, map[string]interface{}{
+		log.Error("Failed to check nickname availability", err, map[string]interface{}{
 			"nickname": nickname,
 		})
 		return false, err
@@ -425,7 +455,7 @@ func (s *authService) CheckNickname(nickname string) (bool, error) {
 
 	// If user exists, nickname is not available
 	isAvailable := existingUser == nil
-	logger.Debug("Nickname availability checked", map[string]interface{}{
+	log.Debug("Nickname availability checked", map[string]interface{}{
 		"nickname":    nickname,
 		"is_available": isAvailable,
 	})
@@ -434,15 +464,16 @@ func (s *authService) CheckNickname(nickname string) (bool, error) {
 }
 
 // CheckEmailAvailability checks if an email is available for registration
-func (s *authService) CheckEmailAvailability(email string) (bool, error) {
-	logger.Debug("Checking email availability", map[string]interface{}{
+func (s *authService) CheckEmailAvailability(ctx context.Context, email string) (bool, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Checking email availability", map[string]interface{}{
 		"email": email,
 	})
 
 	// Check if email already exists
-	existingUser, err := s.userRepo.FindByEmail(email)
+	existingUser, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Error("Failed to check email availability", err, map[string]interface{}{
+		log.Error("Failed to check email availability", err, map[string]interface{}{
 			"email": email,
 		})
 		return false, err
@@ -450,7 +481,7 @@ func (s *authService) CheckEmailAvailability(email string) (bool, error) {
 
 	// If user exists, email is not available
 	isAvailable := existingUser == nil
-	logger.Debug("Email availability checked", map[string]interface{}{
+	log.Debug("Email availability checked", map[string]interface{}{
 		"email":        email,
 		"is_available": isAvailable,
 	})
@@ -459,98 +490,131 @@ func (s *authService) CheckEmailAvailability(email string) (bool, error) {
 }
 
 // RefreshToken validates a refresh token and generates a new token pair
-func (s *authService) RefreshToken(refreshToken string) (*util.TokenPair, error) {
-	logger.Debug("Attempting to refresh token")
+func (s *authService) RefreshToken(ctx context.Context, refreshToken string) (*util.TokenPair, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Attempting to refresh token")
 
 	// Check if token is blacklisted
-	ctx := context.Background()
 	isBlacklisted, err := redisClient.IsTokenBlacklisted(ctx, refreshToken)
 	if err != nil {
-		logger.Error("Failed to check token blacklist", err, nil)
+		log.Error("Failed to check token blacklist", err, nil)
 		return nil, err
 	}
 	if isBlacklisted {
-		logger.Warn("Attempted to use revoked refresh token", nil)
+		log.Warn("Attempted to use revoked refresh token", nil)
 		return nil, ErrTokenRevoked
 	}
 
 	// Validate the refresh token
-	claims, err := util.ValidateToken(refreshToken, s.jwtSecret)
+	claims, err := s.tokenManager.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		if errors.Is(err, util.ErrExpiredToken) {
-			logger.Warn("Refresh token has expired", nil)
+			log.Warn("Refresh token has expired", nil)
 			return nil, ErrExpiredToken
 		}
-		logger.Warn("Invalid refresh token", map[string]interface{}{
+		log.Warn("Invalid refresh token", map[string]interface{}{
 			"error": err.Error(),
 		})
 		return nil, ErrInvalidToken
 	}
 
 	// Verify user still exists
-	user, err := s.userRepo.FindByID(claims.UserID)
+	user, err := s.userRepo.FindByID(ctx, claims.UserID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("User not found for token refresh", map[string]interface{}{
+			log.Warn("User not found for token refresh", map[string]interface{}{
 				"user_id": claims.UserID,
 			})
 			return nil, ErrUserNotFound
 		}
-		logger.Error("Failed to fetch user for token refresh", err, map[string]interface{}{
+		log.Error("Failed to fetch user for token refresh", err, map[string]interface{}{
 			"user_id": claims.UserID,
 		})
 		return nil, err
 	}
 
-	// Generate new token pair
-	tokens, err := util.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-		s.jwtSecret,
-		s.accessExpiry,
-		s.refreshExpiry,
-	)
+	if claims.SessionID != "" {
+		// Rotate the refresh token within its session
+		tokens, err := s.rotateSession(ctx, user, claims.SessionID, refreshToken)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Info("Token refreshed successfully", map[string]interface{}{
+			"user_id":    user.ID,
+			"session_id": claims.SessionID,
+		})
+		return tokens, nil
+	}
+
+	// 세션 도입 이전에 발급된 토큰은 새 세션으로 전환
+	// 같은 토큰으로 동시에 요청해도 한 번만 전환되도록 세션을 만들기 전에 블랙리스트에 등록
+	claimed, err := redisClient.BlacklistTokenIfAbsent(ctx, refreshToken, s.refreshExpiry)
 	if err != nil {
-		logger.Error("Failed to generate new token pair", err, map[string]interface{}{
+		return nil, err
+	}
+	if !claimed {
+		log.Warn("Attempted to use revoked refresh token", map[string]interface{}{
 			"user_id": user.ID,
 		})
-		return nil, err
+		return nil, ErrTokenRevoked
 	}
 
-	// Blacklist the old refresh token (token rotation)
-	if err := redisClient.BlacklistToken(ctx, refreshToken, s.refreshExpiry); err != nil {
-		logger.Error("Failed to blacklist old refresh token", err, nil)
-		// Don't fail the request, just log the error
+	tokens, err := s.startSession(ctx, user)
+	if err != nil {
+		log.Error("Failed to generate new token pair", err, map[string]interface{}{
+			"user_id": user.ID,
+		})
+		// 세션을 만들지 못했으면 같은 토큰으로 다시 시도할 수 있도록 등록 해제
+		if err := redisClient.UnblacklistToken(ctx, refreshToken); err != nil {
+			log.Error("Failed to release old refresh token", err, nil)
+		}
+		return nil, err
 	}
 
-	logger.Info("Token refreshed successfully", map[string]interface{}{
+	log.Info("Token refreshed successfully", map[string]interface{}{
 		"user_id": user.ID,
 	})
 
 	return tokens, nil
 }
 
-// RevokeToken adds a refresh token to the blacklist
-func (s *authService) RevokeToken(refreshToken string) error {
-	logger.Debug("Attempting to revoke token")
+// RevokeToken revokes the session of a refresh token (or blacklists a legacy token)
+func (s *authService) RevokeToken(ctx context.Context, refreshToken string) error {
+	log := logger.FromContext(ctx)
+	log.Debug("Attempting to revoke token")
 
 	// Validate token to get expiry time
-	claims, err := util.ValidateToken(refreshToken, s.jwtSecret)
+	claims, err := s.tokenManager.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		// Even if token is invalid/expired, we still blacklist it
-		logger.Warn("Revoking invalid/expired token", map[string]interface{}{
+		log.Warn("Revoking invalid/expired token", map[string]interface{}{
 			"error": err.Error(),
 		})
 	}
 
+	// 세션에 묶인 토큰은 세션을 폐기 (세션의 모든 refresh token이 무효화됨)
+	if claims != nil && claims.SessionID != "" {
+		if err := s.sessionRepo.Revoke(ctx, claims.SessionID, model.SessionRevokedLogout); err != nil {
+			log.Error("Failed to revoke session", err, map[string]interface{}{
+				"session_id": claims.SessionID,
+			})
+			return err
+		}
+
+		log.Info("Token revoked successfully", map[string]interface{}{
+			"session_id": claims.SessionID,
+		})
+		return nil
+	}
+
 	// Calculate remaining TTL
 	var ttl time.Duration
 	if claims != nil && claims.ExpiresAt != nil {
 		ttl = time.Until(claims.ExpiresAt.Time)
 		if ttl < 0 {
 			// Token already expired, no need to blacklist
-			logger.Debug("Token already expired, skipping blacklist", nil)
+			log.Debug("Token already expired, skipping blacklist", nil)
 			return nil
 		}
 	} else {
@@ -558,18 +622,29 @@ func (s *authService) RevokeToken(refreshToken string) error {
 		ttl = s.refreshExpiry
 	}
 
-	ctx := context.Background()
 	if err := redisClient.BlacklistToken(ctx, refreshToken, ttl); err != nil {
-		logger.Error("Failed to blacklist token", err, nil)
+		log.Error("Failed to blacklist token", err, nil)
 		return err
 	}
 
-	logger.Info("Token revoked successfully", nil)
+	log.Info("Token revoked successfully", nil)
 	return nil
 }
 
+// AddListener 로그인 수신자 등록 (서버 시작 시에만 호출)
+func (s *authService) AddListener(listener AuthListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+func (s *authService) notifyLogin(ctx context.Context, user *model.User) {
+	for _, listener := range s.listeners {
+		listener.OnLogin(ctx, user)
+	}
+}
+
 // generateUniqueNickname generates a random unique nickname
-func (s *authService) generateUniqueNickname() (string, error) {
+func (s *authService) generateUniqueNickname(ctx context.Context) (string, error) {
+	log := logger.FromContext(ctx)
 	const (
 		maxRetries = 10
 		prefix     = "사용자"
@@ -581,9 +656,9 @@ func (s *authService) generateUniqueNickname() (string, error) {
 		nickname := fmt.Sprintf("%s%d", prefix, randomNum)
 
 		// Check if nickname already exists
-		existingUser, err := s.userRepo.FindByNickname(nickname)
+		existingUser, err := s.userRepo.FindByNickname(ctx, nickname)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Error("Failed to check existing nickname", err, map[string]interface{}{
+			log.Error("Failed to check existing nickname", err, map[string]interface{}{
 				"nickname": nickname,
 			})
 			return "", err
@@ -591,13 +666,13 @@ func (s *authService) generateUniqueNickname() (string, error) {
 
 		// If nickname doesn't exist, return it
 		if existingUser == nil {
-			logger.Debug("Generated unique nickname", map[string]interface{}{
+			log.Debug("Generated unique nickname", map[string]interface{}{
 				"nickname": nickname,
 			})
 			return nickname, nil
 		}
 
-		logger.Debug("Nickname already exists, retrying", map[string]interface{}{
+		log.Debug("Nickname already exists, retrying", map[string]interface{}{
 			"nickname": nickname,
 			"attempt":  i + 1,
 		})
@@ -639,304 +714,161 @@ func normalizePhoneNumber(kakaoPhone string) string {
 	return phone
 }
 
-// getProfileImageURL returns the best available profile image URL from Kakao
-func getProfileImageURL(kakaoUserInfo *kakaoUserInfo) string {
-	// Priority: kakao_account.profile.profile_image_url > properties.profile_image
-	if kakaoUserInfo.KakaoAccount.Profile.ProfileImageURL != "" &&
-	   !kakaoUserInfo.KakaoAccount.Profile.IsDefaultImage {
-		return kakaoUserInfo.KakaoAccount.Profile.ProfileImageURL
-	}
+// === 소셜 로그인 (OAuth) ===
 
-	if kakaoUserInfo.Properties.ProfileImage != "" {
-		return kakaoUserInfo.Properties.ProfileImage
+// oauthProvider 설정된 로그인 제공자
+func (s *authService) oauthProvider(name model.IdentityProvider) (OAuthProvider, error) {
+	provider, ok := s.oauthProviders[name]
+	if !ok {
+		return nil, ErrUnsupportedProvider
 	}
-
-	return ""
+	return provider, nil
 }
 
-// GetKakaoLoginURL returns the Kakao OAuth login URL
-func (s *authService) GetKakaoLoginURL() string {
-	return fmt.Sprintf("https://kauth.kakao.com/oauth/authorize?client_id=%s&redirect_uri=%s&response_type=code",
-		s.kakaoClientID, s.kakaoRedirectURI)
+// loginStateBinding 로그인 state의 발급 대상 (로그인을 시작한 브라우저의 nonce)
+func loginStateBinding(nonce string) string {
+	return "login:" + nonce
 }
 
-// KakaoLogin handles Kakao OAuth login
-func (s *authService) KakaoLogin(code string) (*model.User, *util.TokenPair, error) {
-	logger.Info("Starting Kakao login", map[string]interface{}{
-		"code": code,
-	})
+// linkStateBinding 연결 state의 발급 대상 (연결을 시작한 사용자)
+func linkStateBinding(userID uint) string {
+	return fmt.Sprintf("link:%d", userID)
+}
 
-	// 1. Get Kakao access token
-	kakaoToken, err := s.getKakaoToken(code)
+// GetOAuthLoginURL 제공자 로그인 페이지 주소 (콜백에서 확인할 state와 브라우저 nonce를 새로 발급해 저장)
+func (s *authService) GetOAuthLoginURL(ctx context.Context, provider model.IdentityProvider) (string, string, error) {
+	p, err := s.oauthProvider(provider)
 	if err != nil {
-		logger.Error("Failed to get Kakao access token", err, nil)
-		return nil, nil, fmt.Errorf("failed to get Kakao access token: %w", err)
+		return "", "", err
 	}
-
-	// 2. Get user info from Kakao
-	kakaoUserInfo, err := s.getKakaoUserInfo(kakaoToken.AccessToken)
+	nonce := uuid.New().String()
+	state, err := s.issueOAuthState(ctx, provider, loginStateBinding(nonce))
 	if err != nil {
-		logger.Error("Failed to get Kakao user info", err, nil)
-		return nil, nil, fmt.Errorf("failed to get Kakao user info: %w", err)
-	}
-
-	logger.Debug("Kakao user info retrieved", map[string]interface{}{
-		"email": kakaoUserInfo.KakaoAccount.Email,
-	})
-
-	// 3. Check if user already exists
-	user, err := s.userRepo.FindByEmail(kakaoUserInfo.KakaoAccount.Email)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Error("Failed to check existing user", err, map[string]interface{}{
-			"email": kakaoUserInfo.KakaoAccount.Email,
-		})
-		return nil, nil, err
-	}
-
-	// 4. Create new user if not exists
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Info("Creating new user from Kakao login", map[string]interface{}{
-			"email": kakaoUserInfo.KakaoAccount.Email,
-		})
-
-		// Generate unique nickname
-		nickname, err := s.generateUniqueNickname()
-		if err != nil {
-			logger.Error("Failed to generate unique nickname", err, nil)
-			return nil, nil, err
-		}
-
-		// Normalize phone number
-		phone := normalizePhoneNumber(kakaoUserInfo.KakaoAccount.PhoneNumber)
-
-		// Get profile image URL
-		profileImage := getProfileImageURL(kakaoUserInfo)
-
-		user = &model.User{
-			Email:        kakaoUserInfo.KakaoAccount.Email,
-			PasswordHash: "", // Kakao login users don't have password
-			Name:         kakaoUserInfo.Properties.Nickname,
-			Nickname:     nickname,
-			Phone:        phone,
-			ProfileImage: profileImage,
-			Role:         model.RoleUser,
-		}
-
-		logger.Debug("Creating user with Kakao info", map[string]interface{}{
-			"email":         user.Email,
-			"name":          user.Name,
-			"phone":         user.Phone,
-			"profile_image": user.ProfileImage,
-		})
-
-		if err := s.userRepo.Create(user); err != nil {
-			logger.Error("Failed to create Kakao user", err, map[string]interface{}{
-				"email": kakaoUserInfo.KakaoAccount.Email,
-			})
-			return nil, nil, err
-		}
-
-		logger.Info("New user created from Kakao login", map[string]interface{}{
-			"user_id": user.ID,
-			"email":   user.Email,
-		})
-	} else {
-		logger.Info("Existing user found for Kakao login", map[string]interface{}{
-			"user_id": user.ID,
-			"email":   user.Email,
-		})
+		return "", "", err
 	}
+	return p.AuthURL(state), nonce, nil
+}
 
-	// 5. Store Kakao access token in Redis
-	ctx := context.Background()
-	kakaoRedisKey := fmt.Sprintf("kakao_access_token:%d", user.ID)
-	if err := redisClient.StoreKakaoToken(ctx, kakaoRedisKey, kakaoToken.AccessToken, 15*time.Minute); err != nil {
-		logger.Error("Failed to store Kakao access token in Redis", err, map[string]interface{}{
-			"user_id": user.ID,
-		})
-		// Don't fail the login if Redis storage fails
+// GetOAuthLinkURL 로그인한 사용자가 외부 로그인을 연결할 때 사용할 제공자 로그인 페이지 주소
+func (s *authService) GetOAuthLinkURL(ctx context.Context, userID uint, provider model.IdentityProvider) (string, error) {
+	p, err := s.oauthProvider(provider)
+	if err != nil {
+		return "", err
 	}
-
-	// 6. Generate JWT tokens
-	tokens, err := util.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-		s.jwtSecret,
-		s.accessExpiry,
-		s.refreshExpiry,
-	)
+	state, err := s.issueOAuthState(ctx, provider, linkStateBinding(userID))
 	if err != nil {
-		logger.Error("Failed to generate tokens for Kakao login", err, map[string]interface{}{
-			"user_id": user.ID,
-		})
-		return nil, nil, err
+		return "", err
 	}
-
-	logger.Info("Kakao login successful", map[string]interface{}{
-		"user_id": user.ID,
-		"email":   user.Email,
-	})
-
-	return user, tokens, nil
-}
-
-// kakaoTokenResponse represents Kakao token response
-type kakaoTokenResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	ExpiresIn    int    `json:"expires_in"`
-}
-
-// kakaoUserInfo represents Kakao user information
-type kakaoUserInfo struct {
-	ID           int64         `json:"id"`
-	ConnectedAt  string        `json:"connected_at"`
-	Properties   kakaoProperties `json:"properties"`
-	KakaoAccount kakaoAccount  `json:"kakao_account"`
-}
-
-type kakaoProperties struct {
-	Nickname       string `json:"nickname"`
-	ProfileImage   string `json:"profile_image"`
-	ThumbnailImage string `json:"thumbnail_image"`
+	return p.AuthURL(state), nil
 }
 
-type kakaoAccount struct {
-	Email                 string        `json:"email"`
-	ProfileNeedsAgreement bool          `json:"profile_needs_agreement"`
-	HasEmail              bool          `json:"has_email"`
-	PhoneNumber           string        `json:"phone_number"`
-	HasPhoneNumber        bool          `json:"has_phone_number"`
-	Profile               kakaoProfile  `json:"profile"`
-}
-
-type kakaoProfile struct {
-	Nickname         string `json:"nickname"`
-	ProfileImageURL  string `json:"profile_image_url"`
-	ThumbnailURL     string `json:"thumbnail_image_url"`
-	IsDefaultImage   bool   `json:"is_default_image"`
+func (s *authService) issueOAuthState(ctx context.Context, provider model.IdentityProvider, binding string) (string, error) {
+	state := uuid.New().String()
+	if err := s.stateStore.Save(ctx, string(provider), state, binding, OAuthStateTTL); err != nil {
+		return "", err
+	}
+	return state, nil
 }
 
-// getKakaoToken requests access token from Kakao
-func (s *authService) getKakaoToken(code string) (*kakaoTokenResponse, error) {
-	logger.Debug("Requesting Kakao token")
-
-	reqBody := fmt.Sprintf("grant_type=authorization_code&client_id=%s&client_secret=%s&redirect_uri=%s&code=%s",
-		s.kakaoClientID, s.kakaoClientSecret, s.kakaoRedirectURI, code)
-
-	resp, err := http.Post("https://kauth.kakao.com/oauth/token",
-		"application/x-www-form-urlencoded",
-		strings.NewReader(reqBody))
-	if err != nil {
-		logger.Error("Failed to make HTTP request to Kakao token endpoint", err, nil)
-		return nil, err
+// consumeOAuthState 이 서버가 같은 대상에게 발급한 state인지 확인하고 다시 쓸 수 없게 함
+// 다른 브라우저나 사용자가 시작한 흐름의 콜백(로그인 CSRF, 다른 계정 연결)이나 재사용한 콜백을 거부
+func (s *authService) consumeOAuthState(ctx context.Context, provider model.IdentityProvider, state, binding string) error {
+	if state == "" {
+		return ErrOAuthStateInvalid
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
+	issuedTo, issued, err := s.stateStore.Consume(ctx, string(provider), state)
 	if err != nil {
-		logger.Error("Failed to read Kakao token response", err, nil)
-		return nil, err
-	}
-
-	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		logger.Error("Kakao token request failed", nil, map[string]interface{}{
-			"status_code":   resp.StatusCode,
-			"response_body": string(body),
-		})
-		return nil, fmt.Errorf("kakao token request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var tokenResp kakaoTokenResponse
-	if err := json.Unmarshal(body, &tokenResp); err != nil {
-		logger.Error("Failed to unmarshal Kakao token response", err, map[string]interface{}{
-			"response_body": string(body),
-		})
-		return nil, err
+		return err
 	}
-
-	// Validate access token exists
-	if tokenResp.AccessToken == "" {
-		logger.Error("Kakao token response missing access_token", nil, map[string]interface{}{
-			"response_body": string(body),
-		})
-		return nil, fmt.Errorf("kakao token response missing access_token")
+	if !issued || subtle.ConstantTimeCompare([]byte(issuedTo), []byte(binding)) != 1 {
+		return ErrOAuthStateInvalid
 	}
-
-	logger.Debug("Kakao token obtained successfully")
-	return &tokenResp, nil
+	return nil
 }
 
-// getKakaoUserInfo gets user information from Kakao
-func (s *authService) getKakaoUserInfo(accessToken string) (*kakaoUserInfo, error) {
-	logger.Debug("Requesting Kakao user info")
+// OAuthLogin 인가 코드로 로그인 (연결된 계정이 없으면 가입)
+func (s *authService) OAuthLogin(ctx context.Context, provider model.IdentityProvider, code, state, nonce string) (*model.User, *util.TokenPair, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Starting social login", map[string]interface{}{
+		"provider": provider,
+	})
 
-	req, err := http.NewRequest("GET", "https://kapi.kakao.com/v2/user/me", nil)
+	p, err := s.oauthProvider(provider)
 	if err != nil {
-		logger.Error("Failed to create HTTP request for Kakao user info", err, nil)
-		return nil, err
+		return nil, nil, err
 	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		logger.Error("Failed to make HTTP request to Kakao user info endpoint", err, nil)
-		return nil, err
+	if nonce == "" {
+		return nil, nil, ErrOAuthStateInvalid
+	}
+	if err := s.consumeOAuthState(ctx, provider, state, loginStateBinding(nonce)); err != nil {
+		return nil, nil, err
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
+	// 1. Exchange the authorization code for the provider profile
+	profile, err := p.Exchange(ctx, code, state)
 	if err != nil {
-		logger.Error("Failed to read Kakao user info response", err, nil)
-		return nil, err
+		log.Error("Failed to get social login profile", err, map[string]interface{}{
+			"provider": provider,
+		})
+		return nil, nil, err
 	}
 
-	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		logger.Error("Kakao user info request failed", nil, map[string]interface{}{
-			"status_code":   resp.StatusCode,
-			"response_body": string(body),
-		})
-		return nil, fmt.Errorf("kakao user info request failed with status %d: %s", resp.StatusCode, string(body))
+	log.Debug("Social login profile retrieved", map[string]interface{}{
+		"provider": provider,
+		"email":    profile.Email,
+	})
+
+	// 2. Find the user linked to this account (or sign up)
+	user, err := s.resolveSocialUser(ctx, profile)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var userInfo kakaoUserInfo
-	if err := json.Unmarshal(body, &userInfo); err != nil {
-		logger.Error("Failed to unmarshal Kakao user info response", err, map[string]interface{}{
-			"response_body": string(body),
-		})
-		return nil, err
+	// 3. Store Kakao access token in Redis
+	if provider == model.IdentityProviderKakao && profile.AccessToken != "" {
+		kakaoRedisKey := fmt.Sprintf("kakao_access_token:%d", user.ID)
+		if err := redisClient.StoreKakaoToken(ctx, kakaoRedisKey, profile.AccessToken, 15*time.Minute); err != nil {
+			log.Error("Failed to store Kakao access token in Redis", err, map[string]interface{}{
+				"user_id": user.ID,
+			})
+			// Don't fail the login if Redis storage fails
+		}
 	}
 
-	// Validate email exists
-	if userInfo.KakaoAccount.Email == "" {
-		logger.Error("Kakao user info missing email", nil, map[string]interface{}{
-			"response_body": string(body),
+	// 4. Generate JWT tokens
+	tokens, err := s.startSession(ctx, user)
+	if err != nil {
+		log.Error("Failed to generate tokens for social login", err, map[string]interface{}{
+			"user_id":  user.ID,
+			"provider": provider,
 		})
-		return nil, fmt.Errorf("카카오 사용자가 이메일을 제공하지 않았습니다 - 이메일 동의가 필요합니다")
+		return nil, nil, err
 	}
 
-	logger.Debug("Kakao user info obtained successfully", map[string]interface{}{
-		"email": userInfo.KakaoAccount.Email,
+	log.Info("Social login successful", map[string]interface{}{
+		"user_id":  user.ID,
+		"email":    user.Email,
+		"provider": provider,
 	})
-	return &userInfo, nil
+	s.notifyLogin(ctx, user)
+
+	return user, tokens, nil
 }
 
 // === 이메일/휴대폰 인증 메서드 ===
 
 // SendEmailVerification sends verification code to email
-func (s *authService) SendEmailVerification(email string) error {
+func (s *authService) SendEmailVerification(ctx context.Context, email string) error {
 	// Generate verification code
 	code, err := util.GenerateVerificationCode()
 	if err != nil {
 		return fmt.Errorf("failed to generate verification code: %w", err)
 	}
 
-	// Store code
-	util.StoreEmailVerificationCode(email, code)
+	// Store code (재전송 쿨다운/잠금 확인 포함)
+	if err := s.verificationStore.Save(ctx, util.VerificationPurposeEmail, email, code); err != nil {
+		return translateVerificationError(err)
+	}
 
 	// Send email
 	err = util.SendVerificationEmail(email, code)
@@ -948,14 +880,14 @@ func (s *authService) SendEmailVerification(email string) error {
 }
 
 // VerifyEmail verifies email with code
-func (s *authService) VerifyEmail(email, code string) error {
+func (s *authService) VerifyEmail(ctx context.Context, email, code string) error {
 	// Verify code
-	if !util.VerifyEmailCode(email, code) {
-		return ErrInvalidVerificationCode
+	if err := s.verificationStore.Verify(ctx, util.VerificationPurposeEmail, email, code); err != nil {
+		return translateVerificationError(err)
 	}
 
 	// Find user by email
-	user, err := s.userRepo.FindByEmail(email)
+	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			// 회원가입 전 이메일 인증인 경우 - 이메일만 검증하고 user 업데이트는 하지 않음
@@ -969,7 +901,7 @@ func (s *authService) VerifyEmail(email, code string) error {
 	user.EmailVerified = true
 	user.EmailVerifiedAt = &now
 
-	err = s.userRepo.Update(user)
+	err = s.userRepo.Update(ctx, user)
 	if err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
@@ -978,9 +910,9 @@ func (s *authService) VerifyEmail(email, code string) error {
 }
 
 // SendPhoneVerification sends verification code to phone
-func (s *authService) SendPhoneVerification(userID uint, phone string) error {
+func (s *authService) SendPhoneVerification(ctx context.Context, userID uint, phone string) error {
 	// Get user
-	user, err := s.userRepo.FindByID(userID)
+	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -996,11 +928,13 @@ func (s *authService) SendPhoneVerification(userID uint, phone string) error {
 		return fmt.Errorf("failed to generate verification code: %w", err)
 	}
 
-	// Store code
-	util.StorePhoneVerificationCode(phone, code)
+	// Store code (재전송 쿨다운/잠금 확인 포함)
+	if err := s.verificationStore.Save(ctx, util.VerificationPurposePhone, phone, code); err != nil {
+		return translateVerificationError(err)
+	}
 
 	// Send SMS
-	err = util.SendVerificationSMS(phone, code)
+	err = util.SendVerificationSMS(ctx, phone, code)
 	if err != nil {
 		return fmt.Errorf("failed to send verification SMS: %w", err)
 	}
@@ -1009,14 +943,14 @@ func (s *authService) SendPhoneVerification(userID uint, phone string) error {
 }
 
 // VerifyPhone verifies phone with code
-func (s *authService) VerifyPhone(userID uint, phone, code string) error {
+func (s *authService) VerifyPhone(ctx context.Context, userID uint, phone, code string) error {
 	// Verify code
-	if !util.VerifyPhoneCode(phone, code) {
-		return ErrInvalidVerificationCode
+	if err := s.verificationStore.Verify(ctx, util.VerificationPurposePhone, phone, code); err != nil {
+		return translateVerificationError(err)
 	}
 
 	// Get user
-	user, err := s.userRepo.FindByID(userID)
+	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return fmt.Errorf("failed to find user: %w", err)
 	}
@@ -1027,7 +961,7 @@ func (s *authService) VerifyPhone(userID uint, phone, code string) error {
 	user.PhoneVerified = true
 	user.PhoneVerifiedAt = &now
 
-	err = s.userRepo.Update(user)
+	err = s.userRepo.Update(ctx, user)
 	if err != nil {
 		return fmt.Errorf("failed to update user: %w", err)
 	}
@@ -1035,220 +969,16 @@ func (s *authService) VerifyPhone(userID uint, phone, code string) error {
 	return nil
 }
 
-// === Google OAuth ===
-
-// googleTokenResponse represents Google token response
-type googleTokenResponse struct {
-	AccessToken string `json:"access_token"`
-	IDToken     string `json:"id_token"`
-	ExpiresIn   int    `json:"expires_in"`
-	TokenType   string `json:"token_type"`
-}
-
-// googleUserInfo represents Google user information
-type googleUserInfo struct {
-	Sub           string `json:"sub"`
-	Email         string `json:"email"`
-	EmailVerified bool   `json:"email_verified"`
-	Name          string `json:"name"`
-	GivenName     string `json:"given_name"`
-	FamilyName    string `json:"family_name"`
-	Picture       string `json:"picture"`
-}
-
-// GetGoogleLoginURL returns the Google OAuth login URL
-func (s *authService) GetGoogleLoginURL() string {
-	scope := "openid profile email"
-	return fmt.Sprintf(
-		"https://accounts.google.com/o/oauth2/v2/auth?client_id=%s&redirect_uri=%s&response_type=code&scope=%s",
-		s.googleClientID, s.googleRedirectURI, scope,
-	)
-}
-
-// GoogleLogin handles Google OAuth login
-func (s *authService) GoogleLogin(code string) (*model.User, *util.TokenPair, error) {
-	logger.Info("Starting Google login", map[string]interface{}{
-		"code": code,
-	})
-
-	// 1. Get Google access token
-	googleToken, err := s.getGoogleToken(code)
-	if err != nil {
-		logger.Error("Failed to get Google access token", err, nil)
-		return nil, nil, fmt.Errorf("failed to get Google access token: %w", err)
-	}
-
-	// 2. Get user info from Google
-	googleUser, err := s.getGoogleUserInfo(googleToken.AccessToken)
-	if err != nil {
-		logger.Error("Failed to get Google user info", err, nil)
-		return nil, nil, fmt.Errorf("failed to get Google user info: %w", err)
-	}
-
-	logger.Debug("Google user info retrieved", map[string]interface{}{
-		"email": googleUser.Email,
-	})
-
-	// 3. Check if user already exists
-	user, err := s.userRepo.FindByEmail(googleUser.Email)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Error("Failed to check existing user", err, map[string]interface{}{
-			"email": googleUser.Email,
-		})
-		return nil, nil, err
-	}
-
-	// 4. Create new user if not exists
-	if errors.Is(err, gorm.ErrRecordNotFound) {
-		logger.Info("Creating new user from Google login", map[string]interface{}{
-			"email": googleUser.Email,
-		})
-
-		nickname, err := s.generateUniqueNickname()
-		if err != nil {
-			logger.Error("Failed to generate unique nickname", err, nil)
-			return nil, nil, err
-		}
-
-		user = &model.User{
-			Email:        googleUser.Email,
-			PasswordHash: "",
-			Name:         googleUser.Name,
-			Nickname:     nickname,
-			ProfileImage: googleUser.Picture,
-			Role:         model.RoleUser,
-		}
-
-		if err := s.userRepo.Create(user); err != nil {
-			logger.Error("Failed to create Google user", err, map[string]interface{}{
-				"email": googleUser.Email,
-			})
-			return nil, nil, err
-		}
-
-		logger.Info("New user created from Google login", map[string]interface{}{
-			"user_id": user.ID,
-			"email":   user.Email,
-		})
-	} else {
-		logger.Info("Existing user found for Google login", map[string]interface{}{
-			"user_id": user.ID,
-			"email":   user.Email,
-		})
-	}
-
-	// 5. Generate JWT tokens
-	tokens, err := util.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-		s.jwtSecret,
-		s.accessExpiry,
-		s.refreshExpiry,
-	)
-	if err != nil {
-		logger.Error("Failed to generate tokens for Google login", err, map[string]interface{}{
-			"user_id": user.ID,
-		})
-		return nil, nil, err
-	}
-
-	logger.Info("Google login successful", map[string]interface{}{
-		"user_id": user.ID,
-		"email":   user.Email,
-	})
-
-	return user, tokens, nil
-}
-
-// getGoogleToken exchanges authorization code for access token
-func (s *authService) getGoogleToken(code string) (*googleTokenResponse, error) {
-	logger.Debug("Requesting Google token")
-
-	reqBody := fmt.Sprintf(
-		"grant_type=authorization_code&client_id=%s&client_secret=%s&redirect_uri=%s&code=%s",
-		s.googleClientID, s.googleClientSecret, s.googleRedirectURI, code,
-	)
-
-	resp, err := http.Post(
-		"https://oauth2.googleapis.com/token",
-		"application/x-www-form-urlencoded",
-		strings.NewReader(reqBody),
-	)
-	if err != nil {
-		logger.Error("Failed to make HTTP request to Google token endpoint", err, nil)
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logger.Error("Failed to read Google token response", err, nil)
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		logger.Error("Google token request failed", nil, map[string]interface{}{
-			"status_code":   resp.StatusCode,
-			"response_body": string(body),
-		})
-		return nil, fmt.Errorf("google token request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var tokenResp googleTokenResponse
-	if err := json.Unmarshal(body, &tokenResp); err != nil {
-		return nil, err
-	}
-
-	if tokenResp.AccessToken == "" {
-		return nil, fmt.Errorf("google token response missing access_token")
-	}
-
-	logger.Debug("Google token obtained successfully")
-	return &tokenResp, nil
-}
-
-// getGoogleUserInfo fetches user information from Google
-func (s *authService) getGoogleUserInfo(accessToken string) (*googleUserInfo, error) {
-	logger.Debug("Requesting Google user info")
-
-	req, err := http.NewRequest("GET", "https://www.googleapis.com/oauth2/v3/userinfo", nil)
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		logger.Error("Google user info request failed", nil, map[string]interface{}{
-			"status_code":   resp.StatusCode,
-			"response_body": string(body),
-		})
-		return nil, fmt.Errorf("google user info request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var userInfo googleUserInfo
-	if err := json.Unmarshal(body, &userInfo); err != nil {
-		return nil, err
-	}
-
-	if userInfo.Email == "" {
-		return nil, fmt.Errorf("구글 사용자가 이메일을 제공하지 않았습니다")
+// translateVerificationError 인증 코드 저장소 에러를 서비스 에러로 변환
+func translateVerificationError(err error) error {
+	switch {
+	case errors.Is(err, util.ErrVerificationCodeInvalid):
+		return ErrInvalidVerificationCode
+	case errors.Is(err, util.ErrVerificationCooldown):
+		return ErrVerificationCooldown
+	case errors.Is(err, util.ErrVerificationLocked):
+		return ErrVerificationLocked
+	default:
+		return fmt.Errorf("verification store error: %w", err)
 	}
-
-	logger.Debug("Google user info obtained successfully", map[string]interface{}{
-		"email": userInfo.Email,
-	})
-	return &userInfo, nil
 }
@@ -1,12 +1,14 @@
 package service
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
 	"github.com/ikkim/udonggeum-backend/internal/db"
+	"github.com/ikkim/udonggeum-backend/pkg/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -18,15 +20,23 @@ func setupAuthServiceTest(t *testing.T) (AuthService, *repository.UserRepository
 	userRepo := repository.NewUserRepository(testDB)
 	authService := NewAuthService(
 		userRepo,
-		"test-jwt-secret",
+		repository.NewUserSessionRepository(testDB),
+		repository.NewExternalIdentityRepository(testDB),
+		util.NewHMACTokenManager("test-jwt-secret"),
 		15*time.Minute,
 		7*24*time.Hour,
-		"test-kakao-client-id",
-		"test-kakao-client-secret",
-		"http://localhost:8080/api/v1/auth/kakao/callback",
-		"test-google-client-id",
-		"test-google-client-secret",
-		"http://localhost:8080/api/v1/auth/google/callback",
+		util.NewMemoryVerificationCodeStore(util.DefaultVerificationPolicy()),
+		util.NewMemoryOAuthStateStore(),
+		NewKakaoOAuthProvider(OAuthClientConfig{
+			ClientID:     "test-kakao-client-id",
+			ClientSecret: "test-kakao-client-secret",
+			RedirectURI:  "http://localhost:8080/api/v1/auth/kakao/callback",
+		}),
+		NewGoogleOAuthProvider(OAuthClientConfig{
+			ClientID:     "test-google-client-id",
+			ClientSecret: "test-google-client-secret",
+			RedirectURI:  "http://localhost:8080/api/v1/auth/google/callback",
+		}),
 	)
 
 	return authService, &userRepo
@@ -64,6 +74,7 @@ func TestAuthService_Register(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			user, tokens, err := authService.Register(
+				context.Background(),
 				tt.email,
 				tt.password,
 				tt.userName,
@@ -96,7 +107,7 @@ func TestAuthService_Login(t *testing.T) {
 	// Register a user first
 	email := "test@example.com"
 	password := "password123"
-	_, _, err := authService.Register(email, password, "Test User", "", "010-1234-5678", false, false, false, false)
+	_, _, err := authService.Register(context.Background(), email, password, "Test User", "", "010-1234-5678", false, false, false, false)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -127,7 +138,7 @@ func TestAuthService_Login(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			user, tokens, err := authService.Login(tt.email, tt.password)
+			user, tokens, err := authService.Login(context.Background(), tt.email, tt.password)
 
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
@@ -150,6 +161,7 @@ func TestAuthService_GetUserByID(t *testing.T) {
 
 	// Register a user
 	user, _, err := authService.Register(
+		context.Background(),
 		"test@example.com",
 		"password123",
 		"Test User",
@@ -178,7 +190,7 @@ func TestAuthService_GetUserByID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			found, err := authService.GetUserByID(tt.userID)
+			found, err := authService.GetUserByID(context.Background(), tt.userID)
 
 			if tt.wantErr != nil {
 				assert.ErrorIs(t, err, tt.wantErr)
@@ -198,6 +210,7 @@ func TestAuthService_PasswordSecurity(t *testing.T) {
 
 	password := "mySecretPassword123"
 	user, _, err := authService.Register(
+		context.Background(),
 		"test@example.com",
 		password,
 		"Test User",
@@ -216,6 +229,7 @@ func TestAuthService_TokenGeneration(t *testing.T) {
 	authService, _ := setupAuthServiceTest(t)
 
 	user, tokens, err := authService.Register(
+		context.Background(),
 		"test@example.com",
 		"password123",
 		"Test User",
@@ -233,7 +247,7 @@ func TestAuthService_TokenGeneration(t *testing.T) {
 	assert.Contains(t, tokens.RefreshToken, ".")
 
     // Login should generate new tokens
-    _, newTokens, err := authService.Login("test@example.com", "password123")
+    _, newTokens, err := authService.Login(context.Background(), "test@example.com", "password123")
     require.NoError(t, err)
     assert.NotEmpty(t, newTokens.AccessToken)
     assert.NotEmpty(t, newTokens.RefreshToken)
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/util"
+	"gorm.io/gorm"
+)
+
+// SessionDevice 로그인/토큰 갱신을 요청한 기기 정보
+type SessionDevice struct {
+	Name      string
+	IPAddress string
+	UserAgent string
+}
+
+type sessionDeviceKey struct{}
+
+// WithSessionDevice 요청 기기 정보를 context에 담음 (세션 생성/갱신 시 기록)
+func WithSessionDevice(ctx context.Context, device SessionDevice) context.Context {
+	return context.WithValue(ctx, sessionDeviceKey{}, device)
+}
+
+func sessionDeviceFromContext(ctx context.Context) SessionDevice {
+	device, _ := ctx.Value(sessionDeviceKey{}).(SessionDevice)
+	return device
+}
+
+// hashRefreshToken DB에는 refresh token 원문 대신 해시만 저장
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// truncateRunes 컬럼 길이를 넘는 헤더 값은 잘라서 저장
+func truncateRunes(value string, max int) string {
+	runes := []rune(value)
+	if len(runes) > max {
+		return string(runes[:max])
+	}
+	return value
+}
+
+// startSession 새 로그인 세션을 만들고 세션에 묶인 토큰을 발급
+func (s *authService) startSession(ctx context.Context, user *model.User) (*util.TokenPair, error) {
+	log := logger.FromContext(ctx)
+	device := sessionDeviceFromContext(ctx)
+
+	sessionID := uuid.New().String()
+	tokens, err := s.tokenManager.GenerateTokenPair(
+		user.ID,
+		user.Email,
+		string(user.Role),
+		sessionID,
+		s.accessExpiry,
+		s.refreshExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &model.UserSession{
+		ID:         sessionID,
+		UserID:     user.ID,
+		TokenHash:  hashRefreshToken(tokens.RefreshToken),
+		DeviceName: truncateRunes(device.Name, 100),
+		IPAddress:  truncateRunes(device.IPAddress, 45),
+		UserAgent:  truncateRunes(device.UserAgent, 255),
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(s.refreshExpiry),
+	}
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	log.Info("User session started", map[string]interface{}{
+		"user_id":    user.ID,
+		"session_id": sessionID,
+		"device":     session.DeviceName,
+	})
+	return tokens, nil
+}
+
+// rotateSession refresh token을 회전. 이미 회전된 토큰이 다시 쓰이면 탈취로 보고 세션 전체를 폐기
+func (s *authService) rotateSession(ctx context.Context, user *model.User, sessionID, refreshToken string) (*util.TokenPair, error) {
+	log := logger.FromContext(ctx)
+
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn("Session not found for token refresh", map[string]interface{}{
+				"user_id":    user.ID,
+				"session_id": sessionID,
+			})
+			return nil, ErrTokenRevoked
+		}
+		return nil, err
+	}
+	if session.UserID != user.ID {
+		log.Warn("Session does not belong to token user", map[string]interface{}{
+			"user_id":    user.ID,
+			"session_id": sessionID,
+		})
+		return nil, ErrInvalidToken
+	}
+	if session.RevokedAt != nil {
+		log.Warn("Attempted to refresh revoked session", map[string]interface{}{
+			"user_id":    user.ID,
+			"session_id": sessionID,
+			"reason":     session.RevokedReason,
+		})
+		return nil, ErrTokenRevoked
+	}
+
+	currentHash := hashRefreshToken(refreshToken)
+	if session.TokenHash != currentHash {
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+
+	tokens, err := s.tokenManager.GenerateTokenPair(
+		user.ID,
+		user.Email,
+		string(user.Role),
+		session.ID,
+		s.accessExpiry,
+		s.refreshExpiry,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	device := sessionDeviceFromContext(ctx)
+	now := time.Now()
+	session.TokenHash = hashRefreshToken(tokens.RefreshToken)
+	session.LastUsedAt = now
+	session.ExpiresAt = now.Add(s.refreshExpiry)
+	if device.IPAddress != "" {
+		session.IPAddress = truncateRunes(device.IPAddress, 45)
+	}
+	if device.UserAgent != "" {
+		session.UserAgent = truncateRunes(device.UserAgent, 255)
+	}
+
+	rotated, err := s.sessionRepo.Rotate(ctx, session, currentHash)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		// 같은 토큰으로 동시에 갱신한 다른 요청이 먼저 회전시킴
+		return nil, s.revokeReusedSession(ctx, session)
+	}
+
+	return tokens, nil
+}
+
+func (s *authService) revokeReusedSession(ctx context.Context, session *model.UserSession) error {
+	log := logger.FromContext(ctx)
+	log.Warn("Refresh token reuse detected, revoking session", map[string]interface{}{
+		"user_id":    session.UserID,
+		"session_id": session.ID,
+	})
+
+	if err := s.sessionRepo.Revoke(ctx, session.ID, model.SessionRevokedReuseDetected); err != nil {
+		return err
+	}
+	return ErrTokenReused
+}
+
+// IsSessionActive 세션이 폐기/만료되지 않았는지 확인 (인증 미들웨어에서 access token 검증 후 호출)
+func (s *authService) IsSessionActive(ctx context.Context, sessionID string) (bool, error) {
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return session.RevokedAt == nil && time.Now().Before(session.ExpiresAt), nil
+}
+
+// ListSessions 사용자의 활성 로그인 세션 목록
+func (s *authService) ListSessions(ctx context.Context, userID uint) ([]model.UserSession, error) {
+	return s.sessionRepo.FindActiveByUserID(ctx, userID)
+}
+
+// RevokeSession 사용자의 세션 하나를 폐기 (다른 기기 로그아웃)
+func (s *authService) RevokeSession(ctx context.Context, userID uint, sessionID string) error {
+	log := logger.FromContext(ctx)
+
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrSessionNotFound
+		}
+		return err
+	}
+	if session.UserID != userID || session.RevokedAt != nil {
+		return ErrSessionNotFound
+	}
+
+	if err := s.sessionRepo.Revoke(ctx, session.ID, model.SessionRevokedByUser); err != nil {
+		return err
+	}
+
+	log.Info("User session revoked", map[string]interface{}{
+		"user_id":    userID,
+		"session_id": sessionID,
+	})
+	return nil
+}
+
+// RevokeOtherSessions 현재 세션을 제외한 모든 세션을 폐기
+func (s *authService) RevokeOtherSessions(ctx context.Context, userID uint, currentSessionID string) (int64, error) {
+	log := logger.FromContext(ctx)
+
+	count, err := s.sessionRepo.RevokeByUserID(ctx, userID, currentSessionID, model.SessionRevokedByUser)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info("Other user sessions revoked", map[string]interface{}{
+		"user_id":            userID,
+		"current_session_id": currentSessionID,
+		"count":              count,
+	})
+	return count, nil
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fakeUserSessionRepository struct {
+	repository.UserSessionRepository
+	sessions map[string]*model.UserSession
+}
+
+func (r *fakeUserSessionRepository) Create(ctx context.Context, session *model.UserSession) error {
+	stored := *session
+	r.sessions[session.ID] = &stored
+	return nil
+}
+
+func (r *fakeUserSessionRepository) FindByID(ctx context.Context, id string) (*model.UserSession, error) {
+	session, ok := r.sessions[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	found := *session
+	return &found, nil
+}
+
+func (r *fakeUserSessionRepository) Rotate(ctx context.Context, session *model.UserSession, currentHash string) (bool, error) {
+	stored, ok := r.sessions[session.ID]
+	if !ok || stored.TokenHash != currentHash || stored.RevokedAt != nil {
+		return false, nil
+	}
+	stored.TokenHash = session.TokenHash
+	stored.LastUsedAt = session.LastUsedAt
+	stored.ExpiresAt = session.ExpiresAt
+	return true, nil
+}
+
+func (r *fakeUserSessionRepository) Revoke(ctx context.Context, id, reason string) error {
+	if session, ok := r.sessions[id]; ok && session.RevokedAt == nil {
+		now := time.Now()
+		session.RevokedAt = &now
+		session.RevokedReason = reason
+	}
+	return nil
+}
+
+func TestAuthService_RotateSession(t *testing.T) {
+	repo := &fakeUserSessionRepository{sessions: map[string]*model.UserSession{}}
+	svc := &authService{
+		sessionRepo:   repo,
+		tokenManager:  util.NewHMACTokenManager("test-jwt-secret"),
+		accessExpiry:  15 * time.Minute,
+		refreshExpiry: 7 * 24 * time.Hour,
+	}
+	user := &model.User{ID: 1, Email: "test@example.com", Role: model.RoleUser}
+	ctx := WithSessionDevice(context.Background(), SessionDevice{Name: "iPhone Safari", IPAddress: "203.0.113.1"})
+
+	first, err := svc.startSession(ctx, user)
+	require.NoError(t, err)
+	claims, err := svc.tokenManager.ValidateRefreshToken(first.RefreshToken)
+	require.NoError(t, err)
+	require.NotEmpty(t, claims.SessionID)
+	require.Contains(t, repo.sessions, claims.SessionID)
+	assert.Equal(t, "iPhone Safari", repo.sessions[claims.SessionID].DeviceName)
+
+	second, err := svc.rotateSession(ctx, user, claims.SessionID, first.RefreshToken)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.RefreshToken, second.RefreshToken)
+	active, err := svc.IsSessionActive(ctx, claims.SessionID)
+	require.NoError(t, err)
+	assert.True(t, active)
+
+	// 회전된 토큰을 다시 쓰면 세션 전체 폐기
+	_, err = svc.rotateSession(ctx, user, claims.SessionID, first.RefreshToken)
+	assert.ErrorIs(t, err, ErrTokenReused)
+	assert.Equal(t, model.SessionRevokedReuseDetected, repo.sessions[claims.SessionID].RevokedReason)
+	active, err = svc.IsSessionActive(ctx, claims.SessionID)
+	require.NoError(t, err)
+	assert.False(t, active)
+
+	// 정상 사용자가 가진 최신 토큰도 더 이상 쓸 수 없음
+	_, err = svc.rotateSession(ctx, user, claims.SessionID, second.RefreshToken)
+	assert.ErrorIs(t, err, ErrTokenRevoked)
+
+	// 다른 사용자의 세션은 폐기할 수 없음
+	other, err := svc.startSession(ctx, &model.User{ID: 2, Email: "other@example.com", Role: model.RoleUser})
+	require.NoError(t, err)
+	otherClaims, err := svc.tokenManager.ValidateAccessToken(other.AccessToken)
+	require.NoError(t, err)
+	assert.ErrorIs(t, svc.RevokeSession(ctx, user.ID, otherClaims.SessionID), ErrSessionNotFound)
+	assert.NoError(t, svc.RevokeSession(ctx, 2, otherClaims.SessionID))
+	assert.Equal(t, model.SessionRevokedByUser, repo.sessions[otherClaims.SessionID].RevokedReason)
+}
@@ -1,52 +1,93 @@
 package service
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
 	"github.com/ikkim/udonggeum-backend/internal/websocket"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
 	"gorm.io/gorm"
 )
 
+var (
+	ErrChatMessageNotFound = errors.New("메시지를 찾을 수 없습니다")
+	ErrChatAccessDenied    = errors.New("채팅방 접근 권한이 없습니다")
+)
+
 type ChatService interface {
 	// ChatRoom operations
-	CreateOrGetChatRoom(user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, bool, error)
-	GetChatRoom(roomID, userID uint) (*model.ChatRoom, error)
-	GetUserChatRooms(userID uint, page, pageSize int) ([]model.ChatRoomWithUnread, int64, error)
-	MarkChatRoomAsRead(roomID, userID uint) error
+	CreateOrGetChatRoom(ctx context.Context, user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, bool, error)
+	GetChatRoom(ctx context.Context, roomID, userID uint) (*model.ChatRoom, error)
+	GetUserChatRooms(ctx context.Context, userID uint, page, pageSize int) ([]model.ChatRoomWithUnread, int64, error)
+	MarkChatRoomAsRead(ctx context.Context, roomID, userID uint, upToSeq uint64) error
+	MarkMessagesDelivered(ctx context.Context, roomID, userID uint, upToSeq uint64) error
 
 	// Message operations
-	SendMessage(roomID, senderID uint, content string, messageType string) (*model.Message, error)
-	SendMessageWithFile(roomID, senderID uint, content string, messageType string, fileURL string, fileName string) (*model.Message, error)
-	GetChatRoomMessages(roomID, userID uint, page, pageSize int) ([]model.Message, int64, error)
-	SearchMessages(userID uint, keyword string, page, pageSize int) ([]model.Message, int64, error)
-	UpdateMessage(messageID, userID uint, content string) (*model.Message, error)
-	DeleteMessage(messageID, userID uint) error
+	SendMessage(ctx context.Context, roomID, senderID uint, content string, messageType string) (*model.Message, error)
+	SendMessageWithFile(ctx context.Context, roomID, senderID uint, content string, messageType string, fileURL string, fileName string) (*model.Message, error)
+	GetChatRoomMessages(ctx context.Context, roomID, userID uint, page, pageSize int) ([]model.Message, int64, error)
+	GetMessagesAfterSeq(ctx context.Context, roomID, userID uint, afterSeq uint64, limit int) ([]model.Message, error)
+	GetMessage(ctx context.Context, roomID, messageID, userID uint) (*model.Message, error)
+	SearchMessages(ctx context.Context, userID uint, keyword string, page, pageSize int) ([]model.Message, int64, error)
+	UpdateMessage(ctx context.Context, messageID, userID uint, content string) (*model.Message, error)
+	DeleteMessage(ctx context.Context, messageID, userID uint) error
 
 	// WebSocket operations
-	JoinChatRoom(userID, roomID uint) error
-	LeaveChatRoom(userID, roomID uint) error
+	JoinChatRoom(ctx context.Context, userID, roomID uint) error
+	LeaveChatRoom(ctx context.Context, userID, roomID uint) error
+	ReplayMessages(ctx context.Context, userID, roomID uint, afterSeq uint64, limit int) ([]interface{}, error)
 }
 
 type chatService struct {
-	db   *gorm.DB
-	repo repository.ChatRepository
-	hub  *websocket.Hub
+	db            *gorm.DB
+	repo          repository.ChatRepository
+	hub           *websocket.Hub
+	uploadService UploadService
 }
 
-func NewChatService(db *gorm.DB, repo repository.ChatRepository, hub *websocket.Hub) ChatService {
+func NewChatService(db *gorm.DB, repo repository.ChatRepository, hub *websocket.Hub, uploadService UploadService) ChatService {
 	return &chatService{
-		db:   db,
-		repo: repo,
-		hub:  hub,
+		db:            db,
+		repo:          repo,
+		hub:           hub,
+		uploadService: uploadService,
+	}
+}
+
+// signFileURLs 응답/실시간 전송할 메시지의 비공개 첨부 파일 URL을 짧은 유효 시간의 URL로 교체
+// 저장된 URL은 바뀌지 않으며, 발급에 실패하면 원래 URL을 그대로 둠 (GetMessageFile로 다시 발급 가능)
+func (s *chatService) signFileURLs(ctx context.Context, messages ...*model.Message) {
+	for _, message := range messages {
+		if message.FileURL == "" {
+			continue
+		}
+		access, err := s.uploadService.AccessURL(message.FileURL)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to sign chat file url", map[string]interface{}{
+				"message_id": message.ID,
+				"error":      err.Error(),
+			})
+			continue
+		}
+		message.FileURL = access.URL
+		message.FileURLExpiresAt = access.ExpiresAt
+	}
+}
+
+// signMessageFileURLs 메시지 목록의 비공개 첨부 파일 URL 교체
+func (s *chatService) signMessageFileURLs(ctx context.Context, messages []model.Message) {
+	for i := range messages {
+		s.signFileURLs(ctx, &messages[i])
 	}
 }
 
 // CreateOrGetChatRoom 채팅방 생성 또는 기존 채팅방 가져오기
-func (s *chatService) CreateOrGetChatRoom(user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, bool, error) {
+func (s *chatService) CreateOrGetChatRoom(ctx context.Context, user1ID, user2ID uint, roomType model.ChatRoomType, resourceID *uint) (*model.ChatRoom, bool, error) {
 	// 기존 채팅방 찾기
-	existingRoom, err := s.repo.FindExistingChatRoom(user1ID, user2ID, roomType, resourceID)
+	existingRoom, err := s.repo.FindExistingChatRoom(ctx, user1ID, user2ID, roomType, resourceID)
 	if err != nil {
 		return nil, false, err
 	}
@@ -65,7 +106,7 @@ func (s *chatService) CreateOrGetChatRoom(user1ID, user2ID uint, roomType model.
 
 		// 재참여가 필요하면 트랜잭션으로 처리
 		if needsRejoin {
-			tx := s.db.Begin()
+			tx := s.db.WithContext(ctx).Begin()
 			if tx.Error != nil {
 				return nil, false, tx.Error
 			}
@@ -112,7 +153,7 @@ func (s *chatService) CreateOrGetChatRoom(user1ID, user2ID uint, roomType model.
 		}
 
 		// 업데이트된 채팅방 정보를 다시 조회하여 반환
-		updatedRoom, err := s.repo.GetChatRoomByIDWithUsers(existingRoom.ID)
+		updatedRoom, err := s.repo.GetChatRoomByIDWithUsers(ctx, existingRoom.ID)
 		if err != nil {
 			return nil, false, err
 		}
@@ -132,12 +173,12 @@ func (s *chatService) CreateOrGetChatRoom(user1ID, user2ID uint, roomType model.
 		newRoom.StoreID = resourceID
 	}
 
-	if err := s.repo.CreateChatRoom(newRoom); err != nil {
+	if err := s.repo.CreateChatRoom(ctx, newRoom); err != nil {
 		return nil, false, err
 	}
 
 	// 생성된 채팅방을 사용자 정보와 함께 다시 조회
-	room, err := s.repo.GetChatRoomByIDWithUsers(newRoom.ID)
+	room, err := s.repo.GetChatRoomByIDWithUsers(ctx, newRoom.ID)
 	if err != nil {
 		return nil, false, err
 	}
@@ -146,8 +187,8 @@ func (s *chatService) CreateOrGetChatRoom(user1ID, user2ID uint, roomType model.
 }
 
 // GetChatRoom 채팅방 조회 (권한 검증 포함)
-func (s *chatService) GetChatRoom(roomID, userID uint) (*model.ChatRoom, error) {
-	room, err := s.repo.GetChatRoomByIDWithUsers(roomID)
+func (s *chatService) GetChatRoom(ctx context.Context, roomID, userID uint) (*model.ChatRoom, error) {
+	room, err := s.repo.GetChatRoomByIDWithUsers(ctx, roomID)
 	if err != nil {
 		return nil, err
 	}
@@ -161,9 +202,9 @@ func (s *chatService) GetChatRoom(roomID, userID uint) (*model.ChatRoom, error)
 }
 
 // GetUserChatRooms 사용자의 채팅방 목록 조회
-func (s *chatService) GetUserChatRooms(userID uint, page, pageSize int) ([]model.ChatRoomWithUnread, int64, error) {
+func (s *chatService) GetUserChatRooms(ctx context.Context, userID uint, page, pageSize int) ([]model.ChatRoomWithUnread, int64, error) {
 	offset := (page - 1) * pageSize
-	rooms, total, err := s.repo.GetUserChatRooms(userID, pageSize, offset)
+	rooms, total, err := s.repo.GetUserChatRooms(ctx, userID, pageSize, offset)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -186,24 +227,36 @@ func (s *chatService) GetUserChatRooms(userID uint, page, pageSize int) ([]model
 	return result, total, nil
 }
 
-// MarkChatRoomAsRead 채팅방을 읽음 처리
-func (s *chatService) MarkChatRoomAsRead(roomID, userID uint) error {
+// MarkChatRoomAsRead 채팅방을 읽음 처리 (upToSeq 이하 메시지만, 0이면 전체)
+func (s *chatService) MarkChatRoomAsRead(ctx context.Context, roomID, userID uint, upToSeq uint64) error {
 	// 권한 검증
-	if _, err := s.GetChatRoom(roomID, userID); err != nil {
+	if _, err := s.GetChatRoom(ctx, roomID, userID); err != nil {
 		return err
 	}
 
 	// 읽지 않은 메시지를 읽음 처리
-	if err := s.repo.MarkMessagesAsRead(roomID, userID); err != nil {
+	messages, err := s.repo.MarkMessagesAsRead(ctx, roomID, userID, upToSeq)
+	if err != nil {
 		return err
 	}
 
-	// 채팅방의 읽지 않은 메시지 수 초기화
-	if err := s.repo.ResetUnreadCount(roomID, userID); err != nil {
-		return err
+	// 채팅방의 읽지 않은 메시지 수 갱신
+	if upToSeq == 0 {
+		if err := s.repo.ResetUnreadCount(ctx, roomID, userID); err != nil {
+			return err
+		}
+	} else {
+		remaining, err := s.repo.GetUnreadMessageCount(ctx, roomID, userID)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.SetUnreadCount(ctx, roomID, userID, remaining); err != nil {
+			return err
+		}
 	}
 
 	// 상대방에게 읽음 이벤트 전송 (WebSocket)
+	// "read"는 기존 클라이언트 호환용, 메시지 단위 상태는 message_read 사용
 	wsMessage := map[string]interface{}{
 		"type":         "read",
 		"chat_room_id": roomID,
@@ -215,13 +268,60 @@ func (s *chatService) MarkChatRoomAsRead(roomID, userID uint) error {
 		// 로깅은 hub 내부에서 처리
 	}
 
+	if len(messages) > 0 {
+		s.sendReceiptEvent(ctx, "message_read", roomID, userID, messages, messages[0].ReadAt)
+	}
+
+	return nil
+}
+
+// MarkMessagesDelivered 수신자 기기에 도착한 메시지를 전달 처리 (upToSeq 이하)
+func (s *chatService) MarkMessagesDelivered(ctx context.Context, roomID, userID uint, upToSeq uint64) error {
+	// 권한 검증
+	if _, err := s.GetChatRoom(ctx, roomID, userID); err != nil {
+		return err
+	}
+
+	messages, err := s.repo.MarkMessagesDelivered(ctx, roomID, userID, upToSeq)
+	if err != nil {
+		return err
+	}
+
+	if len(messages) > 0 {
+		s.sendReceiptEvent(ctx, "message_delivered", roomID, userID, messages, messages[0].DeliveredAt)
+	}
+
 	return nil
 }
 
+// sendReceiptEvent 상대방(발신자)에게 메시지 전달/읽음 이벤트 전송
+func (s *chatService) sendReceiptEvent(ctx context.Context, eventType string, roomID, userID uint, messages []model.Message, at *time.Time) {
+	messageIDs := make([]uint, len(messages))
+	var upToSeq uint64
+	for i, message := range messages {
+		messageIDs[i] = message.ID
+		if message.Seq > upToSeq {
+			upToSeq = message.Seq
+		}
+	}
+
+	wsMessage := map[string]interface{}{
+		"type":         eventType,
+		"chat_room_id": roomID,
+		"user_id":      userID,
+		"message_ids":  messageIDs,
+		"up_to_seq":    upToSeq,
+		"at":           at,
+	}
+	if err := s.hub.SendToRoom(roomID, wsMessage, userID); err != nil {
+		// 로깅은 hub 내부에서 처리
+	}
+}
+
 // SendMessage 메시지 전송
-func (s *chatService) SendMessage(roomID, senderID uint, content string, messageType string) (*model.Message, error) {
+func (s *chatService) SendMessage(ctx context.Context, roomID, senderID uint, content string, messageType string) (*model.Message, error) {
 	// 채팅방 권한 검증
-	room, err := s.GetChatRoom(roomID, senderID)
+	room, err := s.GetChatRoom(ctx, roomID, senderID)
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +344,7 @@ func (s *chatService) SendMessage(roomID, senderID uint, content string, message
 	}
 
 	// 트랜잭션 시작
-	tx := s.db.Begin()
+	tx := s.db.WithContext(ctx).Begin()
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
@@ -255,9 +355,17 @@ func (s *chatService) SendMessage(roomID, senderID uint, content string, message
 		}
 	}()
 
-	// 1. 메시지 생성
+	// 1. 채팅방 메시지 순번 발급
+	seq, err := nextMessageSeq(tx, roomID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 2. 메시지 생성
 	message := &model.Message{
 		ChatRoomID:  roomID,
+		Seq:         seq,
 		SenderID:    senderID,
 		Content:     content,
 		MessageType: messageType,
@@ -269,7 +377,7 @@ func (s *chatService) SendMessage(roomID, senderID uint, content string, message
 		return nil, err
 	}
 
-	// 2. 채팅방의 마지막 메시지 정보 업데이트
+	// 3. 채팅방의 마지막 메시지 정보 업데이트
 	if err := tx.Model(&model.ChatRoom{}).
 		Where("id = ?", roomID).
 		Updates(map[string]interface{}{
@@ -281,7 +389,7 @@ func (s *chatService) SendMessage(roomID, senderID uint, content string, message
 		return nil, err
 	}
 
-	// 3. 수신자의 읽지 않은 메시지 수 증가
+	// 4. 수신자의 읽지 않은 메시지 수 증가
 	if err := tx.Model(&model.ChatRoom{}).
 		Where("id = ?", roomID).
 		UpdateColumn(unreadCountField, gorm.Expr(unreadCountField+" + ?", 1)).Error; err != nil {
@@ -296,7 +404,7 @@ func (s *chatService) SendMessage(roomID, senderID uint, content string, message
 	}
 
 	// 메시지를 다시 조회 (Sender 정보 포함)
-	createdMessage, err := s.repo.GetMessageByID(message.ID)
+	createdMessage, err := s.repo.GetMessageByID(ctx, message.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -313,26 +421,105 @@ func (s *chatService) SendMessage(roomID, senderID uint, content string, message
 	return createdMessage, nil
 }
 
+// nextMessageSeq 채팅방의 다음 메시지 순번 발급
+// 채팅방 행을 먼저 갱신해 트랜잭션이 끝날 때까지 잠그므로 동시 전송에도 순번이 중복되지 않음
+func nextMessageSeq(tx *gorm.DB, roomID uint) (uint64, error) {
+	if err := tx.Model(&model.ChatRoom{}).
+		Where("id = ?", roomID).
+		UpdateColumn("last_seq", gorm.Expr("last_seq + ?", 1)).Error; err != nil {
+		return 0, err
+	}
+
+	var seq uint64
+	if err := tx.Model(&model.ChatRoom{}).
+		Where("id = ?", roomID).
+		Pluck("last_seq", &seq).Error; err != nil {
+		return 0, err
+	}
+	return seq, nil
+}
+
 // GetChatRoomMessages 채팅방의 메시지 목록 조회
-func (s *chatService) GetChatRoomMessages(roomID, userID uint, page, pageSize int) ([]model.Message, int64, error) {
+func (s *chatService) GetChatRoomMessages(ctx context.Context, roomID, userID uint, page, pageSize int) ([]model.Message, int64, error) {
 	// 권한 검증
-	if _, err := s.GetChatRoom(roomID, userID); err != nil {
+	if _, err := s.GetChatRoom(ctx, roomID, userID); err != nil {
 		return nil, 0, err
 	}
 
 	offset := (page - 1) * pageSize
-	return s.repo.GetChatRoomMessages(roomID, pageSize, offset)
+	messages, total, err := s.repo.GetChatRoomMessages(ctx, roomID, pageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.signMessageFileURLs(ctx, messages)
+	return messages, total, nil
+}
+
+// GetMessage 채팅방 참여자에게만 메시지 조회 (첨부 파일 URL 발급용)
+func (s *chatService) GetMessage(ctx context.Context, roomID, messageID, userID uint) (*model.Message, error) {
+	message, err := s.repo.GetMessageByID(ctx, messageID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrChatMessageNotFound
+		}
+		return nil, err
+	}
+	if message.ChatRoomID != roomID {
+		return nil, ErrChatMessageNotFound
+	}
+
+	room, err := s.repo.GetChatRoomByID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if room.User1ID != userID && room.User2ID != userID {
+		return nil, ErrChatAccessDenied
+	}
+
+	return message, nil
+}
+
+// GetMessagesAfterSeq afterSeq 이후 메시지 조회 (재연결 후 누락분 동기화)
+func (s *chatService) GetMessagesAfterSeq(ctx context.Context, roomID, userID uint, afterSeq uint64, limit int) ([]model.Message, error) {
+	// 권한 검증
+	if _, err := s.GetChatRoom(ctx, roomID, userID); err != nil {
+		return nil, err
+	}
+
+	messages, err := s.repo.GetMessagesAfterSeq(ctx, roomID, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	s.signMessageFileURLs(ctx, messages)
+	return messages, nil
+}
+
+// ReplayMessages WebSocket resume 요청 시 놓친 메시지를 실시간 전송과 같은 형식의 이벤트로 반환
+func (s *chatService) ReplayMessages(ctx context.Context, userID, roomID uint, afterSeq uint64, limit int) ([]interface{}, error) {
+	messages, err := s.GetMessagesAfterSeq(ctx, roomID, userID, afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]interface{}, len(messages))
+	for i := range messages {
+		events[i] = map[string]interface{}{
+			"type":    "new_message",
+			"message": &messages[i],
+		}
+	}
+	return events, nil
 }
 
 // JoinChatRoom 채팅방 참여 (WebSocket)
-func (s *chatService) JoinChatRoom(userID, roomID uint) error {
+func (s *chatService) JoinChatRoom(ctx context.Context, userID, roomID uint) error {
 	// 권한 검증
-	if _, err := s.GetChatRoom(roomID, userID); err != nil {
+	if _, err := s.GetChatRoom(ctx, roomID, userID); err != nil {
 		return err
 	}
 
 	// 나간 상태였다면 재입장 처리 (user_left_at을 null로 초기화)
-	if err := s.repo.RejoinChatRoom(roomID, userID); err != nil {
+	if err := s.repo.RejoinChatRoom(ctx, roomID, userID); err != nil {
 		return err
 	}
 
@@ -341,14 +528,14 @@ func (s *chatService) JoinChatRoom(userID, roomID uint) error {
 }
 
 // LeaveChatRoom 채팅방 나가기 (DB에서 나가기 + WebSocket)
-func (s *chatService) LeaveChatRoom(userID, roomID uint) error {
+func (s *chatService) LeaveChatRoom(ctx context.Context, userID, roomID uint) error {
 	// 권한 검증
-	if _, err := s.GetChatRoom(roomID, userID); err != nil {
+	if _, err := s.GetChatRoom(ctx, roomID, userID); err != nil {
 		return err
 	}
 
 	// DB에서 채팅방 나가기 (soft delete)
-	if err := s.repo.LeaveChatRoom(roomID, userID); err != nil {
+	if err := s.repo.LeaveChatRoom(ctx, roomID, userID); err != nil {
 		return err
 	}
 
@@ -356,7 +543,7 @@ func (s *chatService) LeaveChatRoom(userID, roomID uint) error {
 	s.hub.LeaveRoom(userID, roomID)
 
 	// 양쪽 모두 나갔으면 채팅방 삭제
-	if err := s.repo.DeleteChatRoomIfBothLeft(roomID); err != nil {
+	if err := s.repo.DeleteChatRoomIfBothLeft(ctx, roomID); err != nil {
 		// 삭제 실패해도 무시 (중요하지 않음)
 		return nil
 	}
@@ -365,15 +552,20 @@ func (s *chatService) LeaveChatRoom(userID, roomID uint) error {
 }
 
 // SearchMessages 메시지 검색
-func (s *chatService) SearchMessages(userID uint, keyword string, page, pageSize int) ([]model.Message, int64, error) {
+func (s *chatService) SearchMessages(ctx context.Context, userID uint, keyword string, page, pageSize int) ([]model.Message, int64, error) {
 	offset := (page - 1) * pageSize
-	return s.repo.SearchMessages(userID, keyword, pageSize, offset)
+	messages, total, err := s.repo.SearchMessages(ctx, userID, keyword, pageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.signMessageFileURLs(ctx, messages)
+	return messages, total, nil
 }
 
 // SendMessageWithFile 파일이 포함된 메시지 전송
-func (s *chatService) SendMessageWithFile(roomID, senderID uint, content string, messageType string, fileURL string, fileName string) (*model.Message, error) {
+func (s *chatService) SendMessageWithFile(ctx context.Context, roomID, senderID uint, content string, messageType string, fileURL string, fileName string) (*model.Message, error) {
 	// 채팅방 권한 검증
-	room, err := s.GetChatRoom(roomID, senderID)
+	room, err := s.GetChatRoom(ctx, roomID, senderID)
 	if err != nil {
 		return nil, err
 	}
@@ -404,7 +596,7 @@ func (s *chatService) SendMessageWithFile(roomID, senderID uint, content string,
 	}
 
 	// 트랜잭션 시작
-	tx := s.db.Begin()
+	tx := s.db.WithContext(ctx).Begin()
 	if tx.Error != nil {
 		return nil, tx.Error
 	}
@@ -415,9 +607,17 @@ func (s *chatService) SendMessageWithFile(roomID, senderID uint, content string,
 		}
 	}()
 
-	// 1. 메시지 생성
+	// 1. 채팅방 메시지 순번 발급
+	seq, err := nextMessageSeq(tx, roomID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 2. 메시지 생성
 	message := &model.Message{
 		ChatRoomID:  roomID,
+		Seq:         seq,
 		SenderID:    senderID,
 		Content:     content,
 		MessageType: messageType,
@@ -431,7 +631,7 @@ func (s *chatService) SendMessageWithFile(roomID, senderID uint, content string,
 		return nil, err
 	}
 
-	// 2. 채팅방의 마지막 메시지 정보 업데이트
+	// 3. 채팅방의 마지막 메시지 정보 업데이트
 	if err := tx.Model(&model.ChatRoom{}).
 		Where("id = ?", roomID).
 		Updates(map[string]interface{}{
@@ -443,7 +643,7 @@ func (s *chatService) SendMessageWithFile(roomID, senderID uint, content string,
 		return nil, err
 	}
 
-	// 3. 수신자의 읽지 않은 메시지 수 증가
+	// 4. 수신자의 읽지 않은 메시지 수 증가
 	if err := tx.Model(&model.ChatRoom{}).
 		Where("id = ?", roomID).
 		UpdateColumn(unreadCountField, gorm.Expr(unreadCountField+" + ?", 1)).Error; err != nil {
@@ -458,10 +658,11 @@ func (s *chatService) SendMessageWithFile(roomID, senderID uint, content string,
 	}
 
 	// 메시지를 다시 조회 (Sender 정보 포함)
-	createdMessage, err := s.repo.GetMessageByID(message.ID)
+	createdMessage, err := s.repo.GetMessageByID(ctx, message.ID)
 	if err != nil {
 		return nil, err
 	}
+	s.signFileURLs(ctx, createdMessage)
 
 	// WebSocket으로 실시간 전송 (트랜잭션 외부에서 처리)
 	wsMessage := map[string]interface{}{
@@ -476,9 +677,9 @@ func (s *chatService) SendMessageWithFile(roomID, senderID uint, content string,
 }
 
 // UpdateMessage 메시지 수정
-func (s *chatService) UpdateMessage(messageID, userID uint, content string) (*model.Message, error) {
+func (s *chatService) UpdateMessage(ctx context.Context, messageID, userID uint, content string) (*model.Message, error) {
 	// 메시지 조회
-	message, err := s.repo.GetMessageByID(messageID)
+	message, err := s.repo.GetMessageByID(ctx, messageID)
 	if err != nil {
 		return nil, err
 	}
@@ -494,15 +695,16 @@ func (s *chatService) UpdateMessage(messageID, userID uint, content string) (*mo
 	}
 
 	// 메시지 수정
-	if err := s.repo.UpdateMessage(messageID, content); err != nil {
+	if err := s.repo.UpdateMessage(ctx, messageID, content); err != nil {
 		return nil, err
 	}
 
 	// 수정된 메시지 다시 조회
-	updatedMessage, err := s.repo.GetMessageByID(messageID)
+	updatedMessage, err := s.repo.GetMessageByID(ctx, messageID)
 	if err != nil {
 		return nil, err
 	}
+	s.signFileURLs(ctx, updatedMessage)
 
 	// WebSocket으로 실시간 전송
 	wsMessage := map[string]interface{}{
@@ -517,9 +719,9 @@ func (s *chatService) UpdateMessage(messageID, userID uint, content string) (*mo
 }
 
 // DeleteMessage 메시지 삭제
-func (s *chatService) DeleteMessage(messageID, userID uint) error {
+func (s *chatService) DeleteMessage(ctx context.Context, messageID, userID uint) error {
 	// 메시지 조회
-	message, err := s.repo.GetMessageByID(messageID)
+	message, err := s.repo.GetMessageByID(ctx, messageID)
 	if err != nil {
 		return err
 	}
@@ -535,7 +737,7 @@ func (s *chatService) DeleteMessage(messageID, userID uint) error {
 	}
 
 	// 메시지 삭제
-	if err := s.repo.DeleteMessage(messageID, userID); err != nil {
+	if err := s.repo.DeleteMessage(ctx, messageID, userID); err != nil {
 		return err
 	}
 
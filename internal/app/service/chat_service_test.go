@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatService_SignFileURLs(t *testing.T) {
+	ctx := context.Background()
+	svc := &chatService{uploadService: NewUploadService(&fakeStorage{objects: map[string]fakeObject{}}, &fakeUploadRepository{})}
+
+	messages := []model.Message{
+		{ID: 1, MessageType: "FILE", FileURL: fakeStorageURL + "private/chat/room_1/report.pdf"},
+		{ID: 2, MessageType: "IMAGE", FileURL: fakeStorageURL + "chat/legacy.png"},
+		{ID: 3, MessageType: "TEXT"},
+	}
+	svc.signMessageFileURLs(ctx, messages)
+
+	// 비공개 파일은 짧은 유효 시간의 URL로, 비공개 폴더 도입 전 파일은 공개 URL 그대로
+	assert.Equal(t, fakeStorageURL+"private/chat/room_1/report.pdf?signature=test", messages[0].FileURL)
+	assert.NotNil(t, messages[0].FileURLExpiresAt)
+	assert.Equal(t, fakeStorageURL+"chat/legacy.png", messages[1].FileURL)
+	assert.Nil(t, messages[1].FileURLExpiresAt)
+	assert.Empty(t, messages[2].FileURL)
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
@@ -10,63 +11,66 @@ import (
 // CommunityService 커뮤니티 서비스 인터페이스
 type CommunityService interface {
 	// Post operations
-	CreatePost(req *model.CreatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error)
-	GetPost(id uint, userID *uint) (*model.CommunityPost, bool, error) // post, isLiked, error
-	GetPosts(query *model.PostListQuery, userID *uint) ([]model.CommunityPost, int64, error)
-	UpdatePost(id uint, req *model.UpdatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error)
-	DeletePost(id uint, userID uint, userRole model.UserRole) error
+	CreatePost(ctx context.Context, req *model.CreatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error)
+	GetPost(ctx context.Context, id uint, userID *uint) (*model.CommunityPost, bool, error) // post, isLiked, error
+	GetPostByID(ctx context.Context, id uint) (*model.CommunityPost, error)                  // 조회수 증가 없이 조회
+	GetPosts(ctx context.Context, query *model.PostListQuery, userID *uint) ([]model.CommunityPost, int64, error)
+	UpdatePost(ctx context.Context, id uint, req *model.UpdatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error)
+	DeletePost(ctx context.Context, id uint, userID uint, userRole model.UserRole) error
 
 	// Comment operations
-	CreateComment(req *model.CreateCommentRequest, userID uint) (*model.CommunityComment, error)
-	GetComments(query *model.CommentListQuery, userID *uint) ([]model.CommunityComment, int64, error)
-	UpdateComment(id uint, req *model.UpdateCommentRequest, userID uint, userRole model.UserRole) (*model.CommunityComment, error)
-	DeleteComment(id uint, userID uint, userRole model.UserRole) error
+	CreateComment(ctx context.Context, req *model.CreateCommentRequest, userID uint) (*model.CommunityComment, error)
+	GetComments(ctx context.Context, query *model.CommentListQuery, userID *uint) ([]model.CommunityComment, int64, error)
+	UpdateComment(ctx context.Context, id uint, req *model.UpdateCommentRequest, userID uint, userRole model.UserRole) (*model.CommunityComment, error)
+	DeleteComment(ctx context.Context, id uint, userID uint, userRole model.UserRole) error
 
 	// Like operations
-	TogglePostLike(postID, userID uint) (bool, error) // returns new like status
-	ToggleCommentLike(commentID, userID uint) (bool, error)
-	GetUserLikedPosts(userID uint) ([]model.CommunityPost, error)
+	TogglePostLike(ctx context.Context, postID, userID uint) (bool, error) // returns new like status
+	ToggleCommentLike(ctx context.Context, commentID, userID uint) (bool, error)
+	GetUserLikedPosts(ctx context.Context, userID uint) ([]model.CommunityPost, error)
 
 	// QnA operations
-	AcceptAnswer(postID, commentID, userID uint) error
+	AcceptAnswer(ctx context.Context, postID, commentID, userID uint) error
 
 	// Store post management
-	PinPost(postID, userID uint) error
-	UnpinPost(postID, userID uint) error
-	GetStoreGallery(storeID uint, page, pageSize int) ([]map[string]interface{}, int64, error)
+	PinPost(ctx context.Context, postID, userID uint) error
+	UnpinPost(ctx context.Context, postID, userID uint) error
+	GetStoreGallery(ctx context.Context, storeID uint, page, pageSize int) ([]map[string]interface{}, int64, error)
 
 	// Reservation and transaction operations (금거래만)
-	ReservePost(postID, reservedByUserID, authorUserID uint) error     // 예약하기
-	CancelReservation(postID, userID uint) error                       // 예약 취소
-	CompleteTransaction(postID, userID uint) error                     // 거래 완료
+	ReservePost(ctx context.Context, postID, reservedByUserID, authorUserID uint) error     // 예약하기
+	CancelReservation(ctx context.Context, postID, userID uint) error                       // 예약 취소
+	CompleteTransaction(ctx context.Context, postID, userID uint) error                     // 거래 완료
 }
 
 type communityService struct {
 	repo                repository.CommunityRepository
 	userRepo            repository.UserRepository
 	notificationService NotificationService
+	imageService        ImageService
 }
 
 // NewCommunityService 커뮤니티 서비스 생성자
-func NewCommunityService(repo repository.CommunityRepository, userRepo repository.UserRepository, notificationService NotificationService) CommunityService {
+func NewCommunityService(repo repository.CommunityRepository, userRepo repository.UserRepository, notificationService NotificationService, imageService ImageService) CommunityService {
 	return &communityService{
 		repo:                repo,
 		userRepo:            userRepo,
 		notificationService: notificationService,
+		imageService:        imageService,
 	}
 }
 
 // CreatePost 게시글 생성
-func (s *communityService) CreatePost(req *model.CreatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error) {
+func (s *communityService) CreatePost(ctx context.Context, req *model.CreatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error) {
 	// 권한 검증
-	if err := s.validatePostCreation(req, userRole); err != nil {
+	if err := s.validatePostCreation(ctx, req, userRole); err != nil {
 		return nil, err
 	}
 
 	// Admin 사용자의 경우 매장 ID 자동 설정
 	var storeID *uint
 	if userRole == model.RoleAdmin {
-		user, err := s.userRepo.FindByIDWithStores(userID)
+		user, err := s.userRepo.FindByIDWithStores(ctx, userID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find user: %v", err)
 		}
@@ -99,14 +103,16 @@ func (s *communityService) CreatePost(req *model.CreatePostRequest, userID uint,
 		StoreID:   storeID,
 	}
 
-	if err := s.repo.CreatePost(post); err != nil {
+	if err := s.repo.CreatePost(ctx, post); err != nil {
 		return nil, err
 	}
 
 	// 금 판매글인 경우 알림 생성 (비동기로 처리, 실패해도 게시글 생성은 성공)
 	if s.notificationService != nil {
+		// 응답 후에도 취소되지 않도록 요청 컨텍스트에서 분리 (요청 ID 등 값은 유지)
+		notifyCtx := context.WithoutCancel(ctx)
 		go func() {
-			if err := s.notificationService.CreateNewSellPostNotification(post); err != nil {
+			if err := s.notificationService.CreateNewSellPostNotification(notifyCtx, post); err != nil {
 				fmt.Printf("Failed to create notification for new sell post: %v\n", err)
 			}
 		}()
@@ -116,7 +122,7 @@ func (s *communityService) CreatePost(req *model.CreatePostRequest, userID uint,
 }
 
 // validatePostCreation 게시글 생성 권한 검증
-func (s *communityService) validatePostCreation(req *model.CreatePostRequest, userRole model.UserRole) error {
+func (s *communityService) validatePostCreation(ctx context.Context, req *model.CreatePostRequest, userRole model.UserRole) error {
 	// FAQ는 관리자만 작성 가능
 	if req.Type == model.TypeFAQ && userRole != model.RoleAdmin {
 		return fmt.Errorf("FAQ는 관리자만 작성할 수 있습니다")
@@ -133,14 +139,14 @@ func (s *communityService) validatePostCreation(req *model.CreatePostRequest, us
 }
 
 // GetPost 게시글 조회
-func (s *communityService) GetPost(id uint, userID *uint) (*model.CommunityPost, bool, error) {
-	post, err := s.repo.GetPostByID(id, true)
+func (s *communityService) GetPost(ctx context.Context, id uint, userID *uint) (*model.CommunityPost, bool, error) {
+	post, err := s.repo.GetPostByID(ctx, id, true)
 	if err != nil {
 		return nil, false, err
 	}
 
 	// 조회수 증가
-	if err := s.repo.IncrementViewCount(id); err != nil {
+	if err := s.repo.IncrementViewCount(ctx, id); err != nil {
 		// 조회수 증가 실패는 무시
 		fmt.Printf("failed to increment view count: %v\n", err)
 	}
@@ -148,15 +154,20 @@ func (s *communityService) GetPost(id uint, userID *uint) (*model.CommunityPost,
 	// 좋아요 여부 확인
 	var isLiked bool
 	if userID != nil {
-		isLiked, _ = s.repo.IsPostLiked(id, *userID)
+		isLiked, _ = s.repo.IsPostLiked(ctx, id, *userID)
 	}
 
 	return post, isLiked, nil
 }
 
+// GetPostByID 게시글 조회 (조회수 증가 없음)
+func (s *communityService) GetPostByID(ctx context.Context, id uint) (*model.CommunityPost, error) {
+	return s.repo.GetPostByID(ctx, id, false)
+}
+
 // GetPosts 게시글 목록 조회
-func (s *communityService) GetPosts(query *model.PostListQuery, userID *uint) ([]model.CommunityPost, int64, error) {
-	posts, total, err := s.repo.GetPosts(query)
+func (s *communityService) GetPosts(ctx context.Context, query *model.PostListQuery, userID *uint) ([]model.CommunityPost, int64, error) {
+	posts, total, err := s.repo.GetPosts(ctx, query)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -165,8 +176,8 @@ func (s *communityService) GetPosts(query *model.PostListQuery, userID *uint) ([
 }
 
 // UpdatePost 게시글 수정
-func (s *communityService) UpdatePost(id uint, req *model.UpdatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error) {
-	post, err := s.repo.GetPostByID(id, false)
+func (s *communityService) UpdatePost(ctx context.Context, id uint, req *model.UpdatePostRequest, userID uint, userRole model.UserRole) (*model.CommunityPost, error) {
+	post, err := s.repo.GetPostByID(ctx, id, false)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +219,7 @@ func (s *communityService) UpdatePost(id uint, req *model.UpdatePostRequest, use
 		post.ImageURLs = req.ImageURLs
 	}
 
-	if err := s.repo.UpdatePost(post); err != nil {
+	if err := s.repo.UpdatePost(ctx, post); err != nil {
 		return nil, err
 	}
 
@@ -216,8 +227,8 @@ func (s *communityService) UpdatePost(id uint, req *model.UpdatePostRequest, use
 }
 
 // DeletePost 게시글 삭제
-func (s *communityService) DeletePost(id uint, userID uint, userRole model.UserRole) error {
-	post, err := s.repo.GetPostByID(id, false)
+func (s *communityService) DeletePost(ctx context.Context, id uint, userID uint, userRole model.UserRole) error {
+	post, err := s.repo.GetPostByID(ctx, id, false)
 	if err != nil {
 		return err
 	}
@@ -227,20 +238,20 @@ func (s *communityService) DeletePost(id uint, userID uint, userRole model.UserR
 		return fmt.Errorf("권한이 없습니다")
 	}
 
-	return s.repo.DeletePost(id)
+	return s.repo.DeletePost(ctx, id)
 }
 
 // CreateComment 댓글 생성
-func (s *communityService) CreateComment(req *model.CreateCommentRequest, userID uint) (*model.CommunityComment, error) {
+func (s *communityService) CreateComment(ctx context.Context, req *model.CreateCommentRequest, userID uint) (*model.CommunityComment, error) {
 	// 게시글 존재 여부 확인
-	post, err := s.repo.GetPostByID(req.PostID, false)
+	post, err := s.repo.GetPostByID(ctx, req.PostID, false)
 	if err != nil {
 		return nil, fmt.Errorf("게시글을 찾을 수 없습니다")
 	}
 
 	// 부모 댓글 존재 여부 확인 (대댓글인 경우)
 	if req.ParentID != nil {
-		if _, err := s.repo.GetCommentByID(*req.ParentID); err != nil {
+		if _, err := s.repo.GetCommentByID(ctx, *req.ParentID); err != nil {
 			return nil, fmt.Errorf("parent comment not found")
 		}
 	}
@@ -253,14 +264,16 @@ func (s *communityService) CreateComment(req *model.CreateCommentRequest, userID
 		IsAnswer: req.IsAnswer && post.Category == model.CategoryQnA,
 	}
 
-	if err := s.repo.CreateComment(comment); err != nil {
+	if err := s.repo.CreateComment(ctx, comment); err != nil {
 		return nil, err
 	}
 
 	// 댓글 알림 생성 (비동기로 처리, 실패해도 댓글 생성은 성공)
 	if s.notificationService != nil {
+		// 게시글 알림과 마찬가지로 요청 컨텍스트에서 분리
+		notifyCtx := context.WithoutCancel(ctx)
 		go func() {
-			if err := s.notificationService.CreatePostCommentNotification(comment, post); err != nil {
+			if err := s.notificationService.CreatePostCommentNotification(notifyCtx, comment, post); err != nil {
 				fmt.Printf("Failed to create notification for comment: %v\n", err)
 			}
 		}()
@@ -270,13 +283,13 @@ func (s *communityService) CreateComment(req *model.CreateCommentRequest, userID
 }
 
 // GetComments 댓글 목록 조회
-func (s *communityService) GetComments(query *model.CommentListQuery, userID *uint) ([]model.CommunityComment, int64, error) {
-	return s.repo.GetComments(query)
+func (s *communityService) GetComments(ctx context.Context, query *model.CommentListQuery, userID *uint) ([]model.CommunityComment, int64, error) {
+	return s.repo.GetComments(ctx, query)
 }
 
 // UpdateComment 댓글 수정
-func (s *communityService) UpdateComment(id uint, req *model.UpdateCommentRequest, userID uint, userRole model.UserRole) (*model.CommunityComment, error) {
-	comment, err := s.repo.GetCommentByID(id)
+func (s *communityService) UpdateComment(ctx context.Context, id uint, req *model.UpdateCommentRequest, userID uint, userRole model.UserRole) (*model.CommunityComment, error) {
+	comment, err := s.repo.GetCommentByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -290,7 +303,7 @@ func (s *communityService) UpdateComment(id uint, req *model.UpdateCommentReques
 		comment.Content = *req.Content
 	}
 
-	if err := s.repo.UpdateComment(comment); err != nil {
+	if err := s.repo.UpdateComment(ctx, comment); err != nil {
 		return nil, err
 	}
 
@@ -298,8 +311,8 @@ func (s *communityService) UpdateComment(id uint, req *model.UpdateCommentReques
 }
 
 // DeleteComment 댓글 삭제
-func (s *communityService) DeleteComment(id uint, userID uint, userRole model.UserRole) error {
-	comment, err := s.repo.GetCommentByID(id)
+func (s *communityService) DeleteComment(ctx context.Context, id uint, userID uint, userRole model.UserRole) error {
+	comment, err := s.repo.GetCommentByID(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -309,53 +322,53 @@ func (s *communityService) DeleteComment(id uint, userID uint, userRole model.Us
 		return fmt.Errorf("권한이 없습니다")
 	}
 
-	return s.repo.DeleteComment(id)
+	return s.repo.DeleteComment(ctx, id)
 }
 
 // TogglePostLike 게시글 좋아요 토글
-func (s *communityService) TogglePostLike(postID, userID uint) (bool, error) {
-	isLiked, err := s.repo.IsPostLiked(postID, userID)
+func (s *communityService) TogglePostLike(ctx context.Context, postID, userID uint) (bool, error) {
+	isLiked, err := s.repo.IsPostLiked(ctx, postID, userID)
 	if err != nil {
 		return false, err
 	}
 
 	if isLiked {
-		if err := s.repo.UnlikePost(postID, userID); err != nil {
+		if err := s.repo.UnlikePost(ctx, postID, userID); err != nil {
 			return false, err
 		}
 		return false, nil
 	}
 
-	if err := s.repo.LikePost(postID, userID); err != nil {
+	if err := s.repo.LikePost(ctx, postID, userID); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
 // ToggleCommentLike 댓글 좋아요 토글
-func (s *communityService) ToggleCommentLike(commentID, userID uint) (bool, error) {
-	isLiked, err := s.repo.IsCommentLiked(commentID, userID)
+func (s *communityService) ToggleCommentLike(ctx context.Context, commentID, userID uint) (bool, error) {
+	isLiked, err := s.repo.IsCommentLiked(ctx, commentID, userID)
 	if err != nil {
 		return false, err
 	}
 
 	if isLiked {
-		if err := s.repo.UnlikeComment(commentID, userID); err != nil {
+		if err := s.repo.UnlikeComment(ctx, commentID, userID); err != nil {
 			return false, err
 		}
 		return false, nil
 	}
 
-	if err := s.repo.LikeComment(commentID, userID); err != nil {
+	if err := s.repo.LikeComment(ctx, commentID, userID); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
 // AcceptAnswer QnA 답변 채택
-func (s *communityService) AcceptAnswer(postID, commentID, userID uint) error {
+func (s *communityService) AcceptAnswer(ctx context.Context, postID, commentID, userID uint) error {
 	// 게시글 조회
-	post, err := s.repo.GetPostByID(postID, false)
+	post, err := s.repo.GetPostByID(ctx, postID, false)
 	if err != nil {
 		return err
 	}
@@ -376,7 +389,7 @@ func (s *communityService) AcceptAnswer(postID, commentID, userID uint) error {
 	}
 
 	// 댓글이 해당 게시글에 속하는지 확인
-	comment, err := s.repo.GetCommentByID(commentID)
+	comment, err := s.repo.GetCommentByID(ctx, commentID)
 	if err != nil {
 		return err
 	}
@@ -384,13 +397,13 @@ func (s *communityService) AcceptAnswer(postID, commentID, userID uint) error {
 		return fmt.Errorf("comment does not belong to this post")
 	}
 
-	return s.repo.AcceptAnswer(postID, commentID)
+	return s.repo.AcceptAnswer(ctx, postID, commentID)
 }
 
 // PinPost 게시글 고정
-func (s *communityService) PinPost(postID, userID uint) error {
+func (s *communityService) PinPost(ctx context.Context, postID, userID uint) error {
 	// 게시글 조회
-	post, err := s.repo.GetPostByID(postID, false)
+	post, err := s.repo.GetPostByID(ctx, postID, false)
 	if err != nil {
 		return err
 	}
@@ -405,13 +418,13 @@ func (s *communityService) PinPost(postID, userID uint) error {
 		return fmt.Errorf("only store owner can pin posts")
 	}
 
-	return s.repo.UpdatePostPin(postID, true)
+	return s.repo.UpdatePostPin(ctx, postID, true)
 }
 
 // UnpinPost 게시글 고정 해제
-func (s *communityService) UnpinPost(postID, userID uint) error {
+func (s *communityService) UnpinPost(ctx context.Context, postID, userID uint) error {
 	// 게시글 조회
-	post, err := s.repo.GetPostByID(postID, false)
+	post, err := s.repo.GetPostByID(ctx, postID, false)
 	if err != nil {
 		return err
 	}
@@ -426,17 +439,24 @@ func (s *communityService) UnpinPost(postID, userID uint) error {
 		return fmt.Errorf("only store owner can unpin posts")
 	}
 
-	return s.repo.UpdatePostPin(postID, false)
+	return s.repo.UpdatePostPin(ctx, postID, false)
 }
 
 // GetStoreGallery 매장 갤러리 조회
-func (s *communityService) GetStoreGallery(storeID uint, page, pageSize int) ([]map[string]interface{}, int64, error) {
+func (s *communityService) GetStoreGallery(ctx context.Context, storeID uint, page, pageSize int) ([]map[string]interface{}, int64, error) {
 	offset := (page - 1) * pageSize
-	posts, total, err := s.repo.GetPostsWithImages(storeID, pageSize, offset)
+	posts, total, err := s.repo.GetPostsWithImages(ctx, storeID, pageSize, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	// 썸네일이 있으면 썸네일로 제공
+	var imageURLs []string
+	for _, post := range posts {
+		imageURLs = append(imageURLs, post.ImageURLs...)
+	}
+	thumbnails := s.imageService.ThumbnailURLs(ctx, imageURLs)
+
 	// 갤러리 형식으로 변환
 	gallery := make([]map[string]interface{}, 0)
 	for _, post := range posts {
@@ -446,11 +466,12 @@ func (s *communityService) GetStoreGallery(storeID uint, page, pageSize int) ([]
 
 		for _, imageURL := range post.ImageURLs {
 			gallery = append(gallery, map[string]interface{}{
-				"post_id":    post.ID,
-				"image_url":  imageURL,
-				"title":      post.Title,
-				"content":    truncateText(post.Content, 100),
-				"created_at": post.CreatedAt,
+				"post_id":       post.ID,
+				"image_url":     imageURL,
+				"thumbnail_url": thumbnails[imageURL],
+				"title":         post.Title,
+				"content":       truncateText(post.Content, 100),
+				"created_at":    post.CreatedAt,
 			})
 		}
 	}
@@ -467,9 +488,9 @@ func truncateText(text string, maxLen int) string {
 }
 
 // ReservePost 금거래 게시글 예약하기
-func (s *communityService) ReservePost(postID, reservedByUserID, authorUserID uint) error {
+func (s *communityService) ReservePost(ctx context.Context, postID, reservedByUserID, authorUserID uint) error {
 	// 게시글 조회
-	post, err := s.repo.GetPostByID(postID, false)
+	post, err := s.repo.GetPostByID(ctx, postID, false)
 	if err != nil {
 		return fmt.Errorf("failed to get post: %v", err)
 	}
@@ -500,7 +521,7 @@ func (s *communityService) ReservePost(postID, reservedByUserID, authorUserID ui
 	}
 
 	// 예약 처리
-	if err := s.repo.ReservePost(postID, reservedByUserID); err != nil {
+	if err := s.repo.ReservePost(ctx, postID, reservedByUserID); err != nil {
 		return fmt.Errorf("failed to reserve post: %v", err)
 	}
 
@@ -508,9 +529,9 @@ func (s *communityService) ReservePost(postID, reservedByUserID, authorUserID ui
 }
 
 // CancelReservation 예약 취소
-func (s *communityService) CancelReservation(postID, userID uint) error {
+func (s *communityService) CancelReservation(ctx context.Context, postID, userID uint) error {
 	// 게시글 조회
-	post, err := s.repo.GetPostByID(postID, false)
+	post, err := s.repo.GetPostByID(ctx, postID, false)
 	if err != nil {
 		return fmt.Errorf("failed to get post: %v", err)
 	}
@@ -530,8 +551,13 @@ func (s *communityService) CancelReservation(postID, userID uint) error {
 		return fmt.Errorf("post is not reserved")
 	}
 
+	// 안전거래로 진행 중인 예약은 에스크로 절차로만 처리
+	if err := s.ensureNoActiveEscrow(ctx, postID); err != nil {
+		return err
+	}
+
 	// 예약 취소 처리
-	if err := s.repo.CancelReservation(postID); err != nil {
+	if err := s.repo.CancelReservation(ctx, postID); err != nil {
 		return fmt.Errorf("failed to cancel reservation: %v", err)
 	}
 
@@ -539,9 +565,9 @@ func (s *communityService) CancelReservation(postID, userID uint) error {
 }
 
 // CompleteTransaction 거래 완료
-func (s *communityService) CompleteTransaction(postID, userID uint) error {
+func (s *communityService) CompleteTransaction(ctx context.Context, postID, userID uint) error {
 	// 게시글 조회
-	post, err := s.repo.GetPostByID(postID, false)
+	post, err := s.repo.GetPostByID(ctx, postID, false)
 	if err != nil {
 		return fmt.Errorf("failed to get post: %v", err)
 	}
@@ -561,14 +587,31 @@ func (s *communityService) CompleteTransaction(postID, userID uint) error {
 		return fmt.Errorf("post is already completed")
 	}
 
+	// 안전거래로 진행 중인 예약은 에스크로 절차로만 처리
+	if err := s.ensureNoActiveEscrow(ctx, postID); err != nil {
+		return err
+	}
+
 	// 거래 완료 처리
-	if err := s.repo.CompleteTransaction(postID); err != nil {
+	if err := s.repo.CompleteTransaction(ctx, postID); err != nil {
 		return fmt.Errorf("failed to complete transaction: %v", err)
 	}
 
 	return nil
 }
 
-func (s *communityService) GetUserLikedPosts(userID uint) ([]model.CommunityPost, error) {
-	return s.repo.GetUserLikedPosts(userID)
+// ensureNoActiveEscrow 진행 중인 안전거래가 있으면 에러
+func (s *communityService) ensureNoActiveEscrow(ctx context.Context, postID uint) error {
+	active, err := s.repo.HasActiveEscrow(ctx, postID)
+	if err != nil {
+		return fmt.Errorf("failed to check escrow: %v", err)
+	}
+	if active {
+		return fmt.Errorf("post is under escrow; use the escrow endpoints")
+	}
+	return nil
+}
+
+func (s *communityService) GetUserLikedPosts(ctx context.Context, userID uint) ([]model.CommunityPost, error) {
+	return s.repo.GetUserLikedPosts(ctx, userID)
 }
@@ -0,0 +1,506 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/config"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrEscrowNotFound        = errors.New("안전거래 정보를 찾을 수 없습니다")
+	ErrEscrowAccessDenied    = errors.New("안전거래 접근 권한이 없습니다")
+	ErrEscrowInvalidState    = errors.New("현재 안전거래 상태에서는 처리할 수 없습니다")
+	ErrEscrowInvalidPost     = errors.New("안전거래를 진행할 수 없는 게시글입니다")
+	ErrEscrowPostUnavailable = errors.New("이미 예약되었거나 거래가 완료된 게시글입니다")
+)
+
+const (
+	// expiredEscrowBatchSize 한 번의 만료 처리에서 다루는 최대 건수
+	expiredEscrowBatchSize = 100
+	// refundRetryDelay 환불 진행 중 상태로 남은 건(서버 중단 등)을 만료 처리에서 다시 환불하기까지의 대기 시간
+	refundRetryDelay = 10 * time.Minute
+)
+
+// EscrowService 금 판매글 안전거래 서비스
+//
+// 상태 흐름:
+//
+//	awaiting_payment --결제 승인--> held --판매자 인계--> handed_over --구매자 수령--> released
+//	awaiting_payment --결제 실패/취소/기한 초과--> cancelled
+//	held --취소/인계 기한 초과--> refunding --환불 완료--> refunded
+//	handed_over --판매자·마스터 취소--> refunding --환불 완료--> refunded
+//	refunding --환불 실패--> held/handed_over (원래 상태로 복구)
+//	handed_over --수령 확인 기한 초과--> released
+type EscrowService interface {
+	PaymentListener
+
+	// Start 구매자가 안전거래를 시작 (결제 준비 + 게시글 예약)
+	Start(ctx context.Context, postID, buyerID uint, req *model.CreateEscrowRequest) (*model.EscrowReadyResponse, error)
+	// ConfirmHandover 판매자가 금 인계를 확인
+	ConfirmHandover(ctx context.Context, postID, userID uint) (*model.Escrow, error)
+	// ConfirmReceipt 구매자가 수령을 확인하고 대금을 지급
+	ConfirmReceipt(ctx context.Context, postID, userID uint) (*model.Escrow, error)
+	// Cancel 안전거래 취소 (결제 완료 건은 환불)
+	Cancel(ctx context.Context, postID, userID uint, userRole model.UserRole, reason string) (*model.Escrow, error)
+	// GetByPostID 게시글의 최근 안전거래 조회 (구매자, 판매자, 마스터만 가능)
+	GetByPostID(ctx context.Context, postID, userID uint, userRole model.UserRole) (*model.Escrow, error)
+	// ProcessExpired 기한이 지난 안전거래를 자동 취소/환불/지급 처리하고 처리 건수를 반환
+	ProcessExpired(ctx context.Context) (int, error)
+}
+
+type escrowService struct {
+	repo           repository.EscrowRepository
+	communityRepo  repository.CommunityRepository
+	paymentService PaymentService
+	cfg            config.EscrowConfig
+}
+
+// NewEscrowService 안전거래 서비스 생성자
+// 결제 승인/종료를 전달받을 수 있도록 paymentService에 수신자로 등록됨
+func NewEscrowService(
+	repo repository.EscrowRepository,
+	communityRepo repository.CommunityRepository,
+	paymentService PaymentService,
+	cfg config.EscrowConfig,
+) EscrowService {
+	s := &escrowService{
+		repo:           repo,
+		communityRepo:  communityRepo,
+		paymentService: paymentService,
+		cfg:            cfg,
+	}
+	paymentService.AddListener(s)
+	return s
+}
+
+// Start 안전거래 시작
+func (s *escrowService) Start(ctx context.Context, postID, buyerID uint, req *model.CreateEscrowRequest) (*model.EscrowReadyResponse, error) {
+	log := logger.FromContext(ctx)
+
+	post, err := s.communityRepo.GetPostByID(ctx, postID, false)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEscrowInvalidPost
+		}
+		return nil, fmt.Errorf("failed to get post: %w", err)
+	}
+
+	// 금 판매글만 안전거래 가능, 본인 게시글 불가
+	if post.Type != model.TypeSellGold || post.UserID == buyerID {
+		return nil, ErrEscrowInvalidPost
+	}
+	if post.ReservationStatus != nil {
+		return nil, ErrEscrowPostUnavailable
+	}
+	if _, err := s.repo.FindActiveByPostID(ctx, postID); err == nil {
+		return nil, ErrEscrowPostUnavailable
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to check escrow: %w", err)
+	}
+
+	ready, err := s.paymentService.Ready(ctx, buyerID, &model.CreatePaymentRequest{
+		ItemName:    escrowItemName(post.Title),
+		TotalAmount: req.Amount,
+		PostID:      &postID,
+		Escrow:      true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(s.cfg.PaymentTimeout)
+	escrow := &model.Escrow{
+		PostID:    postID,
+		SellerID:  post.UserID,
+		BuyerID:   buyerID,
+		PaymentID: ready.Payment.ID,
+		Amount:    req.Amount,
+		Status:    model.EscrowStatusAwaitingPayment,
+		ExpiresAt: &expiresAt,
+	}
+	event := &model.EscrowEvent{
+		ToStatus: model.EscrowStatusAwaitingPayment,
+		ActorID:  &buyerID,
+		Reason:   "안전거래 시작",
+	}
+
+	// 동시에 다른 구매자가 시작한 경우 진행 중 에스크로 유니크 인덱스에 막힘
+	if err := s.repo.Create(ctx, escrow, event); err != nil {
+		if _, abortErr := s.paymentService.Abort(ctx, ready.Payment.OrderID); abortErr != nil {
+			log.Error("Failed to abort payment for rejected escrow", abortErr, map[string]interface{}{
+				"payment_id": ready.Payment.ID,
+			})
+		}
+		if _, findErr := s.repo.FindActiveByPostID(ctx, postID); findErr == nil {
+			return nil, ErrEscrowPostUnavailable
+		}
+		return nil, fmt.Errorf("failed to create escrow: %w", err)
+	}
+
+	if err := s.communityRepo.ReservePost(ctx, postID, buyerID); err != nil {
+		return nil, fmt.Errorf("failed to reserve post: %w", err)
+	}
+
+	log.Info("Escrow started", map[string]interface{}{
+		"escrow_id":  escrow.ID,
+		"post_id":    postID,
+		"buyer_id":   buyerID,
+		"payment_id": escrow.PaymentID,
+		"amount":     escrow.Amount,
+	})
+
+	created, err := s.repo.FindByID(ctx, escrow.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.EscrowReadyResponse{Escrow: created, Payment: ready}, nil
+}
+
+// ConfirmHandover 판매자 인계 확인
+func (s *escrowService) ConfirmHandover(ctx context.Context, postID, userID uint) (*model.Escrow, error) {
+	escrow, err := s.findActive(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if escrow.SellerID != userID {
+		return nil, ErrEscrowAccessDenied
+	}
+	if escrow.Status != model.EscrowStatusHeld {
+		return nil, ErrEscrowInvalidState
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.cfg.ReceiptTimeout)
+	return s.transition(ctx, escrow, model.EscrowStatusHandedOver, map[string]interface{}{
+		"handed_over_at": now,
+		"expires_at":     expiresAt,
+	}, &userID, "판매자 인계 확인")
+}
+
+// ConfirmReceipt 구매자 수령 확인 → 대금 지급
+func (s *escrowService) ConfirmReceipt(ctx context.Context, postID, userID uint) (*model.Escrow, error) {
+	escrow, err := s.findActive(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if escrow.BuyerID != userID {
+		return nil, ErrEscrowAccessDenied
+	}
+	if escrow.Status != model.EscrowStatusHandedOver {
+		return nil, ErrEscrowInvalidState
+	}
+
+	return s.release(ctx, escrow, &userID, "구매자 수령 확인")
+}
+
+// Cancel 안전거래 취소
+// 결제 전에는 구매자·판매자 모두 취소 가능, 인계 후에는 구매자가 일방적으로 환불받을 수 없음
+func (s *escrowService) Cancel(ctx context.Context, postID, userID uint, userRole model.UserRole, reason string) (*model.Escrow, error) {
+	escrow, err := s.findActive(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	isMaster := userRole == model.RoleMaster
+	if escrow.BuyerID != userID && escrow.SellerID != userID && !isMaster {
+		return nil, ErrEscrowAccessDenied
+	}
+	if escrow.Status == model.EscrowStatusHandedOver && escrow.SellerID != userID && !isMaster {
+		return nil, ErrEscrowInvalidState
+	}
+
+	if reason == "" {
+		reason = "사용자 취소"
+	}
+	return s.cancel(ctx, escrow, &userID, reason)
+}
+
+// GetByPostID 게시글의 최근 안전거래 조회
+func (s *escrowService) GetByPostID(ctx context.Context, postID, userID uint, userRole model.UserRole) (*model.Escrow, error) {
+	escrow, err := s.repo.FindLatestByPostID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEscrowNotFound
+		}
+		return nil, err
+	}
+
+	if escrow.BuyerID != userID && escrow.SellerID != userID && userRole != model.RoleMaster {
+		return nil, ErrEscrowAccessDenied
+	}
+	return escrow, nil
+}
+
+// ProcessExpired 기한 초과 안전거래 처리
+func (s *escrowService) ProcessExpired(ctx context.Context) (int, error) {
+	escrows, err := s.repo.FindExpired(ctx, time.Now(), expiredEscrowBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expired escrows: %w", err)
+	}
+
+	processed := 0
+	for i := range escrows {
+		escrow := &escrows[i]
+
+		var err error
+		switch escrow.Status {
+		case model.EscrowStatusAwaitingPayment:
+			_, err = s.cancel(ctx, escrow, nil, "결제 기한 초과")
+		case model.EscrowStatusHeld:
+			_, err = s.cancel(ctx, escrow, nil, "판매자 인계 기한 초과")
+		case model.EscrowStatusHandedOver:
+			// 판매자는 이미 금을 넘겼으므로 구매자가 응답하지 않으면 대금을 지급
+			_, err = s.release(ctx, escrow, nil, "구매자 수령 확인 기한 초과")
+		case model.EscrowStatusRefunding:
+			// 환불 도중 서버가 중단되어 남은 건은 환불을 다시 시도
+			_, err = s.finishRefund(ctx, escrow, nil, "환불 재시도")
+		}
+		if err != nil {
+			// 다른 요청이 먼저 상태를 바꾼 경우는 정상
+			if !errors.Is(err, ErrEscrowInvalidState) {
+				logger.FromContext(ctx).Error("Failed to process expired escrow", err, map[string]interface{}{
+					"escrow_id": escrow.ID,
+					"status":    escrow.Status,
+				})
+			}
+			continue
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// OnPaymentApproved 결제 승인 → 대금 보관 상태로 전환
+func (s *escrowService) OnPaymentApproved(ctx context.Context, payment *model.Payment) {
+	log := logger.FromContext(ctx)
+
+	if !payment.Escrow {
+		return
+	}
+
+	escrow, err := s.repo.FindByPaymentID(ctx, payment.ID)
+	if err != nil {
+		log.Error("Failed to find escrow for approved payment", err, map[string]interface{}{
+			"payment_id": payment.ID,
+		})
+		return
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.cfg.HandoverTimeout)
+	_, err = s.transition(ctx, escrow, model.EscrowStatusHeld, map[string]interface{}{
+		"paid_at":    now,
+		"expires_at": expiresAt,
+	}, nil, "결제 승인")
+	if err == nil {
+		return
+	}
+
+	// 결제 기한 초과 등으로 이미 취소된 거래에 승인이 늦게 도착하면 즉시 환불
+	if errors.Is(err, ErrEscrowInvalidState) {
+		log.Warn("Payment approved for closed escrow, refunding", map[string]interface{}{
+			"escrow_id":  escrow.ID,
+			"payment_id": payment.ID,
+		})
+		if _, refundErr := s.paymentService.Refund(ctx, payment.ID); refundErr != nil {
+			log.Error("Failed to refund late escrow payment", refundErr, map[string]interface{}{
+				"payment_id": payment.ID,
+			})
+		}
+		return
+	}
+	log.Error("Failed to hold escrow after payment approval", err, map[string]interface{}{
+		"escrow_id": escrow.ID,
+	})
+}
+
+// OnPaymentClosed 결제 전 실패/취소 → 안전거래 취소
+// 대금 보관 이후의 환불은 이 서비스가 직접 요청하므로 결제 대기 상태만 처리
+func (s *escrowService) OnPaymentClosed(ctx context.Context, payment *model.Payment) {
+	log := logger.FromContext(ctx)
+
+	if !payment.Escrow {
+		return
+	}
+
+	escrow, err := s.repo.FindByPaymentID(ctx, payment.ID)
+	if err != nil {
+		log.Error("Failed to find escrow for closed payment", err, map[string]interface{}{
+			"payment_id": payment.ID,
+		})
+		return
+	}
+	if escrow.Status != model.EscrowStatusAwaitingPayment {
+		return
+	}
+
+	reason := "결제 취소"
+	if payment.Status == model.PaymentStatusFailed {
+		reason = "결제 실패"
+	}
+	if _, err := s.closeEscrow(ctx, escrow, model.EscrowStatusCancelled, nil, reason); err != nil && !errors.Is(err, ErrEscrowInvalidState) {
+		log.Error("Failed to cancel escrow after payment closed", err, map[string]interface{}{
+			"escrow_id": escrow.ID,
+		})
+	}
+}
+
+// cancel 결제 대기 건은 취소, 대금 보관 건은 환불 후 종료
+func (s *escrowService) cancel(ctx context.Context, escrow *model.Escrow, actorID *uint, reason string) (*model.Escrow, error) {
+	switch escrow.Status {
+	case model.EscrowStatusAwaitingPayment:
+		// 에스크로를 먼저 닫아 두면 뒤늦은 승인 콜백이 와도 환불 처리됨
+		closed, err := s.closeEscrow(ctx, escrow, model.EscrowStatusCancelled, actorID, reason)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.paymentService.Refund(ctx, escrow.PaymentID); err != nil && !errors.Is(err, ErrPaymentInvalidState) {
+			logger.FromContext(ctx).Error("Failed to cancel escrow payment", err, map[string]interface{}{
+				"escrow_id":  escrow.ID,
+				"payment_id": escrow.PaymentID,
+			})
+		}
+		return closed, nil
+	case model.EscrowStatusHeld, model.EscrowStatusHandedOver:
+		// 환불 전에 환불 진행 중으로 선점해 두어야 동시에 도착한 인계 확인이나 대금 지급과 겹치지 않음
+		from := escrow.Status
+		var restoreExpiresAt interface{}
+		if escrow.ExpiresAt != nil {
+			restoreExpiresAt = *escrow.ExpiresAt
+		}
+		claimed, err := s.transition(ctx, escrow, model.EscrowStatusRefunding, map[string]interface{}{
+			"expires_at": time.Now().Add(refundRetryDelay),
+		}, actorID, reason)
+		if err != nil {
+			return nil, err
+		}
+
+		refunded, err := s.finishRefund(ctx, claimed, actorID, reason)
+		if err == nil {
+			return refunded, nil
+		}
+
+		// 환불이 실패하면 선점을 풀어 원래 상태와 기한으로 되돌림
+		if _, restoreErr := s.transition(ctx, claimed, from, map[string]interface{}{
+			"expires_at": restoreExpiresAt,
+		}, actorID, "환불 실패"); restoreErr != nil {
+			logger.FromContext(ctx).Error("Failed to restore escrow after refund failure", restoreErr, map[string]interface{}{
+				"escrow_id": escrow.ID,
+				"status":    from,
+			})
+		}
+		return nil, err
+	default:
+		return nil, ErrEscrowInvalidState
+	}
+}
+
+// finishRefund 환불 진행 중으로 선점한 건의 결제를 환불하고 환불 완료로 종료
+func (s *escrowService) finishRefund(ctx context.Context, escrow *model.Escrow, actorID *uint, reason string) (*model.Escrow, error) {
+	// 이전 시도에서 환불은 끝났지만 상태 변경 전에 중단된 경우 결제는 이미 취소 상태
+	if _, err := s.paymentService.Refund(ctx, escrow.PaymentID); err != nil && !errors.Is(err, ErrPaymentInvalidState) {
+		return nil, err
+	}
+	return s.closeEscrow(ctx, escrow, model.EscrowStatusRefunded, actorID, reason)
+}
+
+// closeEscrow 취소/환불로 종료하고 게시글 예약을 해제
+func (s *escrowService) closeEscrow(ctx context.Context, escrow *model.Escrow, to model.EscrowStatus, actorID *uint, reason string) (*model.Escrow, error) {
+	closed, err := s.transition(ctx, escrow, to, map[string]interface{}{
+		"cancelled_at":  time.Now(),
+		"cancel_reason": reason,
+		"expires_at":    nil,
+	}, actorID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.communityRepo.CancelReservation(ctx, escrow.PostID); err != nil {
+		logger.FromContext(ctx).Error("Failed to release post reservation", err, map[string]interface{}{
+			"escrow_id": escrow.ID,
+			"post_id":   escrow.PostID,
+		})
+	}
+	return closed, nil
+}
+
+// release 대금 지급 및 게시글 거래 완료 처리
+func (s *escrowService) release(ctx context.Context, escrow *model.Escrow, actorID *uint, reason string) (*model.Escrow, error) {
+	log := logger.FromContext(ctx)
+
+	released, err := s.transition(ctx, escrow, model.EscrowStatusReleased, map[string]interface{}{
+		"released_at": time.Now(),
+		"expires_at":  nil,
+	}, actorID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.communityRepo.CompleteTransaction(ctx, escrow.PostID); err != nil {
+		log.Error("Failed to complete post transaction", err, map[string]interface{}{
+			"escrow_id": escrow.ID,
+			"post_id":   escrow.PostID,
+		})
+	}
+
+	log.Info("Escrow released", map[string]interface{}{
+		"escrow_id": escrow.ID,
+		"seller_id": escrow.SellerID,
+		"amount":    escrow.Amount,
+	})
+	return released, nil
+}
+
+// transition 현재 상태를 기준으로 상태를 변경하고 이력을 남김
+func (s *escrowService) transition(ctx context.Context, escrow *model.Escrow, to model.EscrowStatus, updates map[string]interface{}, actorID *uint, reason string) (*model.Escrow, error) {
+	updates["status"] = to
+	ok, err := s.repo.Transition(ctx, escrow.ID, escrow.Status, updates, &model.EscrowEvent{
+		FromStatus: escrow.Status,
+		ToStatus:   to,
+		ActorID:    actorID,
+		Reason:     reason,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update escrow: %w", err)
+	}
+	if !ok {
+		return nil, ErrEscrowInvalidState
+	}
+
+	logger.FromContext(ctx).Info("Escrow status changed", map[string]interface{}{
+		"escrow_id": escrow.ID,
+		"from":      escrow.Status,
+		"to":        to,
+		"reason":    reason,
+	})
+
+	return s.repo.FindByID(ctx, escrow.ID)
+}
+
+func (s *escrowService) findActive(ctx context.Context, postID uint) (*model.Escrow, error) {
+	escrow, err := s.repo.FindActiveByPostID(ctx, postID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEscrowNotFound
+		}
+		return nil, err
+	}
+	return escrow, nil
+}
+
+// escrowItemName 결제 상품명 (카카오페이 상품명 최대 100자)
+func escrowItemName(title string) string {
+	name := []rune("금 안전거래 - " + title)
+	if len(name) > 100 {
+		name = name[:100]
+	}
+	return string(name)
+}
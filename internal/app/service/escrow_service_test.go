@@ -0,0 +1,388 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/config"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// fakeEscrowRepository 메모리 기반 안전거래 저장소 (테스트용)
+type fakeEscrowRepository struct {
+	mu      sync.Mutex
+	escrows map[uint]*model.Escrow
+	events  []model.EscrowEvent
+	nextID  uint
+}
+
+func newFakeEscrowRepository() *fakeEscrowRepository {
+	return &fakeEscrowRepository{escrows: make(map[uint]*model.Escrow)}
+}
+
+func (r *fakeEscrowRepository) Create(ctx context.Context, escrow *model.Escrow, event *model.EscrowEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	escrow.ID = r.nextID
+	copied := *escrow
+	r.escrows[escrow.ID] = &copied
+	event.EscrowID = escrow.ID
+	r.events = append(r.events, *event)
+	return nil
+}
+
+func (r *fakeEscrowRepository) find(match func(*model.Escrow) bool) (*model.Escrow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, escrow := range r.escrows {
+		if match(escrow) {
+			copied := *escrow
+			for _, event := range r.events {
+				if event.EscrowID == escrow.ID {
+					copied.Events = append(copied.Events, event)
+				}
+			}
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeEscrowRepository) FindByID(ctx context.Context, id uint) (*model.Escrow, error) {
+	return r.find(func(e *model.Escrow) bool { return e.ID == id })
+}
+
+func (r *fakeEscrowRepository) FindByPaymentID(ctx context.Context, paymentID uint) (*model.Escrow, error) {
+	return r.find(func(e *model.Escrow) bool { return e.PaymentID == paymentID })
+}
+
+func (r *fakeEscrowRepository) FindLatestByPostID(ctx context.Context, postID uint) (*model.Escrow, error) {
+	return r.find(func(e *model.Escrow) bool { return e.PostID == postID })
+}
+
+func (r *fakeEscrowRepository) FindActiveByPostID(ctx context.Context, postID uint) (*model.Escrow, error) {
+	return r.find(func(e *model.Escrow) bool { return e.PostID == postID && e.Status.IsActive() })
+}
+
+func (r *fakeEscrowRepository) FindExpired(ctx context.Context, now time.Time, limit int) ([]model.Escrow, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var escrows []model.Escrow
+	for _, escrow := range r.escrows {
+		if escrow.Status.IsActive() && escrow.ExpiresAt != nil && !escrow.ExpiresAt.After(now) {
+			escrows = append(escrows, *escrow)
+		}
+	}
+	return escrows, nil
+}
+
+func (r *fakeEscrowRepository) Transition(ctx context.Context, id uint, from model.EscrowStatus, updates map[string]interface{}, event *model.EscrowEvent) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	escrow, ok := r.escrows[id]
+	if !ok || escrow.Status != from {
+		return false, nil
+	}
+	escrow.Status = updates["status"].(model.EscrowStatus)
+	if expiresAt, ok := updates["expires_at"].(time.Time); ok {
+		escrow.ExpiresAt = &expiresAt
+	} else if _, ok := updates["expires_at"]; ok {
+		escrow.ExpiresAt = nil
+	}
+	event.EscrowID = id
+	r.events = append(r.events, *event)
+	return true, nil
+}
+
+// fakeCommunityRepository 안전거래에 필요한 게시글 조회/예약 메서드만 구현
+type fakeCommunityRepository struct {
+	repository.CommunityRepository
+	mu    sync.Mutex
+	posts map[uint]*model.CommunityPost
+}
+
+func (r *fakeCommunityRepository) GetPostByID(ctx context.Context, id uint, preload bool) (*model.CommunityPost, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	post, ok := r.posts[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *post
+	return &copied, nil
+}
+
+func (r *fakeCommunityRepository) setReservation(postID uint, status *string, userID *uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.posts[postID].ReservationStatus = status
+	r.posts[postID].ReservedByUserID = userID
+	return nil
+}
+
+func (r *fakeCommunityRepository) ReservePost(ctx context.Context, postID, reservedByUserID uint) error {
+	status := "reserved"
+	return r.setReservation(postID, &status, &reservedByUserID)
+}
+
+func (r *fakeCommunityRepository) CancelReservation(ctx context.Context, postID uint) error {
+	return r.setReservation(postID, nil, nil)
+}
+
+func (r *fakeCommunityRepository) CompleteTransaction(ctx context.Context, postID uint) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	status := "completed"
+	r.posts[postID].ReservationStatus = &status
+	return nil
+}
+
+const (
+	escrowTestPostID = 10
+	escrowSellerID   = 1
+	escrowBuyerID    = 2
+)
+
+func setupEscrowServiceTest(t *testing.T, cfg config.EscrowConfig) (EscrowService, PaymentService, *fakeEscrowRepository, *fakeCommunityRepository, *[]string) {
+	server, calls := newFakeKakaoPayServer(t, 50000)
+	t.Cleanup(server.Close)
+
+	communityRepo := &fakeCommunityRepository{posts: map[uint]*model.CommunityPost{
+		escrowTestPostID: {
+			ID:       escrowTestPostID,
+			UserID:   escrowSellerID,
+			Title:    "24K 금반지 3.75g 판매합니다",
+			Category: model.CategoryGoldTrade,
+			Type:     model.TypeSellGold,
+		},
+	}}
+
+	paymentService := NewPaymentService(newFakePaymentRepository(), nil, communityRepo, newTestKakaoPayClient(t, server.URL))
+	escrowRepo := newFakeEscrowRepository()
+	escrowService := NewEscrowService(escrowRepo, communityRepo, paymentService, cfg)
+
+	return escrowService, paymentService, escrowRepo, communityRepo, calls
+}
+
+func TestEscrowService_ReleaseFlow(t *testing.T) {
+	ctx := context.Background()
+	escrowService, paymentService, _, communityRepo, calls := setupEscrowServiceTest(t, config.EscrowConfig{
+		PaymentTimeout:  time.Hour,
+		HandoverTimeout: time.Hour,
+		ReceiptTimeout:  time.Hour,
+	})
+
+	// 판매자 본인은 안전거래 불가
+	_, err := escrowService.Start(ctx, escrowTestPostID, escrowSellerID, &model.CreateEscrowRequest{Amount: 50000})
+	assert.ErrorIs(t, err, ErrEscrowInvalidPost)
+
+	ready, err := escrowService.Start(ctx, escrowTestPostID, escrowBuyerID, &model.CreateEscrowRequest{Amount: 50000})
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusAwaitingPayment, ready.Escrow.Status)
+	assert.True(t, ready.Payment.Payment.Escrow)
+
+	post, _ := communityRepo.GetPostByID(context.Background(), escrowTestPostID, false)
+	require.NotNil(t, post.ReservationStatus)
+	assert.Equal(t, "reserved", *post.ReservationStatus)
+
+	// 다른 구매자는 중복 시작 불가
+	_, err = escrowService.Start(ctx, escrowTestPostID, 3, &model.CreateEscrowRequest{Amount: 50000})
+	assert.ErrorIs(t, err, ErrEscrowPostUnavailable)
+
+	// 결제 전에는 인계 불가
+	_, err = escrowService.ConfirmHandover(ctx, escrowTestPostID, escrowSellerID)
+	assert.ErrorIs(t, err, ErrEscrowInvalidState)
+
+	_, err = paymentService.Approve(ctx, ready.Payment.Payment.OrderID, "pg-token")
+	require.NoError(t, err)
+
+	// 에스크로 결제는 결제 API로 직접 환불 불가
+	_, err = paymentService.Cancel(ctx, ready.Payment.Payment.ID, escrowBuyerID, model.RoleUser)
+	assert.ErrorIs(t, err, ErrPaymentEscrowManaged)
+
+	_, err = escrowService.ConfirmHandover(ctx, escrowTestPostID, escrowBuyerID)
+	assert.ErrorIs(t, err, ErrEscrowAccessDenied)
+
+	handedOver, err := escrowService.ConfirmHandover(ctx, escrowTestPostID, escrowSellerID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusHandedOver, handedOver.Status)
+
+	// 인계 이후 구매자는 일방적으로 취소 불가
+	_, err = escrowService.Cancel(ctx, escrowTestPostID, escrowBuyerID, model.RoleUser, "")
+	assert.ErrorIs(t, err, ErrEscrowInvalidState)
+
+	released, err := escrowService.ConfirmReceipt(ctx, escrowTestPostID, escrowBuyerID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusReleased, released.Status)
+
+	var history []model.EscrowStatus
+	for _, event := range released.Events {
+		history = append(history, event.ToStatus)
+	}
+	assert.Equal(t, []model.EscrowStatus{
+		model.EscrowStatusAwaitingPayment,
+		model.EscrowStatusHeld,
+		model.EscrowStatusHandedOver,
+		model.EscrowStatusReleased,
+	}, history)
+
+	post, _ = communityRepo.GetPostByID(context.Background(), escrowTestPostID, false)
+	assert.Equal(t, "completed", *post.ReservationStatus)
+	assert.Equal(t, []string{"ready", "approve"}, *calls)
+}
+
+func TestEscrowService_HandoverTimeoutRefunds(t *testing.T) {
+	ctx := context.Background()
+	escrowService, paymentService, _, communityRepo, calls := setupEscrowServiceTest(t, config.EscrowConfig{
+		PaymentTimeout:  time.Hour,
+		HandoverTimeout: -time.Second, // 결제 승인 즉시 인계 기한 초과
+		ReceiptTimeout:  time.Hour,
+	})
+
+	ready, err := escrowService.Start(ctx, escrowTestPostID, escrowBuyerID, &model.CreateEscrowRequest{Amount: 50000})
+	require.NoError(t, err)
+	_, err = paymentService.Approve(ctx, ready.Payment.Payment.OrderID, "pg-token")
+	require.NoError(t, err)
+
+	processed, err := escrowService.ProcessExpired(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	escrow, err := escrowService.GetByPostID(ctx, escrowTestPostID, escrowSellerID, model.RoleUser)
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusRefunded, escrow.Status)
+	lastEvent := escrow.Events[len(escrow.Events)-1]
+	assert.Nil(t, lastEvent.ActorID)
+
+	payment, err := paymentService.GetPayment(ctx, ready.Payment.Payment.ID, escrowBuyerID, model.RoleUser)
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusCancelled, payment.Status)
+
+	post, _ := communityRepo.GetPostByID(context.Background(), escrowTestPostID, false)
+	assert.Nil(t, post.ReservationStatus)
+	assert.Equal(t, []string{"ready", "approve", "cancel"}, *calls)
+
+	// 다른 사용자는 조회 불가
+	_, err = escrowService.GetByPostID(ctx, escrowTestPostID, 3, model.RoleUser)
+	assert.ErrorIs(t, err, ErrEscrowAccessDenied)
+}
+
+func TestEscrowService_PaymentAbortCancelsEscrow(t *testing.T) {
+	ctx := context.Background()
+	escrowService, paymentService, _, communityRepo, _ := setupEscrowServiceTest(t, config.EscrowConfig{
+		PaymentTimeout:  time.Hour,
+		HandoverTimeout: time.Hour,
+		ReceiptTimeout:  time.Hour,
+	})
+
+	ready, err := escrowService.Start(ctx, escrowTestPostID, escrowBuyerID, &model.CreateEscrowRequest{Amount: 50000})
+	require.NoError(t, err)
+
+	_, err = paymentService.Abort(ctx, ready.Payment.Payment.OrderID)
+	require.NoError(t, err)
+
+	escrow, err := escrowService.GetByPostID(ctx, escrowTestPostID, escrowBuyerID, model.RoleUser)
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusCancelled, escrow.Status)
+
+	post, _ := communityRepo.GetPostByID(context.Background(), escrowTestPostID, false)
+	assert.Nil(t, post.ReservationStatus)
+}
+
+// refundHookPaymentService 환불 직전에 다른 요청을 끼워 넣거나 환불 실패를 흉내내는 결제 서비스
+type refundHookPaymentService struct {
+	PaymentService
+	beforeRefund func()
+	refundErr    error
+}
+
+func (s *refundHookPaymentService) Refund(ctx context.Context, paymentID uint) (*model.Payment, error) {
+	if s.beforeRefund != nil {
+		s.beforeRefund()
+	}
+	if s.refundErr != nil {
+		return nil, s.refundErr
+	}
+	return s.PaymentService.Refund(ctx, paymentID)
+}
+
+func TestEscrowService_CancelClaimsBeforeRefund(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.EscrowConfig{
+		PaymentTimeout:  time.Hour,
+		HandoverTimeout: time.Hour,
+		ReceiptTimeout:  time.Hour,
+	}
+	escrowService, paymentService, escrowRepo, communityRepo, calls := setupEscrowServiceTest(t, cfg)
+
+	ready, err := escrowService.Start(ctx, escrowTestPostID, escrowBuyerID, &model.CreateEscrowRequest{Amount: 50000})
+	require.NoError(t, err)
+	_, err = paymentService.Approve(ctx, ready.Payment.Payment.OrderID, "pg-token")
+	require.NoError(t, err)
+
+	// 환불이 진행되는 동안 도착한 인계 확인은 거절됨
+	hooked := &refundHookPaymentService{PaymentService: paymentService}
+	racingService := NewEscrowService(escrowRepo, communityRepo, hooked, cfg)
+	hooked.beforeRefund = func() {
+		_, err := racingService.ConfirmHandover(ctx, escrowTestPostID, escrowSellerID)
+		assert.ErrorIs(t, err, ErrEscrowInvalidState)
+	}
+
+	refunded, err := racingService.Cancel(ctx, escrowTestPostID, escrowBuyerID, model.RoleUser, "")
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusRefunded, refunded.Status)
+
+	var history []model.EscrowStatus
+	for _, event := range refunded.Events {
+		history = append(history, event.ToStatus)
+	}
+	assert.Equal(t, []model.EscrowStatus{
+		model.EscrowStatusAwaitingPayment,
+		model.EscrowStatusHeld,
+		model.EscrowStatusRefunding,
+		model.EscrowStatusRefunded,
+	}, history)
+	assert.Equal(t, []string{"ready", "approve", "cancel"}, *calls)
+}
+
+func TestEscrowService_RefundFailureRestoresState(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.EscrowConfig{
+		PaymentTimeout:  time.Hour,
+		HandoverTimeout: time.Hour,
+		ReceiptTimeout:  time.Hour,
+	}
+	escrowService, paymentService, escrowRepo, communityRepo, _ := setupEscrowServiceTest(t, cfg)
+
+	ready, err := escrowService.Start(ctx, escrowTestPostID, escrowBuyerID, &model.CreateEscrowRequest{Amount: 50000})
+	require.NoError(t, err)
+	_, err = paymentService.Approve(ctx, ready.Payment.Payment.OrderID, "pg-token")
+	require.NoError(t, err)
+	held, err := escrowService.GetByPostID(ctx, escrowTestPostID, escrowBuyerID, model.RoleUser)
+	require.NoError(t, err)
+
+	failing := NewEscrowService(escrowRepo, communityRepo, &refundHookPaymentService{
+		PaymentService: paymentService,
+		refundErr:      ErrPaymentGatewayFailed,
+	}, cfg)
+	_, err = failing.Cancel(ctx, escrowTestPostID, escrowBuyerID, model.RoleUser, "")
+	assert.ErrorIs(t, err, ErrPaymentGatewayFailed)
+
+	// 원래 상태와 기한으로 돌아가 판매자가 계속 인계할 수 있음
+	restored, err := escrowService.GetByPostID(ctx, escrowTestPostID, escrowBuyerID, model.RoleUser)
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusHeld, restored.Status)
+	require.NotNil(t, restored.ExpiresAt)
+	assert.True(t, held.ExpiresAt.Equal(*restored.ExpiresAt))
+
+	handedOver, err := escrowService.ConfirmHandover(ctx, escrowTestPostID, escrowSellerID)
+	require.NoError(t, err)
+	assert.Equal(t, model.EscrowStatusHandedOver, handedOver.Status)
+}
@@ -1,16 +1,18 @@
 package service
 
 import (
+	"context"
+
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
 )
 
 type FAQService interface {
-	GetAll() ([]model.FAQ, error)
-	GetByTarget(target model.FAQTarget) ([]model.FAQ, error)
-	Create(faq *model.FAQ) error
-	Update(id uint, question, answer string, sortOrder int) (*model.FAQ, error)
-	Delete(id uint) error
+	GetAll(ctx context.Context) ([]model.FAQ, error)
+	GetByTarget(ctx context.Context, target model.FAQTarget) ([]model.FAQ, error)
+	Create(ctx context.Context, faq *model.FAQ) error
+	Update(ctx context.Context, id uint, question, answer string, sortOrder int) (*model.FAQ, error)
+	Delete(ctx context.Context, id uint) error
 }
 
 type faqService struct {
@@ -21,32 +23,32 @@ func NewFAQService(faqRepo repository.FAQRepository) FAQService {
 	return &faqService{faqRepo: faqRepo}
 }
 
-func (s *faqService) GetAll() ([]model.FAQ, error) {
-	return s.faqRepo.FindAll()
+func (s *faqService) GetAll(ctx context.Context) ([]model.FAQ, error) {
+	return s.faqRepo.FindAll(ctx)
 }
 
-func (s *faqService) GetByTarget(target model.FAQTarget) ([]model.FAQ, error) {
-	return s.faqRepo.FindByTarget(target)
+func (s *faqService) GetByTarget(ctx context.Context, target model.FAQTarget) ([]model.FAQ, error) {
+	return s.faqRepo.FindByTarget(ctx, target)
 }
 
-func (s *faqService) Create(faq *model.FAQ) error {
-	return s.faqRepo.Create(faq)
+func (s *faqService) Create(ctx context.Context, faq *model.FAQ) error {
+	return s.faqRepo.Create(ctx, faq)
 }
 
-func (s *faqService) Update(id uint, question, answer string, sortOrder int) (*model.FAQ, error) {
-	faq, err := s.faqRepo.FindByID(id)
+func (s *faqService) Update(ctx context.Context, id uint, question, answer string, sortOrder int) (*model.FAQ, error) {
+	faq, err := s.faqRepo.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	faq.Question = question
 	faq.Answer = answer
 	faq.SortOrder = sortOrder
-	if err := s.faqRepo.Update(faq); err != nil {
+	if err := s.faqRepo.Update(ctx, faq); err != nil {
 		return nil, err
 	}
 	return faq, nil
 }
 
-func (s *faqService) Delete(id uint) error {
-	return s.faqRepo.Delete(id)
+func (s *faqService) Delete(ctx context.Context, id uint) error {
+	return s.faqRepo.Delete(ctx, id)
 }
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+)
+
+// krxLookbackDays 최신 시세 조회 시 거슬러 올라갈 일수 (연휴 대비)
+const krxLookbackDays = 10
+
+// KRXGoldPriceAPI KRX 금시장 시세 API (공공데이터포털)
+// 일별 종가만 제공하므로 최신 시세 공급자로는 다른 공급자 시세를 확인하는 참고 용도
+type KRXGoldPriceAPI struct {
+	apiURL string
+	apiKey string
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewKRXGoldPriceAPI KRX 금 시세 API 생성
+func NewKRXGoldPriceAPI(apiURL, apiKey string) *KRXGoldPriceAPI {
+	return &KRXGoldPriceAPI{
+		apiURL: apiURL,
+		apiKey: apiKey,
+		client: tracing.NewClient("krx", 30*time.Second),
+		now:    time.Now,
+	}
+}
+
+// Name 시세 출처 이름
+func (api *KRXGoldPriceAPI) Name() string {
+	return "KRX"
+}
+
+func (api *KRXGoldPriceAPI) configured() bool {
+	return api.apiURL != "" && api.apiKey != ""
+}
+
+// FetchGoldPrices 오늘(KST) 순금 종가를 24K/18K/14K 참고 시세로 반환
+// 종가에는 매입/매도 구분이 없으므로 ReferencePrice만 채우고, 오늘 종가가 아니면 오류
+func (api *KRXGoldPriceAPI) FetchGoldPrices(ctx context.Context) (map[model.GoldPriceType]GoldPriceData, error) {
+	if !api.configured() {
+		return nil, errors.New("KRX API URL 또는 API Key가 설정되지 않았습니다")
+	}
+
+	now := api.now().In(kst)
+	startDate := now.AddDate(0, 0, -krxLookbackDays).Format("20060102")
+	endDate := now.Format("20060102")
+
+	apiResponse, err := api.fetchPage(ctx, startDate, endDate, 1, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *krxClose
+	for _, item := range apiResponse.Response.Body.Items.Item {
+		closing, err := parseKRXClose(item)
+		if err != nil || closing == nil {
+			continue
+		}
+		if latest == nil || closing.Date > latest.Date {
+			latest = closing
+		}
+	}
+
+	if latest == nil {
+		return nil, errors.New("KRX API로부터 유효한 순금 시세를 받지 못했습니다")
+	}
+	// 지난 거래일 종가를 현재 시세처럼 저장하지 않도록 오늘 종가만 사용
+	if latest.Date != endDate {
+		return nil, fmt.Errorf("KRX 최신 종가가 오늘 시세가 아닙니다 (기준일 %s)", latest.Date)
+	}
+
+	// 18K = 24K × (18/24), 14K = 24K × (14/24)
+	return map[model.GoldPriceType]GoldPriceData{
+		model.Gold24K: {ReferencePrice: latest.Price},
+		model.Gold18K: {ReferencePrice: latest.Price * 0.75},
+		model.Gold14K: {ReferencePrice: latest.Price * (14.0 / 24.0)},
+	}, nil
+}
+
+// fetchPage 기간(YYYYMMDD) 시세 한 페이지 조회
+func (api *KRXGoldPriceAPI) fetchPage(ctx context.Context, startDate, endDate string, pageNo, numOfRows int) (*KRXAPIResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, api.apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// 쿼리 파라미터 설정
+	q := req.URL.Query()
+	q.Add("serviceKey", api.apiKey)
+	q.Add("pageNo", fmt.Sprintf("%d", pageNo))
+	q.Add("numOfRows", fmt.Sprintf("%d", numOfRows))
+	q.Add("resultType", "json")
+	q.Add("beginBasDt", startDate) // YYYYMMDD 형식
+	q.Add("endBasDt", endDate)     // YYYYMMDD 형식
+	req.URL.RawQuery = q.Encode()
+
+	logger.FromContext(ctx).Info("Fetching KRX data", map[string]interface{}{
+		"page":       pageNo,
+		"start_date": startDate,
+		"end_date":   endDate,
+	})
+
+	resp, err := api.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call KRX API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("KRX API returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	// 응답 파싱
+	var apiResponse KRXAPIResponse
+	if err := json.Unmarshal(body, &apiResponse); err != nil {
+		return nil, fmt.Errorf("failed to parse KRX API response: %w", err)
+	}
+
+	// 에러 체크
+	if apiResponse.Response.Header.ResultCode != "00" {
+		return nil, fmt.Errorf("KRX API error: %s - %s",
+			apiResponse.Response.Header.ResultCode,
+			apiResponse.Response.Header.ResultMsg)
+	}
+
+	return &apiResponse, nil
+}
+
+// KRXAPIResponse KRX API 응답 구조체
+type KRXAPIResponse struct {
+	Response struct {
+		Header struct {
+			ResultCode string `json:"resultCode"`
+			ResultMsg  string `json:"resultMsg"`
+		} `json:"header"`
+		Body struct {
+			NumOfRows  int `json:"numOfRows"`
+			PageNo     int `json:"pageNo"`
+			TotalCount int `json:"totalCount"`
+			Items      struct {
+				Item []KRXGoldPriceItem `json:"item"`
+			} `json:"items"`
+		} `json:"body"`
+	} `json:"response"`
+}
+
+// KRXGoldPriceItem KRX 금 시세 아이템
+type KRXGoldPriceItem struct {
+	BasDt  string `json:"basDt"`  // 기준일자 (YYYYMMDD)
+	SrtnCd string `json:"srtnCd"` // 단축코드
+	IsinCd string `json:"isinCd"` // ISIN코드
+	ItmsNm string `json:"itmsNm"` // 종목명
+	Clpr   string `json:"clpr"`   // 종가
+	Vs     string `json:"vs"`     // 대비
+	FltRt  string `json:"fltRt"`  // 등락률
+	Mkp    string `json:"mkp"`    // 시가
+	Hipr   string `json:"hipr"`   // 고가
+	Lopr   string `json:"lopr"`   // 저가
+	Trqu   string `json:"trqu"`   // 거래량
+	TrPrc  string `json:"trPrc"`  // 거래대금
+}
+
+// krxClose KRX 순금 종가
+type krxClose struct {
+	Date  string // 기준일자 (YYYYMMDD)
+	Price float64
+}
+
+// parseKRXClose KRX 아이템의 순금 종가 (순금이 아니면 nil)
+func parseKRXClose(item KRXGoldPriceItem) (*krxClose, error) {
+	// 종목명 분석 - 순금(99.99%, 24K)만 처리
+	if !contains(item.ItmsNm, "99.99") && !contains(item.ItmsNm, "순금") && !contains(item.ItmsNm, "24K") {
+		return nil, nil // 순금이 아닌 경우 스킵
+	}
+
+	// 종가를 float64로 변환
+	var clpr float64
+	if _, err := fmt.Sscanf(item.Clpr, "%f", &clpr); err != nil {
+		return nil, fmt.Errorf("failed to parse price: %w", err)
+	}
+	return &krxClose{Date: item.BasDt, Price: clpr}, nil
+}
+
+// convertKRXItemToGoldPrice KRX 아이템을 GoldPrice로 변환 (24K 순금만, 과거 데이터 임포트용)
+func convertKRXItemToGoldPrice(item KRXGoldPriceItem) (*model.GoldPrice, error) {
+	closing, err := parseKRXClose(item)
+	if err != nil || closing == nil {
+		return nil, err
+	}
+	clpr := closing.Price
+
+	// KRX는 종가만 제공하므로, 매입가/매도가를 종가 기준으로 설정
+	// 일반적으로 매입가는 시세보다 낮고, 매도가는 높음
+	buyPrice := clpr * 0.98  // 종가의 98%를 매입가로
+	sellPrice := clpr * 1.02 // 종가의 102%를 매도가로
+
+	// 날짜 파싱 (YYYYMMDD -> time.Time)
+	sourceDate, err := time.Parse("20060102", item.BasDt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse date: %w", err)
+	}
+
+	goldPrice := &model.GoldPrice{
+		Type:        model.Gold24K,
+		BuyPrice:    buyPrice,
+		SellPrice:   sellPrice,
+		Source:      "KRX",
+		SourceDate:  sourceDate,
+		Description: fmt.Sprintf("KRX 금시장 시세 (순금 99.99%%) - %s", item.ItmsNm),
+	}
+
+	return goldPrice, nil
+}
+
+// contains 문자열 포함 여부 체크
+func contains(s, substr string) bool {
+	return len(s) >= len(substr) && indexOf(s, substr) >= 0
+}
+
+func indexOf(s, substr string) int {
+	for i := 0; i <= len(s)-len(substr); i++ {
+		if s[i:i+len(substr)] == substr {
+			return i
+		}
+	}
+	return -1
+}
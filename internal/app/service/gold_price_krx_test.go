@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKRXGoldPriceAPI_FetchGoldPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var resp KRXAPIResponse
+		resp.Response.Header.ResultCode = "00"
+		resp.Response.Body.Items.Item = []KRXGoldPriceItem{
+			{BasDt: "20260227", ItmsNm: "금 99.99_1kg", Clpr: "140000"},
+			{BasDt: "20260302", ItmsNm: "금 99.99_1kg", Clpr: "142000"},
+			{BasDt: "20260302", ItmsNm: "미니금 99.99_100g", Clpr: "abc"},
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	api := NewKRXGoldPriceAPI(server.URL, "test-key")
+	api.now = func() time.Time { return time.Date(2026, 3, 2, 16, 0, 0, 0, kst) }
+
+	// 종가는 매입/매도가 없이 참고 시세로만 반환
+	prices, err := api.FetchGoldPrices(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, GoldPriceData{ReferencePrice: 142000}, prices[model.Gold24K])
+	assert.InDelta(t, 106500, prices[model.Gold18K].ReferencePrice, 0.001)
+
+	// 다음 날 장중에는 전일 종가뿐이므로 사용하지 않음
+	api.now = func() time.Time { return time.Date(2026, 3, 3, 10, 0, 0, 0, kst) }
+	_, err = api.FetchGoldPrices(context.Background())
+	assert.Error(t, err)
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+)
+
+// GoldPriceProviderRegistry 여러 시세 공급자를 우선순위 순서로 묶은 ExternalGoldPriceAPI
+// 금 종류별로 조회에 성공한 공급자 중 우선순위가 가장 높은 시세를 채택하고,
+// 나머지 공급자와 maxDeviationPercent 이상 차이가 나면 SourceMismatch로 표시
+// 참고 시세(ReferencePrice, KRX 종가)는 채택하지 않고 채택된 시세의 매입/매도 중간값과 비교만 함
+type GoldPriceProviderRegistry struct {
+	providers           []ExternalGoldPriceAPI
+	maxDeviationPercent float64
+}
+
+// NewGoldPriceProviderRegistry 시세 공급자 레지스트리 생성 (providers는 우선순위 순서)
+func NewGoldPriceProviderRegistry(maxDeviationPercent float64, providers ...ExternalGoldPriceAPI) *GoldPriceProviderRegistry {
+	return &GoldPriceProviderRegistry{
+		providers:           providers,
+		maxDeviationPercent: maxDeviationPercent,
+	}
+}
+
+// Name 등록된 공급자 이름 (우선순위 순서)
+func (r *GoldPriceProviderRegistry) Name() string {
+	names := make([]string, 0, len(r.providers))
+	for _, provider := range r.providers {
+		names = append(names, provider.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+type providerResult struct {
+	prices map[model.GoldPriceType]GoldPriceData
+	err    error
+}
+
+// FetchGoldPrices 모든 공급자를 동시에 조회한 뒤 우선순위대로 병합
+// 비교를 위해 상위 공급자가 성공해도 나머지 공급자를 함께 조회
+func (r *GoldPriceProviderRegistry) FetchGoldPrices(ctx context.Context) (map[model.GoldPriceType]GoldPriceData, error) {
+	if len(r.providers) == 0 {
+		return nil, errors.New("금 시세 공급자가 설정되지 않았습니다")
+	}
+
+	results := make([]providerResult, len(r.providers))
+	var wg sync.WaitGroup
+	for i, provider := range r.providers {
+		wg.Add(1)
+		go func(i int, provider ExternalGoldPriceAPI) {
+			defer wg.Done()
+			prices, err := provider.FetchGoldPrices(ctx)
+			results[i] = providerResult{prices: prices, err: err}
+		}(i, provider)
+	}
+	wg.Wait()
+
+	log := logger.FromContext(ctx)
+	merged := make(map[model.GoldPriceType]GoldPriceData)
+	var references []providerReference
+	var failures []string
+	for i, result := range results {
+		name := r.providers[i].Name()
+		if result.err != nil {
+			log.Warn("Gold price provider failed", map[string]interface{}{
+				"provider": name,
+				"error":    result.err.Error(),
+			})
+			failures = append(failures, fmt.Sprintf("%s: %v", name, result.err))
+			continue
+		}
+
+		for priceType, data := range result.prices {
+			if data.ReferencePrice > 0 {
+				references = append(references, providerReference{name: name, priceType: priceType, price: data.ReferencePrice})
+			}
+			if data.SellPrice <= 0 {
+				continue
+			}
+
+			winner, ok := merged[priceType]
+			if !ok {
+				data.Source = name
+				data.SourceMismatch = false
+				data.MaxDeviation = 0
+				merged[priceType] = data
+				continue
+			}
+
+			merged[priceType] = r.compare(ctx, priceType, winner, winner.SellPrice, name, data.SellPrice)
+		}
+	}
+
+	for _, ref := range references {
+		winner, ok := merged[ref.priceType]
+		if !ok {
+			continue
+		}
+		mid := (winner.BuyPrice + winner.SellPrice) / 2
+		merged[ref.priceType] = r.compare(ctx, ref.priceType, winner, mid, ref.name, ref.price)
+	}
+
+	if len(merged) == 0 {
+		return nil, fmt.Errorf("모든 금 시세 공급자 조회에 실패했습니다: %s", strings.Join(failures, "; "))
+	}
+
+	return merged, nil
+}
+
+// providerReference 공급자의 참고 시세
+type providerReference struct {
+	name      string
+	priceType model.GoldPriceType
+	price     float64
+}
+
+// compare 채택된 시세(winnerPrice)와 다른 공급자 시세의 차이를 winner에 반영
+func (r *GoldPriceProviderRegistry) compare(ctx context.Context, priceType model.GoldPriceType, winner GoldPriceData, winnerPrice float64, other string, otherPrice float64) GoldPriceData {
+	deviation := math.Abs(otherPrice-winnerPrice) / winnerPrice * 100
+	if deviation > winner.MaxDeviation {
+		winner.MaxDeviation = deviation
+	}
+	if r.maxDeviationPercent > 0 && deviation > r.maxDeviationPercent {
+		winner.SourceMismatch = true
+		logger.FromContext(ctx).Warn("Gold price sources disagree", map[string]interface{}{
+			"type":          priceType,
+			"source":        winner.Source,
+			"source_price":  winnerPrice,
+			"other":         other,
+			"other_price":   otherPrice,
+			"deviation_pct": deviation,
+		})
+	}
+	return winner
+}
+
+// CSVGoldPriceFeed 운영자가 직접 관리하는 CSV 시세 파일
+// 형식: type,buy_price,sell_price (헤더 행과 '#' 주석 허용)
+type CSVGoldPriceFeed struct {
+	path   string
+	maxAge time.Duration
+	now    func() time.Time
+}
+
+// NewCSVGoldPriceFeed CSV 시세 공급자 생성 (maxAge보다 오래된 파일은 사용하지 않음, 0이면 제한 없음)
+func NewCSVGoldPriceFeed(path string, maxAge time.Duration) *CSVGoldPriceFeed {
+	return &CSVGoldPriceFeed{
+		path:   path,
+		maxAge: maxAge,
+		now:    time.Now,
+	}
+}
+
+// Name 시세 출처 이름
+func (f *CSVGoldPriceFeed) Name() string {
+	return "Manual CSV"
+}
+
+// FetchGoldPrices CSV 파일에서 시세 읽기
+func (f *CSVGoldPriceFeed) FetchGoldPrices(ctx context.Context) (map[model.GoldPriceType]GoldPriceData, error) {
+	if f.path == "" {
+		return nil, errors.New("CSV 시세 파일 경로가 설정되지 않았습니다")
+	}
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat CSV feed: %w", err)
+	}
+	if f.maxAge > 0 && f.now().Sub(info.ModTime()) > f.maxAge {
+		return nil, fmt.Errorf("CSV 시세 파일이 오래되었습니다 (수정 시각 %s)", info.ModTime().Format(time.RFC3339))
+	}
+
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open CSV feed: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	prices := make(map[model.GoldPriceType]GoldPriceData)
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV feed: %w", err)
+		}
+
+		// 헤더 행
+		if first && strings.EqualFold(record[0], "type") {
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		priceType := model.GoldPriceType(strings.TrimSpace(record[0]))
+		switch priceType {
+		case model.Gold24K, model.Gold18K, model.Gold14K, model.Platinum, model.Silver:
+		default:
+			return nil, fmt.Errorf("CSV %d행: %w (%s)", line, ErrInvalidGoldPriceType, priceType)
+		}
+
+		buyPrice, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil || buyPrice <= 0 {
+			return nil, fmt.Errorf("CSV %d행: 잘못된 매입가 %q", line, record[1])
+		}
+		sellPrice, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil || sellPrice <= 0 {
+			return nil, fmt.Errorf("CSV %d행: 잘못된 매도가 %q", line, record[2])
+		}
+
+		prices[priceType] = GoldPriceData{
+			BuyPrice:  buyPrice,
+			SellPrice: sellPrice,
+		}
+	}
+
+	if len(prices) == 0 {
+		return nil, errors.New("CSV 시세 파일에 시세가 없습니다")
+	}
+
+	return prices, nil
+}
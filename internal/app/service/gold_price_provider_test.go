@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubGoldPriceAPI struct {
+	name   string
+	prices map[model.GoldPriceType]GoldPriceData
+	err    error
+}
+
+func (s *stubGoldPriceAPI) Name() string { return s.name }
+
+func (s *stubGoldPriceAPI) FetchGoldPrices(ctx context.Context) (map[model.GoldPriceType]GoldPriceData, error) {
+	return s.prices, s.err
+}
+
+func TestGoldPriceProviderRegistry_FallsBackInPriorityOrder(t *testing.T) {
+	registry := NewGoldPriceProviderRegistry(2,
+		&stubGoldPriceAPI{name: "GOLDAPI", err: errors.New("timeout")},
+		&stubGoldPriceAPI{name: "KRX", prices: map[model.GoldPriceType]GoldPriceData{
+			model.Gold24K: {BuyPrice: 98000, SellPrice: 102000},
+		}},
+		&stubGoldPriceAPI{name: "Manual CSV", prices: map[model.GoldPriceType]GoldPriceData{
+			model.Gold24K: {BuyPrice: 98500, SellPrice: 102500},
+			model.Silver:  {BuyPrice: 1200, SellPrice: 1300},
+		}},
+	)
+
+	prices, err := registry.FetchGoldPrices(context.Background())
+	require.NoError(t, err)
+
+	// 24K는 KRX가 우선, 차이(약 0.49%)는 허용 범위 이내
+	assert.Equal(t, "KRX", prices[model.Gold24K].Source)
+	assert.Equal(t, 102000.0, prices[model.Gold24K].SellPrice)
+	assert.False(t, prices[model.Gold24K].SourceMismatch)
+	assert.InDelta(t, 0.49, prices[model.Gold24K].MaxDeviation, 0.01)
+
+	// 상위 공급자에 없는 종류는 하위 공급자 시세 사용
+	assert.Equal(t, "Manual CSV", prices[model.Silver].Source)
+}
+
+func TestGoldPriceProviderRegistry_FlagsDisagreement(t *testing.T) {
+	registry := NewGoldPriceProviderRegistry(2,
+		&stubGoldPriceAPI{name: "GOLDAPI", prices: map[model.GoldPriceType]GoldPriceData{
+			model.Gold24K: {BuyPrice: 98000, SellPrice: 100000},
+		}},
+		&stubGoldPriceAPI{name: "KRX", prices: map[model.GoldPriceType]GoldPriceData{
+			model.Gold24K: {BuyPrice: 101000, SellPrice: 105000},
+		}},
+	)
+
+	prices, err := registry.FetchGoldPrices(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "GOLDAPI", prices[model.Gold24K].Source)
+	assert.Equal(t, 100000.0, prices[model.Gold24K].SellPrice)
+	assert.True(t, prices[model.Gold24K].SourceMismatch)
+	assert.InDelta(t, 5.0, prices[model.Gold24K].MaxDeviation, 0.001)
+}
+
+func TestGoldPriceProviderRegistry_ReferenceOnly(t *testing.T) {
+	krx := &stubGoldPriceAPI{name: "KRX", prices: map[model.GoldPriceType]GoldPriceData{
+		model.Gold24K: {ReferencePrice: 100000},
+	}}
+
+	// 참고 시세는 매입/매도 중간값(100,000원)과 비교하므로 스프레드만으로는 불일치가 아님
+	registry := NewGoldPriceProviderRegistry(2, krx, &stubGoldPriceAPI{name: "GOLDAPI", prices: map[model.GoldPriceType]GoldPriceData{
+		model.Gold24K: {BuyPrice: 98000, SellPrice: 102000},
+	}})
+	prices, err := registry.FetchGoldPrices(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "GOLDAPI", prices[model.Gold24K].Source)
+	assert.False(t, prices[model.Gold24K].SourceMismatch)
+
+	// 참고 시세만 있으면 저장할 시세가 없음
+	registry = NewGoldPriceProviderRegistry(2, krx)
+	_, err = registry.FetchGoldPrices(context.Background())
+	assert.Error(t, err)
+}
+
+func TestGoldPriceProviderRegistry_AllProvidersFail(t *testing.T) {
+	registry := NewGoldPriceProviderRegistry(2,
+		&stubGoldPriceAPI{name: "GOLDAPI", err: errors.New("timeout")},
+		&stubGoldPriceAPI{name: "KRX", err: errors.New("quota exceeded")},
+	)
+
+	_, err := registry.FetchGoldPrices(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "GOLDAPI: timeout")
+	assert.Contains(t, err.Error(), "KRX: quota exceeded")
+}
+
+func TestCSVGoldPriceFeed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	content := "type,buy_price,sell_price\n# 수동 입력\n24K, 98000, 102000\n18K,73500,76500\n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	feed := NewCSVGoldPriceFeed(path, time.Hour)
+	prices, err := feed.FetchGoldPrices(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, prices, 2)
+	assert.Equal(t, GoldPriceData{BuyPrice: 98000, SellPrice: 102000}, prices[model.Gold24K])
+
+	// 오래된 파일은 사용하지 않음
+	feed.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	_, err = feed.FetchGoldPrices(context.Background())
+	assert.Error(t, err)
+}
+
+func TestCSVGoldPriceFeed_InvalidRow(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	require.NoError(t, os.WriteFile(path, []byte("24K,98000,102000\n22K,1,2\n"), 0o644))
+
+	_, err := NewCSVGoldPriceFeed(path, 0).FetchGoldPrices(context.Background())
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInvalidGoldPriceType)
+	assert.Contains(t, err.Error(), "CSV 2행")
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,59 +12,94 @@ import (
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 )
 
 var (
 	ErrGoldPriceNotFound     = errors.New("금 시세를 찾을 수 없습니다")
 	ErrExternalAPIFailed     = errors.New("외부 API에서 금 시세를 가져오는데 실패했습니다")
 	ErrInvalidGoldPriceType  = errors.New("잘못된 금 종류입니다")
+	ErrInvalidCandleInterval = errors.New("interval은 1d, 1w, 1M 중 하나여야 합니다")
+	ErrInvalidDateRange      = errors.New("조회 기간이 올바르지 않습니다 (YYYY-MM-DD, from은 to 이전)")
 )
 
+// kst 시세 날짜 기준 시간대 (서머타임 없음)
+var kst = time.FixedZone("KST", 9*60*60)
+
 // ExternalGoldPriceAPI 외부 금 시세 API 인터페이스
 type ExternalGoldPriceAPI interface {
-	FetchGoldPrices() (map[model.GoldPriceType]GoldPriceData, error)
+	// Name 시세 출처 이름 (GoldPrice.Source에 기록)
+	Name() string
+	FetchGoldPrices(ctx context.Context) (map[model.GoldPriceType]GoldPriceData, error)
 }
 
 // GoldPriceData 금 시세 데이터
 type GoldPriceData struct {
 	BuyPrice  float64
 	SellPrice float64
+	// ReferencePrice 매입/매도 구분이 없는 참고 시세 (KRX 종가), 채택하지 않고 다른 공급자와 비교에만 사용
+	ReferencePrice float64
+
+	// 여러 공급자를 합친 경우에만 채워짐 (GoldPriceProviderRegistry)
+	Source         string  // 채택된 공급자
+	SourceMismatch bool    // 다른 공급자와 허용 범위 이상 차이
+	MaxDeviation   float64 // 다른 공급자 대비 최대 차이 (%)
+}
+
+// GoldPriceListener 새 금 시세 저장 수신자 (시세 알림 등에서 구현)
+type GoldPriceListener interface {
+	// OnGoldPriceCreated 최신 시세가 저장된 뒤 호출 (previous는 직전 최신 시세, 없으면 nil)
+	OnGoldPriceCreated(ctx context.Context, price, previous *model.GoldPrice)
 }
 
 // GoldPriceService 금 시세 서비스 인터페이스
 type GoldPriceService interface {
-	GetLatestPrices() ([]model.GoldPriceResponse, error)
-	GetPriceByID(id uint) (*model.GoldPrice, error)
-	GetPriceByType(priceType model.GoldPriceType) (*model.GoldPriceResponse, error)
-	GetPriceHistory(priceType model.GoldPriceType, period string) ([]model.GoldPriceHistoryItem, error)
-	UpdatePricesFromExternalAPI() error
-	CreatePrice(goldPrice *model.GoldPrice) error
-	UpdatePrice(goldPrice *model.GoldPrice) error
-	ImportHistoricalDataFromKRX(startDate, endDate string) (int, error)
+	// AddListener 새 시세 저장 수신자 등록
+	AddListener(listener GoldPriceListener)
+
+	GetLatestPrices(ctx context.Context) ([]model.GoldPriceResponse, error)
+	GetPriceByID(ctx context.Context, id uint) (*model.GoldPrice, error)
+	GetPriceByType(ctx context.Context, priceType model.GoldPriceType) (*model.GoldPriceResponse, error)
+	GetPriceHistory(ctx context.Context, priceType model.GoldPriceType, period string) ([]model.GoldPriceHistoryItem, error)
+	// GetCandles 구간별 OHLC 캔들 (from, to는 YYYY-MM-DD, 비어 있으면 구간별 기본 기간)
+	GetCandles(ctx context.Context, priceType model.GoldPriceType, interval model.GoldPriceCandleInterval, from, to string) ([]model.GoldPriceCandle, error)
+	// UpdatePricesFromExternalAPI 외부 API 시세 저장 (저장된 시세 수 반환, 조회 실패는 ErrExternalAPIFailed)
+	UpdatePricesFromExternalAPI(ctx context.Context) (int, error)
+	CreatePrice(ctx context.Context, goldPrice *model.GoldPrice) error
+	UpdatePrice(ctx context.Context, goldPrice *model.GoldPrice) error
+	ImportHistoricalDataFromKRX(ctx context.Context, startDate, endDate string) (int, error)
+
+	// 자동 수집 실행 기록
+	StartUpdateRun(ctx context.Context, startedAt time.Time) (*model.GoldPriceUpdateRun, error)
+	FinishUpdateRun(ctx context.Context, run *model.GoldPriceUpdateRun, finishedAt time.Time, runErr error) error
+	ListUpdateRuns(ctx context.Context, page, pageSize int) ([]model.GoldPriceUpdateRun, int64, error)
 }
 
 type goldPriceService struct {
 	repo        repository.GoldPriceRepository
+	runRepo     repository.GoldPriceUpdateRunRepository
 	externalAPI ExternalGoldPriceAPI
-	krxAPIURL   string
-	krxAPIKey   string
+	krxAPI      *KRXGoldPriceAPI
+	listeners   []GoldPriceListener
 }
 
 // NewGoldPriceService 금 시세 서비스 생성
-func NewGoldPriceService(repo repository.GoldPriceRepository, externalAPI ExternalGoldPriceAPI, krxAPIURL, krxAPIKey string) GoldPriceService {
+// externalAPI는 보통 여러 공급자를 우선순위대로 묶은 GoldPriceProviderRegistry, krxAPI는 과거 데이터 임포트용
+func NewGoldPriceService(repo repository.GoldPriceRepository, runRepo repository.GoldPriceUpdateRunRepository, externalAPI ExternalGoldPriceAPI, krxAPI *KRXGoldPriceAPI) GoldPriceService {
 	return &goldPriceService{
 		repo:        repo,
+		runRepo:     runRepo,
 		externalAPI: externalAPI,
-		krxAPIURL:   krxAPIURL,
-		krxAPIKey:   krxAPIKey,
+		krxAPI:      krxAPI,
 	}
 }
 
 // GetLatestPrices 최신 금 시세 조회 (모든 유형)
-func (s *goldPriceService) GetLatestPrices() ([]model.GoldPriceResponse, error) {
-	goldPrices, err := s.repo.FindLatest()
+func (s *goldPriceService) GetLatestPrices(ctx context.Context) ([]model.GoldPriceResponse, error) {
+	log := logger.FromContext(ctx)
+	goldPrices, err := s.repo.FindLatest(ctx)
 	if err != nil {
-		logger.Error("Failed to get latest gold prices", err)
+		log.Error("Failed to get latest gold prices", err)
 		return nil, err
 	}
 
@@ -73,15 +109,16 @@ func (s *goldPriceService) GetLatestPrices() ([]model.GoldPriceResponse, error)
 			Type:        gp.Type,
 			BuyPrice:    gp.BuyPrice,
 			SellPrice:   gp.SellPrice,
-			Source:      gp.Source,
-			SourceDate:  gp.SourceDate.Format(time.RFC3339),
-			Description: gp.Description,
-			UpdatedAt:   gp.UpdatedAt.Format(time.RFC3339),
+			Source:         gp.Source,
+			SourceDate:     gp.SourceDate.Format(time.RFC3339),
+			SourceMismatch: gp.SourceMismatch,
+			Description:    gp.Description,
+			UpdatedAt:      gp.UpdatedAt.Format(time.RFC3339),
 		}
 
 		// 전일 데이터 조회
 		yesterday := time.Now().AddDate(0, 0, -1)
-		previousPrice, err := s.repo.FindByTypeAndDate(gp.Type, yesterday)
+		previousPrice, err := s.repo.FindByTypeAndDate(ctx, gp.Type, yesterday)
 		if err == nil && previousPrice != nil {
 			// 전일 대비 변동률 계산
 			changeAmount := gp.SellPrice - previousPrice.SellPrice
@@ -99,10 +136,10 @@ func (s *goldPriceService) GetLatestPrices() ([]model.GoldPriceResponse, error)
 }
 
 // GetPriceByType 특정 유형의 최신 금 시세 조회
-func (s *goldPriceService) GetPriceByType(priceType model.GoldPriceType) (*model.GoldPriceResponse, error) {
-	goldPrice, err := s.repo.FindByType(priceType)
+func (s *goldPriceService) GetPriceByType(ctx context.Context, priceType model.GoldPriceType) (*model.GoldPriceResponse, error) {
+	goldPrice, err := s.repo.FindByType(ctx, priceType)
 	if err != nil {
-		logger.Error("Failed to get gold price by type", err)
+		logger.FromContext(ctx).Error("Failed to get gold price by type", err)
 		return nil, err
 	}
 
@@ -114,83 +151,224 @@ func (s *goldPriceService) GetPriceByType(priceType model.GoldPriceType) (*model
 		Type:        goldPrice.Type,
 		BuyPrice:    goldPrice.BuyPrice,
 		SellPrice:   goldPrice.SellPrice,
-		Source:      goldPrice.Source,
-		SourceDate:  goldPrice.SourceDate.Format(time.RFC3339),
-		Description: goldPrice.Description,
-		UpdatedAt:   goldPrice.UpdatedAt.Format(time.RFC3339),
+		Source:         goldPrice.Source,
+		SourceDate:     goldPrice.SourceDate.Format(time.RFC3339),
+		SourceMismatch: goldPrice.SourceMismatch,
+		Description:    goldPrice.Description,
+		UpdatedAt:      goldPrice.UpdatedAt.Format(time.RFC3339),
 	}
 
 	return response, nil
 }
 
 // UpdatePricesFromExternalAPI 외부 API에서 금 시세 업데이트
-func (s *goldPriceService) UpdatePricesFromExternalAPI() error {
+func (s *goldPriceService) UpdatePricesFromExternalAPI(ctx context.Context) (int, error) {
+	log := logger.FromContext(ctx)
 	if s.externalAPI == nil {
-		return errors.New("외부 API가 설정되지 않았습니다")
+		return 0, errors.New("외부 API가 설정되지 않았습니다")
 	}
 
-	prices, err := s.externalAPI.FetchGoldPrices()
+	prices, err := s.externalAPI.FetchGoldPrices(ctx)
 	if err != nil {
-		logger.Error("Failed to fetch gold prices from external API", err)
-		return ErrExternalAPIFailed
+		log.Error("Failed to fetch gold prices from external API", err)
+		return 0, fmt.Errorf("%w: %v", ErrExternalAPIFailed, err)
 	}
 
 	now := time.Now()
+	written := 0
 	for priceType, priceData := range prices {
+		source := priceData.Source
+		if source == "" {
+			source = s.externalAPI.Name()
+		}
+
 		goldPrice := &model.GoldPrice{
-			Type:       priceType,
-			BuyPrice:   priceData.BuyPrice,
-			SellPrice:  priceData.SellPrice,
-			Source:     "External API",
-			SourceDate: now,
+			Type:           priceType,
+			BuyPrice:       priceData.BuyPrice,
+			SellPrice:      priceData.SellPrice,
+			Source:         source,
+			SourceDate:     now,
+			SourceMismatch: priceData.SourceMismatch,
+		}
+		if priceData.SourceMismatch {
+			goldPrice.Description = fmt.Sprintf("공급자 간 시세 차이 %.2f%%", priceData.MaxDeviation)
 		}
 
-		if err := s.repo.Create(goldPrice); err != nil {
-			logger.Error("Failed to save gold price", err)
-			return err
+		if err := s.createAndNotify(ctx, goldPrice); err != nil {
+			log.Error("Failed to save gold price", err)
+			return written, err
 		}
+		written++
 	}
 
-	logger.Info("Successfully updated gold prices from external API", map[string]interface{}{
-		"count": len(prices),
+	log.Info("Successfully updated gold prices from external API", map[string]interface{}{
+		"count": written,
 	})
 
+	return written, nil
+}
+
+// StartUpdateRun 자동 수집 실행 기록 생성 (running 상태)
+func (s *goldPriceService) StartUpdateRun(ctx context.Context, startedAt time.Time) (*model.GoldPriceUpdateRun, error) {
+	run := &model.GoldPriceUpdateRun{
+		StartedAt: startedAt,
+		Status:    model.GoldPriceUpdateRunRunning,
+	}
+	if err := s.runRepo.Create(ctx, run); err != nil {
+		logger.FromContext(ctx).Error("Failed to create gold price update run", err)
+		return nil, err
+	}
+	return run, nil
+}
+
+// FinishUpdateRun 자동 수집 실행 결과 기록 (Attempts, RowsWritten은 호출 측에서 채움)
+func (s *goldPriceService) FinishUpdateRun(ctx context.Context, run *model.GoldPriceUpdateRun, finishedAt time.Time, runErr error) error {
+	run.FinishedAt = &finishedAt
+	run.Status = model.GoldPriceUpdateRunSucceeded
+	run.Error = ""
+	if runErr != nil {
+		run.Status = model.GoldPriceUpdateRunFailed
+		run.Error = runErr.Error()
+	}
+
+	if err := s.runRepo.Update(ctx, run); err != nil {
+		logger.FromContext(ctx).Error("Failed to update gold price update run", err, map[string]interface{}{
+			"run_id": run.ID,
+		})
+		return err
+	}
 	return nil
 }
 
+// ListUpdateRuns 자동 수집 실행 기록 조회 (최신순)
+func (s *goldPriceService) ListUpdateRuns(ctx context.Context, page, pageSize int) ([]model.GoldPriceUpdateRun, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	runs, total, err := s.runRepo.FindRecent(ctx, pageSize, (page-1)*pageSize)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to list gold price update runs", err)
+		return nil, 0, err
+	}
+	return runs, total, nil
+}
+
 // CreatePrice 금 시세 생성
-func (s *goldPriceService) CreatePrice(goldPrice *model.GoldPrice) error {
-	if err := s.repo.Create(goldPrice); err != nil {
-		logger.Error("Failed to create gold price", err)
+func (s *goldPriceService) CreatePrice(ctx context.Context, goldPrice *model.GoldPrice) error {
+	if err := s.createAndNotify(ctx, goldPrice); err != nil {
+		logger.FromContext(ctx).Error("Failed to create gold price", err)
 		return err
 	}
 	return nil
 }
 
-// GetPriceHistory 과거 시세 이력 조회
-func (s *goldPriceService) GetPriceHistory(priceType model.GoldPriceType, period string) ([]model.GoldPriceHistoryItem, error) {
+// AddListener 새 시세 저장 수신자 등록 (서버 시작 시에만 호출)
+func (s *goldPriceService) AddListener(listener GoldPriceListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// createAndNotify 시세를 저장하고, 최신 시세이면 수신자에게 알림
+// 과거 데이터 적재(KRX import)는 알림 대상이 아니므로 repo.Create를 직접 사용
+func (s *goldPriceService) createAndNotify(ctx context.Context, goldPrice *model.GoldPrice) error {
+	previous, err := s.repo.FindByType(ctx, goldPrice.Type)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.Create(ctx, goldPrice); err != nil {
+		return err
+	}
+
+	// 과거 시점 시세를 입력한 경우는 알림 대상 아님
+	if previous != nil && goldPrice.SourceDate.Before(previous.SourceDate) {
+		return nil
+	}
+
+	for _, listener := range s.listeners {
+		listener.OnGoldPriceCreated(ctx, goldPrice, previous)
+	}
+	return nil
+}
+
+// GetPriceHistory 과거 시세 이력 조회 (하루에 여러 번 수집하므로 날짜별(KST) 마지막 시세만 반환)
+func (s *goldPriceService) GetPriceHistory(ctx context.Context, priceType model.GoldPriceType, period string) ([]model.GoldPriceHistoryItem, error) {
 	days := getPeriodDays(period)
 	startDate := time.Now().AddDate(0, 0, -days)
 	endDate := time.Now()
 
-	prices, err := s.repo.FindByTypeAndDateRange(priceType, startDate, endDate)
+	prices, err := s.repo.FindByTypeAndDateRange(ctx, priceType, startDate, endDate)
 	if err != nil {
-		logger.Error("Failed to get price history", err)
+		logger.FromContext(ctx).Error("Failed to get price history", err)
 		return nil, err
 	}
 
+	// prices는 source_date 오름차순이므로 같은 날짜는 뒤의 값으로 덮어씀
 	history := make([]model.GoldPriceHistoryItem, 0, len(prices))
 	for _, price := range prices {
-		history = append(history, model.GoldPriceHistoryItem{
-			Date:      price.SourceDate.Format("2006-01-02"),
+		item := model.GoldPriceHistoryItem{
+			Date:      price.SourceDate.In(kst).Format("2006-01-02"),
 			SellPrice: price.SellPrice,
 			BuyPrice:  price.BuyPrice,
-		})
+		}
+		if n := len(history); n > 0 && history[n-1].Date == item.Date {
+			history[n-1] = item
+			continue
+		}
+		history = append(history, item)
 	}
 
 	return history, nil
 }
 
+// GetCandles 구간별 OHLC 캔들 조회 (to 날짜 포함, KST 기준)
+func (s *goldPriceService) GetCandles(ctx context.Context, priceType model.GoldPriceType, interval model.GoldPriceCandleInterval, from, to string) ([]model.GoldPriceCandle, error) {
+	var defaultRange time.Duration
+	switch interval {
+	case model.CandleIntervalDay:
+		defaultRange = 90 * 24 * time.Hour
+	case model.CandleIntervalWeek:
+		defaultRange = 365 * 24 * time.Hour
+	case model.CandleIntervalMonth:
+		defaultRange = 5 * 365 * 24 * time.Hour
+	default:
+		return nil, ErrInvalidCandleInterval
+	}
+
+	now := time.Now().In(kst)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, kst).AddDate(0, 0, 1)
+	if to != "" {
+		toDate, err := time.ParseInLocation("2006-01-02", to, kst)
+		if err != nil {
+			return nil, ErrInvalidDateRange
+		}
+		end = toDate.AddDate(0, 0, 1)
+	}
+
+	start := end.Add(-defaultRange)
+	if from != "" {
+		fromDate, err := time.ParseInLocation("2006-01-02", from, kst)
+		if err != nil {
+			return nil, ErrInvalidDateRange
+		}
+		start = fromDate
+	}
+
+	if !start.Before(end) {
+		return nil, ErrInvalidDateRange
+	}
+
+	candles, err := s.repo.FindCandles(ctx, priceType, interval, start, end)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to get gold price candles", err)
+		return nil, err
+	}
+	return candles, nil
+}
+
 // getPeriodDays 기간 문자열을 일수로 변환
 func getPeriodDays(period string) int {
 	switch period {
@@ -210,10 +388,10 @@ func getPeriodDays(period string) int {
 }
 
 // GetPriceByID ID로 금 시세 조회
-func (s *goldPriceService) GetPriceByID(id uint) (*model.GoldPrice, error) {
-	goldPrice, err := s.repo.FindByID(id)
+func (s *goldPriceService) GetPriceByID(ctx context.Context, id uint) (*model.GoldPrice, error) {
+	goldPrice, err := s.repo.FindByID(ctx, id)
 	if err != nil {
-		logger.Error("Failed to get gold price by ID", err)
+		logger.FromContext(ctx).Error("Failed to get gold price by ID", err)
 		return nil, err
 	}
 	if goldPrice == nil {
@@ -223,12 +401,12 @@ func (s *goldPriceService) GetPriceByID(id uint) (*model.GoldPrice, error) {
 }
 
 // UpdatePrice 금 시세 업데이트
-func (s *goldPriceService) UpdatePrice(goldPrice *model.GoldPrice) error {
+func (s *goldPriceService) UpdatePrice(ctx context.Context, goldPrice *model.GoldPrice) error {
 	if goldPrice == nil {
 		return fmt.Errorf("goldPrice cannot be nil")
 	}
-	if err := s.repo.Update(goldPrice); err != nil {
-		logger.Error("Failed to update gold price", err)
+	if err := s.repo.Update(ctx, goldPrice); err != nil {
+		logger.FromContext(ctx).Error("Failed to update gold price", err)
 		return err
 	}
 	return nil
@@ -238,6 +416,7 @@ func (s *goldPriceService) UpdatePrice(goldPrice *model.GoldPrice) error {
 type DefaultGoldPriceAPI struct {
 	apiURL string
 	apiKey string
+	client *http.Client
 }
 
 // NewDefaultGoldPriceAPI 기본 금 시세 API 생성
@@ -245,9 +424,15 @@ func NewDefaultGoldPriceAPI(apiURL, apiKey string) *DefaultGoldPriceAPI {
 	return &DefaultGoldPriceAPI{
 		apiURL: apiURL,
 		apiKey: apiKey,
+		client: tracing.NewClient("goldapi", 10*time.Second),
 	}
 }
 
+// Name 시세 출처 이름
+func (api *DefaultGoldPriceAPI) Name() string {
+	return "GOLDAPI"
+}
+
 // GoldAPIResponse GOLD API 응답 구조체
 type GoldAPIResponse struct {
 	Timestamp      int64   `json:"timestamp"`
@@ -271,16 +456,12 @@ type GoldAPIResponse struct {
 }
 
 // FetchGoldPrices 외부 API에서 금 시세 조회 (GOLDAPI)
-func (api *DefaultGoldPriceAPI) FetchGoldPrices() (map[model.GoldPriceType]GoldPriceData, error) {
+func (api *DefaultGoldPriceAPI) FetchGoldPrices(ctx context.Context) (map[model.GoldPriceType]GoldPriceData, error) {
 	if api.apiURL == "" {
 		return nil, errors.New("금 시세 API URL이 설정되지 않았습니다")
 	}
 
-	client := &http.Client{
-		Timeout: 10 * time.Second,
-	}
-
-	req, err := http.NewRequest("GET", api.apiURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, api.apiURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -291,7 +472,7 @@ func (api *DefaultGoldPriceAPI) FetchGoldPrices() (map[model.GoldPriceType]GoldP
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := client.Do(req)
+	resp, err := api.client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to call API: %w", err)
 	}
@@ -346,7 +527,7 @@ func (api *DefaultGoldPriceAPI) FetchGoldPrices() (map[model.GoldPriceType]GoldP
 		return nil, errors.New("API로부터 유효한 금 시세 데이터를 받지 못했습니다")
 	}
 
-	logger.Info("Successfully fetched gold prices from GOLDAPI", map[string]interface{}{
+	logger.FromContext(ctx).Info("Successfully fetched gold prices from GOLDAPI", map[string]interface{}{
 		"24K": apiResponse.PriceGram24K,
 		"18K": apiResponse.PriceGram18K,
 		"14K": apiResponse.PriceGram14K,
@@ -355,47 +536,14 @@ func (api *DefaultGoldPriceAPI) FetchGoldPrices() (map[model.GoldPriceType]GoldP
 	return prices, nil
 }
 
-// KRXAPIResponse KRX API 응답 구조체
-type KRXAPIResponse struct {
-	Response struct {
-		Header struct {
-			ResultCode string `json:"resultCode"`
-			ResultMsg  string `json:"resultMsg"`
-		} `json:"header"`
-		Body struct {
-			NumOfRows  int    `json:"numOfRows"`
-			PageNo     int    `json:"pageNo"`
-			TotalCount int    `json:"totalCount"`
-			Items      struct {
-				Item []KRXGoldPriceItem `json:"item"`
-			} `json:"items"`
-		} `json:"body"`
-	} `json:"response"`
-}
-
-// KRXGoldPriceItem KRX 금 시세 아이템
-type KRXGoldPriceItem struct {
-	BasDt   string  `json:"basDt"`   // 기준일자 (YYYYMMDD)
-	SrtnCd  string  `json:"srtnCd"`  // 단축코드
-	IsinCd  string  `json:"isinCd"`  // ISIN코드
-	ItmsNm  string  `json:"itmsNm"`  // 종목명
-	Clpr    string  `json:"clpr"`    // 종가
-	Vs      string  `json:"vs"`      // 대비
-	FltRt   string  `json:"fltRt"`   // 등락률
-	Mkp     string  `json:"mkp"`     // 시가
-	Hipr    string  `json:"hipr"`    // 고가
-	Lopr    string  `json:"lopr"`    // 저가
-	Trqu    string  `json:"trqu"`    // 거래량
-	TrPrc   string  `json:"trPrc"`   // 거래대금
-}
-
 // ImportHistoricalDataFromKRX KRX API에서 과거 데이터 가져오기
-func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string) (int, error) {
-	if s.krxAPIURL == "" || s.krxAPIKey == "" {
+func (s *goldPriceService) ImportHistoricalDataFromKRX(ctx context.Context, startDate, endDate string) (int, error) {
+	log := logger.FromContext(ctx)
+	if s.krxAPI == nil || !s.krxAPI.configured() {
 		return 0, errors.New("KRX API URL 또는 API Key가 설정되지 않았습니다")
 	}
 
-	logger.Info("Starting KRX historical data import", map[string]interface{}{
+	log.Info("Starting KRX historical data import", map[string]interface{}{
 		"start_date": startDate,
 		"end_date":   endDate,
 	})
@@ -405,63 +553,14 @@ func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string
 	numOfRows := 100
 
 	for {
-		// API 호출
-		client := &http.Client{
-			Timeout: 30 * time.Second,
-		}
-
-		req, err := http.NewRequest("GET", s.krxAPIURL, nil)
-		if err != nil {
-			return importedCount, fmt.Errorf("failed to create request: %w", err)
-		}
-
-		// 쿼리 파라미터 설정
-		q := req.URL.Query()
-		q.Add("serviceKey", s.krxAPIKey)
-		q.Add("pageNo", fmt.Sprintf("%d", pageNo))
-		q.Add("numOfRows", fmt.Sprintf("%d", numOfRows))
-		q.Add("resultType", "json")
-		q.Add("beginBasDt", startDate) // YYYYMMDD 형식
-		q.Add("endBasDt", endDate)     // YYYYMMDD 형식
-		req.URL.RawQuery = q.Encode()
-
-		logger.Info("Fetching KRX data", map[string]interface{}{
-			"page":  pageNo,
-			"url":   req.URL.String(),
-		})
-
-		resp, err := client.Do(req)
-		if err != nil {
-			return importedCount, fmt.Errorf("failed to call KRX API: %w", err)
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			body, _ := io.ReadAll(resp.Body)
-			return importedCount, fmt.Errorf("KRX API returned status %d: %s", resp.StatusCode, string(body))
-		}
-
-		body, err := io.ReadAll(resp.Body)
+		apiResponse, err := s.krxAPI.fetchPage(ctx, startDate, endDate, pageNo, numOfRows)
 		if err != nil {
-			return importedCount, fmt.Errorf("failed to read response body: %w", err)
-		}
-
-		// 응답 파싱
-		var apiResponse KRXAPIResponse
-		if err := json.Unmarshal(body, &apiResponse); err != nil {
-			return importedCount, fmt.Errorf("failed to parse KRX API response: %w", err)
-		}
-
-		// 에러 체크
-		if apiResponse.Response.Header.ResultCode != "00" {
-			return importedCount, fmt.Errorf("KRX API error: %s - %s",
-				apiResponse.Response.Header.ResultCode,
-				apiResponse.Response.Header.ResultMsg)
+			return importedCount, err
 		}
 
 		// 데이터가 없으면 종료
 		if len(apiResponse.Response.Body.Items.Item) == 0 {
-			logger.Info("No more data to import from KRX", map[string]interface{}{
+			log.Info("No more data to import from KRX", map[string]interface{}{
 				"page":           pageNo,
 				"imported_count": importedCount,
 			})
@@ -471,9 +570,9 @@ func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string
 		// 데이터 저장
 		for _, item := range apiResponse.Response.Body.Items.Item {
 			// 24K (순금) 데이터만 처리
-			goldPrice24K, err := s.convertKRXItemToGoldPrice(item)
+			goldPrice24K, err := convertKRXItemToGoldPrice(item)
 			if err != nil {
-				logger.Warn("Failed to convert KRX item", map[string]interface{}{
+				log.Warn("Failed to convert KRX item", map[string]interface{}{
 					"item":  item.ItmsNm,
 					"error": err.Error(),
 				})
@@ -511,14 +610,14 @@ func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string
 			// 각 금 종류별로 저장
 			for _, gp := range goldPrices {
 				// 중복 체크 (같은 날짜, 같은 타입의 데이터가 이미 있는지)
-				existing, err := s.repo.FindByTypeAndDate(gp.Type, sourceDate)
+				existing, err := s.repo.FindByTypeAndDate(ctx, gp.Type, sourceDate)
 				if err != nil {
-					logger.Error("Failed to check existing data", err)
+					log.Error("Failed to check existing data", err)
 					continue
 				}
 
 				if existing != nil {
-					logger.Info("Skipping duplicate data", map[string]interface{}{
+					log.Info("Skipping duplicate data", map[string]interface{}{
 						"type": gp.Type,
 						"date": item.BasDt,
 					})
@@ -526,8 +625,8 @@ func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string
 				}
 
 				// 데이터 저장
-				if err := s.repo.Create(gp); err != nil {
-					logger.Error("Failed to save KRX gold price", err, map[string]interface{}{
+				if err := s.repo.Create(ctx, gp); err != nil {
+					log.Error("Failed to save KRX gold price", err, map[string]interface{}{
 						"type": gp.Type,
 						"date": item.BasDt,
 					})
@@ -538,7 +637,7 @@ func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string
 			}
 		}
 
-		logger.Info("Imported KRX data page", map[string]interface{}{
+		log.Info("Imported KRX data page", map[string]interface{}{
 			"page":           pageNo,
 			"items":          len(apiResponse.Response.Body.Items.Item),
 			"imported_count": importedCount,
@@ -554,7 +653,7 @@ func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string
 		time.Sleep(100 * time.Millisecond) // API 호출 제한 방지
 	}
 
-	logger.Info("Completed KRX historical data import", map[string]interface{}{
+	log.Info("Completed KRX historical data import", map[string]interface{}{
 		"imported_count": importedCount,
 		"start_date":     startDate,
 		"end_date":       endDate,
@@ -562,53 +661,3 @@ func (s *goldPriceService) ImportHistoricalDataFromKRX(startDate, endDate string
 
 	return importedCount, nil
 }
-
-// convertKRXItemToGoldPrice KRX 아이템을 GoldPrice로 변환 (24K 순금만)
-func (s *goldPriceService) convertKRXItemToGoldPrice(item KRXGoldPriceItem) (*model.GoldPrice, error) {
-	// 종목명 분석 - 순금(99.99%, 24K)만 처리
-	if !contains(item.ItmsNm, "99.99") && !contains(item.ItmsNm, "순금") && !contains(item.ItmsNm, "24K") {
-		return nil, nil // 순금이 아닌 경우 스킵
-	}
-
-	// 종가를 float64로 변환
-	var clpr float64
-	if _, err := fmt.Sscanf(item.Clpr, "%f", &clpr); err != nil {
-		return nil, fmt.Errorf("failed to parse price: %w", err)
-	}
-
-	// KRX는 종가만 제공하므로, 매입가/매도가를 종가 기준으로 설정
-	// 일반적으로 매입가는 시세보다 낮고, 매도가는 높음
-	buyPrice := clpr * 0.98  // 종가의 98%를 매입가로
-	sellPrice := clpr * 1.02 // 종가의 102%를 매도가로
-
-	// 날짜 파싱 (YYYYMMDD -> time.Time)
-	sourceDate, err := time.Parse("20060102", item.BasDt)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse date: %w", err)
-	}
-
-	goldPrice := &model.GoldPrice{
-		Type:        model.Gold24K,
-		BuyPrice:    buyPrice,
-		SellPrice:   sellPrice,
-		Source:      "KRX",
-		SourceDate:  sourceDate,
-		Description: fmt.Sprintf("KRX 금시장 시세 (순금 99.99%%) - %s", item.ItmsNm),
-	}
-
-	return goldPrice, nil
-}
-
-// contains 문자열 포함 여부 체크
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && indexOf(s, substr) >= 0
-}
-
-func indexOf(s, substr string) int {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return i
-		}
-	}
-	return -1
-}
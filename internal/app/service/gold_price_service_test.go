@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type candleRecordingRepository struct {
+	repository.GoldPriceRepository
+	interval model.GoldPriceCandleInterval
+	from, to time.Time
+}
+
+func (r *candleRecordingRepository) FindCandles(ctx context.Context, priceType model.GoldPriceType, interval model.GoldPriceCandleInterval, from, to time.Time) ([]model.GoldPriceCandle, error) {
+	r.interval, r.from, r.to = interval, from, to
+	return []model.GoldPriceCandle{}, nil
+}
+
+type historyRepository struct {
+	repository.GoldPriceRepository
+	prices []model.GoldPrice
+}
+
+func (r *historyRepository) FindByTypeAndDateRange(ctx context.Context, priceType model.GoldPriceType, startDate, endDate time.Time) ([]model.GoldPrice, error) {
+	return r.prices, nil
+}
+
+func TestGoldPriceService_GetPriceHistory(t *testing.T) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, kst)
+	repo := &historyRepository{prices: []model.GoldPrice{
+		{SourceDate: day.Add(9 * time.Hour), SellPrice: 100},
+		{SourceDate: day.Add(15 * time.Hour), SellPrice: 110},
+		{SourceDate: day.Add(33 * time.Hour), SellPrice: 120},
+		{SourceDate: day.Add(39 * time.Hour), SellPrice: 130},
+	}}
+	svc := NewGoldPriceService(repo, nil, nil, nil)
+
+	// 장중 수집분은 날짜별 마지막 시세로 합침
+	history, err := svc.GetPriceHistory(context.Background(), model.Gold24K, "1주")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, model.GoldPriceHistoryItem{Date: "2026-03-02", SellPrice: 110}, history[0])
+	assert.Equal(t, model.GoldPriceHistoryItem{Date: "2026-03-03", SellPrice: 130}, history[1])
+}
+
+func TestGoldPriceService_GetCandles(t *testing.T) {
+	repo := &candleRecordingRepository{}
+	svc := NewGoldPriceService(repo, nil, nil, nil)
+
+	_, err := svc.GetCandles(context.Background(), model.Gold24K, model.CandleIntervalWeek, "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+	assert.Equal(t, model.CandleIntervalWeek, repo.interval)
+	assert.True(t, repo.from.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, kst)))
+	// to 날짜를 포함하도록 다음 날 0시까지 조회
+	assert.True(t, repo.to.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, kst)))
+
+	// from을 생략하면 구간별 기본 기간
+	_, err = svc.GetCandles(context.Background(), model.Gold24K, model.CandleIntervalDay, "", "2025-12-31")
+	require.NoError(t, err)
+	assert.Equal(t, 90*24*time.Hour, repo.to.Sub(repo.from))
+
+	_, err = svc.GetCandles(context.Background(), model.Gold24K, "1h", "", "")
+	assert.ErrorIs(t, err, ErrInvalidCandleInterval)
+
+	_, err = svc.GetCandles(context.Background(), model.Gold24K, model.CandleIntervalDay, "2025-12-31", "2025-01-01")
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+	_, err = svc.GetCandles(context.Background(), model.Gold24K, model.CandleIntervalDay, "20250101", "")
+	assert.ErrorIs(t, err, ErrInvalidDateRange)
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/internal/imaging"
+	"github.com/ikkim/udonggeum-backend/internal/storage"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+)
+
+// imageVariant 업로드 이미지 변환본 규격
+// WebP 인코더가 표준 라이브러리에 없어 변환본은 모두 JPEG로 생성
+type imageVariant struct {
+	name    string
+	maxSide int
+	quality int
+}
+
+var imageVariants = []imageVariant{
+	{name: "thumb", maxSide: 320, quality: 80},
+	{name: "web", maxSide: 1280, quality: 85},
+}
+
+const (
+	imageQueueSize = 256
+	imageWorkers   = 2
+	// maxImagePixels 디코딩할 최대 픽셀 수 (압축 폭탄 방지)
+	maxImagePixels = 50_000_000
+)
+
+// processableImageTypes 변환본을 만들 수 있는 형식 (내용으로 판별한 형식 기준)
+var processableImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// ImageService 업로드 이미지 처리 서비스
+// 업로드 확인을 통과한 이미지의 썸네일/웹용 변환본을 백그라운드에서 생성 (원본의 위치 정보는 업로드 확인 시 제거됨)
+type ImageService interface {
+	UploadListener
+
+	// Start 처리 작업자 시작 (이전에 처리하지 못한 이미지도 다시 처리)
+	Start()
+	// Stop 진행 중인 작업을 마치고 작업자 종료
+	Stop()
+	// ThumbnailURLs 원본 URL별 썸네일 URL (아직 처리되지 않은 이미지는 원본 URL)
+	ThumbnailURLs(ctx context.Context, urls []string) map[string]string
+}
+
+type imageService struct {
+	repo    repository.ProcessedImageRepository
+	storage storage.Storage
+	queue   chan model.ProcessedImage
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// NewImageService 업로드 이미지 처리 서비스 생성자 (업로드 서비스에 수신자로 등록)
+func NewImageService(repo repository.ProcessedImageRepository, storage storage.Storage, uploadService UploadService) ImageService {
+	s := &imageService{
+		repo:    repo,
+		storage: storage,
+		queue:   make(chan model.ProcessedImage, imageQueueSize),
+		done:    make(chan struct{}),
+	}
+	uploadService.AddListener(s)
+	return s
+}
+
+// OnUploadConfirmed 처음 확인된 이미지를 처리 대기열에 추가 (UploadListener)
+func (s *imageService) OnUploadConfirmed(ctx context.Context, fileURL string, info *storage.ObjectInfo) {
+	if !processableImageTypes[info.ContentType] || isImageVariantKey(info.Key) {
+		return
+	}
+
+	processed := &model.ProcessedImage{
+		OriginalKey: info.Key,
+		OriginalURL: fileURL,
+		GPSStripped: imaging.CarriesLocation(info.ContentType),
+		Status:      model.ProcessedImagePending,
+	}
+	created, err := s.repo.CreateIfAbsent(ctx, processed)
+	if err != nil {
+		logger.FromContext(ctx).Error("Failed to record uploaded image", err, map[string]interface{}{
+			"key": info.Key,
+		})
+		return
+	}
+	if created {
+		s.enqueue(*processed)
+	}
+}
+
+// OnUploadDeleted 삭제된 원본의 변환본과 처리 기록 삭제 (UploadListener)
+func (s *imageService) OnUploadDeleted(ctx context.Context, key string) {
+	log := logger.FromContext(ctx)
+
+	if isImageVariantKey(key) {
+		return
+	}
+	for _, variant := range imageVariants {
+		variantKey := imageVariantKey(key, variant.name)
+		if err := s.storage.Delete(ctx, variantKey); err != nil {
+			log.Error("Failed to delete image variant", err, map[string]interface{}{
+				"key": variantKey,
+			})
+		}
+	}
+	if err := s.repo.DeleteByOriginalKey(ctx, key); err != nil {
+		log.Error("Failed to delete processed image", err, map[string]interface{}{
+			"key": key,
+		})
+	}
+}
+
+func (s *imageService) Start() {
+	for i := 0; i < imageWorkers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+
+	// 서버 재시작 등으로 처리하지 못한 이미지
+	pending, err := s.repo.FindPending(context.Background(), imageQueueSize)
+	if err != nil {
+		logger.Error("Failed to load pending images", err)
+		return
+	}
+	for _, processed := range pending {
+		s.enqueue(processed)
+	}
+}
+
+func (s *imageService) Stop() {
+	close(s.done)
+	s.wg.Wait()
+}
+
+func (s *imageService) ThumbnailURLs(ctx context.Context, urls []string) map[string]string {
+	thumbnails := make(map[string]string, len(urls))
+	for _, url := range urls {
+		thumbnails[url] = url
+	}
+
+	processed, err := s.repo.FindDoneByOriginalURLs(ctx, urls)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load thumbnails, using original images", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return thumbnails
+	}
+	for _, processedImage := range processed {
+		if processedImage.ThumbnailURL != "" {
+			thumbnails[processedImage.OriginalURL] = processedImage.ThumbnailURL
+		}
+	}
+	return thumbnails
+}
+
+// enqueue 대기열이 가득 차면 pending 상태로 두고 다음 시작 시 처리
+func (s *imageService) enqueue(processed model.ProcessedImage) {
+	select {
+	case s.queue <- processed:
+	case <-s.done:
+	default:
+		logger.Warn("Image processing queue is full", map[string]interface{}{
+			"key": processed.OriginalKey,
+		})
+	}
+}
+
+func (s *imageService) work() {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-s.done:
+			return
+		case processed := <-s.queue:
+			s.process(tracing.StartJob(context.Background(), "image_processing"), &processed)
+		}
+	}
+}
+
+// process 이미지 하나를 처리하고 결과 기록 (실패하면 원본을 그대로 사용)
+func (s *imageService) process(ctx context.Context, processed *model.ProcessedImage) {
+	log := logger.FromContext(ctx)
+
+	if err := s.generateVariants(ctx, processed); err != nil {
+		log.Warn("Failed to process uploaded image", map[string]interface{}{
+			"key":   processed.OriginalKey,
+			"error": err.Error(),
+		})
+		processed.Status = model.ProcessedImageFailed
+		processed.Error = err.Error()
+	} else {
+		processed.Status = model.ProcessedImageDone
+		processed.Error = ""
+	}
+
+	if err := s.repo.Update(ctx, processed); err != nil {
+		log.Error("Failed to update processed image", err, map[string]interface{}{
+			"key": processed.OriginalKey,
+		})
+	}
+}
+
+// generateVariants 변환본 생성
+func (s *imageService) generateVariants(ctx context.Context, processed *model.ProcessedImage) error {
+	data, err := s.readOriginal(ctx, processed.OriginalKey)
+	if err != nil {
+		return err
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("unsupported image: %w", err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return fmt.Errorf("image too large: %dx%d", config.Width, config.Height)
+	}
+
+	orientation := 1
+	if format == "jpeg" {
+		orientation = imaging.JPEGOrientation(data)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decode image: %w", err)
+	}
+	// 변환본에는 EXIF가 없으므로 방향을 픽셀에 반영
+	src = imaging.Orient(src, orientation)
+	processed.Width = src.Bounds().Dx()
+	processed.Height = src.Bounds().Dy()
+
+	for _, variant := range imageVariants {
+		encoded, err := imaging.EncodeJPEG(imaging.Fit(src, variant.maxSide), variant.quality)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", variant.name, err)
+		}
+
+		key := imageVariantKey(processed.OriginalKey, variant.name)
+		if err := s.storage.Put(ctx, key, "image/jpeg", encoded); err != nil {
+			return fmt.Errorf("failed to store %s: %w", variant.name, err)
+		}
+
+		switch variant.name {
+		case "thumb":
+			processed.ThumbnailURL = s.storage.FileURL(key)
+		case "web":
+			processed.WebURL = s.storage.FileURL(key)
+		}
+	}
+	return nil
+}
+
+func (s *imageService) readOriginal(ctx context.Context, key string) ([]byte, error) {
+	reader, err := s.storage.Open(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, ErrUploadNotFound
+		}
+		return nil, err
+	}
+	defer reader.Close()
+
+	maxSize := max(ImageUploadPolicy.MaxSize, ChatFileUploadPolicy.MaxSize)
+	data, err := io.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, ErrUploadTooLarge
+	}
+	return data, nil
+}
+
+// imageVariantKey 원본 옆에 저장할 변환본 key (community/abc.png → community/abc_thumb.jpg)
+func imageVariantKey(key, variant string) string {
+	return strings.TrimSuffix(key, path.Ext(key)) + "_" + variant + ".jpg"
+}
+
+func isImageVariantKey(key string) bool {
+	for _, variant := range imageVariants {
+		if strings.HasSuffix(key, "_"+variant.name+".jpg") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProcessedImageRepository struct {
+	repository.ProcessedImageRepository
+	images map[string]*model.ProcessedImage
+}
+
+func (r *fakeProcessedImageRepository) CreateIfAbsent(ctx context.Context, image *model.ProcessedImage) (bool, error) {
+	if _, ok := r.images[image.OriginalKey]; ok {
+		return false, nil
+	}
+	r.images[image.OriginalKey] = image
+	return true, nil
+}
+
+func (r *fakeProcessedImageRepository) Update(ctx context.Context, image *model.ProcessedImage) error {
+	saved := *image
+	r.images[image.OriginalKey] = &saved
+	return nil
+}
+
+func (r *fakeProcessedImageRepository) FindDoneByOriginalURLs(ctx context.Context, urls []string) ([]model.ProcessedImage, error) {
+	var images []model.ProcessedImage
+	for _, url := range urls {
+		for _, image := range r.images {
+			if image.OriginalURL == url && image.Status == model.ProcessedImageDone {
+				images = append(images, *image)
+			}
+		}
+	}
+	return images, nil
+}
+
+func (r *fakeProcessedImageRepository) DeleteByOriginalKey(ctx context.Context, key string) error {
+	delete(r.images, key)
+	return nil
+}
+
+func newTestImageService(t *testing.T, objects map[string]fakeObject) (*imageService, *fakeProcessedImageRepository, *fakeStorage) {
+	t.Helper()
+	store := &fakeStorage{objects: objects}
+	repo := &fakeProcessedImageRepository{images: map[string]*model.ProcessedImage{}}
+	svc := NewImageService(repo, store, NewUploadService(store, &fakeUploadRepository{})).(*imageService)
+	return svc, repo, store
+}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 150, B: 50, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestImageService_GeneratesVariants(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, store := newTestImageService(t, map[string]fakeObject{
+		"community/ring.png": {contentType: "image/png", data: encodePNG(t, 1600, 800)},
+	})
+
+	svc.OnUploadConfirmed(ctx, fakeStorageURL+"community/ring.png", &storage.ObjectInfo{Key: "community/ring.png", ContentType: "image/png"})
+	require.Contains(t, repo.images, "community/ring.png")
+	require.Len(t, svc.queue, 1)
+
+	// 같은 파일을 다시 확인해도 한 번만 처리
+	svc.OnUploadConfirmed(ctx, fakeStorageURL+"community/ring.png", &storage.ObjectInfo{Key: "community/ring.png", ContentType: "image/png"})
+	require.Len(t, svc.queue, 1)
+
+	processed := <-svc.queue
+	svc.process(ctx, &processed)
+
+	saved := repo.images["community/ring.png"]
+	assert.Equal(t, model.ProcessedImageDone, saved.Status)
+	assert.Equal(t, 1600, saved.Width)
+	assert.Equal(t, fakeStorageURL+"community/ring_thumb.jpg", saved.ThumbnailURL)
+	assert.Equal(t, fakeStorageURL+"community/ring_web.jpg", saved.WebURL)
+
+	thumb, err := jpeg.DecodeConfig(bytes.NewReader(store.objects["community/ring_thumb.jpg"].data))
+	require.NoError(t, err)
+	assert.Equal(t, 320, thumb.Width)
+	assert.Equal(t, 160, thumb.Height)
+	web, err := jpeg.DecodeConfig(bytes.NewReader(store.objects["community/ring_web.jpg"].data))
+	require.NoError(t, err)
+	assert.Equal(t, 1280, web.Width)
+
+	thumbnails := svc.ThumbnailURLs(context.Background(), []string{fakeStorageURL + "community/ring.png", "https://cdn.example.com/other.png"})
+	assert.Equal(t, fakeStorageURL+"community/ring_thumb.jpg", thumbnails[fakeStorageURL+"community/ring.png"])
+	// 처리되지 않은 이미지는 원본 URL
+	assert.Equal(t, "https://cdn.example.com/other.png", thumbnails["https://cdn.example.com/other.png"])
+
+	// 원본이 정리되면 변환본과 처리 기록도 삭제
+	svc.OnUploadDeleted(ctx, "community/ring.png")
+	assert.NotContains(t, store.objects, "community/ring_thumb.jpg")
+	assert.NotContains(t, store.objects, "community/ring_web.jpg")
+	assert.NotContains(t, repo.images, "community/ring.png")
+}
+
+func TestImageService_SkipsNonImagesAndVariants(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newTestImageService(t, map[string]fakeObject{})
+
+	svc.OnUploadConfirmed(ctx, fakeStorageURL+"chat/a.pdf", &storage.ObjectInfo{Key: "chat/a.pdf", ContentType: "application/pdf"})
+	svc.OnUploadConfirmed(ctx, fakeStorageURL+"community/a_thumb.jpg", &storage.ObjectInfo{Key: "community/a_thumb.jpg", ContentType: "image/jpeg"})
+	assert.Empty(t, repo.images)
+}
+
+func TestImageService_RecordsFailure(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, _ := newTestImageService(t, map[string]fakeObject{
+		"community/broken.png": {contentType: "image/png", data: []byte("\x89PNG\r\n\x1a\nbroken")},
+	})
+
+	svc.OnUploadConfirmed(ctx, fakeStorageURL+"community/broken.png", &storage.ObjectInfo{Key: "community/broken.png", ContentType: "image/png"})
+	processed := <-svc.queue
+	svc.process(ctx, &processed)
+
+	saved := repo.images["community/broken.png"]
+	assert.Equal(t, model.ProcessedImageFailed, saved.Status)
+	assert.NotEmpty(t, saved.Error)
+}
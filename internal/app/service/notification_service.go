@@ -1,7 +1,9 @@
 package service
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
@@ -11,20 +13,21 @@ import (
 // NotificationService 알림 서비스 인터페이스
 type NotificationService interface {
 	// Notification operations
-	GetNotifications(userID uint, notifType *model.NotificationType, isRead *bool, page, pageSize int) ([]model.Notification, int64, int64, error)
-	GetUnreadCount(userID uint) (int64, error)
-	MarkAsRead(notificationID, userID uint) (*model.Notification, error)
-	MarkAllAsRead(userID uint) error
-	DeleteNotification(notificationID, userID uint) error
+	GetNotifications(ctx context.Context, userID uint, notifType *model.NotificationType, isRead *bool, page, pageSize int) ([]model.Notification, int64, int64, error)
+	GetUnreadCount(ctx context.Context, userID uint) (int64, error)
+	MarkAsRead(ctx context.Context, notificationID, userID uint) (*model.Notification, error)
+	MarkAllAsRead(ctx context.Context, userID uint) error
+	DeleteNotification(ctx context.Context, notificationID, userID uint) error
 
 	// NotificationSettings operations
-	GetNotificationSettings(userID uint) (*model.NotificationSettings, error)
-	UpdateNotificationSettings(userID uint, req *UpdateNotificationSettingsRequest) (*model.NotificationSettings, error)
+	GetNotificationSettings(ctx context.Context, userID uint) (*model.NotificationSettings, error)
+	UpdateNotificationSettings(ctx context.Context, userID uint, req *UpdateNotificationSettingsRequest) (*model.NotificationSettings, error)
 
 	// Notification creation helpers
-	CreateNewSellPostNotification(post *model.CommunityPost) error
-	CreatePostCommentNotification(comment *model.CommunityComment, post *model.CommunityPost) error
-	CreateStoreLikedNotification(storeID, likedByUserID uint) error
+	CreateNewSellPostNotification(ctx context.Context, post *model.CommunityPost) error
+	CreatePostCommentNotification(ctx context.Context, comment *model.CommunityComment, post *model.CommunityPost) error
+	CreateStoreLikedNotification(ctx context.Context, storeID, likedByUserID uint) error
+	CreatePriceAlertNotification(ctx context.Context, alert *model.PriceAlert, price *model.GoldPrice, changePercent *float64) error
 }
 
 type notificationService struct {
@@ -51,6 +54,7 @@ func NewNotificationService(repo repository.NotificationRepository, hub *websock
 
 // GetNotifications 알림 목록 조회
 func (s *notificationService) GetNotifications(
+	ctx context.Context,
 	userID uint,
 	notifType *model.NotificationType,
 	isRead *bool,
@@ -69,13 +73,13 @@ func (s *notificationService) GetNotifications(
 
 	offset := (page - 1) * pageSize
 
-	notifications, total, err := s.repo.GetNotifications(userID, notifType, isRead, pageSize, offset)
+	notifications, total, err := s.repo.GetNotifications(ctx, userID, notifType, isRead, pageSize, offset)
 	if err != nil {
 		return nil, 0, 0, err
 	}
 
 	// 안읽은 개수
-	unreadCount, err := s.repo.GetUnreadCount(userID)
+	unreadCount, err := s.repo.GetUnreadCount(ctx, userID)
 	if err != nil {
 		return nil, 0, 0, err
 	}
@@ -84,14 +88,14 @@ func (s *notificationService) GetNotifications(
 }
 
 // GetUnreadCount 안읽은 알림 개수 조회
-func (s *notificationService) GetUnreadCount(userID uint) (int64, error) {
-	return s.repo.GetUnreadCount(userID)
+func (s *notificationService) GetUnreadCount(ctx context.Context, userID uint) (int64, error) {
+	return s.repo.GetUnreadCount(ctx, userID)
 }
 
 // MarkAsRead 알림 읽음 처리
-func (s *notificationService) MarkAsRead(notificationID, userID uint) (*model.Notification, error) {
+func (s *notificationService) MarkAsRead(ctx context.Context, notificationID, userID uint) (*model.Notification, error) {
 	// 알림 조회
-	notification, err := s.repo.GetNotificationByID(notificationID)
+	notification, err := s.repo.GetNotificationByID(ctx, notificationID)
 	if err != nil {
 		return nil, fmt.Errorf("알림을 찾을 수 없습니다")
 	}
@@ -107,7 +111,7 @@ func (s *notificationService) MarkAsRead(notificationID, userID uint) (*model.No
 	}
 
 	// 읽음 처리
-	if err := s.repo.MarkAsRead(notificationID); err != nil {
+	if err := s.repo.MarkAsRead(ctx, notificationID); err != nil {
 		return nil, err
 	}
 
@@ -116,14 +120,14 @@ func (s *notificationService) MarkAsRead(notificationID, userID uint) (*model.No
 }
 
 // MarkAllAsRead 모든 알림 읽음 처리
-func (s *notificationService) MarkAllAsRead(userID uint) error {
-	return s.repo.MarkAllAsRead(userID)
+func (s *notificationService) MarkAllAsRead(ctx context.Context, userID uint) error {
+	return s.repo.MarkAllAsRead(ctx, userID)
 }
 
 // DeleteNotification 알림 삭제
-func (s *notificationService) DeleteNotification(notificationID, userID uint) error {
+func (s *notificationService) DeleteNotification(ctx context.Context, notificationID, userID uint) error {
 	// 알림 조회
-	notification, err := s.repo.GetNotificationByID(notificationID)
+	notification, err := s.repo.GetNotificationByID(ctx, notificationID)
 	if err != nil {
 		return fmt.Errorf("알림을 찾을 수 없습니다")
 	}
@@ -133,21 +137,22 @@ func (s *notificationService) DeleteNotification(notificationID, userID uint) er
 		return fmt.Errorf("권한이 없습니다")
 	}
 
-	return s.repo.DeleteNotification(notificationID)
+	return s.repo.DeleteNotification(ctx, notificationID)
 }
 
 // GetNotificationSettings 알림 설정 조회
-func (s *notificationService) GetNotificationSettings(userID uint) (*model.NotificationSettings, error) {
-	return s.repo.GetNotificationSettings(userID)
+func (s *notificationService) GetNotificationSettings(ctx context.Context, userID uint) (*model.NotificationSettings, error) {
+	return s.repo.GetNotificationSettings(ctx, userID)
 }
 
 // UpdateNotificationSettings 알림 설정 수정
 func (s *notificationService) UpdateNotificationSettings(
+	ctx context.Context,
 	userID uint,
 	req *UpdateNotificationSettingsRequest,
 ) (*model.NotificationSettings, error) {
 	// 기존 설정 조회 (없으면 자동 생성)
-	settings, err := s.repo.GetNotificationSettings(userID)
+	settings, err := s.repo.GetNotificationSettings(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -174,7 +179,7 @@ func (s *notificationService) UpdateNotificationSettings(
 		settings.LikeNotification = *req.LikeNotification
 	}
 
-	if err := s.repo.UpdateNotificationSettings(settings); err != nil {
+	if err := s.repo.UpdateNotificationSettings(ctx, settings); err != nil {
 		return nil, err
 	}
 
@@ -182,7 +187,7 @@ func (s *notificationService) UpdateNotificationSettings(
 }
 
 // CreateNewSellPostNotification 금 판매글 알림 생성
-func (s *notificationService) CreateNewSellPostNotification(post *model.CommunityPost) error {
+func (s *notificationService) CreateNewSellPostNotification(ctx context.Context, post *model.CommunityPost) error {
 	// 금 판매글이 아니면 알림 생성 안 함
 	if post.Type != model.TypeSellGold {
 		fmt.Printf("[DEBUG] Not a sell_gold post, skipping notification. Type: %s\n", post.Type)
@@ -198,7 +203,7 @@ func (s *notificationService) CreateNewSellPostNotification(post *model.Communit
 	fmt.Printf("[DEBUG] Creating notification for new sell post in %s %s\n", *post.Region, *post.District)
 
 	// 알림을 받을 관리자 목록 조회
-	adminIDs, err := s.repo.GetAdminsForNewSellPost(*post.Region, *post.District)
+	adminIDs, err := s.repo.GetAdminsForNewSellPost(ctx, *post.Region, *post.District)
 	if err != nil {
 		fmt.Printf("[DEBUG] Error getting admins: %v\n", err)
 		return err
@@ -219,7 +224,7 @@ func (s *notificationService) CreateNewSellPostNotification(post *model.Communit
 			RelatedUserID:  &post.UserID,
 		}
 
-		if err := s.repo.CreateNotification(notification); err != nil {
+		if err := s.repo.CreateNotification(ctx, notification); err != nil {
 			// 로그만 남기고 계속 진행
 			fmt.Printf("[DEBUG] Failed to create notification for admin %d: %v\n", adminID, err)
 		} else {
@@ -227,7 +232,7 @@ func (s *notificationService) CreateNewSellPostNotification(post *model.Communit
 
 			// WebSocket으로 실시간 알림 전송
 			if s.hub != nil {
-				unreadCount, _ := s.repo.GetUnreadCount(adminID)
+				unreadCount, _ := s.repo.GetUnreadCount(ctx, adminID)
 				wsMessage := map[string]interface{}{
 					"type":          "new_notification",
 					"unread_count":  unreadCount,
@@ -244,14 +249,14 @@ func (s *notificationService) CreateNewSellPostNotification(post *model.Communit
 }
 
 // CreatePostCommentNotification 게시글 댓글 알림 생성
-func (s *notificationService) CreatePostCommentNotification(comment *model.CommunityComment, post *model.CommunityPost) error {
+func (s *notificationService) CreatePostCommentNotification(ctx context.Context, comment *model.CommunityComment, post *model.CommunityPost) error {
 	// 본인 댓글이면 알림 생성 안 함
 	if comment.UserID == post.UserID {
 		return nil
 	}
 
 	// 게시글 작성자의 알림 설정 확인
-	settings, err := s.repo.GetNotificationSettings(post.UserID)
+	settings, err := s.repo.GetNotificationSettings(ctx, post.UserID)
 	if err != nil {
 		// 설정이 없으면 기본값으로 알림 생성
 		fmt.Printf("Failed to get notification settings for user %d: %v\n", post.UserID, err)
@@ -271,13 +276,13 @@ func (s *notificationService) CreatePostCommentNotification(comment *model.Commu
 		RelatedUserID:  &comment.UserID,
 	}
 
-	if err := s.repo.CreateNotification(notification); err != nil {
+	if err := s.repo.CreateNotification(ctx, notification); err != nil {
 		return err
 	}
 
 	// WebSocket으로 실시간 알림 전송
 	if s.hub != nil {
-		unreadCount, _ := s.repo.GetUnreadCount(post.UserID)
+		unreadCount, _ := s.repo.GetUnreadCount(ctx, post.UserID)
 		wsMessage := map[string]interface{}{
 			"type":          "new_notification",
 			"unread_count":  unreadCount,
@@ -292,7 +297,7 @@ func (s *notificationService) CreatePostCommentNotification(comment *model.Commu
 }
 
 // CreateStoreLikedNotification 매장 찜 알림 생성
-func (s *notificationService) CreateStoreLikedNotification(storeID, likedByUserID uint) error {
+func (s *notificationService) CreateStoreLikedNotification(ctx context.Context, storeID, likedByUserID uint) error {
 	// TODO: storeID로 매장 조회하여 UserID 찾기
 	// 지금은 StoreRepository가 없으므로 나중에 구현
 
@@ -317,3 +322,68 @@ func (s *notificationService) CreateStoreLikedNotification(storeID, likedByUserI
 
 	return nil
 }
+
+// CreatePriceAlertNotification 금 시세 알림 생성
+func (s *notificationService) CreatePriceAlertNotification(ctx context.Context, alert *model.PriceAlert, price *model.GoldPrice, changePercent *float64) error {
+	var title string
+	switch {
+	case alert.Condition == model.PriceAlertConditionPercentChange && changePercent != nil:
+		if *changePercent >= 0 {
+			title = fmt.Sprintf("%s 금 시세가 전일 대비 %.2f%% 올랐어요", price.Type, *changePercent)
+		} else {
+			title = fmt.Sprintf("%s 금 시세가 전일 대비 %.2f%% 내렸어요", price.Type, -*changePercent)
+		}
+	case alert.Direction == model.PriceAlertDirectionAbove:
+		title = fmt.Sprintf("%s 금 시세가 %s원을 넘었어요", price.Type, formatWon(alert.Threshold))
+	default:
+		title = fmt.Sprintf("%s 금 시세가 %s원 아래로 내려갔어요", price.Type, formatWon(alert.Threshold))
+	}
+
+	notification := &model.Notification{
+		UserID:  alert.UserID,
+		Type:    model.NotificationTypePriceAlert,
+		Title:   title,
+		Content: fmt.Sprintf("현재 매도가 %s원/g", formatWon(price.SellPrice)),
+		Link:    "/gold-prices",
+		IsRead:  false,
+	}
+
+	if err := s.repo.CreateNotification(ctx, notification); err != nil {
+		return err
+	}
+
+	// WebSocket으로 실시간 알림 전송
+	if s.hub != nil {
+		unreadCount, _ := s.repo.GetUnreadCount(ctx, alert.UserID)
+		wsMessage := map[string]interface{}{
+			"type":         "new_notification",
+			"unread_count": unreadCount,
+			"notification": notification,
+		}
+		if err := s.hub.SendNotificationToUser(alert.UserID, wsMessage); err != nil {
+			fmt.Printf("Failed to send WebSocket notification: %v\n", err)
+		}
+	}
+
+	return nil
+}
+
+// formatWon 원 단위 금액을 천 단위 구분 기호와 함께 표시 (소수점 이하 반올림)
+func formatWon(amount float64) string {
+	digits := fmt.Sprintf("%.0f", amount)
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+
+	var b strings.Builder
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+
+	if negative {
+		return "-" + b.String()
+	}
+	return b.String()
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+)
+
+const (
+	// appleIssuer id_token 발급자이자 client secret의 대상(aud)
+	appleIssuer = "https://appleid.apple.com"
+	// appleClientSecretTTL 요청마다 새로 서명하므로 짧게 (최대 6개월)
+	appleClientSecretTTL = 5 * time.Minute
+	// appleKeysTTL 애플 공개키 캐시 유지 시간 (모르는 kid가 오면 바로 다시 조회)
+	appleKeysTTL = 24 * time.Hour
+	// appleKeysRefreshInterval 모르는 kid로 공개키를 다시 조회하는 최소 간격
+	appleKeysRefreshInterval = time.Minute
+)
+
+// AppleOAuthConfig 애플 로그인 설정 (Apple Developer의 Services ID와 Sign in with Apple 키)
+type AppleOAuthConfig struct {
+	ClientID      string // Services ID (웹) 또는 번들 ID (iOS 앱)
+	TeamID        string
+	KeyID         string
+	PrivateKeyPEM []byte // .p8 개인키 (ES256)
+	RedirectURI   string
+}
+
+// AppleOAuthProvider 애플 로그인
+// client secret은 개인키로 서명한 JWT이고, 사용자 정보는 토큰 응답의 id_token을 검증해서 얻음
+type AppleOAuthProvider struct {
+	config     AppleOAuthConfig
+	privateKey *ecdsa.PrivateKey
+	authURL    string
+	tokenURL   string
+	keysURL    string
+	client     *http.Client
+	now        func() time.Time
+
+	keysMu        sync.Mutex
+	keys          map[string]*rsa.PublicKey
+	keysFetchedAt time.Time
+}
+
+func NewAppleOAuthProvider(config AppleOAuthConfig) (*AppleOAuthProvider, error) {
+	privateKey, err := jwt.ParseECPrivateKeyFromPEM(config.PrivateKeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Apple private key: %w", err)
+	}
+
+	return &AppleOAuthProvider{
+		config:     config,
+		privateKey: privateKey,
+		authURL:    appleIssuer + "/auth/authorize",
+		tokenURL:   appleIssuer + "/auth/token",
+		keysURL:    appleIssuer + "/auth/keys",
+		client:     tracing.NewClient("apple", 10*time.Second),
+		now:        time.Now,
+	}, nil
+}
+
+func (p *AppleOAuthProvider) Name() model.IdentityProvider {
+	return model.IdentityProviderApple
+}
+
+// AuthURL 이름/이메일을 요청하면 애플은 form_post로만 콜백하므로 콜백은 POST로도 받아야 함
+func (p *AppleOAuthProvider) AuthURL(state string) string {
+	query := url.Values{
+		"client_id":     {p.config.ClientID},
+		"redirect_uri":  {p.config.RedirectURI},
+		"response_type": {"code"},
+		"response_mode": {"form_post"},
+		"scope":         {"name email"},
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+	return p.authURL + "?" + query.Encode()
+}
+
+func (p *AppleOAuthProvider) Exchange(ctx context.Context, code, state string) (*OAuthProfile, error) {
+	clientSecret, err := p.clientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	var token appleTokenResponse
+	if err := oauthPostForm(ctx, p.client, p.Name(), p.tokenURL, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {clientSecret},
+		"redirect_uri":  {p.config.RedirectURI},
+		"code":          {code},
+	}, &token); err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, fmt.Errorf("apple token response missing id_token")
+	}
+
+	claims, err := p.validateIDToken(ctx, token.IDToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Email == "" {
+		return nil, ErrOAuthEmailRequired
+	}
+	// 애플은 확인한 이메일(또는 릴레이 주소)만 발급하므로 확인되지 않은 이메일은 거부
+	if !claimBool(claims.EmailVerified) {
+		return nil, ErrOAuthEmailUnverified
+	}
+
+	// 애플은 이름을 최초 동의 시에만 앱에 직접 전달하므로 id_token에는 이름이 없음
+	return &OAuthProfile{
+		Provider:      model.IdentityProviderApple,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: true,
+		AccessToken:   token.AccessToken,
+	}, nil
+}
+
+// clientSecret 팀 ID(iss)와 클라이언트 ID(sub)로 ES256 서명한 client secret
+func (p *AppleOAuthProvider) clientSecret() (string, error) {
+	now := p.now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    p.config.TeamID,
+		Subject:   p.config.ClientID,
+		Audience:  jwt.ClaimStrings{appleIssuer},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appleClientSecretTTL)),
+	})
+	token.Header["kid"] = p.config.KeyID
+	return token.SignedString(p.privateKey)
+}
+
+// validateIDToken 애플 공개키로 서명을 확인하고 발급자, 대상(클라이언트 ID), 만료를 검증
+func (p *AppleOAuthProvider) validateIDToken(ctx context.Context, idToken string) (*appleIDTokenClaims, error) {
+	claims := &appleIDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims,
+		func(token *jwt.Token) (interface{}, error) {
+			keyID, _ := token.Header["kid"].(string)
+			return p.publicKey(ctx, keyID)
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(appleIssuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid apple id_token: %v", ErrOAuthRejected, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: apple id_token missing sub", ErrOAuthRejected)
+	}
+	return claims, nil
+}
+
+// publicKey kid에 해당하는 애플 공개키 (캐시에 없으면 다시 조회)
+func (p *AppleOAuthProvider) publicKey(ctx context.Context, keyID string) (*rsa.PublicKey, error) {
+	p.keysMu.Lock()
+	defer p.keysMu.Unlock()
+
+	now := p.now()
+	key, ok := p.keys[keyID]
+	if ok && now.Sub(p.keysFetchedAt) < appleKeysTTL {
+		return key, nil
+	}
+	// 모르는 kid로 공개키 조회를 반복하지 않도록 최소 간격을 둠
+	if !ok && p.keys != nil && now.Sub(p.keysFetchedAt) < appleKeysRefreshInterval {
+		return nil, fmt.Errorf("unknown apple key id %q", keyID)
+	}
+
+	var keySet appleKeySet
+	if err := oauthGetJSON(ctx, p.client, p.Name(), p.keysURL, "", &keySet); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(keySet.Keys))
+	for _, jwk := range keySet.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+		publicKey, err := jwk.rsaPublicKey()
+		if err != nil {
+			return nil, fmt.Errorf("apple key %q: %w", jwk.Kid, err)
+		}
+		keys[jwk.Kid] = publicKey
+	}
+	p.keys = keys
+	p.keysFetchedAt = now
+
+	key, ok = keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown apple key id %q", keyID)
+	}
+	return key, nil
+}
+
+// appleTokenResponse 애플 토큰 응답
+type appleTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	IDToken      string `json:"id_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	TokenType    string `json:"token_type"`
+}
+
+// appleIDTokenClaims 애플 id_token (email_verified는 문자열 "true" 또는 bool)
+type appleIDTokenClaims struct {
+	Email          string      `json:"email"`
+	EmailVerified  interface{} `json:"email_verified"`
+	IsPrivateEmail interface{} `json:"is_private_email"`
+	jwt.RegisteredClaims
+}
+
+// claimBool 애플 id_token의 bool 클레임 (true 또는 "true")
+func claimBool(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// appleKeySet 애플 공개키 목록 (JWKS)
+type appleKeySet struct {
+	Keys []appleJWK `json:"keys"`
+}
+
+type appleJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+func (k appleJWK) rsaPublicKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 || exponent.Int64() < 3 {
+		return nil, errors.New("invalid RSA exponent")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
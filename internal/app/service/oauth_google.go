@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+)
+
+// GoogleOAuthProvider 구글 로그인
+type GoogleOAuthProvider struct {
+	config      OAuthClientConfig
+	authURL     string
+	tokenURL    string
+	userInfoURL string
+	client      *http.Client
+}
+
+func NewGoogleOAuthProvider(config OAuthClientConfig) *GoogleOAuthProvider {
+	return &GoogleOAuthProvider{
+		config:      config,
+		authURL:     "https://accounts.google.com/o/oauth2/v2/auth",
+		tokenURL:    "https://oauth2.googleapis.com/token",
+		userInfoURL: "https://www.googleapis.com/oauth2/v3/userinfo",
+		client:      tracing.NewClient("google", 10*time.Second),
+	}
+}
+
+func (p *GoogleOAuthProvider) Name() model.IdentityProvider {
+	return model.IdentityProviderGoogle
+}
+
+func (p *GoogleOAuthProvider) AuthURL(state string) string {
+	query := url.Values{
+		"client_id":     {p.config.ClientID},
+		"redirect_uri":  {p.config.RedirectURI},
+		"response_type": {"code"},
+		"scope":         {"openid profile email"},
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+	return p.authURL + "?" + query.Encode()
+}
+
+func (p *GoogleOAuthProvider) Exchange(ctx context.Context, code, state string) (*OAuthProfile, error) {
+	var token googleTokenResponse
+	if err := oauthPostForm(ctx, p.client, p.Name(), p.tokenURL, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+		"redirect_uri":  {p.config.RedirectURI},
+		"code":          {code},
+	}, &token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("google token response missing access_token")
+	}
+
+	var info googleUserInfo
+	if err := oauthGetJSON(ctx, p.client, p.Name(), p.userInfoURL, token.AccessToken, &info); err != nil {
+		return nil, err
+	}
+	if info.Email == "" {
+		return nil, ErrOAuthEmailRequired
+	}
+
+	return &OAuthProfile{
+		Provider:      model.IdentityProviderGoogle,
+		Subject:       info.Sub,
+		Email:         info.Email,
+		EmailVerified: info.EmailVerified,
+		Name:          info.Name,
+		ProfileImage:  info.Picture,
+		AccessToken:   token.AccessToken,
+	}, nil
+}
+
+// googleTokenResponse represents Google token response
+type googleTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	TokenType   string `json:"token_type"`
+}
+
+// googleUserInfo represents Google user information
+type googleUserInfo struct {
+	Sub           string `json:"sub"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	Picture       string `json:"picture"`
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+)
+
+// KakaoOAuthProvider 카카오 로그인
+type KakaoOAuthProvider struct {
+	config      OAuthClientConfig
+	authURL     string
+	tokenURL    string
+	userInfoURL string
+	client      *http.Client
+}
+
+func NewKakaoOAuthProvider(config OAuthClientConfig) *KakaoOAuthProvider {
+	return &KakaoOAuthProvider{
+		config:      config,
+		authURL:     "https://kauth.kakao.com/oauth/authorize",
+		tokenURL:    "https://kauth.kakao.com/oauth/token",
+		userInfoURL: "https://kapi.kakao.com/v2/user/me",
+		client:      tracing.NewClient("kakao", 10*time.Second),
+	}
+}
+
+func (p *KakaoOAuthProvider) Name() model.IdentityProvider {
+	return model.IdentityProviderKakao
+}
+
+func (p *KakaoOAuthProvider) AuthURL(state string) string {
+	query := url.Values{
+		"client_id":     {p.config.ClientID},
+		"redirect_uri":  {p.config.RedirectURI},
+		"response_type": {"code"},
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+	return p.authURL + "?" + query.Encode()
+}
+
+func (p *KakaoOAuthProvider) Exchange(ctx context.Context, code, state string) (*OAuthProfile, error) {
+	var token kakaoTokenResponse
+	if err := oauthPostForm(ctx, p.client, p.Name(), p.tokenURL, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+		"redirect_uri":  {p.config.RedirectURI},
+		"code":          {code},
+	}, &token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("kakao token response missing access_token")
+	}
+
+	var info kakaoUserInfo
+	if err := oauthGetJSON(ctx, p.client, p.Name(), p.userInfoURL, token.AccessToken, &info); err != nil {
+		return nil, err
+	}
+	if info.KakaoAccount.Email == "" {
+		return nil, ErrOAuthEmailRequired
+	}
+
+	return &OAuthProfile{
+		Provider:      model.IdentityProviderKakao,
+		Subject:       strconv.FormatInt(info.ID, 10),
+		Email:         info.KakaoAccount.Email,
+		EmailVerified: info.KakaoAccount.IsEmailValid && info.KakaoAccount.IsEmailVerified,
+		Name:          info.Properties.Nickname,
+		Phone:         normalizePhoneNumber(info.KakaoAccount.PhoneNumber),
+		ProfileImage:  getProfileImageURL(&info),
+		AccessToken:   token.AccessToken,
+	}, nil
+}
+
+// kakaoTokenResponse represents Kakao token response
+type kakaoTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// kakaoUserInfo represents Kakao user information
+type kakaoUserInfo struct {
+	ID           int64           `json:"id"`
+	ConnectedAt  string          `json:"connected_at"`
+	Properties   kakaoProperties `json:"properties"`
+	KakaoAccount kakaoAccount    `json:"kakao_account"`
+}
+
+type kakaoProperties struct {
+	Nickname       string `json:"nickname"`
+	ProfileImage   string `json:"profile_image"`
+	ThumbnailImage string `json:"thumbnail_image"`
+}
+
+type kakaoAccount struct {
+	Email                 string       `json:"email"`
+	ProfileNeedsAgreement bool         `json:"profile_needs_agreement"`
+	HasEmail              bool         `json:"has_email"`
+	IsEmailValid          bool         `json:"is_email_valid"`    // 만료되지 않은 이메일
+	IsEmailVerified       bool         `json:"is_email_verified"` // 카카오가 소유를 확인한 이메일
+	PhoneNumber           string       `json:"phone_number"`
+	HasPhoneNumber        bool         `json:"has_phone_number"`
+	Profile               kakaoProfile `json:"profile"`
+}
+
+type kakaoProfile struct {
+	Nickname        string `json:"nickname"`
+	ProfileImageURL string `json:"profile_image_url"`
+	ThumbnailURL    string `json:"thumbnail_image_url"`
+	IsDefaultImage  bool   `json:"is_default_image"`
+}
+
+// getProfileImageURL returns the best available profile image URL from Kakao
+func getProfileImageURL(kakaoUserInfo *kakaoUserInfo) string {
+	// Priority: kakao_account.profile.profile_image_url > properties.profile_image
+	if kakaoUserInfo.KakaoAccount.Profile.ProfileImageURL != "" &&
+		!kakaoUserInfo.KakaoAccount.Profile.IsDefaultImage {
+		return kakaoUserInfo.KakaoAccount.Profile.ProfileImageURL
+	}
+
+	if kakaoUserInfo.Properties.ProfileImage != "" {
+		return kakaoUserInfo.Properties.ProfileImage
+	}
+
+	return ""
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+)
+
+// NaverOAuthProvider 네이버 로그인
+type NaverOAuthProvider struct {
+	config      OAuthClientConfig
+	authURL     string
+	tokenURL    string
+	userInfoURL string
+	client      *http.Client
+}
+
+func NewNaverOAuthProvider(config OAuthClientConfig) *NaverOAuthProvider {
+	return &NaverOAuthProvider{
+		config:      config,
+		authURL:     "https://nid.naver.com/oauth2.0/authorize",
+		tokenURL:    "https://nid.naver.com/oauth2.0/token",
+		userInfoURL: "https://openapi.naver.com/v1/nid/me",
+		client:      tracing.NewClient("naver", 10*time.Second),
+	}
+}
+
+func (p *NaverOAuthProvider) Name() model.IdentityProvider {
+	return model.IdentityProviderNaver
+}
+
+// AuthURL 네이버는 state가 필수이고 토큰 요청에도 같은 값을 보내야 함
+func (p *NaverOAuthProvider) AuthURL(state string) string {
+	query := url.Values{
+		"client_id":     {p.config.ClientID},
+		"redirect_uri":  {p.config.RedirectURI},
+		"response_type": {"code"},
+		"state":         {state},
+	}
+	return p.authURL + "?" + query.Encode()
+}
+
+func (p *NaverOAuthProvider) Exchange(ctx context.Context, code, state string) (*OAuthProfile, error) {
+	var token naverTokenResponse
+	if err := oauthPostForm(ctx, p.client, p.Name(), p.tokenURL, url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+		"code":          {code},
+		"state":         {state},
+	}, &token); err != nil {
+		return nil, err
+	}
+	// 네이버는 잘못된 인가 코드도 200으로 응답하고 error 필드로 알려줌
+	if token.Error != "" {
+		return nil, fmt.Errorf("%w: naver token request failed: %s (%s)", ErrOAuthRejected, token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("naver token response missing access_token")
+	}
+
+	var info naverUserInfo
+	if err := oauthGetJSON(ctx, p.client, p.Name(), p.userInfoURL, token.AccessToken, &info); err != nil {
+		return nil, err
+	}
+	if info.ResultCode != "00" {
+		return nil, fmt.Errorf("naver user info request failed: %s %s", info.ResultCode, info.Message)
+	}
+	if info.Response.Email == "" {
+		return nil, ErrOAuthEmailRequired
+	}
+
+	name := info.Response.Name
+	if name == "" {
+		name = info.Response.Nickname
+	}
+	phone := info.Response.MobileE164
+	if phone == "" {
+		phone = info.Response.Mobile
+	}
+
+	// 네이버는 이메일 인증 여부를 알려주지 않고 연락처 이메일을 사용자가 바꿀 수 있으므로
+	// 확인되지 않은 이메일로 취급 (같은 이메일의 기존 계정에 자동으로 연결하지 않음)
+	return &OAuthProfile{
+		Provider:      model.IdentityProviderNaver,
+		Subject:       info.Response.ID,
+		Email:         info.Response.Email,
+		EmailVerified: false,
+		Name:          name,
+		Phone:         normalizePhoneNumber(phone),
+		ProfileImage:  info.Response.ProfileImage,
+		AccessToken:   token.AccessToken,
+	}, nil
+}
+
+// naverTokenResponse 네이버 토큰 응답 (실패 시 error/error_description)
+type naverTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        string `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// naverUserInfo 네이버 회원 프로필 조회 응답
+type naverUserInfo struct {
+	ResultCode string `json:"resultcode"`
+	Message    string `json:"message"`
+	Response   struct {
+		ID           string `json:"id"`
+		Email        string `json:"email"`
+		Name         string `json:"name"`
+		Nickname     string `json:"nickname"`
+		ProfileImage string `json:"profile_image"`
+		Mobile       string `json:"mobile"`      // 010-1234-5678
+		MobileE164   string `json:"mobile_e164"` // +821012345678
+	} `json:"response"`
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+)
+
+var (
+	ErrOAuthRejected        = errors.New("로그인 제공자 인증에 실패했습니다. 다시 시도해주세요")
+	ErrOAuthEmailRequired   = errors.New("로그인 시 이메일 제공 동의가 필요합니다")
+	ErrOAuthEmailUnverified = errors.New("로그인 제공자에서 인증을 마친 이메일만 사용할 수 있습니다")
+	ErrOAuthStateInvalid    = errors.New("유효하지 않거나 만료된 로그인 요청입니다. 처음부터 다시 시도해주세요")
+)
+
+// OAuthStateTTL 로그인 URL을 발급한 뒤 콜백까지 허용하는 시간
+const OAuthStateTTL = 10 * time.Minute
+
+// OAuthProfile 외부 로그인 제공자에서 받은 사용자 정보
+type OAuthProfile struct {
+	Provider      model.IdentityProvider
+	Subject       string // 제공자의 사용자 ID
+	Email         string
+	EmailVerified bool // 제공자가 이메일 소유를 확인함 (확인되지 않은 이메일로는 기존 계정에 자동 연결하지 않음)
+	Name          string
+	Phone         string
+	ProfileImage  string
+	AccessToken   string // 제공자 API 호출용 access token
+}
+
+// OAuthProvider 인가 코드 방식의 외부 로그인 제공자
+type OAuthProvider interface {
+	Name() model.IdentityProvider
+	// AuthURL 사용자를 보낼 제공자 로그인 페이지 주소
+	AuthURL(state string) string
+	// Exchange 인가 코드를 토큰으로 바꾸고 사용자 정보를 조회
+	Exchange(ctx context.Context, code, state string) (*OAuthProfile, error)
+}
+
+// OAuthClientConfig 제공자에 등록한 앱 정보
+type OAuthClientConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+}
+
+// oauthPostForm 토큰 엔드포인트에 form 요청을 보내고 JSON 응답을 out에 담음
+func oauthPostForm(ctx context.Context, client *http.Client, provider model.IdentityProvider, endpoint string, form url.Values, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return oauthDo(client, provider, "token request", req, out)
+}
+
+// oauthGetJSON access token으로 사용자 정보 엔드포인트를 조회
+func oauthGetJSON(ctx context.Context, client *http.Client, provider model.IdentityProvider, endpoint, accessToken string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	return oauthDo(client, provider, "user info request", req, out)
+}
+
+func oauthDo(client *http.Client, provider model.IdentityProvider, step string, req *http.Request, out interface{}) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", provider, step, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", provider, step, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		// 400/401은 잘못되었거나 이미 사용된 인가 코드/토큰
+		if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf("%w: %s %s failed with status %d: %s", ErrOAuthRejected, provider, step, resp.StatusCode, body)
+		}
+		return fmt.Errorf("%s %s failed with status %d: %s", provider, step, resp.StatusCode, body)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("%s %s: invalid response: %w", provider, step, err)
+	}
+	return nil
+}
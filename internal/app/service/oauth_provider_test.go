@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNaverOAuthProvider_Exchange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "state-1", r.PostForm.Get("state"))
+			if r.PostForm.Get("code") != "good-code" {
+				// 네이버는 실패도 200으로 응답
+				json.NewEncoder(w).Encode(map[string]string{"error": "invalid_request", "error_description": "no valid data in session"})
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]string{"access_token": "naver-access", "token_type": "bearer"})
+		case "/me":
+			assert.Equal(t, "Bearer naver-access", r.Header.Get("Authorization"))
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"resultcode": "00",
+				"message":    "success",
+				"response": map[string]string{
+					"id":          "naver-123",
+					"email":       "naver@example.com",
+					"nickname":    "네이버닉",
+					"mobile_e164": "+821012345678",
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	provider := NewNaverOAuthProvider(OAuthClientConfig{ClientID: "naver-client", ClientSecret: "secret"})
+	provider.tokenURL = server.URL + "/token"
+	provider.userInfoURL = server.URL + "/me"
+
+	profile, err := provider.Exchange(context.Background(), "good-code", "state-1")
+	require.NoError(t, err)
+	assert.Equal(t, model.IdentityProviderNaver, profile.Provider)
+	assert.Equal(t, "naver-123", profile.Subject)
+	assert.Equal(t, "naver@example.com", profile.Email)
+	assert.Equal(t, "네이버닉", profile.Name)
+	assert.Equal(t, "01012345678", profile.Phone)
+	// 네이버는 이메일 인증 여부를 알려주지 않으므로 기존 계정에 자동 연결하지 않음
+	assert.False(t, profile.EmailVerified)
+
+	_, err = provider.Exchange(context.Background(), "bad-code", "state-1")
+	assert.ErrorIs(t, err, ErrOAuthRejected)
+}
+
+func TestAppleOAuthProvider_Exchange(t *testing.T) {
+	signingKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(signingKey)
+	require.NoError(t, err)
+	privateKeyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	appleKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	emailVerified := "true"
+	idToken := func(audience string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":   appleIssuer,
+			"aud":   audience,
+			"sub":   "apple-001",
+			"email": "hidden@privaterelay.appleid.com",
+			// 애플은 email_verified를 문자열로 보냄
+			"email_verified": emailVerified,
+			"iat":            time.Now().Unix(),
+			"exp":            time.Now().Add(10 * time.Minute).Unix(),
+		})
+		token.Header["kid"] = "apple-key"
+		signed, err := token.SignedString(appleKey)
+		require.NoError(t, err)
+		return signed
+	}
+
+	audience := "com.example.web"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/token":
+			require.NoError(t, r.ParseForm())
+			// client secret은 팀 ID/클라이언트 ID로 ES256 서명한 JWT
+			secret, err := jwt.ParseWithClaims(r.PostForm.Get("client_secret"), &jwt.RegisteredClaims{},
+				func(token *jwt.Token) (interface{}, error) {
+					assert.Equal(t, "KEY123", token.Header["kid"])
+					return &signingKey.PublicKey, nil
+				},
+				jwt.WithValidMethods([]string{"ES256"}),
+				jwt.WithIssuer("TEAM123"),
+				jwt.WithAudience(appleIssuer),
+			)
+			if !assert.NoError(t, err) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			subject, _ := secret.Claims.GetSubject()
+			assert.Equal(t, "com.example.web", subject)
+			json.NewEncoder(w).Encode(map[string]string{"access_token": "apple-access", "id_token": idToken(audience)})
+		case "/auth/keys":
+			json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "apple-key",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(appleKey.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(appleKey.E)).Bytes()),
+			}}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewAppleOAuthProvider(AppleOAuthConfig{
+		ClientID:      "com.example.web",
+		TeamID:        "TEAM123",
+		KeyID:         "KEY123",
+		PrivateKeyPEM: privateKeyPEM,
+		RedirectURI:   "https://api.example.com/api/v1/auth/apple/callback",
+	})
+	require.NoError(t, err)
+	provider.tokenURL = server.URL + "/auth/token"
+	provider.keysURL = server.URL + "/auth/keys"
+
+	authURL, err := url.Parse(provider.AuthURL("state-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "form_post", authURL.Query().Get("response_mode"))
+
+	profile, err := provider.Exchange(context.Background(), "code", "state-1")
+	require.NoError(t, err)
+	assert.Equal(t, model.IdentityProviderApple, profile.Provider)
+	assert.Equal(t, "apple-001", profile.Subject)
+	assert.Equal(t, "hidden@privaterelay.appleid.com", profile.Email)
+	assert.True(t, profile.EmailVerified)
+
+	// 다른 앱에 발급된 id_token은 거부
+	audience = "com.other.app"
+	_, err = provider.Exchange(context.Background(), "code", "state-1")
+	assert.ErrorIs(t, err, ErrOAuthRejected)
+
+	// 확인되지 않은 이메일은 거부
+	audience = "com.example.web"
+	emailVerified = "false"
+	_, err = provider.Exchange(context.Background(), "code", "state-1")
+	assert.ErrorIs(t, err, ErrOAuthEmailUnverified)
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+
 	"crypto/rand"
 	"encoding/hex"
 	"errors"
@@ -27,8 +29,8 @@ const (
 )
 
 type PasswordResetService interface {
-	RequestReset(email string) error
-	ResetPassword(token, newPassword string) error
+	RequestReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
 }
 
 type passwordResetService struct {
@@ -46,23 +48,24 @@ func NewPasswordResetService(
 	}
 }
 
-func (s *passwordResetService) RequestReset(email string) error {
-	logger.Info("Processing password reset request", map[string]interface{}{
+func (s *passwordResetService) RequestReset(ctx context.Context, email string) error {
+	log := logger.FromContext(ctx)
+	log.Info("Processing password reset request", map[string]interface{}{
 		"email": email,
 	})
 
 	// Check if user exists (but don't reveal this information to prevent user enumeration)
-	user, err := s.userRepo.FindByEmail(email)
+	user, err := s.userRepo.FindByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			// For security, we don't reveal if email exists or not
-			logger.Warn("Password reset requested for non-existent email", map[string]interface{}{
+			log.Warn("Password reset requested for non-existent email", map[string]interface{}{
 				"email": email,
 			})
 			// Return success to prevent user enumeration
 			return nil
 		}
-		logger.Error("Failed to find user for password reset", err, map[string]interface{}{
+		log.Error("Failed to find user for password reset", err, map[string]interface{}{
 			"email": email,
 		})
 		return err
@@ -71,7 +74,7 @@ func (s *passwordResetService) RequestReset(email string) error {
 	// Generate secure random token
 	token, err := generateResetToken()
 	if err != nil {
-		logger.Error("Failed to generate reset token", err, map[string]interface{}{
+		log.Error("Failed to generate reset token", err, map[string]interface{}{
 			"email": email,
 		})
 		return err
@@ -85,8 +88,8 @@ func (s *passwordResetService) RequestReset(email string) error {
 		Used:      false,
 	}
 
-	if err := s.resetRepo.Create(reset); err != nil {
-		logger.Error("Failed to create password reset record", err, map[string]interface{}{
+	if err := s.resetRepo.Create(ctx, reset); err != nil {
+		log.Error("Failed to create password reset record", err, map[string]interface{}{
 			"email": email,
 		})
 		return err
@@ -94,14 +97,14 @@ func (s *passwordResetService) RequestReset(email string) error {
 
 	// Send password reset email
 	if err := util.SendPasswordResetEmail(email, token); err != nil {
-		logger.Error("Failed to send password reset email", err, map[string]interface{}{
+		log.Error("Failed to send password reset email", err, map[string]interface{}{
 			"email": email,
 		})
 		// 이메일 전송 실패해도 토큰은 생성되었으므로 에러를 반환하지 않음
 		// 개발 모드에서는 로그에 토큰이 출력됨
 	}
 
-	logger.Info("Password reset email sent", map[string]interface{}{
+	log.Info("Password reset email sent", map[string]interface{}{
 		"email":      email,
 		"expires_at": reset.ExpiresAt,
 		"user_id":    user.ID,
@@ -110,23 +113,24 @@ func (s *passwordResetService) RequestReset(email string) error {
 	return nil
 }
 
-func (s *passwordResetService) ResetPassword(token, newPassword string) error {
-	logger.Info("Processing password reset with token")
+func (s *passwordResetService) ResetPassword(ctx context.Context, token, newPassword string) error {
+	log := logger.FromContext(ctx)
+	log.Info("Processing password reset with token")
 
 	// Find reset record by token
-	reset, err := s.resetRepo.FindByToken(token)
+	reset, err := s.resetRepo.FindByToken(ctx, token)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("Invalid reset token provided", nil)
+			log.Warn("Invalid reset token provided", nil)
 			return ErrInvalidResetToken
 		}
-		logger.Error("Failed to find reset record", err, nil)
+		log.Error("Failed to find reset record", err, nil)
 		return err
 	}
 
 	// Check if token has expired
 	if time.Now().After(reset.ExpiresAt) {
-		logger.Warn("Reset token has expired", map[string]interface{}{
+		log.Warn("Reset token has expired", map[string]interface{}{
 			"email":      reset.Email,
 			"expires_at": reset.ExpiresAt,
 		})
@@ -135,16 +139,16 @@ func (s *passwordResetService) ResetPassword(token, newPassword string) error {
 
 	// Check if token has already been used
 	if reset.Used {
-		logger.Warn("Reset token has already been used", map[string]interface{}{
+		log.Warn("Reset token has already been used", map[string]interface{}{
 			"email": reset.Email,
 		})
 		return ErrResetTokenUsed
 	}
 
 	// Find user by email
-	user, err := s.userRepo.FindByEmail(reset.Email)
+	user, err := s.userRepo.FindByEmail(ctx, reset.Email)
 	if err != nil {
-		logger.Error("Failed to find user for password reset", err, map[string]interface{}{
+		log.Error("Failed to find user for password reset", err, map[string]interface{}{
 			"email": reset.Email,
 		})
 		return err
@@ -153,7 +157,7 @@ func (s *passwordResetService) ResetPassword(token, newPassword string) error {
 	// Hash new password
 	hashedPassword, err := util.HashPassword(newPassword)
 	if err != nil {
-		logger.Error("Failed to hash new password", err, map[string]interface{}{
+		log.Error("Failed to hash new password", err, map[string]interface{}{
 			"user_id": user.ID,
 		})
 		return err
@@ -161,22 +165,22 @@ func (s *passwordResetService) ResetPassword(token, newPassword string) error {
 
 	// Update user password
 	user.PasswordHash = hashedPassword
-	if err := s.userRepo.Update(user); err != nil {
-		logger.Error("Failed to update user password", err, map[string]interface{}{
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		log.Error("Failed to update user password", err, map[string]interface{}{
 			"user_id": user.ID,
 		})
 		return err
 	}
 
 	// Mark reset token as used
-	if err := s.resetRepo.MarkAsUsed(reset.ID); err != nil {
-		logger.Error("Failed to mark reset token as used", err, map[string]interface{}{
+	if err := s.resetRepo.MarkAsUsed(ctx, reset.ID); err != nil {
+		log.Error("Failed to mark reset token as used", err, map[string]interface{}{
 			"reset_id": reset.ID,
 		})
 		// Don't return error as password was already updated
 	}
 
-	logger.Info("Password reset successful", map[string]interface{}{
+	log.Info("Password reset successful", map[string]interface{}{
 		"user_id": user.ID,
 		"email":   user.Email,
 	})
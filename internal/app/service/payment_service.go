@@ -0,0 +1,541 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/payment/kakaopay"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPaymentNotFound       = errors.New("결제 정보를 찾을 수 없습니다")
+	ErrPaymentAccessDenied   = errors.New("결제 접근 권한이 없습니다")
+	ErrPaymentInvalidState   = errors.New("현재 결제 상태에서는 처리할 수 없습니다")
+	ErrPaymentUnavailable    = errors.New("결제 서비스가 설정되지 않았습니다")
+	ErrPaymentGatewayFailed  = errors.New("결제 대행사 요청에 실패했습니다")
+	ErrPaymentAmountMismatch = errors.New("승인 금액이 주문 금액과 일치하지 않습니다")
+	ErrPaymentInvalidTarget  = errors.New("결제 대상이 올바르지 않습니다")
+	ErrPaymentEscrowManaged  = errors.New("안전거래 결제는 안전거래 절차로만 취소할 수 있습니다")
+)
+
+// PaymentListener 결제 상태 변경 수신자 (에스크로 등 결제에 연동되는 기능에서 구현)
+type PaymentListener interface {
+	// OnPaymentApproved 결제가 승인되었을 때 호출
+	OnPaymentApproved(ctx context.Context, payment *model.Payment)
+	// OnPaymentClosed 결제가 실패/취소/환불로 종료되었을 때 호출
+	OnPaymentClosed(ctx context.Context, payment *model.Payment)
+}
+
+// PaymentService 결제 서비스 인터페이스
+type PaymentService interface {
+	// Ready 주문을 생성하고 카카오페이 결제 준비(TID 발급)를 요청
+	Ready(ctx context.Context, userID uint, req *model.CreatePaymentRequest) (*model.PaymentReadyResponse, error)
+	// Approve 카카오페이 승인 콜백(pg_token) 처리
+	Approve(ctx context.Context, orderID, pgToken string) (*model.Payment, error)
+	// Fail 카카오페이 실패 콜백 처리
+	Fail(ctx context.Context, orderID, reason string) (*model.Payment, error)
+	// Abort 사용자가 카카오페이 결제창에서 취소한 경우 처리
+	Abort(ctx context.Context, orderID string) (*model.Payment, error)
+	// Cancel 결제 취소 (승인 완료 건은 전액 환불)
+	Cancel(ctx context.Context, paymentID, userID uint, userRole model.UserRole) (*model.Payment, error)
+	// Refund 시스템 요청에 의한 결제 취소/환불 (권한 검사 없음, 에스크로 결제 포함)
+	Refund(ctx context.Context, paymentID uint) (*model.Payment, error)
+	// AddListener 결제 상태 변경 수신자 등록
+	AddListener(listener PaymentListener)
+
+	GetPayment(ctx context.Context, paymentID, userID uint, userRole model.UserRole) (*model.Payment, error)
+	GetUserPayments(ctx context.Context, userID uint, page, pageSize int) ([]model.Payment, int64, error)
+}
+
+type paymentService struct {
+	repo          repository.PaymentRepository
+	storeRepo     repository.StoreRepository
+	communityRepo repository.CommunityRepository
+	kakaoPay      *kakaopay.Client
+	listeners     []PaymentListener
+}
+
+// NewPaymentService 결제 서비스 생성자
+// kakaoPay가 nil이면 결제 요청 시 ErrPaymentUnavailable을 반환
+func NewPaymentService(
+	repo repository.PaymentRepository,
+	storeRepo repository.StoreRepository,
+	communityRepo repository.CommunityRepository,
+	kakaoPay *kakaopay.Client,
+) PaymentService {
+	return &paymentService{
+		repo:          repo,
+		storeRepo:     storeRepo,
+		communityRepo: communityRepo,
+		kakaoPay:      kakaoPay,
+	}
+}
+
+// Ready 결제 준비
+func (s *paymentService) Ready(ctx context.Context, userID uint, req *model.CreatePaymentRequest) (*model.PaymentReadyResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if s.kakaoPay == nil {
+		return nil, ErrPaymentUnavailable
+	}
+
+	storeID, postID, err := s.resolveTarget(ctx, userID, req.StoreID, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity := req.Quantity
+	if quantity < 1 {
+		quantity = 1
+	}
+
+	payment := &model.Payment{
+		OrderID:     generateOrderID(),
+		ItemName:    req.ItemName,
+		Quantity:    quantity,
+		UserID:      userID,
+		StoreID:     storeID,
+		PostID:      postID,
+		Escrow:      req.Escrow,
+		TotalAmount: req.TotalAmount,
+		Provider:    model.PaymentProviderKakaoPay,
+		Status:      model.PaymentStatusPending,
+	}
+
+	if err := s.repo.Create(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to create payment: %w", err)
+	}
+
+	// 승인/실패/취소 콜백에서 주문을 찾을 수 있도록 order_id를 붙여서 전달
+	cfg := s.kakaoPay.GetConfig()
+	readyResp, err := s.kakaoPay.Ready(ctx, kakaopay.ReadyRequest{
+		PartnerOrderID: payment.OrderID,
+		PartnerUserID:  partnerUserID(userID),
+		ItemName:       payment.ItemName,
+		Quantity:       payment.Quantity,
+		TotalAmount:    payment.TotalAmount,
+		TaxFreeAmount:  payment.TaxFreeAmount,
+		ApprovalURL:    withOrderID(cfg.ApprovalURL, payment.OrderID),
+		FailURL:        withOrderID(cfg.FailURL, payment.OrderID),
+		CancelURL:      withOrderID(cfg.CancelURL, payment.OrderID),
+	})
+	if err != nil {
+		log.Error("KakaoPay ready request failed", err, map[string]interface{}{
+			"order_id": payment.OrderID,
+			"user_id":  userID,
+		})
+		now := time.Now()
+		payment.Status = model.PaymentStatusFailed
+		payment.FailReason = err.Error()
+		payment.FailedAt = &now
+		if updateErr := s.repo.Update(ctx, payment); updateErr != nil {
+			log.Error("Failed to mark payment as failed", updateErr, map[string]interface{}{
+				"order_id": payment.OrderID,
+			})
+		}
+		return nil, fmt.Errorf("%w: %v", ErrPaymentGatewayFailed, err)
+	}
+
+	payment.TID = readyResp.TID
+	payment.Status = model.PaymentStatusReady
+	if err := s.repo.Update(ctx, payment); err != nil {
+		return nil, fmt.Errorf("failed to save payment tid: %w", err)
+	}
+
+	log.Info("Payment ready", map[string]interface{}{
+		"payment_id": payment.ID,
+		"order_id":   payment.OrderID,
+		"tid":        payment.TID,
+		"amount":     payment.TotalAmount,
+	})
+
+	return &model.PaymentReadyResponse{
+		Payment:               payment,
+		NextRedirectAppURL:    readyResp.NextRedirectAppURL,
+		NextRedirectMobileURL: readyResp.NextRedirectMobileURL,
+		NextRedirectPCURL:     readyResp.NextRedirectPCURL,
+		AndroidAppScheme:      readyResp.AndroidAppScheme,
+		IOSAppScheme:          readyResp.IOSAppScheme,
+	}, nil
+}
+
+// resolveTarget 결제 대상 매장/게시글 검증
+// 게시글에 매장이 연결되어 있고 매장이 지정되지 않은 경우 게시글의 매장을 사용
+func (s *paymentService) resolveTarget(ctx context.Context, userID uint, storeID, postID *uint) (*uint, *uint, error) {
+	if postID != nil {
+		post, err := s.communityRepo.GetPostByID(ctx, *postID, false)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, ErrPaymentInvalidTarget
+			}
+			return nil, nil, err
+		}
+		if post.Category != model.CategoryGoldTrade {
+			return nil, nil, ErrPaymentInvalidTarget
+		}
+		if post.UserID == userID {
+			return nil, nil, ErrPaymentInvalidTarget
+		}
+		if storeID == nil && post.StoreID != nil {
+			storeID = post.StoreID
+		}
+	}
+
+	if storeID != nil {
+		store, err := s.storeRepo.FindByID(ctx, *storeID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, ErrStoreNotFound
+			}
+			return nil, nil, err
+		}
+		// 소유자가 없는 매장은 대금을 받을 수 없음
+		if store.UserID == nil {
+			return nil, nil, ErrPaymentInvalidTarget
+		}
+	}
+
+	return storeID, postID, nil
+}
+
+// Approve 결제 승인
+func (s *paymentService) Approve(ctx context.Context, orderID, pgToken string) (*model.Payment, error) {
+	log := logger.FromContext(ctx)
+
+	if s.kakaoPay == nil {
+		return nil, ErrPaymentUnavailable
+	}
+
+	payment, err := s.findByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 이미 승인된 건은 그대로 반환 (콜백 중복 호출 대응)
+	if payment.Status == model.PaymentStatusApproved {
+		return payment, nil
+	}
+	if payment.Status != model.PaymentStatusReady {
+		return nil, ErrPaymentInvalidState
+	}
+
+	approveResp, err := s.kakaoPay.Approve(ctx, kakaopay.ApproveRequest{
+		TID:            payment.TID,
+		PartnerOrderID: payment.OrderID,
+		PartnerUserID:  partnerUserID(payment.UserID),
+		PgToken:        pgToken,
+	})
+	if err != nil {
+		log.Error("KakaoPay approve request failed", err, map[string]interface{}{
+			"payment_id": payment.ID,
+			"order_id":   payment.OrderID,
+		})
+		if _, failErr := s.Fail(ctx, orderID, err.Error()); failErr != nil && !errors.Is(failErr, ErrPaymentInvalidState) {
+			log.Error("Failed to mark payment as failed", failErr, map[string]interface{}{
+				"order_id": orderID,
+			})
+		}
+		return nil, fmt.Errorf("%w: %v", ErrPaymentGatewayFailed, err)
+	}
+
+	if approveResp.Amount.Total != payment.TotalAmount {
+		log.Error("Approved amount mismatch", ErrPaymentAmountMismatch, map[string]interface{}{
+			"payment_id":      payment.ID,
+			"expected_amount": payment.TotalAmount,
+			"approved_amount": approveResp.Amount.Total,
+		})
+		s.cancelMismatchedApproval(ctx, payment, approveResp)
+		return nil, ErrPaymentAmountMismatch
+	}
+
+	approvedAt := approveResp.ApprovedAt
+	if approvedAt.IsZero() {
+		approvedAt = time.Now()
+	}
+
+	ok, err := s.repo.TransitionStatus(ctx, payment.ID, model.PaymentStatusReady, map[string]interface{}{
+		"status":              model.PaymentStatusApproved,
+		"aid":                 approveResp.AID,
+		"payment_method_type": approveResp.PaymentMethodType,
+		"approved_at":         approvedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if !ok {
+		return nil, ErrPaymentInvalidState
+	}
+
+	log.Info("Payment approved", map[string]interface{}{
+		"payment_id": payment.ID,
+		"order_id":   payment.OrderID,
+		"amount":     payment.TotalAmount,
+	})
+
+	approved, err := s.repo.FindByID(ctx, payment.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, listener := range s.listeners {
+		listener.OnPaymentApproved(ctx, approved)
+	}
+	return approved, nil
+}
+
+// cancelMismatchedApproval 주문 금액과 다르게 승인된 결제는 승인된 금액 그대로 취소하고 실패 처리
+// (카카오페이 승인 시점에 이미 결제가 완료되었으므로 취소하지 않으면 대금이 묶임)
+func (s *paymentService) cancelMismatchedApproval(ctx context.Context, payment *model.Payment, approveResp *kakaopay.ApproveResponse) {
+	log := logger.FromContext(ctx)
+
+	reason := fmt.Sprintf("approved amount %d does not match order amount %d", approveResp.Amount.Total, payment.TotalAmount)
+
+	_, err := s.kakaoPay.Cancel(ctx, kakaopay.CancelRequest{
+		TID:                 payment.TID,
+		CancelAmount:        approveResp.Amount.Total,
+		CancelTaxFreeAmount: approveResp.Amount.TaxFree,
+	})
+	if err != nil {
+		// 취소 실패 시 수동 환불이 필요하므로 실패 사유에 남김
+		log.Error("Failed to cancel mismatched payment approval, manual refund required", err, map[string]interface{}{
+			"payment_id":      payment.ID,
+			"order_id":        payment.OrderID,
+			"approved_amount": approveResp.Amount.Total,
+		})
+		reason += "; cancel failed: " + err.Error()
+	} else {
+		reason += "; approval cancelled"
+	}
+
+	if _, err := s.Fail(ctx, payment.OrderID, reason); err != nil {
+		log.Error("Failed to mark payment as failed", err, map[string]interface{}{
+			"order_id": payment.OrderID,
+		})
+	}
+}
+
+// Fail 결제 실패 처리
+func (s *paymentService) Fail(ctx context.Context, orderID, reason string) (*model.Payment, error) {
+	return s.closeUnapproved(ctx, orderID, model.PaymentStatusFailed, reason)
+}
+
+// Abort 결제창에서 사용자가 취소
+func (s *paymentService) Abort(ctx context.Context, orderID string) (*model.Payment, error) {
+	return s.closeUnapproved(ctx, orderID, model.PaymentStatusCancelled, "")
+}
+
+// closeUnapproved 승인 전 결제를 실패/취소 상태로 종료
+func (s *paymentService) closeUnapproved(ctx context.Context, orderID string, status model.PaymentStatus, reason string) (*model.Payment, error) {
+	payment, err := s.findByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status == status {
+		return payment, nil
+	}
+	if payment.Status != model.PaymentStatusReady && payment.Status != model.PaymentStatusPending {
+		return nil, ErrPaymentInvalidState
+	}
+
+	now := time.Now()
+	updates := map[string]interface{}{"status": status}
+	if status == model.PaymentStatusFailed {
+		updates["fail_reason"] = reason
+		updates["failed_at"] = now
+	} else {
+		updates["canceled_at"] = now
+	}
+
+	ok, err := s.repo.TransitionStatus(ctx, payment.ID, payment.Status, updates)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if !ok {
+		return nil, ErrPaymentInvalidState
+	}
+
+	return s.closed(ctx, payment.ID)
+}
+
+// Cancel 결제 취소
+func (s *paymentService) Cancel(ctx context.Context, paymentID, userID uint, userRole model.UserRole) (*model.Payment, error) {
+	payment, err := s.GetPayment(ctx, paymentID, userID, userRole)
+	if err != nil {
+		return nil, err
+	}
+	if payment.Escrow {
+		return nil, ErrPaymentEscrowManaged
+	}
+
+	return s.cancel(ctx, payment)
+}
+
+// Refund 시스템 요청에 의한 결제 취소/환불
+func (s *paymentService) Refund(ctx context.Context, paymentID uint) (*model.Payment, error) {
+	payment, err := s.repo.FindByID(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	return s.cancel(ctx, payment)
+}
+
+// cancel 승인 전 결제는 취소, 승인 완료 결제는 전액 환불
+func (s *paymentService) cancel(ctx context.Context, payment *model.Payment) (*model.Payment, error) {
+	switch payment.Status {
+	case model.PaymentStatusPending, model.PaymentStatusReady:
+		return s.closeUnapproved(ctx, payment.OrderID, model.PaymentStatusCancelled, "")
+	case model.PaymentStatusApproved:
+		return s.refund(ctx, payment)
+	default:
+		return nil, ErrPaymentInvalidState
+	}
+}
+
+// refund 승인 완료된 결제 전액 환불
+func (s *paymentService) refund(ctx context.Context, payment *model.Payment) (*model.Payment, error) {
+	log := logger.FromContext(ctx)
+
+	if s.kakaoPay == nil {
+		return nil, ErrPaymentUnavailable
+	}
+
+	cancelAmount := payment.TotalAmount - payment.CanceledAmount
+	cancelResp, err := s.kakaoPay.Cancel(ctx, kakaopay.CancelRequest{
+		TID:                 payment.TID,
+		CancelAmount:        cancelAmount,
+		CancelTaxFreeAmount: payment.TaxFreeAmount,
+	})
+	if err != nil {
+		log.Error("KakaoPay cancel request failed", err, map[string]interface{}{
+			"payment_id": payment.ID,
+			"order_id":   payment.OrderID,
+		})
+		return nil, fmt.Errorf("%w: %v", ErrPaymentGatewayFailed, err)
+	}
+
+	canceledAt := cancelResp.CanceledAt
+	if canceledAt.IsZero() {
+		canceledAt = time.Now()
+	}
+
+	ok, err := s.repo.TransitionStatus(ctx, payment.ID, model.PaymentStatusApproved, map[string]interface{}{
+		"status":          model.PaymentStatusCancelled,
+		"canceled_amount": payment.CanceledAmount + cancelAmount,
+		"canceled_at":     canceledAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update payment: %w", err)
+	}
+	if !ok {
+		return nil, ErrPaymentInvalidState
+	}
+
+	log.Info("Payment refunded", map[string]interface{}{
+		"payment_id":    payment.ID,
+		"order_id":      payment.OrderID,
+		"cancel_amount": cancelAmount,
+	})
+
+	return s.closed(ctx, payment.ID)
+}
+
+// AddListener 결제 상태 변경 수신자 등록 (서버 시작 시에만 호출)
+func (s *paymentService) AddListener(listener PaymentListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// closed 종료된 결제를 다시 조회하고 수신자에게 알림
+func (s *paymentService) closed(ctx context.Context, paymentID uint) (*model.Payment, error) {
+	payment, err := s.repo.FindByID(ctx, paymentID)
+	if err != nil {
+		return nil, err
+	}
+	for _, listener := range s.listeners {
+		listener.OnPaymentClosed(ctx, payment)
+	}
+	return payment, nil
+}
+
+// GetPayment 결제 상세 조회 (결제자, 대금 수령 매장 소유자, 마스터만 가능)
+func (s *paymentService) GetPayment(ctx context.Context, paymentID, userID uint, userRole model.UserRole) (*model.Payment, error) {
+	payment, err := s.repo.FindByID(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, err
+	}
+
+	if payment.UserID == userID || userRole == model.RoleMaster {
+		return payment, nil
+	}
+
+	if payment.StoreID != nil && s.storeRepo != nil {
+		store, err := s.storeRepo.FindByID(ctx, *payment.StoreID)
+		if err == nil && store.UserID != nil && *store.UserID == userID {
+			return payment, nil
+		}
+	}
+
+	return nil, ErrPaymentAccessDenied
+}
+
+// GetUserPayments 내 결제 목록 조회
+func (s *paymentService) GetUserPayments(ctx context.Context, userID uint, page, pageSize int) ([]model.Payment, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	return s.repo.FindByUserID(ctx, userID, pageSize, (page-1)*pageSize)
+}
+
+func (s *paymentService) findByOrderID(ctx context.Context, orderID string) (*model.Payment, error) {
+	payment, err := s.repo.FindByOrderID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPaymentNotFound
+		}
+		return nil, err
+	}
+	return payment, nil
+}
+
+// generateOrderID 가맹점 주문번호 생성 (예: UDG-20260101120000-1a2b3c4d)
+func generateOrderID() string {
+	return fmt.Sprintf("UDG-%s-%s", time.Now().Format("20060102150405"), strings.ReplaceAll(uuid.New().String(), "-", "")[:8])
+}
+
+func partnerUserID(userID uint) string {
+	return fmt.Sprintf("%d", userID)
+}
+
+// withOrderID 콜백 URL에 order_id 쿼리 파라미터 추가
+func withOrderID(rawURL, orderID string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	q.Set("order_id", orderID)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/pkg/payment/kakaopay"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// fakePaymentRepository 메모리 기반 결제 저장소 (테스트용)
+type fakePaymentRepository struct {
+	mu       sync.Mutex
+	payments map[uint]*model.Payment
+	nextID   uint
+}
+
+func newFakePaymentRepository() *fakePaymentRepository {
+	return &fakePaymentRepository{payments: make(map[uint]*model.Payment)}
+}
+
+func (r *fakePaymentRepository) Create(ctx context.Context, payment *model.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	payment.ID = r.nextID
+	copied := *payment
+	r.payments[payment.ID] = &copied
+	return nil
+}
+
+func (r *fakePaymentRepository) FindByID(ctx context.Context, id uint) (*model.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	payment, ok := r.payments[id]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	copied := *payment
+	return &copied, nil
+}
+
+func (r *fakePaymentRepository) FindByOrderID(ctx context.Context, orderID string) (*model.Payment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, payment := range r.payments {
+		if payment.OrderID == orderID {
+			copied := *payment
+			return &copied, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakePaymentRepository) FindByUserID(ctx context.Context, userID uint, limit, offset int) ([]model.Payment, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var payments []model.Payment
+	for _, payment := range r.payments {
+		if payment.UserID == userID {
+			payments = append(payments, *payment)
+		}
+	}
+	return payments, int64(len(payments)), nil
+}
+
+func (r *fakePaymentRepository) Update(ctx context.Context, payment *model.Payment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	copied := *payment
+	r.payments[payment.ID] = &copied
+	return nil
+}
+
+func (r *fakePaymentRepository) TransitionStatus(ctx context.Context, id uint, from model.PaymentStatus, updates map[string]interface{}) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	payment, ok := r.payments[id]
+	if !ok || payment.Status != from {
+		return false, nil
+	}
+	if status, ok := updates["status"].(model.PaymentStatus); ok {
+		payment.Status = status
+	}
+	if aid, ok := updates["aid"].(string); ok {
+		payment.AID = aid
+	}
+	if amount, ok := updates["canceled_amount"].(int64); ok {
+		payment.CanceledAmount = amount
+	}
+	if reason, ok := updates["fail_reason"].(string); ok {
+		payment.FailReason = reason
+	}
+	return true, nil
+}
+
+// newFakeKakaoPayServer 카카오페이 API 대역 서버
+func newFakeKakaoPayServer(t *testing.T, approvedAmount int64) (*httptest.Server, *[]string) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "SECRET_KEY test-admin-key", r.Header.Get("Authorization"))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "TC0ONETIME", body["cid"])
+
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/ready":
+			calls = append(calls, "ready")
+			approvalURL, err := url.Parse(body["approval_url"].(string))
+			require.NoError(t, err)
+			assert.Equal(t, body["partner_order_id"], approvalURL.Query().Get("order_id"))
+			w.Write([]byte(`{"tid":"T1234567890","next_redirect_pc_url":"https://mockup-pg-web.kakao.com/pc","created_at":"2026-01-01T12:00:00"}`))
+		case "/approve":
+			calls = append(calls, "approve")
+			assert.Equal(t, "T1234567890", body["tid"])
+			assert.Equal(t, "pg-token", body["pg_token"])
+			resp := map[string]interface{}{
+				"aid":                 "A1234567890",
+				"tid":                 "T1234567890",
+				"payment_method_type": "MONEY",
+				"amount":              map[string]interface{}{"total": approvedAmount},
+				"approved_at":         "2026-01-01T12:01:00",
+			}
+			json.NewEncoder(w).Encode(resp)
+		case "/cancel":
+			calls = append(calls, "cancel")
+			// 승인 금액이 주문과 달라도 승인된 금액 그대로 취소
+			assert.Equal(t, float64(approvedAmount), body["cancel_amount"])
+			w.Write([]byte(`{"tid":"T1234567890","status":"CANCEL_PAYMENT","canceled_at":"2026-01-01T12:02:00"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server, &calls
+}
+
+func newTestKakaoPayClient(t *testing.T, baseURL string) *kakaopay.Client {
+	client, err := kakaopay.NewClient(kakaopay.Config{
+		AdminKey:    "test-admin-key",
+		CID:         "TC0ONETIME",
+		BaseURL:     baseURL,
+		ApprovalURL: "http://localhost:8080/api/v1/payments/kakao/success",
+		FailURL:     "http://localhost:8080/api/v1/payments/kakao/fail",
+		CancelURL:   "http://localhost:8080/api/v1/payments/kakao/cancel",
+	})
+	require.NoError(t, err)
+	return client
+}
+
+func setupPaymentServiceTest(t *testing.T, approvedAmount int64) (PaymentService, *[]string) {
+	server, calls := newFakeKakaoPayServer(t, approvedAmount)
+	t.Cleanup(server.Close)
+
+	return NewPaymentService(newFakePaymentRepository(), nil, nil, newTestKakaoPayClient(t, server.URL)), calls
+}
+
+func TestPaymentService_ReadyApproveCancel(t *testing.T) {
+	ctx := context.Background()
+	paymentService, calls := setupPaymentServiceTest(t, 50000)
+
+	ready, err := paymentService.Ready(ctx, 1, &model.CreatePaymentRequest{
+		ItemName:    "금 거래 계약금",
+		TotalAmount: 50000,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusReady, ready.Payment.Status)
+	assert.Equal(t, "T1234567890", ready.Payment.TID)
+	assert.Equal(t, "https://mockup-pg-web.kakao.com/pc", ready.NextRedirectPCURL)
+
+	approved, err := paymentService.Approve(ctx, ready.Payment.OrderID, "pg-token")
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusApproved, approved.Status)
+	assert.Equal(t, "A1234567890", approved.AID)
+
+	// 승인 콜백이 중복 호출되어도 PG 승인 요청은 한 번만 발생
+	_, err = paymentService.Approve(ctx, ready.Payment.OrderID, "pg-token")
+	require.NoError(t, err)
+
+	// 다른 사용자는 취소 불가
+	_, err = paymentService.Cancel(ctx, approved.ID, 2, model.RoleUser)
+	assert.ErrorIs(t, err, ErrPaymentAccessDenied)
+
+	cancelled, err := paymentService.Cancel(ctx, approved.ID, 1, model.RoleUser)
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusCancelled, cancelled.Status)
+	assert.Equal(t, int64(50000), cancelled.CanceledAmount)
+
+	assert.Equal(t, []string{"ready", "approve", "cancel"}, *calls)
+}
+
+func TestPaymentService_ApproveAmountMismatch(t *testing.T) {
+	ctx := context.Background()
+	paymentService, calls := setupPaymentServiceTest(t, 10000)
+
+	ready, err := paymentService.Ready(ctx, 1, &model.CreatePaymentRequest{
+		ItemName:    "금 거래 계약금",
+		TotalAmount: 50000,
+	})
+	require.NoError(t, err)
+
+	_, err = paymentService.Approve(ctx, ready.Payment.OrderID, "pg-token")
+	assert.ErrorIs(t, err, ErrPaymentAmountMismatch)
+
+	// 이미 승인된 금액은 취소하고 결제는 실패로 종료
+	assert.Equal(t, []string{"ready", "approve", "cancel"}, *calls)
+	payment, err := paymentService.GetPayment(ctx, ready.Payment.ID, 1, model.RoleUser)
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusFailed, payment.Status)
+	assert.Contains(t, payment.FailReason, "approval cancelled")
+}
+
+func TestPaymentService_AbortBeforeApproval(t *testing.T) {
+	ctx := context.Background()
+	paymentService, _ := setupPaymentServiceTest(t, 50000)
+
+	ready, err := paymentService.Ready(ctx, 1, &model.CreatePaymentRequest{
+		ItemName:    "금 거래 계약금",
+		TotalAmount: 50000,
+	})
+	require.NoError(t, err)
+
+	aborted, err := paymentService.Abort(ctx, ready.Payment.OrderID)
+	require.NoError(t, err)
+	assert.Equal(t, model.PaymentStatusCancelled, aborted.Status)
+
+	_, err = paymentService.Approve(ctx, ready.Payment.OrderID, "pg-token")
+	assert.ErrorIs(t, err, ErrPaymentInvalidState)
+}
+
+func TestPaymentService_Unavailable(t *testing.T) {
+	ctx := context.Background()
+	paymentService := NewPaymentService(newFakePaymentRepository(), nil, nil, nil)
+
+	_, err := paymentService.Ready(ctx, 1, &model.CreatePaymentRequest{ItemName: "test", TotalAmount: 1000})
+	assert.ErrorIs(t, err, ErrPaymentUnavailable)
+}
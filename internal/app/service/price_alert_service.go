@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"gorm.io/gorm"
+)
+
+// maxPriceAlertsPerUser 사용자당 등록 가능한 시세 알림 수
+const maxPriceAlertsPerUser = 20
+
+var (
+	ErrPriceAlertNotFound      = errors.New("시세 알림을 찾을 수 없습니다")
+	ErrPriceAlertLimitExceeded = fmt.Errorf("시세 알림은 최대 %d개까지 등록할 수 있습니다", maxPriceAlertsPerUser)
+)
+
+// PriceAlertService 금 시세 알림 서비스 인터페이스
+type PriceAlertService interface {
+	ListAlerts(ctx context.Context, userID uint) ([]model.PriceAlert, error)
+	CreateAlert(ctx context.Context, userID uint, req *model.CreatePriceAlertRequest) (*model.PriceAlert, error)
+	UpdateAlert(ctx context.Context, alertID, userID uint, req *model.UpdatePriceAlertRequest) (*model.PriceAlert, error)
+	DeleteAlert(ctx context.Context, alertID, userID uint) error
+
+	// OnGoldPriceCreated 새 시세로 알림 규칙 평가 (GoldPriceListener)
+	OnGoldPriceCreated(ctx context.Context, price, previous *model.GoldPrice)
+}
+
+type priceAlertService struct {
+	repo                repository.PriceAlertRepository
+	goldPriceRepo       repository.GoldPriceRepository
+	notificationService NotificationService
+	now                 func() time.Time
+}
+
+// NewPriceAlertService 금 시세 알림 서비스 생성자 (금 시세 서비스에 수신자로 등록)
+func NewPriceAlertService(
+	repo repository.PriceAlertRepository,
+	goldPriceRepo repository.GoldPriceRepository,
+	notificationService NotificationService,
+	goldPriceService GoldPriceService,
+) PriceAlertService {
+	s := &priceAlertService{
+		repo:                repo,
+		goldPriceRepo:       goldPriceRepo,
+		notificationService: notificationService,
+		now:                 time.Now,
+	}
+	goldPriceService.AddListener(s)
+	return s
+}
+
+func (s *priceAlertService) ListAlerts(ctx context.Context, userID uint) ([]model.PriceAlert, error) {
+	return s.repo.FindByUserID(ctx, userID)
+}
+
+func (s *priceAlertService) CreateAlert(ctx context.Context, userID uint, req *model.CreatePriceAlertRequest) (*model.PriceAlert, error) {
+	count, err := s.repo.CountByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxPriceAlertsPerUser {
+		return nil, ErrPriceAlertLimitExceeded
+	}
+
+	alert := &model.PriceAlert{
+		UserID:    userID,
+		Type:      req.Type,
+		Condition: req.Condition,
+		Direction: req.Direction,
+		Threshold: req.Threshold,
+		IsActive:  true,
+	}
+	if err := s.repo.Create(ctx, alert); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+func (s *priceAlertService) UpdateAlert(ctx context.Context, alertID, userID uint, req *model.UpdatePriceAlertRequest) (*model.PriceAlert, error) {
+	alert, err := s.findOwned(ctx, alertID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Direction != nil {
+		alert.Direction = *req.Direction
+	}
+	if req.Threshold != nil {
+		alert.Threshold = *req.Threshold
+	}
+	if req.IsActive != nil {
+		alert.IsActive = *req.IsActive
+	}
+
+	if err := s.repo.Update(ctx, alert); err != nil {
+		return nil, err
+	}
+	return alert, nil
+}
+
+func (s *priceAlertService) DeleteAlert(ctx context.Context, alertID, userID uint) error {
+	if _, err := s.findOwned(ctx, alertID, userID); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, alertID)
+}
+
+// findOwned 본인 알림만 조회 (다른 사용자의 알림은 존재 여부도 노출하지 않음)
+func (s *priceAlertService) findOwned(ctx context.Context, alertID, userID uint) (*model.PriceAlert, error) {
+	alert, err := s.repo.FindByID(ctx, alertID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPriceAlertNotFound
+		}
+		return nil, err
+	}
+	if alert.UserID != userID {
+		return nil, ErrPriceAlertNotFound
+	}
+	return alert, nil
+}
+
+// OnGoldPriceCreated 새 시세가 조건을 만족하는 알림 규칙에 대해 알림 발송
+// 시세 저장 자체는 이미 끝났으므로 오류는 로그만 남김
+func (s *priceAlertService) OnGoldPriceCreated(ctx context.Context, price, previous *model.GoldPrice) {
+	log := logger.FromContext(ctx)
+
+	alerts, err := s.repo.FindActiveByType(ctx, price.Type)
+	if err != nil {
+		log.Error("Failed to load price alerts", err, map[string]interface{}{
+			"type": price.Type,
+		})
+		return
+	}
+	if len(alerts) == 0 {
+		return
+	}
+
+	// 전일 대비 변동률: 당일(KST) 이전의 마지막 시세 기준 (주말/휴장일 다음 날은 직전 거래일)
+	// 이전 시세가 없으면 변동률 알림은 평가하지 않음
+	var changePercent *float64
+	sourceDate := price.SourceDate.In(kst)
+	startOfDay := time.Date(sourceDate.Year(), sourceDate.Month(), sourceDate.Day(), 0, 0, 0, 0, kst)
+	previousDay, err := s.goldPriceRepo.FindLatestBefore(ctx, price.Type, startOfDay)
+	if err != nil {
+		log.Warn("Failed to load previous day gold price", map[string]interface{}{
+			"type":  price.Type,
+			"error": err.Error(),
+		})
+	} else if previousDay != nil && previousDay.SellPrice > 0 {
+		percent := (price.SellPrice - previousDay.SellPrice) / previousDay.SellPrice * 100
+		changePercent = &percent
+	}
+
+	now := s.now()
+	triggered := 0
+	for i := range alerts {
+		alert := &alerts[i]
+		if !shouldTriggerPriceAlert(alert, price, previous, changePercent, now) {
+			continue
+		}
+
+		if err := s.notificationService.CreatePriceAlertNotification(ctx, alert, price, changePercent); err != nil {
+			log.Error("Failed to send price alert notification", err, map[string]interface{}{
+				"alert_id": alert.ID,
+				"user_id":  alert.UserID,
+			})
+			continue
+		}
+		if err := s.repo.MarkTriggered(ctx, alert.ID, now, price.SellPrice); err != nil {
+			log.Error("Failed to mark price alert triggered", err, map[string]interface{}{
+				"alert_id": alert.ID,
+			})
+		}
+		triggered++
+	}
+
+	if triggered > 0 {
+		log.Info("Price alerts triggered", map[string]interface{}{
+			"type":      price.Type,
+			"price":     price.SellPrice,
+			"triggered": triggered,
+		})
+	}
+}
+
+// shouldTriggerPriceAlert 알림 규칙 평가
+//   - threshold: 직전 시세가 기준 가격 반대편에 있다가 넘어선 경우에만 (기준선 근처에서 반복 발송 방지)
+//   - percent_change: 전일 대비 변동률이 기준 이상이면 하루 한 번만
+func shouldTriggerPriceAlert(alert *model.PriceAlert, price, previous *model.GoldPrice, changePercent *float64, now time.Time) bool {
+	switch alert.Condition {
+	case model.PriceAlertConditionThreshold:
+		if previous == nil {
+			return false
+		}
+		if alert.Direction == model.PriceAlertDirectionAbove {
+			return previous.SellPrice < alert.Threshold && price.SellPrice >= alert.Threshold
+		}
+		return previous.SellPrice > alert.Threshold && price.SellPrice <= alert.Threshold
+
+	case model.PriceAlertConditionPercentChange:
+		if changePercent == nil {
+			return false
+		}
+		if alert.LastTriggeredAt != nil && sameDay(*alert.LastTriggeredAt, now) {
+			return false
+		}
+		if alert.Direction == model.PriceAlertDirectionAbove {
+			return *changePercent >= alert.Threshold
+		}
+		return *changePercent <= -alert.Threshold
+	}
+	return false
+}
+
+func sameDay(a, b time.Time) bool {
+	a = a.In(b.Location())
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay()
+}
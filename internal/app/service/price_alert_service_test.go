@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePriceAlertRepository 메모리 기반 시세 알림 저장소 (테스트용)
+type fakePriceAlertRepository struct {
+	repository.PriceAlertRepository
+	alerts []model.PriceAlert
+}
+
+func (r *fakePriceAlertRepository) FindActiveByType(ctx context.Context, priceType model.GoldPriceType) ([]model.PriceAlert, error) {
+	var result []model.PriceAlert
+	for _, alert := range r.alerts {
+		if alert.Type == priceType && alert.IsActive {
+			result = append(result, alert)
+		}
+	}
+	return result, nil
+}
+
+func (r *fakePriceAlertRepository) MarkTriggered(ctx context.Context, id uint, at time.Time, price float64) error {
+	for i := range r.alerts {
+		if r.alerts[i].ID == id {
+			r.alerts[i].LastTriggeredAt = &at
+			r.alerts[i].LastTriggeredPrice = &price
+		}
+	}
+	return nil
+}
+
+type fakeGoldPriceRepository struct {
+	repository.GoldPriceRepository
+	previousDay *model.GoldPrice
+}
+
+func (r *fakeGoldPriceRepository) FindLatestBefore(ctx context.Context, priceType model.GoldPriceType, before time.Time) (*model.GoldPrice, error) {
+	if r.previousDay == nil || !r.previousDay.SourceDate.Before(before) {
+		return nil, nil
+	}
+	return r.previousDay, nil
+}
+
+type fakeGoldPriceService struct {
+	GoldPriceService
+	listeners []GoldPriceListener
+}
+
+func (s *fakeGoldPriceService) AddListener(listener GoldPriceListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+type recordingNotificationService struct {
+	NotificationService
+	alertIDs []uint
+}
+
+func (s *recordingNotificationService) CreatePriceAlertNotification(ctx context.Context, alert *model.PriceAlert, price *model.GoldPrice, changePercent *float64) error {
+	s.alertIDs = append(s.alertIDs, alert.ID)
+	return nil
+}
+
+func TestPriceAlertService_OnGoldPriceCreated(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	repo := &fakePriceAlertRepository{alerts: []model.PriceAlert{
+		{ID: 1, Type: model.Gold24K, Condition: model.PriceAlertConditionThreshold, Direction: model.PriceAlertDirectionAbove, Threshold: 300000, IsActive: true},
+		{ID: 2, Type: model.Gold24K, Condition: model.PriceAlertConditionThreshold, Direction: model.PriceAlertDirectionBelow, Threshold: 290000, IsActive: true},
+		{ID: 3, Type: model.Gold24K, Condition: model.PriceAlertConditionPercentChange, Direction: model.PriceAlertDirectionAbove, Threshold: 2, IsActive: true},
+		{ID: 4, Type: model.Gold24K, Condition: model.PriceAlertConditionPercentChange, Direction: model.PriceAlertDirectionBelow, Threshold: 2, IsActive: true},
+		{ID: 5, Type: model.Gold24K, Condition: model.PriceAlertConditionThreshold, Direction: model.PriceAlertDirectionAbove, Threshold: 300000, IsActive: false},
+		{ID: 6, Type: model.Gold18K, Condition: model.PriceAlertConditionThreshold, Direction: model.PriceAlertDirectionAbove, Threshold: 200000, IsActive: true},
+	}}
+	// 월요일 시세의 비교 기준은 직전 거래일(금요일) 시세
+	goldPriceRepo := &fakeGoldPriceRepository{previousDay: &model.GoldPrice{Type: model.Gold24K, SellPrice: 294000, SourceDate: now.AddDate(0, 0, -3)}}
+	goldPriceService := &fakeGoldPriceService{}
+	notifications := &recordingNotificationService{}
+
+	svc := NewPriceAlertService(repo, goldPriceRepo, notifications, goldPriceService).(*priceAlertService)
+	svc.now = func() time.Time { return now }
+	require.Len(t, goldPriceService.listeners, 1)
+
+	previous := &model.GoldPrice{Type: model.Gold24K, SellPrice: 298000, SourceDate: now.Add(-time.Hour)}
+	price := &model.GoldPrice{Type: model.Gold24K, SellPrice: 301000, SourceDate: now}
+
+	// 300,000원 상향 돌파 + 직전 거래일(294,000원) 대비 약 2.4% 상승
+	svc.OnGoldPriceCreated(ctx, price, previous)
+	assert.Equal(t, []uint{1, 3}, notifications.alertIDs)
+
+	// 기준선 위에서 계속 오르면 돌파 알림 없음, 변동률 알림은 같은 날 다시 보내지 않음
+	notifications.alertIDs = nil
+	svc.OnGoldPriceCreated(ctx, &model.GoldPrice{Type: model.Gold24K, SellPrice: 305000, SourceDate: now}, price)
+	assert.Empty(t, notifications.alertIDs)
+
+	// 다음 날 하향 돌파 + 전일 대비 하락
+	now = now.AddDate(0, 0, 1)
+	notifications.alertIDs = nil
+	svc.OnGoldPriceCreated(ctx, &model.GoldPrice{Type: model.Gold24K, SellPrice: 288000, SourceDate: now}, price)
+	assert.Equal(t, []uint{2, 4}, notifications.alertIDs)
+}
+
+func TestFormatWon(t *testing.T) {
+	assert.Equal(t, "0", formatWon(0))
+	assert.Equal(t, "999", formatWon(999))
+	assert.Equal(t, "300,000", formatWon(300000))
+	assert.Equal(t, "1,234,568", formatWon(1234567.8))
+	assert.Equal(t, "-12,000", formatWon(-12000))
+}
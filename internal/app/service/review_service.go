@@ -1,25 +1,28 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
 	"github.com/ikkim/udonggeum-backend/internal/app/repository"
 )
 
 type ReviewService struct {
-	reviewRepo *repository.ReviewRepository
-	storeRepo  repository.StoreRepository
+	reviewRepo   *repository.ReviewRepository
+	storeRepo    repository.StoreRepository
+	imageService ImageService
 }
 
-func NewReviewService(reviewRepo *repository.ReviewRepository, storeRepo repository.StoreRepository) *ReviewService {
+func NewReviewService(reviewRepo *repository.ReviewRepository, storeRepo repository.StoreRepository, imageService ImageService) *ReviewService {
 	return &ReviewService{
-		reviewRepo: reviewRepo,
-		storeRepo:  storeRepo,
+		reviewRepo:   reviewRepo,
+		storeRepo:    storeRepo,
+		imageService: imageService,
 	}
 }
 
 // CreateReview 리뷰 생성
-func (s *ReviewService) CreateReview(userID uint, input struct {
+func (s *ReviewService) CreateReview(ctx context.Context, userID uint, input struct {
 	StoreID   uint     `json:"store_id" binding:"required"`
 	Rating    int      `json:"rating" binding:"required,min=1,max=5"`
 	Content   string   `json:"content" binding:"required,min=10"`
@@ -27,7 +30,7 @@ func (s *ReviewService) CreateReview(userID uint, input struct {
 	IsVisitor bool     `json:"is_visitor"`
 }) (*model.StoreReview, error) {
 	// 매장 존재 확인
-	store, err := s.storeRepo.FindByID(input.StoreID)
+	store, err := s.storeRepo.FindByID(ctx, input.StoreID)
 	if err != nil {
 		return nil, errors.New("매장을 찾을 수 없습니다")
 	}
@@ -45,12 +48,12 @@ func (s *ReviewService) CreateReview(userID uint, input struct {
 		IsVisitor: input.IsVisitor,
 	}
 
-	if err := s.reviewRepo.CreateReview(review); err != nil {
+	if err := s.reviewRepo.CreateReview(ctx, review); err != nil {
 		return nil, err
 	}
 
 	// User 정보 로드
-	loadedReview, err := s.reviewRepo.GetReviewByID(review.ID)
+	loadedReview, err := s.reviewRepo.GetReviewByID(ctx, review.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -59,14 +62,14 @@ func (s *ReviewService) CreateReview(userID uint, input struct {
 }
 
 // GetReview 리뷰 조회
-func (s *ReviewService) GetReview(id uint) (*model.StoreReview, error) {
-	return s.reviewRepo.GetReviewByID(id)
+func (s *ReviewService) GetReview(ctx context.Context, id uint) (*model.StoreReview, error) {
+	return s.reviewRepo.GetReviewByID(ctx, id)
 }
 
 // GetStoreReviews 매장별 리뷰 목록 조회
-func (s *ReviewService) GetStoreReviews(storeID uint, page, pageSize int, sortBy, sortOrder string) ([]model.StoreReview, int64, error) {
+func (s *ReviewService) GetStoreReviews(ctx context.Context, storeID uint, page, pageSize int, sortBy, sortOrder string) ([]model.StoreReview, int64, error) {
 	// 매장 존재 확인
-	store, err := s.storeRepo.FindByID(storeID)
+	store, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
 		return nil, 0, errors.New("매장을 찾을 수 없습니다")
 	}
@@ -75,24 +78,24 @@ func (s *ReviewService) GetStoreReviews(storeID uint, page, pageSize int, sortBy
 	}
 
 	offset := (page - 1) * pageSize
-	return s.reviewRepo.GetReviewsByStoreID(storeID, offset, pageSize, sortBy, sortOrder)
+	return s.reviewRepo.GetReviewsByStoreID(ctx, storeID, offset, pageSize, sortBy, sortOrder)
 }
 
 // GetUserReviews 사용자별 리뷰 목록 조회
-func (s *ReviewService) GetUserReviews(userID uint, page, pageSize int) ([]model.StoreReview, int64, error) {
+func (s *ReviewService) GetUserReviews(ctx context.Context, userID uint, page, pageSize int) ([]model.StoreReview, int64, error) {
 	offset := (page - 1) * pageSize
-	return s.reviewRepo.GetReviewsByUserID(userID, offset, pageSize)
+	return s.reviewRepo.GetReviewsByUserID(ctx, userID, offset, pageSize)
 }
 
 // UpdateReview 리뷰 수정
-func (s *ReviewService) UpdateReview(reviewID, userID uint, input struct {
+func (s *ReviewService) UpdateReview(ctx context.Context, reviewID, userID uint, input struct {
 	Rating    *int     `json:"rating"`
 	Content   *string  `json:"content"`
 	ImageURLs []string `json:"image_urls"`
 	IsVisitor *bool    `json:"is_visitor"`
 }) (*model.StoreReview, error) {
 	// 리뷰 조회
-	review, err := s.reviewRepo.GetReviewByID(reviewID)
+	review, err := s.reviewRepo.GetReviewByID(ctx, reviewID)
 	if err != nil {
 		return nil, errors.New("리뷰를 찾을 수 없습니다")
 	}
@@ -122,7 +125,7 @@ func (s *ReviewService) UpdateReview(reviewID, userID uint, input struct {
 		review.IsVisitor = *input.IsVisitor
 	}
 
-	if err := s.reviewRepo.UpdateReview(review); err != nil {
+	if err := s.reviewRepo.UpdateReview(ctx, review); err != nil {
 		return nil, err
 	}
 
@@ -130,9 +133,9 @@ func (s *ReviewService) UpdateReview(reviewID, userID uint, input struct {
 }
 
 // DeleteReview 리뷰 삭제
-func (s *ReviewService) DeleteReview(reviewID, userID uint, isAdmin bool) error {
+func (s *ReviewService) DeleteReview(ctx context.Context, reviewID, userID uint, isAdmin bool) error {
 	// 리뷰 조회
-	review, err := s.reviewRepo.GetReviewByID(reviewID)
+	review, err := s.reviewRepo.GetReviewByID(ctx, reviewID)
 	if err != nil {
 		return errors.New("리뷰를 찾을 수 없습니다")
 	}
@@ -142,24 +145,24 @@ func (s *ReviewService) DeleteReview(reviewID, userID uint, isAdmin bool) error
 		return errors.New("권한이 없습니다")
 	}
 
-	return s.reviewRepo.DeleteReview(reviewID)
+	return s.reviewRepo.DeleteReview(ctx, reviewID)
 }
 
 // ToggleReviewLike 리뷰 좋아요 토글
-func (s *ReviewService) ToggleReviewLike(reviewID, userID uint) (bool, error) {
+func (s *ReviewService) ToggleReviewLike(ctx context.Context, reviewID, userID uint) (bool, error) {
 	// 리뷰 존재 확인
-	_, err := s.reviewRepo.GetReviewByID(reviewID)
+	_, err := s.reviewRepo.GetReviewByID(ctx, reviewID)
 	if err != nil {
 		return false, errors.New("리뷰를 찾을 수 없습니다")
 	}
 
-	return s.reviewRepo.ToggleLike(reviewID, userID)
+	return s.reviewRepo.ToggleLike(ctx, reviewID, userID)
 }
 
 // GetStoreStatistics 매장 통계 조회
-func (s *ReviewService) GetStoreStatistics(storeID uint) (map[string]interface{}, error) {
+func (s *ReviewService) GetStoreStatistics(ctx context.Context, storeID uint) (map[string]interface{}, error) {
 	// 매장 존재 확인
-	store, err := s.storeRepo.FindByID(storeID)
+	store, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
 		return nil, errors.New("매장을 찾을 수 없습니다")
 	}
@@ -167,13 +170,13 @@ func (s *ReviewService) GetStoreStatistics(storeID uint) (map[string]interface{}
 		return nil, errors.New("매장을 찾을 수 없습니다")
 	}
 
-	return s.reviewRepo.GetStoreStatistics(storeID)
+	return s.reviewRepo.GetStoreStatistics(ctx, storeID)
 }
 
 // GetStoreGallery 매장 갤러리 조회
-func (s *ReviewService) GetStoreGallery(storeID uint, page, pageSize int) ([]repository.GalleryImage, int64, error) {
+func (s *ReviewService) GetStoreGallery(ctx context.Context, storeID uint, page, pageSize int) ([]repository.GalleryImage, int64, error) {
 	// 매장 존재 확인
-	store, err := s.storeRepo.FindByID(storeID)
+	store, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
 		return nil, 0, errors.New("매장을 찾을 수 없습니다")
 	}
@@ -182,5 +185,20 @@ func (s *ReviewService) GetStoreGallery(storeID uint, page, pageSize int) ([]rep
 	}
 
 	offset := (page - 1) * pageSize
-	return s.reviewRepo.GetStoreGallery(storeID, offset, pageSize)
+	gallery, total, err := s.reviewRepo.GetStoreGallery(ctx, storeID, offset, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 썸네일이 있으면 썸네일로 제공
+	urls := make([]string, 0, len(gallery))
+	for _, image := range gallery {
+		urls = append(urls, image.ImageURL)
+	}
+	thumbnails := s.imageService.ThumbnailURLs(ctx, urls)
+	for i := range gallery {
+		gallery[i].ThumbnailURL = thumbnails[gallery[i].ImageURL]
+	}
+
+	return gallery, total, nil
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -40,32 +41,32 @@ type StoreLocationSummary struct {
 }
 
 type StoreService interface {
-	ListStores(opts StoreListOptions) (*repository.StoreListResult, error)
-	GetStoreByID(id uint) (*model.Store, error)
-	GetStoresByUserID(userID uint) ([]model.Store, error)
-	GetStoreByUserID(userID uint) (*model.Store, error)
-	GetStoreByBusinessNumber(businessNumber string) (*model.Store, error)
-	ListLocations() ([]StoreLocationSummary, error)
-	CreateStore(store *model.Store) (*model.Store, error)
-	UpdateStore(userID uint, storeID uint, input StoreMutation) (*model.Store, error)
-	UpdateStoreOwnership(store *model.Store) (*model.Store, error)
-	ClaimStoreTransaction(store *model.Store, userID uint) (*model.Store, error)
-	DeleteStore(userID uint, storeID uint) error
-	ToggleStoreLike(storeID, userID uint) (bool, error)
-	IsStoreLiked(storeID, userID uint) (bool, error)
-	GetUserLikedStores(userID uint) ([]model.Store, error)
-	GetUserLikedStoreIDs(userID uint) ([]uint, error)
-	PromoteUserToAdmin(userID uint) error
-	CreateVerification(verification *model.StoreVerification) (*model.StoreVerification, error)
-	GetVerificationByStoreID(storeID uint) (*model.StoreVerification, error)
-	GetVerificationByID(verificationID uint) (*model.StoreVerification, error)
-	ListVerificationsByStatus(status string) ([]*model.StoreVerification, error)
-	ApproveStoreVerification(storeID uint, verifiedAt *time.Time) error
-	UpdateVerification(verification *model.StoreVerification) error
+	ListStores(ctx context.Context, opts StoreListOptions) (*repository.StoreListResult, error)
+	GetStoreByID(ctx context.Context, id uint) (*model.Store, error)
+	GetStoresByUserID(ctx context.Context, userID uint) ([]model.Store, error)
+	GetStoreByUserID(ctx context.Context, userID uint) (*model.Store, error)
+	GetStoreByBusinessNumber(ctx context.Context, businessNumber string) (*model.Store, error)
+	ListLocations(ctx context.Context) ([]StoreLocationSummary, error)
+	CreateStore(ctx context.Context, store *model.Store) (*model.Store, error)
+	UpdateStore(ctx context.Context, userID uint, storeID uint, input StoreMutation) (*model.Store, error)
+	UpdateStoreOwnership(ctx context.Context, store *model.Store) (*model.Store, error)
+	ClaimStoreTransaction(ctx context.Context, store *model.Store, userID uint) (*model.Store, error)
+	DeleteStore(ctx context.Context, userID uint, storeID uint) error
+	ToggleStoreLike(ctx context.Context, storeID, userID uint) (bool, error)
+	IsStoreLiked(ctx context.Context, storeID, userID uint) (bool, error)
+	GetUserLikedStores(ctx context.Context, userID uint) ([]model.Store, error)
+	GetUserLikedStoreIDs(ctx context.Context, userID uint) ([]uint, error)
+	PromoteUserToAdmin(ctx context.Context, userID uint) error
+	CreateVerification(ctx context.Context, verification *model.StoreVerification) (*model.StoreVerification, error)
+	GetVerificationByStoreID(ctx context.Context, storeID uint) (*model.StoreVerification, error)
+	GetVerificationByID(ctx context.Context, verificationID uint) (*model.StoreVerification, error)
+	ListVerificationsByStatus(ctx context.Context, status string) ([]*model.StoreVerification, error)
+	ApproveStoreVerification(ctx context.Context, storeID uint, verifiedAt *time.Time) error
+	UpdateVerification(ctx context.Context, verification *model.StoreVerification) error
 	// 매장등록 요청 관련
-	RequestStoreRegistration(storeID, userID uint) (int64, bool, error)
-	GetStoreRegistrationRequestCount(storeID uint) (int64, error)
-	HasUserRequestedRegistration(storeID, userID uint) (bool, error)
+	RequestStoreRegistration(ctx context.Context, storeID, userID uint) (int64, bool, error)
+	GetStoreRegistrationRequestCount(ctx context.Context, storeID uint) (int64, error)
+	HasUserRequestedRegistration(ctx context.Context, storeID, userID uint) (bool, error)
 }
 
 type storeService struct {
@@ -98,8 +99,9 @@ func NewStoreService(db *gorm.DB, storeRepo repository.StoreRepository, userRepo
 	}
 }
 
-func (s *storeService) ListStores(opts StoreListOptions) (*repository.StoreListResult, error) {
-	logger.Debug("Listing stores", map[string]interface{}{
+func (s *storeService) ListStores(ctx context.Context, opts StoreListOptions) (*repository.StoreListResult, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Listing stores", map[string]interface{}{
 		"region":      opts.Region,
 		"district":    opts.District,
 		"is_verified": opts.IsVerified,
@@ -114,7 +116,7 @@ func (s *storeService) ListStores(opts StoreListOptions) (*repository.StoreListR
 	})
 
 	// Repository에서 거리 계산 및 정렬 처리
-	result, err := s.storeRepo.FindAll(repository.StoreFilter{
+	result, err := s.storeRepo.FindAll(ctx, repository.StoreFilter{
 		Region:     opts.Region,
 		District:   opts.District,
 		Search:     opts.Search,
@@ -129,11 +131,11 @@ func (s *storeService) ListStores(opts StoreListOptions) (*repository.StoreListR
 		Radius:     opts.Radius,
 	})
 	if err != nil {
-		logger.Error("Failed to list stores", err)
+		log.Error("Failed to list stores", err)
 		return nil, err
 	}
 
-	logger.Info("Stores fetched", map[string]interface{}{
+	log.Info("Stores fetched", map[string]interface{}{
 		"count":       len(result.Stores),
 		"total_count": result.TotalCount,
 		"user_lat":    opts.UserLat,
@@ -142,20 +144,21 @@ func (s *storeService) ListStores(opts StoreListOptions) (*repository.StoreListR
 	return result, nil
 }
 
-func (s *storeService) GetStoreByID(id uint) (*model.Store, error) {
-	logger.Debug("Fetching store by ID", map[string]interface{}{
+func (s *storeService) GetStoreByID(ctx context.Context, id uint) (*model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Fetching store by ID", map[string]interface{}{
 		"store_id": id,
 	})
 
-	store, err := s.storeRepo.FindByID(id)
+	store, err := s.storeRepo.FindByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("Store not found", map[string]interface{}{
+			log.Warn("Store not found", map[string]interface{}{
 				"store_id": id,
 			})
 			return nil, ErrStoreNotFound
 		}
-		logger.Error("Failed to fetch store", err, map[string]interface{}{
+		log.Error("Failed to fetch store", err, map[string]interface{}{
 			"store_id": id,
 		})
 		return nil, err
@@ -164,40 +167,42 @@ func (s *storeService) GetStoreByID(id uint) (*model.Store, error) {
 	return store, nil
 }
 
-func (s *storeService) GetStoresByUserID(userID uint) ([]model.Store, error) {
-	logger.Debug("Fetching stores by user ID", map[string]interface{}{
+func (s *storeService) GetStoresByUserID(ctx context.Context, userID uint) ([]model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Fetching stores by user ID", map[string]interface{}{
 		"user_id": userID,
 	})
 
-	stores, err := s.storeRepo.FindByUserID(userID)
+	stores, err := s.storeRepo.FindByUserID(ctx, userID)
 	if err != nil {
-		logger.Error("Failed to fetch stores by user ID", err, map[string]interface{}{
+		log.Error("Failed to fetch stores by user ID", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return nil, err
 	}
 
-	logger.Info("Stores fetched by user ID", map[string]interface{}{
+	log.Info("Stores fetched by user ID", map[string]interface{}{
 		"user_id": userID,
 		"count":   len(stores),
 	})
 	return stores, nil
 }
 
-func (s *storeService) GetStoreByBusinessNumber(businessNumber string) (*model.Store, error) {
-	logger.Debug("Fetching store by business number", map[string]interface{}{
+func (s *storeService) GetStoreByBusinessNumber(ctx context.Context, businessNumber string) (*model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Fetching store by business number", map[string]interface{}{
 		"business_number": businessNumber,
 	})
 
-	store, err := s.storeRepo.FindByBusinessNumber(businessNumber)
+	store, err := s.storeRepo.FindByBusinessNumber(ctx, businessNumber)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Debug("Store not found with business number", map[string]interface{}{
+			log.Debug("Store not found with business number", map[string]interface{}{
 				"business_number": businessNumber,
 			})
 			return nil, nil // 찾지 못한 경우 nil 반환 (에러 아님)
 		}
-		logger.Error("Failed to fetch store by business number", err, map[string]interface{}{
+		log.Error("Failed to fetch store by business number", err, map[string]interface{}{
 			"business_number": businessNumber,
 		})
 		return nil, err
@@ -206,17 +211,18 @@ func (s *storeService) GetStoreByBusinessNumber(businessNumber string) (*model.S
 	return store, nil
 }
 
-func (s *storeService) CreateStore(store *model.Store) (*model.Store, error) {
-	logger.Info("Creating store", map[string]interface{}{
+func (s *storeService) CreateStore(ctx context.Context, store *model.Store) (*model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Creating store", map[string]interface{}{
 		"name":    store.Name,
 		"user_id": store.UserID,
 	})
 
 	// Geocode address to get coordinates if address is provided
 	if store.Address != "" && (store.Latitude == nil || store.Longitude == nil) {
-		lat, lng, err := util.GeocodeAddress(store.Address)
+		lat, lng, err := util.GeocodeAddress(ctx, store.Address)
 		if err != nil {
-			logger.Warn("Failed to geocode store address during creation", map[string]interface{}{
+			log.Warn("Failed to geocode store address during creation", map[string]interface{}{
 				"address": store.Address,
 				"error":   err.Error(),
 			})
@@ -224,7 +230,7 @@ func (s *storeService) CreateStore(store *model.Store) (*model.Store, error) {
 		} else {
 			store.Latitude = lat
 			store.Longitude = lng
-			logger.Info("Successfully geocoded store address during creation", map[string]interface{}{
+			log.Info("Successfully geocoded store address during creation", map[string]interface{}{
 				"address":   store.Address,
 				"latitude":  lat,
 				"longitude": lng,
@@ -235,14 +241,14 @@ func (s *storeService) CreateStore(store *model.Store) (*model.Store, error) {
 	// Begin transaction to ensure atomic store creation + user nickname update
 	tx := s.db.Begin()
 	if tx.Error != nil {
-		logger.Error("Failed to begin transaction for CreateStore", tx.Error)
+		log.Error("Failed to begin transaction for CreateStore", tx.Error)
 		return nil, tx.Error
 	}
 
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
-			logger.Error("Panic in CreateStore, transaction rolled back", fmt.Errorf("%v", r))
+			log.Error("Panic in CreateStore, transaction rolled back", fmt.Errorf("%v", r))
 			panic(r)
 		}
 	}()
@@ -250,7 +256,7 @@ func (s *storeService) CreateStore(store *model.Store) (*model.Store, error) {
 	// Create store
 	if err := tx.Create(store).Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to create store", err, map[string]interface{}{
+		log.Error("Failed to create store", err, map[string]interface{}{
 			"name":    store.Name,
 			"user_id": store.UserID,
 		})
@@ -261,13 +267,13 @@ func (s *storeService) CreateStore(store *model.Store) (*model.Store, error) {
 	if store.UserID != nil {
 		if err := tx.Model(&model.User{}).Where("id = ?", *store.UserID).Update("nickname", store.Name).Error; err != nil {
 			tx.Rollback()
-			logger.Error("Failed to update user nickname after store creation", err, map[string]interface{}{
+			log.Error("Failed to update user nickname after store creation", err, map[string]interface{}{
 				"user_id":    *store.UserID,
 				"store_name": store.Name,
 			})
 			return nil, fmt.Errorf("failed to update user nickname: %w", err)
 		}
-		logger.Info("User nickname updated to store name", map[string]interface{}{
+		log.Info("User nickname updated to store name", map[string]interface{}{
 			"user_id":  *store.UserID,
 			"nickname": store.Name,
 		})
@@ -275,39 +281,40 @@ func (s *storeService) CreateStore(store *model.Store) (*model.Store, error) {
 
 	// Commit transaction
 	if err := tx.Commit().Error; err != nil {
-		logger.Error("Failed to commit CreateStore transaction", err)
+		log.Error("Failed to commit CreateStore transaction", err)
 		return nil, err
 	}
 
-	logger.Info("Store created", map[string]interface{}{
+	log.Info("Store created", map[string]interface{}{
 		"store_id": store.ID,
 		"name":     store.Name,
 	})
 	return store, nil
 }
 
-func (s *storeService) UpdateStore(userID uint, storeID uint, input StoreMutation) (*model.Store, error) {
-	logger.Info("Updating store", map[string]interface{}{
+func (s *storeService) UpdateStore(ctx context.Context, userID uint, storeID uint, input StoreMutation) (*model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Updating store", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 	})
 
-	existing, err := s.storeRepo.FindByID(storeID)
+	existing, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("Store not found for update", map[string]interface{}{
+			log.Warn("Store not found for update", map[string]interface{}{
 				"store_id": storeID,
 			})
 			return nil, ErrStoreNotFound
 		}
-		logger.Error("Failed to find store for update", err, map[string]interface{}{
+		log.Error("Failed to find store for update", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return nil, err
 	}
 
 	if existing.UserID == nil || *existing.UserID != userID {
-		logger.Warn("Store update forbidden", map[string]interface{}{
+		log.Warn("Store update forbidden", map[string]interface{}{
 			"store_id": storeID,
 			"user_id":  userID,
 		})
@@ -339,9 +346,9 @@ func (s *storeService) UpdateStore(userID uint, storeID uint, input StoreMutatio
 
 		// If address changed and not empty, geocode it to get new coordinates
 		if *input.Address != "" {
-			lat, lng, err := util.GeocodeAddress(*input.Address)
+			lat, lng, err := util.GeocodeAddress(ctx, *input.Address)
 			if err != nil {
-				logger.Warn("Failed to geocode store address, using provided coordinates", map[string]interface{}{
+				log.Warn("Failed to geocode store address, using provided coordinates", map[string]interface{}{
 					"store_id": storeID,
 					"address":  *input.Address,
 					"error":    err.Error(),
@@ -356,7 +363,7 @@ func (s *storeService) UpdateStore(userID uint, storeID uint, input StoreMutatio
 			} else {
 				existing.Latitude = lat
 				existing.Longitude = lng
-				logger.Info("Successfully geocoded store address", map[string]interface{}{
+				log.Info("Successfully geocoded store address", map[string]interface{}{
 					"store_id":  storeID,
 					"address":   *input.Address,
 					"latitude":  lat,
@@ -409,8 +416,8 @@ func (s *storeService) UpdateStore(userID uint, storeID uint, input StoreMutatio
 		existing.Tags = tags
 	}
 
-	if err := s.storeRepo.Update(existing); err != nil {
-		logger.Error("Failed to update store", err, map[string]interface{}{
+	if err := s.storeRepo.Update(ctx, existing); err != nil {
+		log.Error("Failed to update store", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return nil, err
@@ -418,18 +425,18 @@ func (s *storeService) UpdateStore(userID uint, storeID uint, input StoreMutatio
 
 	// Update user's nickname to new store name if it changed and user is admin
 	if storeNameChanged && input.Name != nil {
-		user, err := s.userRepo.FindByID(userID)
+		user, err := s.userRepo.FindByID(ctx, userID)
 		if err == nil && user.Role == model.RoleAdmin {
 			user.Nickname = *input.Name
-			if err := s.userRepo.Update(user); err != nil {
-				logger.Warn("Failed to update user nickname after store name change", map[string]interface{}{
+			if err := s.userRepo.Update(ctx, user); err != nil {
+				log.Warn("Failed to update user nickname after store name change", map[string]interface{}{
 					"user_id":    userID,
 					"store_name": *input.Name,
 					"error":      err.Error(),
 				})
 				// Don't fail the entire operation if nickname update fails
 			} else {
-				logger.Info("User nickname updated to new store name", map[string]interface{}{
+				log.Info("User nickname updated to new store name", map[string]interface{}{
 					"user_id":  userID,
 					"nickname": *input.Name,
 				})
@@ -437,34 +444,35 @@ func (s *storeService) UpdateStore(userID uint, storeID uint, input StoreMutatio
 		}
 	}
 
-	logger.Info("Store updated", map[string]interface{}{
+	log.Info("Store updated", map[string]interface{}{
 		"store_id": storeID,
 	})
 	return existing, nil
 }
 
-func (s *storeService) DeleteStore(userID uint, storeID uint) error {
-	logger.Info("Deleting store", map[string]interface{}{
+func (s *storeService) DeleteStore(ctx context.Context, userID uint, storeID uint) error {
+	log := logger.FromContext(ctx)
+	log.Info("Deleting store", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 	})
 
-	existing, err := s.storeRepo.FindByID(storeID)
+	existing, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("Store not found for delete", map[string]interface{}{
+			log.Warn("Store not found for delete", map[string]interface{}{
 				"store_id": storeID,
 			})
 			return ErrStoreNotFound
 		}
-		logger.Error("Failed to find store for delete", err, map[string]interface{}{
+		log.Error("Failed to find store for delete", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return err
 	}
 
 	if existing.UserID == nil || *existing.UserID != userID {
-		logger.Warn("Store delete forbidden", map[string]interface{}{
+		log.Warn("Store delete forbidden", map[string]interface{}{
 			"store_id": storeID,
 			"user_id":  userID,
 		})
@@ -474,7 +482,7 @@ func (s *storeService) DeleteStore(userID uint, storeID uint) error {
 	// 트랜잭션으로 Store와 연관 데이터를 함께 soft delete
 	tx := s.db.Begin()
 	if tx.Error != nil {
-		logger.Error("Failed to begin transaction for store deletion", tx.Error, map[string]interface{}{
+		log.Error("Failed to begin transaction for store deletion", tx.Error, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return tx.Error
@@ -483,7 +491,7 @@ func (s *storeService) DeleteStore(userID uint, storeID uint) error {
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
-			logger.Error("Transaction rolled back due to panic during store deletion", nil, map[string]interface{}{
+			log.Error("Transaction rolled back due to panic during store deletion", nil, map[string]interface{}{
 				"store_id": storeID,
 				"panic":    r,
 			})
@@ -493,7 +501,7 @@ func (s *storeService) DeleteStore(userID uint, storeID uint) error {
 	// Store soft delete
 	if err := tx.Delete(&model.Store{}, storeID).Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to delete store", err, map[string]interface{}{
+		log.Error("Failed to delete store", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return err
@@ -504,7 +512,7 @@ func (s *storeService) DeleteStore(userID uint, storeID uint) error {
 		// BusinessRegistration이 없을 수도 있으므로 RecordNotFound는 무시
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
 			tx.Rollback()
-			logger.Error("Failed to delete business registration", err, map[string]interface{}{
+			log.Error("Failed to delete business registration", err, map[string]interface{}{
 				"store_id": storeID,
 			})
 			return err
@@ -514,24 +522,25 @@ func (s *storeService) DeleteStore(userID uint, storeID uint) error {
 	// 트랜잭션 커밋
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to commit store deletion transaction", err, map[string]interface{}{
+		log.Error("Failed to commit store deletion transaction", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return err
 	}
 
-	logger.Info("Store and related data deleted successfully", map[string]interface{}{
+	log.Info("Store and related data deleted successfully", map[string]interface{}{
 		"store_id": storeID,
 	})
 	return nil
 }
 
-func (s *storeService) ListLocations() ([]StoreLocationSummary, error) {
-	logger.Debug("Listing store locations")
+func (s *storeService) ListLocations(ctx context.Context) ([]StoreLocationSummary, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Listing store locations")
 
-	locations, err := s.storeRepo.ListLocations()
+	locations, err := s.storeRepo.ListLocations(ctx)
 	if err != nil {
-		logger.Error("Failed to list store locations", err)
+		log.Error("Failed to list store locations", err)
 		return nil, err
 	}
 
@@ -544,44 +553,45 @@ func (s *storeService) ListLocations() ([]StoreLocationSummary, error) {
 		})
 	}
 
-	logger.Info("Store locations fetched", map[string]interface{}{
+	log.Info("Store locations fetched", map[string]interface{}{
 		"count": len(summaries),
 	})
 	return summaries, nil
 }
 
 // ToggleStoreLike 매장 좋아요 토글
-func (s *storeService) ToggleStoreLike(storeID, userID uint) (bool, error) {
-	logger.Debug("Toggling store like", map[string]interface{}{
+func (s *storeService) ToggleStoreLike(ctx context.Context, storeID, userID uint) (bool, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Toggling store like", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 	})
 
 	// 매장 존재 확인
-	_, err := s.storeRepo.FindByID(storeID)
+	_, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			logger.Warn("Store not found for like toggle", map[string]interface{}{
+			log.Warn("Store not found for like toggle", map[string]interface{}{
 				"store_id": storeID,
 			})
 			return false, ErrStoreNotFound
 		}
-		logger.Error("Failed to find store for like toggle", err, map[string]interface{}{
+		log.Error("Failed to find store for like toggle", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return false, err
 	}
 
-	isLiked, err := s.storeRepo.ToggleLike(storeID, userID)
+	isLiked, err := s.storeRepo.ToggleLike(ctx, storeID, userID)
 	if err != nil {
-		logger.Error("Failed to toggle store like", err, map[string]interface{}{
+		log.Error("Failed to toggle store like", err, map[string]interface{}{
 			"store_id": storeID,
 			"user_id":  userID,
 		})
 		return false, err
 	}
 
-	logger.Info("Store like toggled", map[string]interface{}{
+	log.Info("Store like toggled", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 		"is_liked": isLiked,
@@ -590,22 +600,23 @@ func (s *storeService) ToggleStoreLike(storeID, userID uint) (bool, error) {
 }
 
 // IsStoreLiked 사용자가 매장에 좋아요를 눌렀는지 확인
-func (s *storeService) IsStoreLiked(storeID, userID uint) (bool, error) {
-	logger.Debug("Checking if store is liked", map[string]interface{}{
+func (s *storeService) IsStoreLiked(ctx context.Context, storeID, userID uint) (bool, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Checking if store is liked", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 	})
 
-	isLiked, err := s.storeRepo.IsLiked(storeID, userID)
+	isLiked, err := s.storeRepo.IsLiked(ctx, storeID, userID)
 	if err != nil {
-		logger.Error("Failed to check if store is liked", err, map[string]interface{}{
+		log.Error("Failed to check if store is liked", err, map[string]interface{}{
 			"store_id": storeID,
 			"user_id":  userID,
 		})
 		return false, err
 	}
 
-	logger.Debug("Store like status checked", map[string]interface{}{
+	log.Debug("Store like status checked", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 		"is_liked": isLiked,
@@ -614,20 +625,21 @@ func (s *storeService) IsStoreLiked(storeID, userID uint) (bool, error) {
 }
 
 // GetUserLikedStores retrieves all stores liked by the user
-func (s *storeService) GetUserLikedStores(userID uint) ([]model.Store, error) {
-	logger.Debug("Getting user liked stores", map[string]interface{}{
+func (s *storeService) GetUserLikedStores(ctx context.Context, userID uint) ([]model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Getting user liked stores", map[string]interface{}{
 		"user_id": userID,
 	})
 
-	stores, err := s.storeRepo.GetUserLikedStores(userID)
+	stores, err := s.storeRepo.GetUserLikedStores(ctx, userID)
 	if err != nil {
-		logger.Error("Failed to get user liked stores", err, map[string]interface{}{
+		log.Error("Failed to get user liked stores", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return nil, err
 	}
 
-	logger.Debug("User liked stores retrieved", map[string]interface{}{
+	log.Debug("User liked stores retrieved", map[string]interface{}{
 		"user_id": userID,
 		"count":   len(stores),
 	})
@@ -635,20 +647,21 @@ func (s *storeService) GetUserLikedStores(userID uint) ([]model.Store, error) {
 }
 
 // GetUserLikedStoreIDs retrieves IDs of all stores liked by the user
-func (s *storeService) GetUserLikedStoreIDs(userID uint) ([]uint, error) {
-	logger.Debug("Getting user liked store IDs", map[string]interface{}{
+func (s *storeService) GetUserLikedStoreIDs(ctx context.Context, userID uint) ([]uint, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Getting user liked store IDs", map[string]interface{}{
 		"user_id": userID,
 	})
 
-	storeIDs, err := s.storeRepo.GetUserLikedStoreIDs(userID)
+	storeIDs, err := s.storeRepo.GetUserLikedStoreIDs(ctx, userID)
 	if err != nil {
-		logger.Error("Failed to get user liked store IDs", err, map[string]interface{}{
+		log.Error("Failed to get user liked store IDs", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return nil, err
 	}
 
-	logger.Debug("User liked store IDs retrieved", map[string]interface{}{
+	log.Debug("User liked store IDs retrieved", map[string]interface{}{
 		"user_id": userID,
 		"count":   len(storeIDs),
 	})
@@ -656,43 +669,45 @@ func (s *storeService) GetUserLikedStoreIDs(userID uint) ([]uint, error) {
 }
 
 // PromoteUserToAdmin promotes a user to admin role
-func (s *storeService) PromoteUserToAdmin(userID uint) error {
-	logger.Info("Promoting user to admin", map[string]interface{}{
+func (s *storeService) PromoteUserToAdmin(ctx context.Context, userID uint) error {
+	log := logger.FromContext(ctx)
+	log.Info("Promoting user to admin", map[string]interface{}{
 		"user_id": userID,
 	})
 
-	user, err := s.userRepo.FindByID(userID)
+	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
-		logger.Error("Failed to find user for promotion", err, map[string]interface{}{
+		log.Error("Failed to find user for promotion", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return err
 	}
 
 	if user.Role == model.RoleAdmin {
-		logger.Info("User is already admin", map[string]interface{}{
+		log.Info("User is already admin", map[string]interface{}{
 			"user_id": userID,
 		})
 		return nil // Already admin, no need to update
 	}
 
 	user.Role = model.RoleAdmin
-	if err := s.userRepo.Update(user); err != nil {
-		logger.Error("Failed to promote user to admin", err, map[string]interface{}{
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		log.Error("Failed to promote user to admin", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return err
 	}
 
-	logger.Info("User promoted to admin successfully", map[string]interface{}{
+	log.Info("User promoted to admin successfully", map[string]interface{}{
 		"user_id": userID,
 	})
 	return nil
 }
 
 // UpdateStoreOwnership updates store ownership information (for claiming stores)
-func (s *storeService) UpdateStoreOwnership(store *model.Store) (*model.Store, error) {
-	logger.Info("Updating store ownership", map[string]interface{}{
+func (s *storeService) UpdateStoreOwnership(ctx context.Context, store *model.Store) (*model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Updating store ownership", map[string]interface{}{
 		"store_id": store.ID,
 		"user_id":  store.UserID,
 	})
@@ -700,7 +715,7 @@ func (s *storeService) UpdateStoreOwnership(store *model.Store) (*model.Store, e
 	// 트랜잭션으로 처리하여 일부만 성공하는 문제 방지
 	tx := s.db.Begin()
 	if tx.Error != nil {
-		logger.Error("Failed to begin transaction", tx.Error, map[string]interface{}{
+		log.Error("Failed to begin transaction", tx.Error, map[string]interface{}{
 			"store_id": store.ID,
 		})
 		return nil, tx.Error
@@ -710,7 +725,7 @@ func (s *storeService) UpdateStoreOwnership(store *model.Store) (*model.Store, e
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
-			logger.Error("Transaction rolled back due to panic", nil, map[string]interface{}{
+			log.Error("Transaction rolled back due to panic", nil, map[string]interface{}{
 				"store_id": store.ID,
 				"panic":    r,
 			})
@@ -720,7 +735,7 @@ func (s *storeService) UpdateStoreOwnership(store *model.Store) (*model.Store, e
 	// Update store with new ownership information (including business registration via association)
 	if err := tx.Save(store).Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to update store ownership", err, map[string]interface{}{
+		log.Error("Failed to update store ownership", err, map[string]interface{}{
 			"store_id": store.ID,
 		})
 		return nil, err
@@ -729,22 +744,22 @@ func (s *storeService) UpdateStoreOwnership(store *model.Store) (*model.Store, e
 	// 트랜잭션 커밋
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to commit transaction", err, map[string]interface{}{
+		log.Error("Failed to commit transaction", err, map[string]interface{}{
 			"store_id": store.ID,
 		})
 		return nil, err
 	}
 
-	logger.Info("Store ownership updated successfully", map[string]interface{}{
+	log.Info("Store ownership updated successfully", map[string]interface{}{
 		"store_id":   store.ID,
 		"user_id":    store.UserID,
 		"is_managed": store.IsManaged,
 	})
 
 	// Reload store with all associations
-	updated, err := s.storeRepo.FindByID(store.ID)
+	updated, err := s.storeRepo.FindByID(ctx, store.ID)
 	if err != nil {
-		logger.Error("Failed to reload claimed store", err, map[string]interface{}{
+		log.Error("Failed to reload claimed store", err, map[string]interface{}{
 			"store_id": store.ID,
 		})
 		return nil, err
@@ -755,8 +770,9 @@ func (s *storeService) UpdateStoreOwnership(store *model.Store) (*model.Store, e
 
 // ClaimStoreTransaction handles the entire store claim process in a single transaction
 // This ensures atomicity: either all operations succeed (store update + user promotion) or all fail
-func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*model.Store, error) {
-	logger.Info("Starting store claim transaction", map[string]interface{}{
+func (s *storeService) ClaimStoreTransaction(ctx context.Context, store *model.Store, userID uint) (*model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Starting store claim transaction", map[string]interface{}{
 		"store_id": store.ID,
 		"user_id":  userID,
 	})
@@ -764,7 +780,7 @@ func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*
 	// 트랜잭션 시작
 	tx := s.db.Begin()
 	if tx.Error != nil {
-		logger.Error("Failed to begin transaction for store claim", tx.Error, map[string]interface{}{
+		log.Error("Failed to begin transaction for store claim", tx.Error, map[string]interface{}{
 			"store_id": store.ID,
 			"user_id":  userID,
 		})
@@ -775,7 +791,7 @@ func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*
 	defer func() {
 		if r := recover(); r != nil {
 			tx.Rollback()
-			logger.Error("Transaction rolled back due to panic during store claim", nil, map[string]interface{}{
+			log.Error("Transaction rolled back due to panic during store claim", nil, map[string]interface{}{
 				"store_id": store.ID,
 				"user_id":  userID,
 				"panic":    r,
@@ -786,7 +802,7 @@ func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*
 	// 1. Store 업데이트 (BusinessRegistration은 GORM association으로 자동 저장)
 	if err := tx.Save(store).Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to update store in claim transaction", err, map[string]interface{}{
+		log.Error("Failed to update store in claim transaction", err, map[string]interface{}{
 			"store_id": store.ID,
 			"user_id":  userID,
 		})
@@ -798,7 +814,7 @@ func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*
 		Where("id = ?", userID).
 		Update("role", model.RoleAdmin).Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to promote user to admin in claim transaction", err, map[string]interface{}{
+		log.Error("Failed to promote user to admin in claim transaction", err, map[string]interface{}{
 			"user_id":  userID,
 			"store_id": store.ID,
 		})
@@ -810,7 +826,7 @@ func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*
 		Where("id = ?", userID).
 		Update("nickname", store.Name).Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to update user nickname in claim transaction", err, map[string]interface{}{
+		log.Error("Failed to update user nickname in claim transaction", err, map[string]interface{}{
 			"user_id":  userID,
 			"store_id": store.ID,
 			"nickname": store.Name,
@@ -821,23 +837,23 @@ func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*
 	// 4. 트랜잭션 커밋
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
-		logger.Error("Failed to commit store claim transaction", err, map[string]interface{}{
+		log.Error("Failed to commit store claim transaction", err, map[string]interface{}{
 			"store_id": store.ID,
 			"user_id":  userID,
 		})
 		return nil, err
 	}
 
-	logger.Info("Store claim transaction completed successfully", map[string]interface{}{
+	log.Info("Store claim transaction completed successfully", map[string]interface{}{
 		"store_id":   store.ID,
 		"user_id":    userID,
 		"is_managed": store.IsManaged,
 	})
 
 	// Reload store with all associations
-	updated, err := s.storeRepo.FindByID(store.ID)
+	updated, err := s.storeRepo.FindByID(ctx, store.ID)
 	if err != nil {
-		logger.Error("Failed to reload claimed store", err, map[string]interface{}{
+		log.Error("Failed to reload claimed store", err, map[string]interface{}{
 			"store_id": store.ID,
 		})
 		return nil, err
@@ -847,14 +863,15 @@ func (s *storeService) ClaimStoreTransaction(store *model.Store, userID uint) (*
 }
 
 // GetStoreByUserID gets a store by user ID
-func (s *storeService) GetStoreByUserID(userID uint) (*model.Store, error) {
-	logger.Info("Getting store by user ID", map[string]interface{}{
+func (s *storeService) GetStoreByUserID(ctx context.Context, userID uint) (*model.Store, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Getting store by user ID", map[string]interface{}{
 		"user_id": userID,
 	})
 
-	store, err := s.storeRepo.FindSingleByUserID(userID)
+	store, err := s.storeRepo.FindSingleByUserID(ctx, userID)
 	if err != nil {
-		logger.Error("Failed to find store by user ID", err, map[string]interface{}{
+		log.Error("Failed to find store by user ID", err, map[string]interface{}{
 			"user_id": userID,
 		})
 		return nil, err
@@ -864,19 +881,20 @@ func (s *storeService) GetStoreByUserID(userID uint) (*model.Store, error) {
 }
 
 // CreateVerification creates a new store verification request
-func (s *storeService) CreateVerification(verification *model.StoreVerification) (*model.StoreVerification, error) {
-	logger.Info("Creating verification", map[string]interface{}{
+func (s *storeService) CreateVerification(ctx context.Context, verification *model.StoreVerification) (*model.StoreVerification, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Creating verification", map[string]interface{}{
 		"store_id": verification.StoreID,
 	})
 
-	if err := s.storeRepo.CreateVerification(verification); err != nil {
-		logger.Error("Failed to create verification", err, map[string]interface{}{
+	if err := s.storeRepo.CreateVerification(ctx, verification); err != nil {
+		log.Error("Failed to create verification", err, map[string]interface{}{
 			"store_id": verification.StoreID,
 		})
 		return nil, err
 	}
 
-	logger.Info("Verification created successfully", map[string]interface{}{
+	log.Info("Verification created successfully", map[string]interface{}{
 		"verification_id": verification.ID,
 		"store_id":        verification.StoreID,
 	})
@@ -885,14 +903,15 @@ func (s *storeService) CreateVerification(verification *model.StoreVerification)
 }
 
 // GetVerificationByStoreID gets verification by store ID
-func (s *storeService) GetVerificationByStoreID(storeID uint) (*model.StoreVerification, error) {
-	logger.Debug("Getting verification by store ID", map[string]interface{}{
+func (s *storeService) GetVerificationByStoreID(ctx context.Context, storeID uint) (*model.StoreVerification, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Getting verification by store ID", map[string]interface{}{
 		"store_id": storeID,
 	})
 
-	verification, err := s.storeRepo.FindVerificationByStoreID(storeID)
+	verification, err := s.storeRepo.FindVerificationByStoreID(ctx, storeID)
 	if err != nil {
-		logger.Debug("Verification not found for store", map[string]interface{}{
+		log.Debug("Verification not found for store", map[string]interface{}{
 			"store_id": storeID,
 		})
 		return nil, err
@@ -902,14 +921,15 @@ func (s *storeService) GetVerificationByStoreID(storeID uint) (*model.StoreVerif
 }
 
 // GetVerificationByID gets verification by ID
-func (s *storeService) GetVerificationByID(verificationID uint) (*model.StoreVerification, error) {
-	logger.Debug("Getting verification by ID", map[string]interface{}{
+func (s *storeService) GetVerificationByID(ctx context.Context, verificationID uint) (*model.StoreVerification, error) {
+	log := logger.FromContext(ctx)
+	log.Debug("Getting verification by ID", map[string]interface{}{
 		"verification_id": verificationID,
 	})
 
-	verification, err := s.storeRepo.FindVerificationByID(verificationID)
+	verification, err := s.storeRepo.FindVerificationByID(ctx, verificationID)
 	if err != nil {
-		logger.Error("Verification not found", err, map[string]interface{}{
+		log.Error("Verification not found", err, map[string]interface{}{
 			"verification_id": verificationID,
 		})
 		return nil, err
@@ -919,20 +939,21 @@ func (s *storeService) GetVerificationByID(verificationID uint) (*model.StoreVer
 }
 
 // ListVerificationsByStatus lists verifications by status
-func (s *storeService) ListVerificationsByStatus(status string) ([]*model.StoreVerification, error) {
-	logger.Info("Listing verifications by status", map[string]interface{}{
+func (s *storeService) ListVerificationsByStatus(ctx context.Context, status string) ([]*model.StoreVerification, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Listing verifications by status", map[string]interface{}{
 		"status": status,
 	})
 
-	verifications, err := s.storeRepo.FindVerificationsByStatus(status)
+	verifications, err := s.storeRepo.FindVerificationsByStatus(ctx, status)
 	if err != nil {
-		logger.Error("Failed to list verifications", err, map[string]interface{}{
+		log.Error("Failed to list verifications", err, map[string]interface{}{
 			"status": status,
 		})
 		return nil, err
 	}
 
-	logger.Info("Verifications listed", map[string]interface{}{
+	log.Info("Verifications listed", map[string]interface{}{
 		"status": status,
 		"count":  len(verifications),
 	})
@@ -941,14 +962,15 @@ func (s *storeService) ListVerificationsByStatus(status string) ([]*model.StoreV
 }
 
 // ApproveStoreVerification approves a store verification (sets is_verified to true)
-func (s *storeService) ApproveStoreVerification(storeID uint, verifiedAt *time.Time) error {
-	logger.Info("Approving store verification", map[string]interface{}{
+func (s *storeService) ApproveStoreVerification(ctx context.Context, storeID uint, verifiedAt *time.Time) error {
+	log := logger.FromContext(ctx)
+	log.Info("Approving store verification", map[string]interface{}{
 		"store_id": storeID,
 	})
 
-	store, err := s.storeRepo.FindByID(storeID)
+	store, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
-		logger.Error("Store not found for verification approval", err, map[string]interface{}{
+		log.Error("Store not found for verification approval", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return err
@@ -957,14 +979,14 @@ func (s *storeService) ApproveStoreVerification(storeID uint, verifiedAt *time.T
 	store.IsVerified = true
 	store.VerifiedAt = verifiedAt
 
-	if err := s.storeRepo.Update(store); err != nil {
-		logger.Error("Failed to update store verification status", err, map[string]interface{}{
+	if err := s.storeRepo.Update(ctx, store); err != nil {
+		log.Error("Failed to update store verification status", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return err
 	}
 
-	logger.Info("Store verification approved", map[string]interface{}{
+	log.Info("Store verification approved", map[string]interface{}{
 		"store_id": storeID,
 	})
 
@@ -972,20 +994,21 @@ func (s *storeService) ApproveStoreVerification(storeID uint, verifiedAt *time.T
 }
 
 // UpdateVerification updates a verification record
-func (s *storeService) UpdateVerification(verification *model.StoreVerification) error {
-	logger.Info("Updating verification", map[string]interface{}{
+func (s *storeService) UpdateVerification(ctx context.Context, verification *model.StoreVerification) error {
+	log := logger.FromContext(ctx)
+	log.Info("Updating verification", map[string]interface{}{
 		"verification_id": verification.ID,
 		"status":          verification.Status,
 	})
 
-	if err := s.storeRepo.UpdateVerification(verification); err != nil {
-		logger.Error("Failed to update verification", err, map[string]interface{}{
+	if err := s.storeRepo.UpdateVerification(ctx, verification); err != nil {
+		log.Error("Failed to update verification", err, map[string]interface{}{
 			"verification_id": verification.ID,
 		})
 		return err
 	}
 
-	logger.Info("Verification updated successfully", map[string]interface{}{
+	log.Info("Verification updated successfully", map[string]interface{}{
 		"verification_id": verification.ID,
 		"status":          verification.Status,
 	})
@@ -994,14 +1017,15 @@ func (s *storeService) UpdateVerification(verification *model.StoreVerification)
 }
 
 // RequestStoreRegistration 매장등록 요청 (유저별 1회 제한)
-func (s *storeService) RequestStoreRegistration(storeID, userID uint) (int64, bool, error) {
-	logger.Info("Requesting store registration", map[string]interface{}{
+func (s *storeService) RequestStoreRegistration(ctx context.Context, storeID, userID uint) (int64, bool, error) {
+	log := logger.FromContext(ctx)
+	log.Info("Requesting store registration", map[string]interface{}{
 		"store_id": storeID,
 		"user_id":  userID,
 	})
 
 	// 매장 존재 확인
-	store, err := s.storeRepo.FindByID(storeID)
+	store, err := s.storeRepo.FindByID(ctx, storeID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return 0, false, ErrStoreNotFound
@@ -1031,7 +1055,7 @@ func (s *storeService) RequestStoreRegistration(storeID, userID uint) (int64, bo
 	}
 
 	if err := s.db.Create(&request).Error; err != nil {
-		logger.Error("Failed to create registration request", err, map[string]interface{}{
+		log.Error("Failed to create registration request", err, map[string]interface{}{
 			"store_id": storeID,
 			"user_id":  userID,
 		})
@@ -1042,7 +1066,7 @@ func (s *storeService) RequestStoreRegistration(storeID, userID uint) (int64, bo
 	var count int64
 	s.db.Model(&model.StoreRegistrationRequest{}).Where("store_id = ?", storeID).Count(&count)
 
-	logger.Info("Store registration request created", map[string]interface{}{
+	log.Info("Store registration request created", map[string]interface{}{
 		"store_id":      storeID,
 		"user_id":       userID,
 		"request_count": count,
@@ -1052,10 +1076,10 @@ func (s *storeService) RequestStoreRegistration(storeID, userID uint) (int64, bo
 }
 
 // GetStoreRegistrationRequestCount 매장등록 요청 수 조회
-func (s *storeService) GetStoreRegistrationRequestCount(storeID uint) (int64, error) {
+func (s *storeService) GetStoreRegistrationRequestCount(ctx context.Context, storeID uint) (int64, error) {
 	var count int64
 	if err := s.db.Model(&model.StoreRegistrationRequest{}).Where("store_id = ?", storeID).Count(&count).Error; err != nil {
-		logger.Error("Failed to get registration request count", err, map[string]interface{}{
+		logger.FromContext(ctx).Error("Failed to get registration request count", err, map[string]interface{}{
 			"store_id": storeID,
 		})
 		return 0, err
@@ -1064,7 +1088,7 @@ func (s *storeService) GetStoreRegistrationRequestCount(storeID uint) (int64, er
 }
 
 // HasUserRequestedRegistration 사용자가 매장등록 요청했는지 확인
-func (s *storeService) HasUserRequestedRegistration(storeID, userID uint) (bool, error) {
+func (s *storeService) HasUserRequestedRegistration(ctx context.Context, storeID, userID uint) (bool, error) {
 	var count int64
 	if err := s.db.Model(&model.StoreRegistrationRequest{}).Where("store_id = ? AND user_id = ?", storeID, userID).Count(&count).Error; err != nil {
 		return false, err
@@ -0,0 +1,507 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/internal/imaging"
+	"github.com/ikkim/udonggeum-backend/internal/storage"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+)
+
+var (
+	ErrUploadNotFound    = errors.New("업로드된 파일을 찾을 수 없습니다")
+	ErrUploadForeignURL  = errors.New("저장소에서 발급한 파일 URL이 아닙니다")
+	ErrUploadTooLarge    = errors.New("파일 크기가 허용 범위를 초과했습니다")
+	ErrUploadContentType = errors.New("허용되지 않는 파일 형식입니다")
+	ErrUploadFolder      = errors.New("이 용도로 업로드한 파일이 아닙니다")
+	ErrUploadLocation    = errors.New("이미지의 위치 정보를 제거할 수 없습니다")
+)
+
+// privateFileURLExpiry 비공개 파일 presigned GET URL 유효 시간
+const privateFileURLExpiry = 5 * time.Minute
+
+// UploadPolicy 업로드 용도별 저장 폴더와 크기/형식 제한
+type UploadPolicy struct {
+	Name         string
+	Folder       string // 기본 저장 폴더 (이 폴더 아래 파일은 이 정책으로 검사)
+	MaxSize      int64
+	AllowedTypes []string
+	// Private 비공개 폴더 정책 (이 폴더에 업로드한 파일만 첨부 가능, presigned GET으로만 접근)
+	Private bool
+}
+
+// ImageUploadPolicy 게시글/리뷰/매장 이미지
+var ImageUploadPolicy = UploadPolicy{
+	Name:    "image",
+	Folder:  "community",
+	MaxSize: 10 * 1024 * 1024, // 10MB
+	AllowedTypes: []string{
+		"image/jpeg",
+		"image/jpg",
+		"image/png",
+		"image/gif",
+		"image/webp",
+	},
+}
+
+// ChatFileUploadPolicy 채팅 첨부 파일 (이미지, 문서, 압축 파일, 텍스트)
+// 대화 참여자만 볼 수 있도록 비공개 폴더에 저장
+var ChatFileUploadPolicy = UploadPolicy{
+	Name:    "chat",
+	Folder:  storage.PrivateFolder + "/chat",
+	Private: true,
+	MaxSize: 10 * 1024 * 1024, // 10MB
+	AllowedTypes: []string{
+		// Images
+		"image/jpeg",
+		"image/jpg",
+		"image/png",
+		"image/gif",
+		"image/webp",
+		// Documents
+		"application/pdf",
+		"application/msword",
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.ms-excel",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		// Archives
+		"application/zip",
+		"application/x-rar-compressed",
+		// Text
+		"text/plain",
+	},
+}
+
+// BusinessLicenseUploadPolicy 매장 인증용 사업자등록증 (심사하는 관리자만 볼 수 있도록 비공개)
+var BusinessLicenseUploadPolicy = UploadPolicy{
+	Name:    "license",
+	Folder:  storage.PrivateFolder + "/licenses",
+	Private: true,
+	MaxSize: 10 * 1024 * 1024, // 10MB
+	AllowedTypes: []string{
+		"image/jpeg",
+		"image/jpg",
+		"image/png",
+		"application/pdf",
+	},
+}
+
+// legacyChatFolder 비공개 폴더 도입 전 채팅 첨부 파일 폴더 (기존 파일은 공개 상태로 유지)
+const legacyChatFolder = "chat"
+
+// sniffedContentTypes 선언된 Content-Type별로 파일 내용에서 판별되는 형식
+// (docx/xlsx는 zip 컨테이너, doc/xls는 OLE 복합 문서로 판별됨)
+var sniffedContentTypes = map[string]string{
+	"image/jpg":                "image/jpeg",
+	"application/msword":       "application/x-ole-storage",
+	"application/vnd.ms-excel": "application/x-ole-storage",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": "application/zip",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":       "application/zip",
+}
+
+func sniffedContentType(declared string) string {
+	if sniffed, ok := sniffedContentTypes[declared]; ok {
+		return sniffed
+	}
+	return declared
+}
+
+// UploadPolicyForKey 저장된 key의 폴더에 해당하는 업로드 정책 (채팅/사업자등록증 폴더 외에는 이미지)
+func UploadPolicyForKey(key string) UploadPolicy {
+	switch {
+	case InFolder(key, ChatFileUploadPolicy.Folder), InFolder(key, legacyChatFolder):
+		return ChatFileUploadPolicy
+	case InFolder(key, BusinessLicenseUploadPolicy.Folder):
+		return BusinessLicenseUploadPolicy
+	}
+	return ImageUploadPolicy
+}
+
+// ChatUploadFolder 채팅 파일 업로드 요청 폴더를 비공개 폴더 경로로 변환 (chat/room_1 → private/chat/room_1)
+func ChatUploadFolder(folder string) (string, bool) {
+	switch {
+	case folder == "":
+		return ChatFileUploadPolicy.Folder, true
+	case InFolder(folder, ChatFileUploadPolicy.Folder):
+		return folder, true
+	case InFolder(folder, legacyChatFolder):
+		return storage.PrivateFolder + "/" + folder, true
+	}
+	return "", false
+}
+
+// InFolder key 또는 폴더 경로가 folder와 같거나 그 하위인지 확인
+func InFolder(path, folder string) bool {
+	return path == folder || strings.HasPrefix(path, folder+"/")
+}
+
+// Allows 선언된 Content-Type이 정책에서 허용되는지 확인
+func (p UploadPolicy) Allows(contentType string) bool {
+	return storage.ValidateContentType(contentType, p.AllowedTypes) == nil
+}
+
+// allowsSniffed 파일 내용에서 판별한 형식이 허용 형식 중 하나에 해당하는지 확인
+func (p UploadPolicy) allowsSniffed(sniffed string) bool {
+	for _, allowed := range p.AllowedTypes {
+		if sniffedContentType(allowed) == sniffed {
+			return true
+		}
+	}
+	return false
+}
+
+// UploadListener 업로드 확인/삭제 수신자 (이미지 처리 등에서 구현)
+type UploadListener interface {
+	// OnUploadConfirmed 확인을 통과한 파일마다 호출 (info.ContentType은 내용으로 판별한 형식)
+	// 같은 파일을 여러 번 확인할 수 있으므로 중복 호출에 안전해야 함
+	OnUploadConfirmed(ctx context.Context, fileURL string, info *storage.ObjectInfo)
+	// OnUploadDeleted 사용되지 않는 업로드 파일을 저장소에서 삭제한 뒤 호출
+	OnUploadDeleted(ctx context.Context, key string)
+}
+
+// orphanSweepBatchSize 사용 여부를 한 번에 확인할 업로드 수
+const orphanSweepBatchSize = 200
+
+// OrphanSweepReport 사용되지 않는 업로드 정리 결과
+type OrphanSweepReport struct {
+	DryRun  bool           `json:"dry_run"`
+	Scanned int            `json:"scanned"` // 유예 기간이 지난 업로드 수
+	Orphans []model.Upload `json:"orphans"` // 어디에서도 참조하지 않는 업로드
+	Deleted int            `json:"deleted"`
+	Failed  int            `json:"failed"`
+}
+
+// FileAccessURL 파일을 내려받을 수 있는 URL (비공개 파일은 ExpiresAt까지만 유효)
+type FileAccessURL struct {
+	URL       string     `json:"url"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// UploadService 업로드 발급/확인/정리 서비스
+// presigned URL 발급을 기록하고, 업로드된 파일이 실제로 존재하고 용도별 제한을 지키는지 확인
+type UploadService interface {
+	// AddListener 업로드 확인/삭제 수신자 등록
+	AddListener(listener UploadListener)
+
+	// PresignUpload 정책에 맞는 presigned POST를 발급하고 업로드 기록에 등록
+	PresignUpload(ctx context.Context, userID uint, filename, contentType, folder string, policy UploadPolicy) (*storage.PresignedPostResponse, error)
+	// ConfirmUpload userID가 첨부하려는 파일 확인 (정책 위반 파일은 본인이 올리고 아직 쓰이지 않은 경우에만 삭제)
+	ConfirmUpload(ctx context.Context, userID uint, fileURL string, policy UploadPolicy) (*storage.ObjectInfo, error)
+	VerifyAttachments(ctx context.Context, userID uint, policy UploadPolicy, fileURLs ...string) error
+	// AccessURL 저장된 파일 URL로 내려받을 URL 발급 (비공개 파일만 짧은 presigned GET, 권한 확인은 호출하는 쪽에서)
+	AccessURL(fileURL string) (*FileAccessURL, error)
+	// SweepOrphans olderThan 이전에 발급되어 어디에서도 참조하지 않는 업로드 삭제 (dryRun이면 목록만 보고)
+	SweepOrphans(ctx context.Context, olderThan time.Time, dryRun bool) (*OrphanSweepReport, error)
+}
+
+type uploadService struct {
+	storage    storage.Storage
+	uploadRepo repository.UploadRepository
+	listeners  []UploadListener
+}
+
+func NewUploadService(storage storage.Storage, uploadRepo repository.UploadRepository) UploadService {
+	return &uploadService{
+		storage:    storage,
+		uploadRepo: uploadRepo,
+	}
+}
+
+func (s *uploadService) PresignUpload(ctx context.Context, userID uint, filename, contentType, folder string, policy UploadPolicy) (*storage.PresignedPostResponse, error) {
+	response, err := s.storage.GeneratePresignedPost(filename, contentType, folder, policy.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// 기록되지 않은 업로드는 정리할 수 없으므로 기록에 실패하면 URL을 발급하지 않음
+	upload := &model.Upload{
+		Key:         response.Key,
+		FileURL:     response.FileURL,
+		Folder:      folder,
+		UserID:      userID,
+		ContentType: contentType,
+	}
+	if err := s.uploadRepo.Create(ctx, upload); err != nil {
+		return nil, fmt.Errorf("failed to register upload: %w", err)
+	}
+	return response, nil
+}
+
+// ConfirmUpload 업로드된 파일의 존재, 크기, 실제 내용 형식 확인
+// 반환하는 ObjectInfo의 ContentType은 파일 내용에서 판별한 형식
+// 이미지는 첨부되기 전에 촬영 위치 정보를 제거하고, 제거할 수 없으면 거절
+// 파일이 저장된 폴더의 정책도 위반하면, 요청한 사용자가 올리고 아직 쓰이지 않은 업로드인 경우에만 삭제
+func (s *uploadService) ConfirmUpload(ctx context.Context, userID uint, fileURL string, policy UploadPolicy) (*storage.ObjectInfo, error) {
+	key, ok := s.storage.KeyFromURL(fileURL)
+	if !ok {
+		return nil, ErrUploadForeignURL
+	}
+	// 공개 폴더 파일을 비공개 용도로 첨부하거나, 비공개 파일을 공개 용도로 첨부하지 않도록
+	if policy.Private && !InFolder(key, policy.Folder) || !policy.Private && storage.IsPrivate(key) {
+		return nil, ErrUploadFolder
+	}
+
+	info, err := s.inspect(ctx, key, policy)
+	if err != nil {
+		if isPolicyViolation(err) {
+			s.discardIfViolating(ctx, userID, key, policy, err)
+		}
+		return nil, err
+	}
+	if err := s.stripLocation(ctx, info); err != nil {
+		return nil, err
+	}
+
+	for _, listener := range s.listeners {
+		listener.OnUploadConfirmed(ctx, fileURL, info)
+	}
+	return info, nil
+}
+
+// AddListener 업로드 확인 수신자 등록 (서버 시작 시에만 호출)
+func (s *uploadService) AddListener(listener UploadListener) {
+	s.listeners = append(s.listeners, listener)
+}
+
+// discardIfViolating 폴더 정책을 위반한 파일 삭제
+// 다른 용도의 더 엄격한 정책으로 확인한 경우에는 폴더 정책으로 다시 검사해 정상 파일을 지우지 않음
+// 업로드 기록이 없거나(도입 전 파일), 다른 사용자가 올렸거나, 이미 첨부된 파일은 요청만 거절하고 남겨 둠
+func (s *uploadService) discardIfViolating(ctx context.Context, userID uint, key string, checked UploadPolicy, violation error) {
+	own := UploadPolicyForKey(key)
+	if own.Name != checked.Name {
+		_, violation = s.inspect(ctx, key, own)
+		if !isPolicyViolation(violation) {
+			return
+		}
+	}
+
+	log := logger.FromContext(ctx)
+	upload, err := s.uploadRepo.FindByKey(ctx, key)
+	if err != nil {
+		log.Error("Failed to load upload violating policy", err, map[string]interface{}{
+			"key": key,
+		})
+		return
+	}
+	if upload == nil || upload.UserID != userID {
+		return
+	}
+	// 이미 게시글 등에 첨부된 파일은 삭제하지 않음
+	orphans, err := s.findOrphans(ctx, []model.Upload{*upload})
+	if err != nil || len(orphans) == 0 {
+		return
+	}
+
+	if err := s.deleteUpload(ctx, *upload); err != nil {
+		log.Error("Failed to delete upload violating policy", err, map[string]interface{}{
+			"key": key,
+		})
+		return
+	}
+	log.Warn("Deleted upload violating policy", map[string]interface{}{
+		"key":     key,
+		"user_id": userID,
+		"policy":  own.Name,
+		"reason":  violation.Error(),
+	})
+}
+
+func isPolicyViolation(err error) bool {
+	return errors.Is(err, ErrUploadTooLarge) || errors.Is(err, ErrUploadContentType)
+}
+
+// stripLocation 이미지 메타데이터의 촬영 위치 정보를 지우고 원본을 교체 (이미 지운 파일은 그대로)
+func (s *uploadService) stripLocation(ctx context.Context, info *storage.ObjectInfo) error {
+	if !imaging.CarriesLocation(info.ContentType) {
+		return nil
+	}
+
+	reader, err := s.storage.Open(ctx, info.Key)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return ErrUploadNotFound
+		}
+		return fmt.Errorf("failed to open upload: %w", err)
+	}
+	defer reader.Close()
+	// 크기는 inspect에서 확인했으나 그 사이 덮어쓴 경우를 대비해 제한
+	data, err := io.ReadAll(io.LimitReader(reader, info.Size+1))
+	if err != nil {
+		return fmt.Errorf("failed to read upload: %w", err)
+	}
+	if int64(len(data)) != info.Size {
+		return fmt.Errorf("%w: file changed while confirming", ErrUploadLocation)
+	}
+
+	stripped, changed, err := imaging.StripLocation(data, info.ContentType)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUploadLocation, err)
+	}
+	if !changed {
+		return nil
+	}
+	if err := s.storage.Put(ctx, info.Key, info.ContentType, stripped); err != nil {
+		return fmt.Errorf("failed to replace upload: %w", err)
+	}
+	info.Size = int64(len(stripped))
+	logger.FromContext(ctx).Info("Stripped location metadata from upload", map[string]interface{}{
+		"key": info.Key,
+	})
+	return nil
+}
+
+// inspect 저장된 파일을 정책으로 검사
+func (s *uploadService) inspect(ctx context.Context, key string, policy UploadPolicy) (*storage.ObjectInfo, error) {
+	info, err := s.storage.Stat(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotFound) {
+			return nil, ErrUploadNotFound
+		}
+		return nil, fmt.Errorf("failed to stat upload: %w", err)
+	}
+
+	if err := storage.ValidateFileSize(info.Size, policy.MaxSize); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUploadTooLarge, err)
+	}
+
+	// 빈 파일은 범위 읽기가 실패하므로 읽지 않음
+	var head []byte
+	if info.Size > 0 {
+		head, err = s.storage.ReadHead(ctx, key, storage.SniffLength)
+		if err != nil {
+			if errors.Is(err, storage.ErrObjectNotFound) {
+				return nil, ErrUploadNotFound
+			}
+			return nil, fmt.Errorf("failed to read upload: %w", err)
+		}
+	}
+	sniffed := storage.SniffContentType(head)
+
+	// 업로드 시 선언한 형식이 허용 목록에 있고, 실제 내용도 선언한 형식과 일치해야 함
+	if info.ContentType != "" {
+		if !policy.Allows(info.ContentType) {
+			return nil, fmt.Errorf("%w: %s", ErrUploadContentType, info.ContentType)
+		}
+		if sniffedContentType(info.ContentType) != sniffed {
+			return nil, fmt.Errorf("%w: declared %s, detected %s", ErrUploadContentType, info.ContentType, sniffed)
+		}
+	} else if !policy.allowsSniffed(sniffed) {
+		return nil, fmt.Errorf("%w: %s", ErrUploadContentType, sniffed)
+	}
+
+	return &storage.ObjectInfo{
+		Key:         info.Key,
+		Size:        info.Size,
+		ContentType: sniffed,
+	}, nil
+}
+
+// VerifyAttachments 게시글/리뷰/메시지/매장에 첨부할 파일 URL 일괄 확인
+func (s *uploadService) VerifyAttachments(ctx context.Context, userID uint, policy UploadPolicy, fileURLs ...string) error {
+	for _, fileURL := range fileURLs {
+		if _, err := s.ConfirmUpload(ctx, userID, fileURL, policy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *uploadService) AccessURL(fileURL string) (*FileAccessURL, error) {
+	// 저장소 밖 URL이나 비공개 폴더 도입 전 파일은 공개 URL 그대로
+	key, ok := s.storage.KeyFromURL(fileURL)
+	if !ok || !storage.IsPrivate(key) {
+		return &FileAccessURL{URL: fileURL}, nil
+	}
+
+	expiresAt := time.Now().Add(privateFileURLExpiry)
+	url, err := s.storage.PresignGet(key, privateFileURLExpiry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign file url: %w", err)
+	}
+	return &FileAccessURL{URL: url, ExpiresAt: &expiresAt}, nil
+}
+
+// SweepOrphans 유예 기간이 지난 업로드 중 참조되지 않는 파일을 저장소에서 삭제하고 기록 제거
+// 저장소 주소 설정이 바뀌었을 수 있으므로 발급 당시 URL과 현재 URL 모두로 참조 여부 확인
+func (s *uploadService) SweepOrphans(ctx context.Context, olderThan time.Time, dryRun bool) (*OrphanSweepReport, error) {
+	report := &OrphanSweepReport{DryRun: dryRun, Orphans: []model.Upload{}}
+
+	var afterID uint
+	for {
+		uploads, err := s.uploadRepo.FindCreatedBefore(ctx, olderThan, afterID, orphanSweepBatchSize)
+		if err != nil {
+			return report, fmt.Errorf("failed to load uploads: %w", err)
+		}
+		if len(uploads) == 0 {
+			return report, nil
+		}
+		afterID = uploads[len(uploads)-1].ID
+		report.Scanned += len(uploads)
+
+		orphans, err := s.findOrphans(ctx, uploads)
+		if err != nil {
+			return report, err
+		}
+		report.Orphans = append(report.Orphans, orphans...)
+		if dryRun {
+			continue
+		}
+
+		for _, orphan := range orphans {
+			if err := s.deleteUpload(ctx, orphan); err != nil {
+				report.Failed++
+				logger.FromContext(ctx).Error("Failed to delete orphaned upload", err, map[string]interface{}{
+					"key": orphan.Key,
+				})
+				continue
+			}
+			report.Deleted++
+		}
+	}
+}
+
+// findOrphans uploads 중 참조되지 않는 업로드
+func (s *uploadService) findOrphans(ctx context.Context, uploads []model.Upload) ([]model.Upload, error) {
+	urls := make([]string, 0, len(uploads)*2)
+	for _, upload := range uploads {
+		urls = append(urls, upload.FileURL)
+		if current := s.storage.FileURL(upload.Key); current != upload.FileURL {
+			urls = append(urls, current)
+		}
+	}
+
+	referenced, err := s.uploadRepo.FindReferencedURLs(ctx, urls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check upload references: %w", err)
+	}
+	inUse := make(map[string]bool, len(referenced))
+	for _, url := range referenced {
+		inUse[url] = true
+	}
+
+	var orphans []model.Upload
+	for _, upload := range uploads {
+		if !inUse[upload.FileURL] && !inUse[s.storage.FileURL(upload.Key)] {
+			orphans = append(orphans, upload)
+		}
+	}
+	return orphans, nil
+}
+
+// deleteUpload 저장소에서 먼저 삭제하고 기록 제거 (저장소 삭제에 실패하면 다음 정리 때 다시 시도)
+func (s *uploadService) deleteUpload(ctx context.Context, upload model.Upload) error {
+	if err := s.storage.Delete(ctx, upload.Key); err != nil {
+		return err
+	}
+	for _, listener := range s.listeners {
+		listener.OnUploadDeleted(ctx, upload.Key)
+	}
+	return s.uploadRepo.Delete(ctx, upload.ID)
+}
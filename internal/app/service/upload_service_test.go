@@ -0,0 +1,326 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/repository"
+	"github.com/ikkim/udonggeum-backend/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fakeStorageURL = "https://cdn.example.com/"
+
+type fakeObject struct {
+	contentType string
+	data        []byte
+}
+
+type fakeStorage struct {
+	storage.Storage
+	objects map[string]fakeObject
+}
+
+func (s *fakeStorage) KeyFromURL(fileURL string) (string, bool) {
+	return strings.CutPrefix(fileURL, fakeStorageURL)
+}
+
+func (s *fakeStorage) Stat(ctx context.Context, key string) (*storage.ObjectInfo, error) {
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return &storage.ObjectInfo{Key: key, Size: int64(len(object.data)), ContentType: object.contentType}, nil
+}
+
+func (s *fakeStorage) Delete(ctx context.Context, key string) error {
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *fakeStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, storage.ErrObjectNotFound
+	}
+	return io.NopCloser(bytes.NewReader(object.data)), nil
+}
+
+func (s *fakeStorage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	s.objects[key] = fakeObject{contentType: contentType, data: data}
+	return nil
+}
+
+func (s *fakeStorage) FileURL(key string) string {
+	return fakeStorageURL + key
+}
+
+func (s *fakeStorage) PresignGet(key string, expiry time.Duration) (string, error) {
+	return fakeStorageURL + key + "?signature=test", nil
+}
+
+func (s *fakeStorage) ReadHead(ctx context.Context, key string, n int64) ([]byte, error) {
+	data := s.objects[key].data
+	if int64(len(data)) > n {
+		data = data[:n]
+	}
+	return data, nil
+}
+
+type fakeUploadRepository struct {
+	repository.UploadRepository
+	uploads    []model.Upload
+	referenced map[string]bool
+}
+
+func (r *fakeUploadRepository) Create(ctx context.Context, upload *model.Upload) error {
+	upload.ID = uint(len(r.uploads) + 1)
+	if upload.CreatedAt.IsZero() {
+		upload.CreatedAt = time.Now()
+	}
+	r.uploads = append(r.uploads, *upload)
+	return nil
+}
+
+func (r *fakeUploadRepository) FindByKey(ctx context.Context, key string) (*model.Upload, error) {
+	for _, upload := range r.uploads {
+		if upload.Key == key {
+			return &upload, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUploadRepository) FindCreatedBefore(ctx context.Context, before time.Time, afterID uint, limit int) ([]model.Upload, error) {
+	var uploads []model.Upload
+	for _, upload := range r.uploads {
+		if upload.CreatedAt.Before(before) && upload.ID > afterID && len(uploads) < limit {
+			uploads = append(uploads, upload)
+		}
+	}
+	return uploads, nil
+}
+
+func (r *fakeUploadRepository) FindReferencedURLs(ctx context.Context, urls []string) ([]string, error) {
+	var referenced []string
+	for _, url := range urls {
+		if r.referenced[url] {
+			referenced = append(referenced, url)
+		}
+	}
+	return referenced, nil
+}
+
+func (r *fakeUploadRepository) Delete(ctx context.Context, id uint) error {
+	for i, upload := range r.uploads {
+		if upload.ID == id {
+			r.uploads = append(r.uploads[:i], r.uploads[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+type recordingUploadListener struct {
+	deleted []string
+}
+
+func (l *recordingUploadListener) OnUploadConfirmed(context.Context, string, *storage.ObjectInfo) {}
+
+func (l *recordingUploadListener) OnUploadDeleted(ctx context.Context, key string) {
+	l.deleted = append(l.deleted, key)
+}
+
+func TestUploadService_ConfirmUpload(t *testing.T) {
+	ctx := context.Background()
+	pngData := encodePNG(t, 1, 1)
+	store := &fakeStorage{objects: map[string]fakeObject{
+		"community/ok.png":   {contentType: "image/png", data: pngData},
+		"community/fake.png": {contentType: "image/png", data: []byte("<html><script>alert(1)</script>")},
+		"community/big.png":  {contentType: "image/png", data: append(pngData, make([]byte, 64)...)},
+		"private/chat/report.docx": {
+			contentType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+			data:        []byte("PK\x03\x04\x14\x00\x06\x00"),
+		},
+		"local/noType.png":      {data: pngData},
+		"community/foreign.png": {contentType: "image/png", data: []byte("<html>")},
+		"community/used.png":    {contentType: "image/png", data: []byte("<html>")},
+		"community/legacy.png":  {contentType: "image/png", data: []byte("<html>")},
+	}}
+	repo := &fakeUploadRepository{referenced: map[string]bool{fakeStorageURL + "community/used.png": true}}
+	for _, upload := range []model.Upload{
+		{Key: "community/fake.png", UserID: 1},
+		{Key: "community/foreign.png", UserID: 2},
+		{Key: "community/used.png", UserID: 1},
+	} {
+		upload.FileURL = fakeStorageURL + upload.Key
+		require.NoError(t, repo.Create(ctx, &upload))
+	}
+	svc := NewUploadService(store, repo)
+
+	info, err := svc.ConfirmUpload(ctx, 1, fakeStorageURL+"community/ok.png", ImageUploadPolicy)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", info.ContentType)
+	assert.Equal(t, int64(len(pngData)), info.Size)
+
+	_, err = svc.ConfirmUpload(ctx, 1, "https://evil.example.com/a.png", ImageUploadPolicy)
+	assert.ErrorIs(t, err, ErrUploadForeignURL)
+
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"community/missing.png", ImageUploadPolicy)
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+
+	// 선언한 형식과 실제 내용이 다르면 폴더 정책 위반으로 삭제
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"community/fake.png", ImageUploadPolicy)
+	assert.ErrorIs(t, err, ErrUploadContentType)
+	assert.NotContains(t, store.objects, "community/fake.png")
+	upload, err := repo.FindByKey(ctx, "community/fake.png")
+	require.NoError(t, err)
+	assert.Nil(t, upload)
+
+	// 다른 사용자가 올렸거나, 이미 첨부됐거나, 업로드 기록이 없는 파일은 거절만 하고 남겨 둠
+	for _, key := range []string{"community/foreign.png", "community/used.png", "community/legacy.png"} {
+		_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+key, ImageUploadPolicy)
+		assert.ErrorIs(t, err, ErrUploadContentType)
+		assert.Contains(t, store.objects, key)
+	}
+
+	// 더 엄격한 정책으로 확인한 경우 폴더 정책(이미지)을 지키면 삭제하지 않음
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"community/big.png", UploadPolicy{Name: "small", MaxSize: 32, AllowedTypes: ImageUploadPolicy.AllowedTypes})
+	assert.ErrorIs(t, err, ErrUploadTooLarge)
+	assert.Contains(t, store.objects, "community/big.png")
+
+	// docx는 zip 컨테이너로 판별됨
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"private/chat/report.docx", ChatFileUploadPolicy)
+	assert.NoError(t, err)
+	// 비공개 파일은 공개 용도로, 공개 파일은 비공개 용도로 첨부할 수 없음
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"private/chat/report.docx", ImageUploadPolicy)
+	assert.ErrorIs(t, err, ErrUploadFolder)
+	assert.Contains(t, store.objects, "private/chat/report.docx")
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"community/ok.png", BusinessLicenseUploadPolicy)
+	assert.ErrorIs(t, err, ErrUploadFolder)
+
+	// Content-Type을 보관하지 않는 저장소는 내용으로만 판별
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"local/noType.png", ImageUploadPolicy)
+	assert.NoError(t, err)
+
+	assert.NoError(t, svc.VerifyAttachments(ctx, 1, ImageUploadPolicy))
+	assert.ErrorIs(t, svc.VerifyAttachments(ctx, 1, ImageUploadPolicy, fakeStorageURL+"community/ok.png", fakeStorageURL+"community/missing.png"), ErrUploadNotFound)
+}
+
+func TestUploadService_ConfirmUploadStripsLocation(t *testing.T) {
+	ctx := context.Background()
+	// IHDR 뒤에 촬영 위치가 든 XMP(iTXt) 청크 삽입
+	plain := encodePNG(t, 4, 4)
+	ihdrEnd := 8 + 12 + 13
+	xmp := []byte("iTXtXML:com.adobe.xmp\x00\x00\x00\x00\x00<rdf:Description exif:GPSLatitude=\"37,33.12N\"/>")
+	located := append([]byte{}, plain[:ihdrEnd]...)
+	located = binary.BigEndian.AppendUint32(located, uint32(len(xmp)-4))
+	located = append(located, xmp...)
+	located = binary.BigEndian.AppendUint32(located, crc32.ChecksumIEEE(xmp))
+	located = append(located, plain[ihdrEnd:]...)
+
+	store := &fakeStorage{objects: map[string]fakeObject{
+		"community/located.png": {contentType: "image/png", data: located},
+		"community/broken.png":  {contentType: "image/png", data: plain[:ihdrEnd+4]},
+	}}
+	svc := NewUploadService(store, &fakeUploadRepository{})
+
+	// 첨부 전에 원본에서 위치 정보 제거
+	info, err := svc.ConfirmUpload(ctx, 1, fakeStorageURL+"community/located.png", ImageUploadPolicy)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(store.objects["community/located.png"].data, []byte("GPSLatitude")))
+	assert.Equal(t, int64(len(store.objects["community/located.png"].data)), info.Size)
+	_, err = png.Decode(bytes.NewReader(store.objects["community/located.png"].data))
+	assert.NoError(t, err)
+
+	// 위치 정보를 확인할 수 없는 이미지는 첨부 거절
+	_, err = svc.ConfirmUpload(ctx, 1, fakeStorageURL+"community/broken.png", ImageUploadPolicy)
+	assert.ErrorIs(t, err, ErrUploadLocation)
+}
+
+func TestUploadService_AccessURL(t *testing.T) {
+	svc := NewUploadService(&fakeStorage{objects: map[string]fakeObject{}}, &fakeUploadRepository{})
+
+	// 비공개 파일은 presigned GET URL
+	access, err := svc.AccessURL(fakeStorageURL + "private/licenses/a.pdf")
+	require.NoError(t, err)
+	assert.Equal(t, fakeStorageURL+"private/licenses/a.pdf?signature=test", access.URL)
+	require.NotNil(t, access.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(privateFileURLExpiry), *access.ExpiresAt, time.Minute)
+
+	// 공개 파일과 저장소 밖 URL은 그대로
+	for _, url := range []string{fakeStorageURL + "chat/legacy.png", "https://example.com/a.png"} {
+		access, err = svc.AccessURL(url)
+		require.NoError(t, err)
+		assert.Equal(t, url, access.URL)
+		assert.Nil(t, access.ExpiresAt)
+	}
+}
+
+func TestChatUploadFolder(t *testing.T) {
+	for folder, want := range map[string]string{
+		"":                    "private/chat",
+		"chat":                "private/chat",
+		"chat/room_1":         "private/chat/room_1",
+		"private/chat/room_1": "private/chat/room_1",
+	} {
+		got, ok := ChatUploadFolder(folder)
+		assert.True(t, ok, folder)
+		assert.Equal(t, want, got)
+	}
+
+	for _, folder := range []string{"community", "private", "private/licenses", "chatroom"} {
+		_, ok := ChatUploadFolder(folder)
+		assert.False(t, ok, folder)
+	}
+}
+
+func TestUploadService_SweepOrphans(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeStorage{objects: map[string]fakeObject{}}
+	old := time.Now().Add(-96 * time.Hour)
+	repo := &fakeUploadRepository{referenced: map[string]bool{
+		fakeStorageURL + "community/used.png": true,
+	}}
+	for _, key := range []string{"community/used.png", "community/orphan.png", "chat/never-uploaded.pdf"} {
+		store.objects[key] = fakeObject{data: []byte("data")}
+		require.NoError(t, repo.Create(ctx, &model.Upload{Key: key, FileURL: fakeStorageURL + key, CreatedAt: old}))
+	}
+	delete(store.objects, "chat/never-uploaded.pdf")
+	// 유예 기간이 지나지 않은 업로드는 대상이 아님
+	store.objects["community/draft.png"] = fakeObject{data: []byte("data")}
+	require.NoError(t, repo.Create(ctx, &model.Upload{Key: "community/draft.png", FileURL: fakeStorageURL + "community/draft.png"}))
+
+	svc := NewUploadService(store, repo)
+	listener := &recordingUploadListener{}
+	svc.AddListener(listener)
+	olderThan := time.Now().Add(-72 * time.Hour)
+
+	report, err := svc.SweepOrphans(ctx, olderThan, true)
+	require.NoError(t, err)
+	assert.Equal(t, 3, report.Scanned)
+	require.Len(t, report.Orphans, 2)
+	assert.Equal(t, "community/orphan.png", report.Orphans[0].Key)
+	assert.Equal(t, "chat/never-uploaded.pdf", report.Orphans[1].Key)
+	assert.Zero(t, report.Deleted)
+	assert.Contains(t, store.objects, "community/orphan.png")
+	assert.Len(t, repo.uploads, 4)
+
+	report, err = svc.SweepOrphans(ctx, olderThan, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Deleted)
+	assert.NotContains(t, store.objects, "community/orphan.png")
+	assert.Contains(t, store.objects, "community/used.png")
+	assert.Contains(t, store.objects, "community/draft.png")
+	assert.Equal(t, []string{"community/orphan.png", "chat/never-uploaded.pdf"}, listener.deleted)
+	assert.Len(t, repo.uploads, 2)
+}
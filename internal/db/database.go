@@ -1,13 +1,13 @@
 package db
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ikkim/udonggeum-backend/config"
 	appLogger "github.com/ikkim/udonggeum-backend/pkg/logger"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
 var DB *gorm.DB
@@ -25,12 +25,16 @@ func Initialize(cfg *config.DatabaseConfig) error {
 
 	var err error
 	DB, err = gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Silent), // Use silent mode, we'll use our own logger
+		Logger: newQueryLogger(), // 실패/느린 쿼리를 요청 ID와 함께 기록
 	})
 	if err != nil {
 		return fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	if err := registerQueryMetrics(DB); err != nil {
+		return fmt.Errorf("failed to register query metrics: %w", err)
+	}
+
 	sqlDB, err := DB.DB()
 	if err != nil {
 		return fmt.Errorf("failed to get database instance: %w", err)
@@ -56,6 +60,15 @@ func Close() error {
 	return sqlDB.Close()
 }
 
+// Ping checks that the database connection is alive
+func Ping(ctx context.Context) error {
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
 // GetDB returns the database instance
 func GetDB() *gorm.DB {
 	return DB
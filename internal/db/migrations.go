@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ikkim/udonggeum-backend/db/migrations"
+	"github.com/ikkim/udonggeum-backend/internal/migrate"
+)
+
+// initialSchemaVersion 버전 관리 이전(AutoMigrate) 스키마에 해당하는 마이그레이션
+const initialSchemaVersion = 20260101000000
+
+// NewMigrator db/migrations의 SQL 파일을 적용하는 Migrator 생성
+// users 테이블은 있는데 적용 기록이 없으면 AutoMigrate로 만든 기존 DB로 보고 초기 스키마를 적용된 것으로 기록
+func NewMigrator() (*migrate.Migrator, error) {
+	sqlDB, err := DB.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := migrate.Load(migrations.FS)
+	if err != nil {
+		return nil, err
+	}
+
+	migrator := migrate.New(sqlDB, files)
+	migrator.AdoptLegacySchema("users", initialSchemaVersion)
+	return migrator, nil
+}
+
+// CheckMigrations 적용되지 않은 마이그레이션이 있거나 적용된 파일이 바뀌었으면 에러 (스키마는 변경하지 않음)
+func CheckMigrations(ctx context.Context) error {
+	migrator, err := NewMigrator()
+	if err != nil {
+		return err
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%d pending migrations (first: %d_%s), run `go run ./cmd/migrate up`",
+			len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appLogger "github.com/ikkim/udonggeum-backend/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// slowQueryThreshold 이보다 오래 걸린 쿼리는 경고로 기록
+const slowQueryThreshold = 500 * time.Millisecond
+
+// queryLogger GORM 쿼리 로그를 요청 context의 logger로 기록 (요청 ID가 함께 남음)
+// 실패한 쿼리와 느린 쿼리만 기록하고, 쿼리 값은 개인정보가 포함될 수 있어 남기지 않음
+type queryLogger struct {
+	level logger.LogLevel
+}
+
+func newQueryLogger() logger.Interface {
+	return &queryLogger{level: logger.Warn}
+}
+
+func (l *queryLogger) LogMode(level logger.LogLevel) logger.Interface {
+	return &queryLogger{level: level}
+}
+
+func (l *queryLogger) Info(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Info {
+		appLogger.FromContext(ctx).Info(fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *queryLogger) Warn(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Warn {
+		appLogger.FromContext(ctx).Warn(fmt.Sprintf(msg, args...))
+	}
+}
+
+func (l *queryLogger) Error(ctx context.Context, msg string, args ...interface{}) {
+	if l.level >= logger.Error {
+		appLogger.FromContext(ctx).Error(fmt.Sprintf(msg, args...), nil)
+	}
+}
+
+func (l *queryLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.level >= logger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		sql, rows := fc()
+		appLogger.FromContext(ctx).Error("Database query failed", err, map[string]interface{}{
+			"sql":        sql,
+			"rows":       rows,
+			"elapsed_ms": elapsed.Milliseconds(),
+		})
+	case elapsed > slowQueryThreshold && l.level >= logger.Warn:
+		sql, rows := fc()
+		appLogger.FromContext(ctx).Warn("Slow database query", map[string]interface{}{
+			"sql":        sql,
+			"rows":       rows,
+			"elapsed_ms": elapsed.Milliseconds(),
+		})
+	case l.level >= logger.Info:
+		sql, rows := fc()
+		appLogger.FromContext(ctx).Debug("Database query", map[string]interface{}{
+			"sql":        sql,
+			"rows":       rows,
+			"elapsed_ms": elapsed.Milliseconds(),
+		})
+	}
+}
+
+// ParamsFilter 쿼리 값 대신 자리표시자($1, $2...)만 기록 (gorm.ParamsFilter)
+func (l *queryLogger) ParamsFilter(ctx context.Context, sql string, params ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"gorm.io/gorm"
+)
+
+const queryStartKey = "metrics:query_start"
+
+// callbackRegisterer GORM 콜백 등록 지점 (Before/After가 반환하는 값)
+type callbackRegisterer interface {
+	Register(name string, fn func(*gorm.DB)) error
+}
+
+// registerQueryMetrics 쿼리 처리 시간/실패 지표를 기록하는 GORM 콜백과 커넥션 풀 지표 등록
+func registerQueryMetrics(db *gorm.DB) error {
+	cb := db.Callback()
+	hooks := []struct {
+		operation     string
+		before, after callbackRegisterer
+	}{
+		{"create", cb.Create().Before("gorm:create"), cb.Create().After("gorm:create")},
+		{"query", cb.Query().Before("gorm:query"), cb.Query().After("gorm:query")},
+		{"update", cb.Update().Before("gorm:update"), cb.Update().After("gorm:update")},
+		{"delete", cb.Delete().Before("gorm:delete"), cb.Delete().After("gorm:delete")},
+		{"row", cb.Row().Before("gorm:row"), cb.Row().After("gorm:row")},
+		{"raw", cb.Raw().Before("gorm:raw"), cb.Raw().After("gorm:raw")},
+	}
+	for _, hook := range hooks {
+		if err := hook.before.Register("metrics:before_"+hook.operation, startQueryTimer); err != nil {
+			return err
+		}
+		if err := hook.after.Register("metrics:after_"+hook.operation, observeQuery(hook.operation)); err != nil {
+			return err
+		}
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return metrics.Registry.Register(collectors.NewDBStatsCollector(sqlDB, "udonggeum"))
+}
+
+func startQueryTimer(db *gorm.DB) {
+	db.InstanceSet(queryStartKey, time.Now())
+}
+
+func observeQuery(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+
+		// raw 쿼리는 테이블을 알 수 없음
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		metrics.DBQueryDuration.WithLabelValues(operation, table).Observe(time.Since(start).Seconds())
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			metrics.DBQueryErrors.WithLabelValues(operation, table).Inc()
+		}
+	}
+}
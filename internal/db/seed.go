@@ -8,107 +8,6 @@ import (
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
 )
 
-// Migrate runs database migrations
-func Migrate() error {
-	logger.Info("Running database migrations...")
-
-	models := []interface{}{
-		&model.User{},
-		&model.PasswordReset{},
-		&model.Store{},
-		&model.BusinessRegistration{},
-		&model.StoreVerification{},
-		&model.GoldPrice{},
-		&model.CommunityPost{},
-		&model.CommunityComment{},
-		&model.PostLike{},
-		&model.CommentLike{},
-		&model.StoreReview{},
-		&model.ReviewLike{},
-		&model.StoreLike{},
-		&model.StoreRegistrationRequest{},
-		&model.Tag{},
-		&model.StoreTag{},
-		&model.ChatRoom{},
-		&model.Message{},
-		&model.Notification{},
-		&model.NotificationSettings{},
-		&model.FAQ{},
-	}
-
-	if err := DB.AutoMigrate(models...); err != nil {
-		logger.Error("Failed to run migrations", err)
-		return err
-	}
-
-	if err := runCustomMigrations(); err != nil {
-		logger.Error("Failed to run custom migrations", err)
-		return err
-	}
-
-	if err := seedInitialData(); err != nil {
-		logger.Error("Failed to seed initial data during migration", err)
-		return err
-	}
-
-	logger.Info("Database migrations completed successfully", map[string]interface{}{
-		"models_count": len(models),
-	})
-	return nil
-}
-
-// runCustomMigrations 이미 적용된 인덱스는 건너뛰고 필요한 것만 실행
-func runCustomMigrations() error {
-	type migration struct {
-		name string
-		sql  string
-	}
-
-	migrations := []migration{
-		{
-			name: "idx_stores_fts",
-			sql: `CREATE INDEX IF NOT EXISTS idx_stores_fts ON stores USING GIN (
-				to_tsvector(
-					'simple',
-					coalesce(name, '') || ' ' ||
-					coalesce(region, '') || ' ' ||
-					coalesce(district, '') || ' ' ||
-					coalesce(dong, '') || ' ' ||
-					coalesce(address, '')
-				)
-			)`,
-		},
-	}
-
-	for _, m := range migrations {
-		// pg_indexes로 이미 존재하는지 확인
-		var count int64
-		if err := DB.Raw("SELECT COUNT(*) FROM pg_indexes WHERE indexname = ?", m.name).Scan(&count).Error; err != nil {
-			return err
-		}
-
-		if count > 0 {
-			logger.Info("Custom migration already applied, skipping", map[string]interface{}{
-				"migration": m.name,
-			})
-			continue
-		}
-
-		if err := DB.Exec(m.sql).Error; err != nil {
-			logger.Error("Failed to apply custom migration", err, map[string]interface{}{
-				"migration": m.name,
-			})
-			return err
-		}
-
-		logger.Info("Custom migration applied", map[string]interface{}{
-			"migration": m.name,
-		})
-	}
-
-	return nil
-}
-
 // Seed adds initial data to the database (optional)
 func Seed() error {
 	return seedInitialData()
@@ -34,6 +34,22 @@ func SetupTestDB(t *testing.T) (*gorm.DB, error) {
 		&model.Store{},
 		&model.PasswordReset{},
 		&model.GoldPrice{},
+		&model.UserSession{},
+		&model.ExternalIdentity{},
+		// 회원 탈퇴 익명화가 다루는 테이블
+		&model.BusinessRegistration{},
+		&model.StoreVerification{},
+		&model.CommunityPost{},
+		&model.CommunityComment{},
+		&model.StoreReview{},
+		&model.ChatRoom{},
+		&model.Message{},
+		&model.Notification{},
+		&model.NotificationSettings{},
+		&model.PriceAlert{},
+		&model.Payment{},
+		&model.Escrow{},
+		&model.AccountDeletion{},
 	); err != nil {
 		return nil, fmt.Errorf("failed to migrate test database: %w", err)
 	}
@@ -48,6 +64,21 @@ func CleanupTestDB(t *testing.T, db *gorm.DB) {
 
 	// Drop all tables in reverse order of dependencies
 	tables := []interface{}{
+		&model.AccountDeletion{},
+		&model.Escrow{},
+		&model.Payment{},
+		&model.PriceAlert{},
+		&model.NotificationSettings{},
+		&model.Notification{},
+		&model.Message{},
+		&model.ChatRoom{},
+		&model.StoreReview{},
+		&model.CommunityComment{},
+		&model.CommunityPost{},
+		&model.StoreVerification{},
+		&model.BusinessRegistration{},
+		&model.ExternalIdentity{},
+		&model.UserSession{},
 		&model.GoldPrice{},
 		&model.PasswordReset{},
 		&model.Store{},
@@ -69,6 +100,7 @@ func CleanupTestDB(t *testing.T, db *gorm.DB) {
 
 func TruncateTables(db *gorm.DB) error {
 	tables := []string{
+		"user_sessions",
 		"gold_prices",
 		"password_resets",
 		"stores",
@@ -18,6 +18,11 @@ const (
 	AuthCodeInvalid         = "AUTH_CODE_INVALID"         // 잘못된 인증코드
 	AuthCodeExpired         = "AUTH_CODE_EXPIRED"         // 인증코드 만료
 	AuthAlreadyVerified     = "AUTH_ALREADY_VERIFIED"     // 이미 인증됨
+	AuthCodeCooldown        = "AUTH_CODE_COOLDOWN"        // 인증코드 재전송 대기
+	AuthCodeLocked          = "AUTH_CODE_LOCKED"          // 인증 시도 횟수 초과로 잠김
+	AuthReauthRequired      = "AUTH_REAUTH_REQUIRED"      // 재로그인 필요 (민감한 작업)
+	AuthIdentityNotLinked   = "AUTH_IDENTITY_NOT_LINKED"  // 같은 이메일 계정에 연결되지 않은 소셜 로그인
+	AuthOAuthStateInvalid   = "AUTH_OAUTH_STATE_INVALID"  // 서버가 발급하지 않았거나 만료/재사용된 소셜 로그인 state
 
 	// ==================== 인가/권한 (AUTHZ_) ====================
 	AuthzForbidden        = "AUTHZ_FORBIDDEN"         // 접근 권한 없음
@@ -80,10 +85,27 @@ const (
 	UploadInvalidFileType  = "UPLOAD_INVALID_FILE_TYPE"  // 잘못된 파일 형식
 	UploadFileTooLarge     = "UPLOAD_FILE_TOO_LARGE"     // 파일 너무 큼
 	UploadFailed           = "UPLOAD_FAILED"             // 업로드 실패
+	UploadNotFound         = "UPLOAD_NOT_FOUND"          // 업로드된 파일 없음
+	UploadInvalidURL       = "UPLOAD_INVALID_URL"        // 저장소에서 발급하지 않은 파일 URL
 
 	// ==================== 금 시세 (GOLD_) ====================
 	GoldPriceNotFound      = "GOLD_PRICE_NOT_FOUND"      // 시세 없음
 	GoldInvalidType        = "GOLD_INVALID_TYPE"         // 잘못된 금 종류
+	PriceAlertNotFound     = "PRICE_ALERT_NOT_FOUND"     // 시세 알림 없음
+	PriceAlertLimitExceeded = "PRICE_ALERT_LIMIT_EXCEEDED" // 시세 알림 등록 개수 초과
+
+	// ==================== 결제 (PAYMENT_) ====================
+	PaymentNotFound        = "PAYMENT_NOT_FOUND"         // 결제 정보 없음
+	PaymentInvalidState    = "PAYMENT_INVALID_STATE"     // 처리할 수 없는 결제 상태
+	PaymentInvalidTarget   = "PAYMENT_INVALID_TARGET"    // 잘못된 결제 대상
+	PaymentFailed          = "PAYMENT_FAILED"            // 결제 실패
+	PaymentUnavailable     = "PAYMENT_UNAVAILABLE"       // 결제 서비스 미설정
+
+	// ==================== 안전거래 (ESCROW_) ====================
+	EscrowNotFound         = "ESCROW_NOT_FOUND"          // 안전거래 정보 없음
+	EscrowInvalidState     = "ESCROW_INVALID_STATE"      // 처리할 수 없는 안전거래 상태
+	EscrowInvalidPost      = "ESCROW_INVALID_POST"       // 안전거래 불가 게시글
+	EscrowPostUnavailable  = "ESCROW_POST_UNAVAILABLE"   // 이미 예약/거래완료된 게시글
 
 	// ==================== 비즈니스 로직 (BUSINESS_) ====================
 	BusinessStoreRequired      = "BUSINESS_STORE_REQUIRED"       // 매장 필요
@@ -2,15 +2,18 @@ package errors
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/internal/metrics"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
 )
 
 // ErrorResponse 표준 에러 응답 구조
 type ErrorResponse struct {
-	Error   string `json:"error"`   // 에러 코드 (프론트엔드에서 매핑용)
-	Message string `json:"message"` // 사용자 친화적 메시지 (한글)
+	Error     string `json:"error"`                // 에러 코드 (프론트엔드에서 매핑용)
+	Message   string `json:"message"`              // 사용자 친화적 메시지 (한글)
+	RequestID string `json:"request_id,omitempty"` // 요청 ID (문의 시 로그 추적용)
 }
 
 // RespondWithError 에러 응답 헬퍼
@@ -18,19 +21,18 @@ type ErrorResponse struct {
 // errorCode: 에러 코드 상수 (codes.go 참조)
 // message: 사용자에게 보여질 한글 메시지
 func RespondWithError(c *gin.Context, statusCode int, errorCode string, message string) {
-	// 에러 로깅
-	logger.Warn("API error response", map[string]interface{}{
+	// 에러 로깅 (요청 로거에 request_id, method, path, ip가 포함됨)
+	logger.FromContext(c.Request.Context()).Warn("API error response", map[string]interface{}{
 		"status_code": statusCode,
 		"error_code":  errorCode,
 		"message":     message,
-		"method":      c.Request.Method,
-		"path":        c.Request.URL.Path,
-		"client_ip":   c.ClientIP(),
 	})
+	metrics.APIErrors.WithLabelValues(errorCode, strconv.Itoa(statusCode)).Inc()
 
 	c.JSON(statusCode, ErrorResponse{
-		Error:   errorCode,
-		Message: message,
+		Error:     errorCode,
+		Message:   message,
+		RequestID: c.GetString("request_id"),
 	})
 }
 
@@ -71,15 +73,18 @@ func InternalError(c *gin.Context, message string) {
 
 // ValidationError 검증 에러 (선택: 여러 필드 검증 오류)
 type ValidationError struct {
-	Error   string              `json:"error"`
-	Message string              `json:"message"`
-	Fields  map[string]string   `json:"fields,omitempty"` // 필드별 오류 메시지
+	Error     string            `json:"error"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"` // 필드별 오류 메시지
+	RequestID string            `json:"request_id,omitempty"`
 }
 
 func RespondWithValidationError(c *gin.Context, fields map[string]string) {
+	metrics.APIErrors.WithLabelValues(ValidationInvalidInput, strconv.Itoa(http.StatusBadRequest)).Inc()
 	c.JSON(http.StatusBadRequest, ValidationError{
-		Error:   ValidationInvalidInput,
-		Message: "입력값이 올바르지 않습니다",
-		Fields:  fields,
+		Error:     ValidationInvalidInput,
+		Message:   "입력값이 올바르지 않습니다",
+		Fields:    fields,
+		RequestID: c.GetString("request_id"),
 	})
 }
@@ -0,0 +1,132 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// 전체 상태
+const (
+	StatusOK       = "ok"       // 모든 점검 통과
+	StatusDegraded = "degraded" // 필수가 아닌 점검만 실패 (트래픽은 계속 받음)
+	StatusFail     = "fail"     // 필수 점검 실패 (트래픽을 받으면 안 됨)
+)
+
+// 점검별 상태
+const (
+	CheckUp   = "up"
+	CheckDown = "down"
+)
+
+// CheckFunc 의존성 하나를 점검 (ctx 만료 전에 반환해야 함)
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name     string
+	critical bool
+	fn       CheckFunc
+}
+
+// Result 점검 하나의 결과
+type Result struct {
+	Status    string `json:"status"`
+	Critical  bool   `json:"critical"`
+	LatencyMs int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report 전체 점검 결과
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]Result `json:"checks"`
+}
+
+// Checker 등록된 점검을 동시에 실행하고 결과를 모음
+type Checker struct {
+	timeout time.Duration
+
+	mu     sync.RWMutex
+	checks []check
+}
+
+// NewChecker 점검당 timeout을 가진 Checker 생성
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// Add 점검 등록 (critical이면 실패 시 전체 상태가 fail)
+func (c *Checker) Add(name string, critical bool, fn CheckFunc) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, check{name: name, critical: critical, fn: fn})
+}
+
+// Run 모든 점검을 동시에 실행
+func (c *Checker) Run(ctx context.Context) Report {
+	c.mu.RLock()
+	checks := append([]check(nil), c.checks...)
+	c.mu.RUnlock()
+
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, chk := range checks {
+		wg.Add(1)
+		go func(i int, chk check) {
+			defer wg.Done()
+			results[i] = c.run(ctx, chk)
+		}(i, chk)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: make(map[string]Result, len(checks))}
+	for i, chk := range checks {
+		result := results[i]
+		report.Checks[chk.name] = result
+		if result.Status == CheckUp {
+			continue
+		}
+		if chk.critical {
+			report.Status = StatusFail
+		} else if report.Status == StatusOK {
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// run 점검 하나를 timeout 안에 실행 (점검 함수가 ctx를 무시하고 멈춰도 timeout에 반환)
+func (c *Checker) run(ctx context.Context, chk check) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- fmt.Errorf("check panicked: %v", r)
+			}
+		}()
+		done <- chk.fn(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("timed out after %s", c.timeout)
+	}
+
+	result := Result{
+		Status:    CheckUp,
+		Critical:  chk.critical,
+		LatencyMs: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = CheckDown
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChecker_Run(t *testing.T) {
+	checker := NewChecker(50 * time.Millisecond)
+	checker.Add("database", true, func(ctx context.Context) error { return nil })
+	checker.Add("scheduler", false, func(ctx context.Context) error { return errors.New("last run failed") })
+
+	report := checker.Run(context.Background())
+	assert.Equal(t, StatusDegraded, report.Status)
+	assert.Equal(t, CheckUp, report.Checks["database"].Status)
+	assert.True(t, report.Checks["database"].Critical)
+	assert.Equal(t, CheckDown, report.Checks["scheduler"].Status)
+	assert.Equal(t, "last run failed", report.Checks["scheduler"].Error)
+
+	// ctx를 무시하고 멈춘 점검도 timeout에 실패 처리
+	block := make(chan struct{})
+	defer close(block)
+	checker.Add("redis", true, func(ctx context.Context) error {
+		<-block
+		return nil
+	})
+
+	start := time.Now()
+	report = checker.Run(context.Background())
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, StatusFail, report.Status)
+	require.Contains(t, report.Checks, "redis")
+	assert.Equal(t, CheckDown, report.Checks["redis"].Status)
+	assert.Contains(t, report.Checks["redis"].Error, "timed out")
+}
+
+func TestChecker_Empty(t *testing.T) {
+	report := NewChecker(time.Second).Run(context.Background())
+	assert.Equal(t, StatusOK, report.Status)
+	assert.Empty(t, report.Checks)
+}
@@ -0,0 +1,175 @@
+// Package imaging 업로드 이미지 처리 (EXIF 정리, 방향 보정, 축소)
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	tagOrientation = 0x0112
+	tagGPSInfo     = 0x8825
+)
+
+var (
+	jpegSOI    = []byte{0xFF, 0xD8}
+	exifHeader = []byte("Exif\x00\x00")
+
+	errInvalidJPEG = errors.New("invalid jpeg")
+	errInvalidEXIF = errors.New("invalid exif")
+)
+
+// exifTypeSizes EXIF 값 타입별 크기 (BYTE, ASCII, SHORT, LONG, RATIONAL, ..., SRATIONAL, FLOAT, DOUBLE)
+var exifTypeSizes = map[uint16]uint32{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8,
+}
+
+// JPEGOrientation EXIF 방향 값 (1~8, 없거나 읽을 수 없으면 1)
+func JPEGOrientation(data []byte) int {
+	segment, err := findEXIFSegment(data)
+	if err != nil || segment == nil {
+		return 1
+	}
+
+	tiff := data[segment.start : segment.start+segment.length]
+	ifd, err := parseIFD0(tiff)
+	if err != nil {
+		return 1
+	}
+	for i := 0; i < ifd.count; i++ {
+		entry := ifd.entry(i)
+		if ifd.order.Uint16(entry) == tagOrientation {
+			value := int(ifd.order.Uint16(entry[8:]))
+			if value >= 1 && value <= 8 {
+				return value
+			}
+		}
+	}
+	return 1
+}
+
+// exifSegment APP1 세그먼트 안의 TIFF 데이터 위치
+type exifSegment struct {
+	start, length int
+}
+
+// findEXIFSegment JPEG 마커를 따라가며 EXIF APP1 세그먼트 탐색 (없으면 nil)
+func findEXIFSegment(data []byte) (*exifSegment, error) {
+	if !bytes.HasPrefix(data, jpegSOI) {
+		return nil, errInvalidJPEG
+	}
+
+	pos := len(jpegSOI)
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return nil, errInvalidJPEG
+		}
+		marker := data[pos+1]
+		// 이미지 데이터 시작(SOS) 이후에는 메타데이터가 없음
+		if marker == 0xDA || marker == 0xD9 {
+			return nil, nil
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return nil, errInvalidJPEG
+		}
+
+		payload := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(payload, exifHeader) {
+			return &exifSegment{
+				start:  pos + 4 + len(exifHeader),
+				length: len(payload) - len(exifHeader),
+			}, nil
+		}
+		pos += 2 + length
+	}
+	return nil, nil
+}
+
+// ifd TIFF IFD 위치
+type ifd struct {
+	tiff   []byte
+	order  binary.ByteOrder
+	offset int
+	count  int
+}
+
+func (d *ifd) entry(i int) []byte {
+	start := d.offset + 2 + i*12
+	return d.tiff[start : start+12]
+}
+
+func parseIFD0(tiff []byte) (*ifd, error) {
+	if len(tiff) < 8 {
+		return nil, errInvalidEXIF
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return nil, errInvalidEXIF
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	return parseIFD(tiff, order, offset)
+}
+
+func parseIFD(tiff []byte, order binary.ByteOrder, offset int) (*ifd, error) {
+	if offset < 8 || offset+2 > len(tiff) {
+		return nil, errInvalidEXIF
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	// 항목 + 다음 IFD 오프셋
+	if offset+2+count*12+4 > len(tiff) {
+		return nil, errInvalidEXIF
+	}
+	return &ifd{tiff: tiff, order: order, offset: offset, count: count}, nil
+}
+
+// removeGPS IFD0의 GPSInfo 항목을 빼고 GPS IFD와 그 값들을 0으로 덮어씀 (TIFF 크기는 유지)
+func removeGPS(tiff []byte) (bool, error) {
+	ifd0, err := parseIFD0(tiff)
+	if err != nil {
+		return false, err
+	}
+
+	gpsIndex := -1
+	for i := 0; i < ifd0.count; i++ {
+		if ifd0.order.Uint16(ifd0.entry(i)) == tagGPSInfo {
+			gpsIndex = i
+			break
+		}
+	}
+	if gpsIndex < 0 {
+		return false, nil
+	}
+
+	// GPS IFD의 값과 항목 지우기 (구조가 깨졌으면 포인터만 제거)
+	gpsOffset := int(ifd0.order.Uint32(ifd0.entry(gpsIndex)[8:]))
+	if gps, err := parseIFD(tiff, ifd0.order, gpsOffset); err == nil {
+		for i := 0; i < gps.count; i++ {
+			entry := gps.entry(i)
+			size := exifTypeSizes[gps.order.Uint16(entry[2:])] * gps.order.Uint32(entry[4:])
+			if size > 4 {
+				valueOffset := int(gps.order.Uint32(entry[8:]))
+				if valueOffset >= 0 && uint64(valueOffset)+uint64(size) <= uint64(len(tiff)) {
+					clear(tiff[valueOffset : valueOffset+int(size)])
+				}
+			}
+		}
+		clear(tiff[gps.offset : gps.offset+2+gps.count*12+4])
+	}
+
+	// IFD0에서 GPSInfo 항목을 빼고 뒤 항목과 다음 IFD 오프셋을 당김
+	entriesEnd := ifd0.offset + 2 + ifd0.count*12
+	start := ifd0.offset + 2 + gpsIndex*12
+	copy(tiff[start:], tiff[start+12:entriesEnd+4])
+	clear(tiff[entriesEnd-12+4 : entriesEnd+4])
+	ifd0.order.PutUint16(tiff[ifd0.offset:], uint16(ifd0.count-1))
+	return true, nil
+}
@@ -0,0 +1,219 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildEXIF IFD0(방향, GPSInfo)와 GPS IFD(위도 RATIONAL 3개)를 가진 리틀엔디언 TIFF 생성
+func buildEXIF(orientation uint16) []byte {
+	le := binary.LittleEndian
+	tiff := make([]byte, 0, 128)
+	tiff = append(tiff, 'I', 'I', 42, 0)
+	tiff = le.AppendUint32(tiff, 8)
+
+	// IFD0 (offset 8): 항목 2개
+	tiff = le.AppendUint16(tiff, 2)
+	tiff = le.AppendUint16(tiff, tagOrientation)
+	tiff = le.AppendUint16(tiff, 3)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint16(tiff, orientation)
+	tiff = le.AppendUint16(tiff, 0)
+	gpsOffset := 8 + 2 + 2*12 + 4
+	tiff = le.AppendUint16(tiff, tagGPSInfo)
+	tiff = le.AppendUint16(tiff, 4)
+	tiff = le.AppendUint32(tiff, 1)
+	tiff = le.AppendUint32(tiff, uint32(gpsOffset))
+	tiff = le.AppendUint32(tiff, 0)
+
+	// GPS IFD: GPSLatitude (RATIONAL × 3, 값은 IFD 뒤)
+	valueOffset := gpsOffset + 2 + 12 + 4
+	tiff = le.AppendUint16(tiff, 1)
+	tiff = le.AppendUint16(tiff, 0x0002)
+	tiff = le.AppendUint16(tiff, 5)
+	tiff = le.AppendUint32(tiff, 3)
+	tiff = le.AppendUint32(tiff, uint32(valueOffset))
+	tiff = le.AppendUint32(tiff, 0)
+	for _, v := range []uint32{37, 1, 33, 1, 1234, 100} {
+		tiff = le.AppendUint32(tiff, v)
+	}
+	return tiff
+}
+
+func buildJPEG(t *testing.T, width, height int, tiff []byte) []byte {
+	t.Helper()
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	var encoded bytes.Buffer
+	require.NoError(t, jpeg.Encode(&encoded, img, nil))
+
+	payload := append(append([]byte{}, exifHeader...), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(payload)+2))
+	app1 = append(app1, payload...)
+
+	data := append([]byte{}, jpegSOI...)
+	data = append(data, app1...)
+	return append(data, encoded.Bytes()[len(jpegSOI):]...)
+}
+
+func TestStripLocation_JPEG(t *testing.T) {
+	data := buildJPEG(t, 8, 4, buildEXIF(6))
+	latitude := binary.LittleEndian.AppendUint32(nil, 1234)
+	require.True(t, bytes.Contains(data, latitude))
+
+	stripped, changed, err := StripLocation(data, "image/jpeg")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Len(t, stripped, len(data))
+	assert.False(t, bytes.Contains(stripped, latitude))
+	// 원본은 변경하지 않음
+	assert.True(t, bytes.Contains(data, latitude))
+
+	// 나머지 EXIF(방향)와 이미지는 그대로
+	assert.Equal(t, 6, JPEGOrientation(stripped))
+	_, err = jpeg.Decode(bytes.NewReader(stripped))
+	require.NoError(t, err)
+
+	// GPS가 없으면 변경 없음
+	_, changed, err = StripLocation(stripped, "image/jpeg")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, _, err = StripLocation([]byte("not a jpeg"), "image/jpeg")
+	assert.Error(t, err)
+}
+
+func TestStripLocation_JPEGXMP(t *testing.T) {
+	data := buildJPEG(t, 4, 4, buildEXIF(1))
+	xmp := append(append([]byte{}, xmpHeader...), `<x:xmpmeta><rdf:Description exif:GPSLatitude="37,33.12N"/></x:xmpmeta>`...)
+	app1 := binary.BigEndian.AppendUint16([]byte{0xFF, 0xE1}, uint16(len(xmp)+2))
+	data = append(append(append([]byte{}, data[:2]...), append(app1, xmp...)...), data[2:]...)
+
+	stripped, changed, err := StripLocation(data, "image/jpeg")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.False(t, bytes.Contains(stripped, []byte("GPSLatitude")))
+	assert.False(t, bytes.Contains(stripped, binary.LittleEndian.AppendUint32(nil, 1234)))
+	_, err = jpeg.Decode(bytes.NewReader(stripped))
+	require.NoError(t, err)
+}
+
+func TestStripLocation_PNG(t *testing.T) {
+	var encoded bytes.Buffer
+	require.NoError(t, png.Encode(&encoded, image.NewRGBA(image.Rect(0, 0, 4, 4))))
+	plain := encoded.Bytes()
+
+	// IHDR 뒤에 eXIf와 XMP(iTXt) 청크 삽입
+	ihdrEnd := len(pngSignature) + 12 + 13
+	data := append([]byte{}, plain[:ihdrEnd]...)
+	data = appendPNGChunk(data, "eXIf", buildEXIF(1))
+	data = appendPNGChunk(data, "iTXt", []byte("XML:com.adobe.xmp\x00\x00\x00\x00\x00<rdf:Description exif:GPSLongitude=\"126,58.5E\"/>"))
+	data = append(data, plain[ihdrEnd:]...)
+
+	stripped, changed, err := StripLocation(data, "image/png")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.False(t, bytes.Contains(stripped, binary.LittleEndian.AppendUint32(nil, 1234)))
+	assert.False(t, bytes.Contains(stripped, []byte("GPSLongitude")))
+	// 나머지 EXIF는 CRC를 다시 계산해 유지
+	assert.True(t, bytes.Contains(stripped, []byte("eXIf")))
+	_, err = png.Decode(bytes.NewReader(stripped))
+	require.NoError(t, err)
+
+	_, changed, err = StripLocation(plain, "image/png")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, _, err = StripLocation(plain[:20], "image/png")
+	assert.Error(t, err)
+}
+
+func TestStripLocation_WebP(t *testing.T) {
+	chunk := func(fourCC string, payload []byte) []byte {
+		out := append([]byte(fourCC), binary.LittleEndian.AppendUint32(nil, uint32(len(payload)))...)
+		out = append(out, payload...)
+		if len(payload)%2 == 1 {
+			out = append(out, 0)
+		}
+		return out
+	}
+	var body []byte
+	body = append(body, chunk("VP8X", []byte{webpFlagEXIF | webpFlagXMP, 0, 0, 0, 3, 0, 0, 3, 0, 0})...)
+	body = append(body, chunk("VP8L", []byte{0x2F, 0x03, 0xC0, 0x00, 0x00})...)
+	body = append(body, chunk("EXIF", buildEXIF(1))...)
+	body = append(body, chunk("XMP ", []byte(`<rdf:Description exif:GPSLatitude="37,33.12N"/>`))...)
+	data := append([]byte("RIFF"), binary.LittleEndian.AppendUint32(nil, uint32(len(body)+4))...)
+	data = append(append(data, "WEBP"...), body...)
+
+	stripped, changed, err := StripLocation(data, "image/webp")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.False(t, bytes.Contains(stripped, binary.LittleEndian.AppendUint32(nil, 1234)))
+	assert.False(t, bytes.Contains(stripped, []byte("XMP ")))
+	assert.Equal(t, uint32(len(stripped)-8), binary.LittleEndian.Uint32(stripped[4:]))
+	// XMP 플래그만 꺼지고 EXIF 플래그는 유지
+	assert.Equal(t, byte(webpFlagEXIF), stripped[20])
+
+	_, changed, err = StripLocation(stripped, "image/webp")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	_, _, err = StripLocation([]byte("RIFF\x04\x00\x00\x00WEBPVP8X"), "image/webp")
+	assert.Error(t, err)
+}
+
+func TestJPEGOrientation_NoEXIF(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, nil))
+	assert.Equal(t, 1, JPEGOrientation(buf.Bytes()))
+}
+
+func TestOrient(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	red := color.RGBA{R: 255, A: 255}
+	src.Set(0, 0, red)
+
+	rotated := Orient(src, 6)
+	assert.Equal(t, image.Rect(0, 0, 2, 3), rotated.Bounds())
+	// 시계 방향 90도 회전하면 좌상단이 우상단으로 이동
+	assert.Equal(t, red, rotated.At(1, 0))
+
+	assert.Equal(t, src, Orient(src, 1))
+}
+
+func TestFit(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 400; x++ {
+			if x < 200 {
+				src.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				src.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+
+	fitted := Fit(src, 100)
+	assert.Equal(t, image.Rect(0, 0, 100, 50), fitted.Bounds())
+	assert.Equal(t, color.RGBA{R: 255, A: 255}, fitted.At(10, 10))
+	assert.Equal(t, color.RGBA{B: 255, A: 255}, fitted.At(90, 10))
+
+	// 작은 이미지는 확대하지 않음
+	assert.Equal(t, image.Rect(0, 0, 400, 200), Fit(src, 1000).Bounds())
+
+	encoded, err := EncodeJPEG(fitted, 80)
+	require.NoError(t, err)
+	decoded, err := jpeg.Decode(bytes.NewReader(encoded))
+	require.NoError(t, err)
+	assert.Equal(t, fitted.Bounds(), decoded.Bounds())
+}
@@ -0,0 +1,267 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"strings"
+)
+
+var (
+	xmpHeader         = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	xmpExtendedHeader = []byte("http://ns.adobe.com/xmp/extension/\x00")
+	pngSignature      = []byte("\x89PNG\r\n\x1a\n")
+	// xmpLocation XMP에서 좌표를 담는 속성 이름에 공통으로 들어가는 문자열 (exif:GPSLatitude 등)
+	xmpLocation = []byte("GPS")
+
+	errInvalidPNG  = errors.New("invalid png")
+	errInvalidWebP = errors.New("invalid webp")
+)
+
+// webpFlagEXIF, webpFlagXMP VP8X 청크의 메타데이터 포함 플래그
+const (
+	webpFlagEXIF = 0x08
+	webpFlagXMP  = 0x04
+)
+
+// CarriesLocation 촬영 위치 메타데이터를 담을 수 있어 StripLocation으로 정리해야 하는 형식인지 확인
+func CarriesLocation(contentType string) bool {
+	switch contentType {
+	case "image/jpeg", "image/png", "image/webp":
+		return true
+	}
+	return false
+}
+
+// StripLocation 이미지 메타데이터에서 촬영 위치 정보 제거 (contentType은 내용으로 판별한 형식)
+// EXIF의 GPS 정보는 지우고, GPS 좌표가 든 XMP와 해석할 수 없는 EXIF는 통째로 제거
+// 위치 정보가 없으면 원본과 false, 파일 구조를 해석할 수 없으면 오류 반환
+func StripLocation(data []byte, contentType string) ([]byte, bool, error) {
+	switch contentType {
+	case "image/jpeg":
+		return stripJPEGLocation(data)
+	case "image/png":
+		return stripPNGLocation(data)
+	case "image/webp":
+		return stripWebPLocation(data)
+	}
+	return data, false, nil
+}
+
+// stripEXIFLocation TIFF 데이터 복사본에서 GPS 제거 (keep이 false면 해석할 수 없어 버려야 하는 EXIF)
+func stripEXIFLocation(tiff []byte) (out []byte, keep, changed bool) {
+	out = append([]byte(nil), tiff...)
+	stripped, err := removeGPS(out)
+	if err != nil {
+		return nil, false, true
+	}
+	return out, true, stripped
+}
+
+func stripJPEGLocation(data []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(data, jpegSOI) {
+		return data, false, errInvalidJPEG
+	}
+
+	type segment struct {
+		start, end int
+		payload    []byte
+	}
+	var segments []segment
+	dropXMP := false
+	pos := len(jpegSOI)
+	for {
+		if pos+2 > len(data) || data[pos] != 0xFF {
+			return data, false, errInvalidJPEG
+		}
+		marker := data[pos+1]
+		// 이미지 데이터 시작(SOS) 이후에는 메타데이터가 없음
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+		if pos+4 > len(data) {
+			return data, false, errInvalidJPEG
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return data, false, errInvalidJPEG
+		}
+		payload := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && isXMPPayload(payload) && bytes.Contains(payload, xmpLocation) {
+			dropXMP = true
+		}
+		segments = append(segments, segment{start: pos, end: pos + 2 + length, payload: payload})
+		pos += 2 + length
+	}
+
+	out := append(make([]byte, 0, len(data)), jpegSOI...)
+	changed := false
+	for _, seg := range segments {
+		marker := data[seg.start+1]
+		switch {
+		case marker == 0xE1 && bytes.HasPrefix(seg.payload, exifHeader):
+			tiff, keep, stripped := stripEXIFLocation(seg.payload[len(exifHeader):])
+			changed = changed || stripped
+			if !keep {
+				continue
+			}
+			// GPS 제거는 TIFF 크기를 바꾸지 않으므로 세그먼트 길이는 그대로
+			out = append(out, data[seg.start:seg.start+4+len(exifHeader)]...)
+			out = append(out, tiff...)
+		case marker == 0xE1 && dropXMP && isXMPPayload(seg.payload):
+			// 확장 XMP는 주 XMP와 나뉘어 저장되므로 함께 제거
+			changed = true
+		default:
+			out = append(out, data[seg.start:seg.end]...)
+		}
+	}
+	if !changed {
+		return data, false, nil
+	}
+	return append(out, data[pos:]...), true, nil
+}
+
+func isXMPPayload(payload []byte) bool {
+	return bytes.HasPrefix(payload, xmpHeader) || bytes.HasPrefix(payload, xmpExtendedHeader)
+}
+
+func stripPNGLocation(data []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return data, false, errInvalidPNG
+	}
+
+	out := append(make([]byte, 0, len(data)), pngSignature...)
+	changed := false
+	pos := len(pngSignature)
+	for {
+		if pos+12 > len(data) {
+			return data, false, errInvalidPNG
+		}
+		length := binary.BigEndian.Uint32(data[pos:])
+		if uint64(pos)+12+uint64(length) > uint64(len(data)) {
+			return data, false, errInvalidPNG
+		}
+		end := pos + 12 + int(length)
+		chunkType := string(data[pos+4 : pos+8])
+		chunk := data[pos+8 : pos+8+int(length)]
+
+		switch {
+		case chunkType == "eXIf":
+			tiff, keep, stripped := stripEXIFLocation(chunk)
+			changed = changed || stripped
+			if keep {
+				out = appendPNGChunk(out, chunkType, tiff)
+			}
+		case isPNGLocationText(chunkType, chunk):
+			changed = true
+		default:
+			out = append(out, data[pos:end]...)
+		}
+
+		pos = end
+		if chunkType == "IEND" {
+			break
+		}
+	}
+	if !changed {
+		return data, false, nil
+	}
+	return out, true, nil
+}
+
+// isPNGLocationText 위치 정보가 들어 있을 수 있는 텍스트 청크
+// XMP는 압축되어 있으면 내용을 확인할 수 없으므로 제거하고, ImageMagick의 Raw profile(EXIF/XMP를 16진수로 저장)도 제거
+func isPNGLocationText(chunkType string, chunk []byte) bool {
+	if chunkType != "iTXt" && chunkType != "tEXt" && chunkType != "zTXt" {
+		return false
+	}
+	keyword, text, _ := bytes.Cut(chunk, []byte{0})
+	switch {
+	case string(keyword) == "XML:com.adobe.xmp":
+		// iTXt: 압축 플래그, 압축 방식, 언어 태그, 번역된 키워드 뒤에 본문
+		compressed := chunkType == "zTXt" || chunkType == "iTXt" && len(text) > 0 && text[0] != 0
+		return compressed || bytes.Contains(text, xmpLocation)
+	case strings.HasPrefix(string(keyword), "Raw profile type "):
+		profile := strings.ToLower(strings.TrimPrefix(string(keyword), "Raw profile type "))
+		return profile == "exif" || profile == "xmp" || profile == "app1"
+	}
+	return false
+}
+
+func appendPNGChunk(out []byte, chunkType string, chunk []byte) []byte {
+	out = binary.BigEndian.AppendUint32(out, uint32(len(chunk)))
+	start := len(out)
+	out = append(out, chunkType...)
+	out = append(out, chunk...)
+	return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(out[start:]))
+}
+
+func stripWebPLocation(data []byte) ([]byte, bool, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return data, false, errInvalidWebP
+	}
+
+	out := append(make([]byte, 0, len(data)), data[:12]...)
+	changed := false
+	vp8x := -1
+	var flags byte
+	pos := 12
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return data, false, errInvalidWebP
+		}
+		size := binary.LittleEndian.Uint32(data[pos+4:])
+		// 청크 크기가 홀수면 1바이트 패딩
+		padded := uint64(size) + uint64(size&1)
+		if uint64(pos)+8+padded > uint64(len(data)) {
+			return data, false, errInvalidWebP
+		}
+		end := pos + 8 + int(padded)
+		fourCC := string(data[pos : pos+4])
+		chunk := data[pos+8 : pos+8+int(size)]
+
+		switch fourCC {
+		case "VP8X":
+			if size < 1 {
+				return data, false, errInvalidWebP
+			}
+			vp8x = len(out) + 8
+			out = append(out, data[pos:end]...)
+		case "EXIF":
+			// 일부 인코더는 JPEG처럼 Exif 헤더를 붙여 저장
+			header := 0
+			if bytes.HasPrefix(chunk, exifHeader) {
+				header = len(exifHeader)
+			}
+			tiff, keep, stripped := stripEXIFLocation(chunk[header:])
+			changed = changed || stripped
+			if keep {
+				out = append(out, data[pos:pos+8+header]...)
+				out = append(out, tiff...)
+				out = append(out, data[pos+8+int(size):end]...)
+				flags |= webpFlagEXIF
+			}
+		case "XMP ":
+			if bytes.Contains(chunk, xmpLocation) {
+				changed = true
+			} else {
+				out = append(out, data[pos:end]...)
+				flags |= webpFlagXMP
+			}
+		default:
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+	if !changed {
+		return data, false, nil
+	}
+
+	// 제거한 메타데이터 청크의 플래그를 끄고 RIFF 크기 갱신
+	if vp8x >= 0 {
+		out[vp8x] &^= (webpFlagEXIF | webpFlagXMP) &^ flags
+	}
+	binary.LittleEndian.PutUint32(out[4:], uint32(len(out)-8))
+	return out, true, nil
+}
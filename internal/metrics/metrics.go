@@ -0,0 +1,108 @@
+// Package metrics Prometheus 지표 (HTTP, DB, WebSocket, 스케줄러)
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "udonggeum"
+
+// Registry 서버 지표 레지스트리 (Go 런타임/프로세스 지표 포함)
+var Registry = prometheus.NewRegistry()
+
+var (
+	// HTTPRequestDuration 라우트별 요청 처리 시간 (route는 /api/v1/stores/:id 같은 패턴)
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// APIErrors 에러 응답 수 (internal/errors 에러 코드별)
+	APIErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_errors_total",
+		Help:      "API error responses by error code.",
+	}, []string{"code", "status"})
+
+	// DBQueryDuration GORM 쿼리 처리 시간 (operation: create, query, update, delete, row, raw)
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "GORM query latency by operation and table.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table"})
+
+	// DBQueryErrors 실패한 GORM 쿼리 수 (레코드 없음 제외)
+	DBQueryErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "db_query_errors_total",
+		Help:      "Failed GORM queries by operation and table.",
+	}, []string{"operation", "table"})
+
+	// WebSocketDroppedMessages 버퍼가 가득 차 버린 WebSocket 메시지 수
+	// reason: broadcast_queue(Hub 큐), room(채팅방 메시지), notification(알림), resume(재전송)
+	WebSocketDroppedMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "websocket_dropped_messages_total",
+		Help:      "WebSocket messages dropped because a buffer was full.",
+	}, []string{"reason"})
+
+	// GoldPriceLastSuccess 금 시세 자동 수집이 마지막으로 성공한 시각 (unix 초)
+	GoldPriceLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "gold_price_update_last_success_timestamp_seconds",
+		Help:      "Unix time of the last successful scheduled gold price update.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequestDuration,
+		APIErrors,
+		DBQueryDuration,
+		DBQueryErrors,
+		WebSocketDroppedMessages,
+		GoldPriceLastSuccess,
+	)
+}
+
+// HubStats WebSocket Hub 현재 상태 (이 인스턴스 기준)
+type HubStats struct {
+	Users          int // 연결된 사용자 수
+	Sessions       int // 연결된 세션 수 (멀티 디바이스)
+	Rooms          int // 참여자가 연결된 채팅방 수
+	BroadcastQueue int // 브로드캐스트 채널에 쌓인 메시지 수
+}
+
+// RegisterHub 수집 시점에 Hub 상태를 읽는 지표 등록 (서버 시작 시 한 번 호출)
+func RegisterHub(stats func() HubStats) {
+	gauge := func(name, help string, value func(HubStats) int) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      name,
+			Help:      help,
+		}, func() float64 {
+			return float64(value(stats()))
+		})
+	}
+
+	Registry.MustRegister(
+		gauge("websocket_connected_users", "Users connected to this instance.", func(s HubStats) int { return s.Users }),
+		gauge("websocket_connected_sessions", "WebSocket sessions connected to this instance.", func(s HubStats) int { return s.Sessions }),
+		gauge("websocket_active_rooms", "Chat rooms with members connected to this instance.", func(s HubStats) int { return s.Rooms }),
+		gauge("websocket_broadcast_queue_depth", "Messages waiting in the hub broadcast channel.", func(s HubStats) int { return s.BroadcastQueue }),
+	)
+}
+
+// Handler Prometheus 수집용 HTTP 핸들러
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -1,8 +1,11 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/ikkim/udonggeum-backend/internal/app/model"
@@ -15,16 +18,90 @@ const (
 	UserIDKey    = "user_id"
 	UserEmailKey = "user_email"
 	UserRoleKey  = "user_role"
+	SessionIDKey = "session_id"
 )
 
+// sessionCacheTTL 세션 확인 결과를 재사용하는 기간
+// 세션을 폐기(로그아웃, 재사용 감지, 회원 탈퇴)해도 해당 세션의 access token은 최대 이 기간 동안 더 쓰일 수 있음
+const sessionCacheTTL = 30 * time.Second
+
+// SessionChecker 토큰의 로그인 세션이 아직 유효한지 확인 (AuthService가 구현)
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, sessionID string) (bool, error)
+}
+
 type AuthMiddleware struct {
-	jwtSecret string
+	tokenManager *util.TokenManager
+	sessions     *sessionCache
+}
+
+// NewAuthMiddleware sessions가 nil이면 세션 폐기 여부를 확인하지 않음 (토큰 만료까지 유효)
+func NewAuthMiddleware(tokenManager *util.TokenManager, sessions SessionChecker) *AuthMiddleware {
+	m := &AuthMiddleware{
+		tokenManager: tokenManager,
+	}
+	if sessions != nil {
+		m.sessions = newSessionCache(sessions, sessionCacheTTL)
+	}
+	return m
+}
+
+type sessionCacheEntry struct {
+	active    bool
+	expiresAt time.Time
+}
+
+// sessionCache 요청마다 DB를 조회하지 않도록 세션 확인 결과를 ttl 동안 캐시
+type sessionCache struct {
+	checker SessionChecker
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]sessionCacheEntry
+}
+
+func newSessionCache(checker SessionChecker, ttl time.Duration) *sessionCache {
+	return &sessionCache{
+		checker: checker,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]sessionCacheEntry),
+	}
+}
+
+func (c *sessionCache) isActive(ctx context.Context, sessionID string) (bool, error) {
+	now := c.now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[sessionID]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.active, nil
+	}
+
+	active, err := c.checker.IsSessionActive(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, stored := range c.entries {
+		if !now.Before(stored.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	c.entries[sessionID] = sessionCacheEntry{active: active, expiresAt: now.Add(c.ttl)}
+	return active, nil
 }
 
-func NewAuthMiddleware(jwtSecret string) *AuthMiddleware {
-	return &AuthMiddleware{
-		jwtSecret: jwtSecret,
+// sessionActive 세션에 묶이지 않은 토큰이거나 세션 확인을 하지 않으면 true
+func (m *AuthMiddleware) sessionActive(ctx context.Context, claims *util.JWTClaims) (bool, error) {
+	if m.sessions == nil || claims.SessionID == "" {
+		return true, nil
 	}
+	return m.sessions.isActive(ctx, claims.SessionID)
 }
 
 // Authenticate validates JWT token (required)
@@ -64,7 +141,7 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			})
 		}
 
-		claims, err := util.ValidateToken(token, m.jwtSecret)
+		claims, err := m.tokenManager.ValidateAccessToken(token)
 		if err != nil {
 			log.Warn("Token validation failed", map[string]interface{}{
 				"path":  c.Request.URL.Path,
@@ -81,10 +158,34 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			return
 		}
 
+		active, err := m.sessionActive(c.Request.Context(), claims)
+		if err != nil {
+			log.Error("Failed to check login session", err, map[string]interface{}{
+				"user_id":    claims.UserID,
+				"session_id": claims.SessionID,
+			})
+			errors.InternalError(c, "인증 정보를 확인하지 못했습니다")
+			c.Abort()
+			return
+		}
+		if !active {
+			log.Warn("Access token of revoked session", map[string]interface{}{
+				"path":       c.Request.URL.Path,
+				"user_id":    claims.UserID,
+				"session_id": claims.SessionID,
+			})
+			errors.RespondWithError(c, http.StatusUnauthorized, errors.AuthTokenRevoked, "로그아웃된 세션입니다")
+			c.Abort()
+			return
+		}
+
 		// Set user information in context
 		c.Set("user_id", claims.UserID)
 		c.Set("user_email", claims.Email)
 		c.Set("user_role", model.UserRole(claims.Role))
+		if claims.SessionID != "" {
+			c.Set("session_id", claims.SessionID)
+		}
 
 		log.Debug("User authenticated successfully", map[string]interface{}{
 			"user_id": claims.UserID,
@@ -125,7 +226,7 @@ func (m *AuthMiddleware) OptionalAuthenticate() gin.HandlerFunc {
 		}
 
 		token := parts[1]
-		claims, err := util.ValidateToken(token, m.jwtSecret)
+		claims, err := m.tokenManager.ValidateAccessToken(token)
 		if err != nil {
 			// Invalid or expired token - continue as guest
 			log.Debug("Token validation failed - continuing as guest", map[string]interface{}{
@@ -136,10 +237,23 @@ func (m *AuthMiddleware) OptionalAuthenticate() gin.HandlerFunc {
 			return
 		}
 
+		// Revoked session - continue as guest
+		if active, err := m.sessionActive(c.Request.Context(), claims); err != nil || !active {
+			log.Debug("Login session is not active - continuing as guest", map[string]interface{}{
+				"path":       c.Request.URL.Path,
+				"session_id": claims.SessionID,
+			})
+			c.Next()
+			return
+		}
+
 		// Valid token - set user information in context
 		c.Set("user_id", claims.UserID)
 		c.Set("user_email", claims.Email)
 		c.Set("user_role", model.UserRole(claims.Role))
+		if claims.SessionID != "" {
+			c.Set("session_id", claims.SessionID)
+		}
 
 		log.Debug("User authenticated successfully (optional)", map[string]interface{}{
 			"user_id": claims.UserID,
@@ -218,3 +332,12 @@ func GetUserRole(c *gin.Context) (model.UserRole, bool) {
 	}
 	return role.(model.UserRole), true
 }
+
+// GetSessionID extracts login session ID from context
+func GetSessionID(c *gin.Context) (string, bool) {
+	sessionID, exists := c.Get("session_id")
+	if !exists {
+		return "", false
+	}
+	return sessionID.(string), true
+}
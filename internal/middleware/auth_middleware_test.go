@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -18,7 +19,7 @@ const testJWTSecret = "test-jwt-secret-for-middleware"
 func setupMiddlewareTest() (*gin.Engine, *AuthMiddleware) {
 	gin.SetMode(gin.TestMode)
 	router := gin.New()
-	middleware := NewAuthMiddleware(testJWTSecret)
+	middleware := NewAuthMiddleware(util.NewHMACTokenManager(testJWTSecret), nil)
 	return router, middleware
 }
 
@@ -229,6 +230,51 @@ func TestAuthMiddleware_RequireRole_MultipleRoles(t *testing.T) {
 	}
 }
 
+// fakeSessionChecker 세션 ID별 유효 여부 (조회 횟수 기록)
+type fakeSessionChecker struct {
+	active map[string]bool
+	calls  int
+}
+
+func (f *fakeSessionChecker) IsSessionActive(ctx context.Context, sessionID string) (bool, error) {
+	f.calls++
+	return f.active[sessionID], nil
+}
+
+func TestAuthMiddleware_Authenticate_RevokedSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	manager := util.NewHMACTokenManager(testJWTSecret)
+	sessions := &fakeSessionChecker{active: map[string]bool{"session-1": true}}
+	authMiddleware := NewAuthMiddleware(manager, sessions)
+	now := time.Now()
+	authMiddleware.sessions.now = func() time.Time { return now }
+
+	router := gin.New()
+	router.GET("/test", authMiddleware.Authenticate(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	tokens, err := manager.GenerateTokenPair(1, "test@example.com", "user", "session-1", 15*time.Minute, 7*24*time.Hour)
+	require.NoError(t, err)
+	request := func() int {
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, request())
+	assert.Equal(t, http.StatusOK, request())
+	assert.Equal(t, 1, sessions.calls)
+
+	// 폐기 후에도 캐시 기간 동안은 허용되고, 지나면 거부
+	sessions.active["session-1"] = false
+	assert.Equal(t, http.StatusOK, request())
+	now = now.Add(sessionCacheTTL)
+	assert.Equal(t, http.StatusUnauthorized, request())
+	assert.Equal(t, 2, sessions.calls)
+}
+
 func TestGetUserID(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 	c, _ := gin.CreateTestContext(httptest.NewRecorder())
@@ -5,37 +5,39 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 )
 
-// LoggingMiddleware logs HTTP requests with structured logging
+// LoggingMiddleware assigns a request ID and trace context to each request and writes a structured access log
+// Incoming X-Request-ID/traceparent headers are honored, and both are echoed back in the response headers.
+// The request-scoped logger is stored in the request context so services and GORM queries log with the request ID.
 func LoggingMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Start timer
 		startTime := time.Now()
 
-		// Get request ID (if exists from context)
-		requestID := c.GetString("request_id")
-		if requestID == "" {
-			requestID = generateRequestID()
-			c.Set("request_id", requestID)
-		}
+		// Continue the caller's trace (new IDs are generated if missing or invalid)
+		trace := tracing.FromHeaders(c.GetHeader(tracing.HeaderRequestID), c.GetHeader(tracing.HeaderTraceparent))
+		c.Set("request_id", trace.RequestID)
+		c.Header(tracing.HeaderRequestID, trace.RequestID)
+		c.Header(tracing.HeaderTraceparent, trace.Traceparent())
 
 		// Create logger with request context
-		log := logger.WithContext(map[string]interface{}{
-			"request_id": requestID,
-			"method":     c.Request.Method,
-			"path":       c.Request.URL.Path,
-			"ip":         c.ClientIP(),
-		})
+		fields := trace.Fields()
+		fields["method"] = c.Request.Method
+		fields["path"] = c.Request.URL.Path
+		fields["ip"] = c.ClientIP()
+		log := logger.WithContext(fields)
 
-		// Log incoming request
-		log.Info("Incoming request", map[string]interface{}{
-			"user_agent": c.Request.UserAgent(),
-			"query":      c.Request.URL.RawQuery,
-		})
-
-		// Store logger in context for use in handlers
+		// Store logger in gin context and request context for use in handlers, services and repositories
 		c.Set("logger", log)
+		ctx := tracing.NewContext(c.Request.Context(), trace)
+		c.Request = c.Request.WithContext(logger.NewContext(ctx, log))
+
+		// Query strings may contain OAuth codes or tokens, so they are logged only at debug level
+		log.Debug("Incoming request", map[string]interface{}{
+			"query": c.Request.URL.RawQuery,
+		})
 
 		// Process request
 		c.Next()
@@ -44,37 +46,36 @@ func LoggingMiddleware() gin.HandlerFunc {
 		latency := time.Since(startTime)
 		statusCode := c.Writer.Status()
 
-		// Determine log level based on status code
-		fields := map[string]interface{}{
+		// Access log fields
+		accessFields := map[string]interface{}{
+			"route":       c.FullPath(),
 			"status_code": statusCode,
 			"latency_ms":  latency.Milliseconds(),
-			"latency":     latency.String(),
-			"body_size":   c.Writer.Size(),
+			"bytes_in":    c.Request.ContentLength,
+			"bytes_out":   c.Writer.Size(),
+			"user_agent":  c.Request.UserAgent(),
+		}
+		if userID, ok := GetUserID(c); ok {
+			accessFields["user_id"] = userID
 		}
 
 		// Add error if exists
 		if len(c.Errors) > 0 {
-			fields["errors"] = c.Errors.String()
+			accessFields["errors"] = c.Errors.String()
 		}
 
 		// Log based on status code
 		msg := "Request completed"
 		if statusCode >= 500 {
-			log.Error(msg, nil, fields)
+			log.Error(msg, nil, accessFields)
 		} else if statusCode >= 400 {
-			log.Warn(msg, fields)
+			log.Warn(msg, accessFields)
 		} else {
-			log.Info(msg, fields)
+			log.Info(msg, accessFields)
 		}
 	}
 }
 
-// generateRequestID generates a simple request ID
-// In production, consider using UUID or similar
-func generateRequestID() string {
-	return time.Now().Format("20060102150405.000")
-}
-
 // GetLoggerFromContext retrieves the logger from gin context
 func GetLoggerFromContext(c *gin.Context) *logger.Logger {
 	if log, exists := c.Get("logger"); exists {
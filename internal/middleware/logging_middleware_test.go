@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apperrors "github.com/ikkim/udonggeum-backend/internal/errors"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingMiddleware_RequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(LoggingMiddleware())
+
+	var ctxRequestID string
+	router.GET("/missing", func(c *gin.Context) {
+		ctxRequestID = tracing.RequestID(c.Request.Context())
+		apperrors.NotFound(c, apperrors.ResourceNotFound, "not found")
+	})
+
+	// 클라이언트가 보낸 요청 ID와 trace를 이어받아 응답 헤더와 에러 본문에 반환
+	req := httptest.NewRequest(http.MethodGet, "/missing", nil)
+	req.Header.Set(tracing.HeaderRequestID, "client-request-1")
+	req.Header.Set(tracing.HeaderTraceparent, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "client-request-1", w.Header().Get(tracing.HeaderRequestID))
+	assert.True(t, strings.HasPrefix(w.Header().Get(tracing.HeaderTraceparent), "00-4bf92f3577b34da6a3ce929d0e0e4736-"))
+	assert.Equal(t, "client-request-1", ctxRequestID)
+
+	var body apperrors.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "client-request-1", body.RequestID)
+
+	// 요청 ID가 없거나 잘못되면 새로 생성
+	req = httptest.NewRequest(http.MethodGet, "/missing", nil)
+	req.Header.Set(tracing.HeaderRequestID, "bad\nid")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	requestID := w.Header().Get(tracing.HeaderRequestID)
+	assert.Len(t, requestID, 36)
+	assert.Equal(t, requestID, ctxRequestID)
+}
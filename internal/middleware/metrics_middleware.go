@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/config"
+	"github.com/ikkim/udonggeum-backend/internal/errors"
+	"github.com/ikkim/udonggeum-backend/internal/metrics"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+)
+
+// MetricsMiddleware 라우트별 요청 처리 시간 기록
+// 라벨 수가 늘어나지 않도록 실제 경로 대신 라우트 패턴을 사용 (매칭되지 않은 요청은 "unmatched")
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		metrics.HTTPRequestDuration.
+			WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
+
+// MetricsGuard /metrics 접근 제한 (허용 IP 또는 Bearer 토큰)
+// X-Forwarded-For는 위조할 수 있으므로 허용 IP는 직접 연결한 주소(RemoteIP)로만 판단
+func MetricsGuard(cfg config.MetricsConfig) gin.HandlerFunc {
+	var networks []*net.IPNet
+	for _, entry := range cfg.AllowedIPs {
+		network, err := parseIPNet(entry)
+		if err != nil {
+			logger.Warn("Ignoring invalid metrics allowlist entry", map[string]interface{}{
+				"entry": entry,
+			})
+			continue
+		}
+		networks = append(networks, network)
+	}
+
+	return func(c *gin.Context) {
+		if cfg.Token != "" {
+			token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+			if ok && subtle.ConstantTimeCompare([]byte(token), []byte(cfg.Token)) == 1 {
+				c.Next()
+				return
+			}
+		}
+
+		if ip := net.ParseIP(c.RemoteIP()); ip != nil {
+			for _, network := range networks {
+				if network.Contains(ip) {
+					c.Next()
+					return
+				}
+			}
+		}
+
+		errors.Forbidden(c, "")
+		c.Abort()
+	}
+}
+
+// parseIPNet IP 하나(/32, /128) 또는 CIDR
+func parseIPNet(entry string) (*net.IPNet, error) {
+	if strings.Contains(entry, "/") {
+		_, network, err := net.ParseCIDR(entry)
+		return network, err
+	}
+	ip := net.ParseIP(entry)
+	if ip == nil {
+		return nil, &net.ParseError{Type: "IP address", Text: entry}
+	}
+	bits := 128
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 32
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
@@ -0,0 +1,65 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ikkim/udonggeum-backend/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsGuard(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/metrics", MetricsGuard(config.MetricsConfig{
+		Token:      "scrape-token",
+		AllowedIPs: []string{"10.0.0.0/8", "192.168.1.5", "not-an-ip"},
+	}), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		header     map[string]string
+		wantStatus int
+	}{
+		{"allowed CIDR", "10.1.2.3:5000", nil, http.StatusOK},
+		{"allowed single IP", "192.168.1.5:5000", nil, http.StatusOK},
+		{"valid token", "203.0.113.7:5000", map[string]string{"Authorization": "Bearer scrape-token"}, http.StatusOK},
+		{"wrong token", "203.0.113.7:5000", map[string]string{"Authorization": "Bearer nope"}, http.StatusForbidden},
+		{"not allowed", "192.168.1.6:5000", nil, http.StatusForbidden},
+		// 프록시 헤더로 허용 IP를 흉내낼 수 없음
+		{"spoofed forwarded IP", "203.0.113.7:5000", map[string]string{"X-Forwarded-For": "10.0.0.1"}, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for key, value := range tt.header {
+				req.Header.Set(key, value)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			assert.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestMetricsGuard_DeniesWithoutConfig(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/metrics", MetricsGuard(config.MetricsConfig{}), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.RemoteAddr = "127.0.0.1:5000"
+	req.Header.Set("Authorization", "Bearer ")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
@@ -0,0 +1,458 @@
+package migrate
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+)
+
+const (
+	// 적용 기록 테이블
+	tableName = "schema_migrations"
+
+	// lockKey 동시에 여러 인스턴스가 마이그레이션하지 않도록 잡는 advisory lock 키
+	lockKey int64 = 0x75646f6e67 // "udong"
+
+	versionLayout = "20060102150405"
+)
+
+var (
+	ErrModified = errors.New("적용된 마이그레이션 파일이 변경되었습니다")
+	ErrMissing  = errors.New("적용된 마이그레이션 파일이 없습니다")
+	ErrNoDown   = errors.New("되돌리기(down) 파일이 없는 마이그레이션입니다")
+
+	// <version>_<name>.up.sql / <version>_<name>.down.sql
+	fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+	namePattern     = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Migration 버전별 스키마 변경
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string // 비어 있으면 되돌릴 수 없음
+	Checksum string // up SQL의 sha256 (적용 후 파일이 바뀌었는지 확인)
+}
+
+// State 마이그레이션 적용 상태
+type State string
+
+const (
+	StatePending  State = "pending"  // 아직 적용되지 않음
+	StateApplied  State = "applied"  // 적용됨
+	StateModified State = "modified" // 적용 후 up 파일이 바뀜
+	StateMissing  State = "missing"  // 적용 기록은 있는데 파일이 없음
+)
+
+// Status 마이그레이션 하나의 상태
+type Status struct {
+	Version   int64
+	Name      string
+	State     State
+	AppliedAt *time.Time
+}
+
+type appliedMigration struct {
+	version   int64
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// Load fsys의 마이그레이션 파일을 버전 순으로 읽음
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q (want <version>_<name>.up.sql or .down.sql)", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %q: %w", entry.Name(), err)
+		}
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("duplicate migration version %d (%s, %s)", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(data)
+			sum := sha256.Sum256(data)
+			m.Checksum = hex.EncodeToString(sum[:])
+		} else {
+			m.Down = string(data)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Checksum == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Create dir에 다음 버전의 빈 up/down 파일 생성
+func Create(dir, name string, now time.Time) ([]string, error) {
+	if !namePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid migration name %q (use lowercase letters, digits and _)", name)
+	}
+
+	version := now.UTC().Format(versionLayout)
+	var paths []string
+	for _, direction := range []string{"up", "down"} {
+		path := filepath.Join(dir, fmt.Sprintf("%s_%s.%s.sql", version, name, direction))
+		content := fmt.Sprintf("-- Migration: %s (%s)\n-- Date: %s\n\n", name, direction, now.Format("2006-01-02"))
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return paths, err
+		}
+		if _, err := file.WriteString(content); err != nil {
+			file.Close()
+			return paths, err
+		}
+		if err := file.Close(); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// Migrator PostgreSQL에 마이그레이션을 적용하고 schema_migrations에 기록
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+
+	// AutoMigrate로 만든 기존 DB 판별 (legacyTable이 있는데 적용 기록이 없으면 legacyVersion까지 적용된 것으로 기록)
+	legacyTable   string
+	legacyVersion int64
+}
+
+// New 버전 순으로 정렬된 migrations를 적용하는 Migrator 생성
+func New(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{db: db, migrations: migrations}
+}
+
+// AdoptLegacySchema 적용 기록 없이 table이 이미 있는 DB는 version까지 적용된 것으로 간주 (Up에서 기록)
+func (m *Migrator) AdoptLegacySchema(table string, version int64) {
+	m.legacyTable = table
+	m.legacyVersion = version
+}
+
+// Status 전체 마이그레이션 상태 (DB를 변경하지 않음)
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.readApplied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	return statuses(m.migrations, applied), nil
+}
+
+// Pending 적용해야 할 마이그레이션 (적용된 파일이 바뀌었거나 없으면 에러)
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	applied, err := m.readApplied(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+	return pending(m.migrations, applied)
+}
+
+// Up 적용되지 않은 마이그레이션을 버전 순으로 하나씩 트랜잭션 안에서 적용
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.readApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			if applied, err = m.adoptLegacySchema(ctx, conn); err != nil {
+				return err
+			}
+		}
+
+		todo, err := pending(m.migrations, applied)
+		if err != nil {
+			return err
+		}
+		for _, migration := range todo {
+			if err := m.apply(ctx, conn, migration); err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down 마지막으로 적용된 마이그레이션부터 steps개를 되돌림
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		applied, err := m.readApplied(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		files := make(map[int64]Migration, len(m.migrations))
+		for _, migration := range m.migrations {
+			files[migration.Version] = migration
+		}
+
+		for i := len(applied) - 1; i >= 0 && len(done) < steps; i-- {
+			migration, ok := files[applied[i].version]
+			if !ok {
+				return fmt.Errorf("%w: %d_%s", ErrMissing, applied[i].version, applied[i].name)
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("%w: %d_%s", ErrNoDown, migration.Version, migration.Name)
+			}
+			if err := m.revert(ctx, conn, migration); err != nil {
+				return err
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// withLock 전용 연결에서 advisory lock을 잡고 기록 테이블을 준비한 뒤 fn 실행
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// 다른 인스턴스가 마이그레이션 중이면 끝날 때까지 대기 (끝난 뒤에는 적용할 것이 없음)
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey); err != nil {
+			logger.Error("Failed to release migration lock", err)
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+tableName+` (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		checksum TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`); err != nil {
+		return fmt.Errorf("failed to create %s: %w", tableName, err)
+	}
+
+	return fn(conn)
+}
+
+// queryer *sql.DB, *sql.Conn 공통 조회
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// readApplied 적용 기록을 버전 순으로 조회 (기록 테이블이 없으면 없음)
+func (m *Migrator) readApplied(ctx context.Context, q queryer) ([]appliedMigration, error) {
+	exists, err := tableExists(ctx, q, tableName)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT version, name, checksum, applied_at FROM "+tableName+" ORDER BY version")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var applied []appliedMigration
+	for rows.Next() {
+		var a appliedMigration
+		if err := rows.Scan(&a.version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			return nil, err
+		}
+		applied = append(applied, a)
+	}
+	return applied, rows.Err()
+}
+
+// adoptLegacySchema AutoMigrate로 만든 기존 DB면 legacyVersion까지 실행하지 않고 적용 기록만 남김
+func (m *Migrator) adoptLegacySchema(ctx context.Context, conn *sql.Conn) ([]appliedMigration, error) {
+	if m.legacyTable == "" {
+		return nil, nil
+	}
+	exists, err := tableExists(ctx, conn, m.legacyTable)
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	var applied []appliedMigration
+	for _, migration := range m.migrations {
+		if migration.Version > m.legacyVersion {
+			break
+		}
+		if _, err := conn.ExecContext(ctx, "INSERT INTO "+tableName+" (version, name, checksum) VALUES ($1, $2, $3)",
+			migration.Version, migration.Name, migration.Checksum); err != nil {
+			return nil, err
+		}
+		applied = append(applied, appliedMigration{version: migration.Version, name: migration.Name, checksum: migration.Checksum})
+		logger.Info("Existing schema adopted as migration", map[string]interface{}{
+			"version": migration.Version,
+			"name":    migration.Name,
+		})
+	}
+	return applied, nil
+}
+
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	start := time.Now()
+	err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "INSERT INTO "+tableName+" (version, name, checksum) VALUES ($1, $2, $3)",
+			migration.Version, migration.Name, migration.Checksum)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+	}
+
+	logger.Info("Migration applied", map[string]interface{}{
+		"version":     migration.Version,
+		"name":        migration.Name,
+		"duration_ms": time.Since(start).Milliseconds(),
+	})
+	return nil
+}
+
+func (m *Migrator) revert(ctx context.Context, conn *sql.Conn, migration Migration) error {
+	err := inTx(ctx, conn, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "DELETE FROM "+tableName+" WHERE version = $1", migration.Version)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("rollback of %d_%s failed: %w", migration.Version, migration.Name, err)
+	}
+
+	logger.Info("Migration rolled back", map[string]interface{}{
+		"version": migration.Version,
+		"name":    migration.Name,
+	})
+	return nil
+}
+
+func inTx(ctx context.Context, conn *sql.Conn, fn func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func tableExists(ctx context.Context, q queryer, table string) (bool, error) {
+	var exists bool
+	err := q.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists)
+	return exists, err
+}
+
+// statuses 파일과 적용 기록을 버전 순으로 합침
+func statuses(migrations []Migration, applied []appliedMigration) []Status {
+	records := make(map[int64]appliedMigration, len(applied))
+	for _, a := range applied {
+		records[a.version] = a
+	}
+
+	result := make([]Status, 0, len(migrations)+len(applied))
+	seen := make(map[int64]bool, len(migrations))
+	for _, migration := range migrations {
+		seen[migration.Version] = true
+		status := Status{Version: migration.Version, Name: migration.Name, State: StatePending}
+		if a, ok := records[migration.Version]; ok {
+			appliedAt := a.appliedAt
+			status.AppliedAt = &appliedAt
+			status.State = StateApplied
+			if a.checksum != migration.Checksum {
+				status.State = StateModified
+			}
+		}
+		result = append(result, status)
+	}
+	for _, a := range applied {
+		if !seen[a.version] {
+			appliedAt := a.appliedAt
+			result = append(result, Status{Version: a.version, Name: a.name, State: StateMissing, AppliedAt: &appliedAt})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Version < result[j].Version
+	})
+	return result
+}
+
+// pending 적용할 마이그레이션 (적용된 파일이 바뀌었거나 없으면 적용하지 않고 에러)
+func pending(migrations []Migration, applied []appliedMigration) ([]Migration, error) {
+	byVersion := make(map[int64]Migration, len(migrations))
+	for _, migration := range migrations {
+		byVersion[migration.Version] = migration
+	}
+
+	var todo []Migration
+	for _, status := range statuses(migrations, applied) {
+		switch status.State {
+		case StateModified:
+			return nil, fmt.Errorf("%w: %d_%s", ErrModified, status.Version, status.Name)
+		case StateMissing:
+			return nil, fmt.Errorf("%w: %d_%s", ErrMissing, status.Version, status.Name)
+		case StatePending:
+			todo = append(todo, byVersion[status.Version])
+		}
+	}
+	return todo, nil
+}
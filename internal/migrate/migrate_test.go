@@ -0,0 +1,112 @@
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/db/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"20260414000000_add_index.up.sql":   {Data: []byte("CREATE INDEX a ON b (c);")},
+		"20260414000000_add_index.down.sql": {Data: []byte("DROP INDEX a;")},
+		"20260101000000_initial.up.sql":     {Data: []byte("CREATE TABLE b (c int);")},
+		"README.md":                         {Data: []byte("docs")},
+	}
+
+	loaded, err := Load(fsys)
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.Equal(t, int64(20260101000000), loaded[0].Version)
+	assert.Equal(t, "initial", loaded[0].Name)
+	assert.Empty(t, loaded[0].Down)
+	assert.Equal(t, "add_index", loaded[1].Name)
+	assert.Equal(t, "DROP INDEX a;", loaded[1].Down)
+	assert.Len(t, loaded[1].Checksum, 64)
+
+	for name, files := range map[string]fstest.MapFS{
+		"bad name":          {"add_index.up.sql": {}},
+		"duplicate version": {"1_a.up.sql": {}, "1_b.up.sql": {}},
+		"down without up":   {"1_a.down.sql": {}},
+	} {
+		_, err := Load(files)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLoad_RepositoryMigrations(t *testing.T) {
+	loaded, err := Load(migrations.FS)
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+	for _, m := range loaded {
+		assert.NotEmpty(t, m.Down, "%d_%s", m.Version, m.Name)
+	}
+}
+
+func TestPending(t *testing.T) {
+	loaded := []Migration{
+		{Version: 1, Name: "a", Checksum: "sum-a"},
+		{Version: 2, Name: "b", Checksum: "sum-b"},
+		{Version: 3, Name: "c", Checksum: "sum-c"},
+	}
+	appliedAt := time.Now()
+
+	todo, err := pending(loaded, nil)
+	require.NoError(t, err)
+	assert.Len(t, todo, 3)
+
+	// 늦게 병합된 이전 버전도 적용
+	todo, err = pending(loaded, []appliedMigration{
+		{version: 1, name: "a", checksum: "sum-a", appliedAt: appliedAt},
+		{version: 3, name: "c", checksum: "sum-c", appliedAt: appliedAt},
+	})
+	require.NoError(t, err)
+	require.Len(t, todo, 1)
+	assert.Equal(t, int64(2), todo[0].Version)
+
+	_, err = pending(loaded, []appliedMigration{{version: 1, name: "a", checksum: "changed"}})
+	assert.ErrorIs(t, err, ErrModified)
+
+	_, err = pending(loaded, []appliedMigration{{version: 9, name: "removed", checksum: "sum"}})
+	assert.ErrorIs(t, err, ErrMissing)
+
+	result := statuses(loaded, []appliedMigration{
+		{version: 2, name: "b", checksum: "sum-b", appliedAt: appliedAt},
+		{version: 9, name: "removed", checksum: "sum", appliedAt: appliedAt},
+	})
+	require.Len(t, result, 4)
+	assert.Equal(t, StatePending, result[0].State)
+	assert.Nil(t, result[0].AppliedAt)
+	assert.Equal(t, StateApplied, result[1].State)
+	assert.Equal(t, StateMissing, result[3].State)
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC)
+
+	paths, err := Create(dir, "add_user_sessions", now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "20261016123000_add_user_sessions.up.sql"),
+		filepath.Join(dir, "20261016123000_add_user_sessions.down.sql"),
+	}, paths)
+
+	loaded, err := Load(os.DirFS(dir))
+	require.NoError(t, err)
+	require.Len(t, loaded, 1)
+	assert.Equal(t, int64(20261016123000), loaded[0].Version)
+
+	// 같은 버전의 파일은 덮어쓰지 않음
+	_, err = Create(dir, "add_user_sessions", now)
+	assert.Error(t, err)
+
+	_, err = Create(dir, "Add Sessions", now)
+	assert.Error(t, err)
+}
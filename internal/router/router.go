@@ -4,11 +4,14 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/ikkim/udonggeum-backend/config"
 	"github.com/ikkim/udonggeum-backend/internal/app/controller"
+	"github.com/ikkim/udonggeum-backend/internal/metrics"
 	"github.com/ikkim/udonggeum-backend/internal/middleware"
+	"github.com/ikkim/udonggeum-backend/internal/storage"
 )
 
 type Router struct {
 	authController         *controller.AuthController
+	accountController      *controller.AccountController
 	storeController        *controller.StoreController
 	goldPriceController    *controller.GoldPriceController
 	communityController    *controller.CommunityController
@@ -18,12 +21,17 @@ type Router struct {
 	chatController         *controller.ChatController
 	notificationController *controller.NotificationController
 	faqController          *controller.FAQController
+	paymentController      *controller.PaymentController
+	escrowController       *controller.EscrowController
+	priceAlertController   *controller.PriceAlertController
+	healthController       *controller.HealthController
 	authMiddleware         *middleware.AuthMiddleware
 	config                 *config.Config
 }
 
 func NewRouter(
 	authController *controller.AuthController,
+	accountController *controller.AccountController,
 	storeController *controller.StoreController,
 	goldPriceController *controller.GoldPriceController,
 	communityController *controller.CommunityController,
@@ -33,11 +41,16 @@ func NewRouter(
 	chatController *controller.ChatController,
 	notificationController *controller.NotificationController,
 	faqController *controller.FAQController,
+	paymentController *controller.PaymentController,
+	escrowController *controller.EscrowController,
+	priceAlertController *controller.PriceAlertController,
+	healthController *controller.HealthController,
 	authMiddleware *middleware.AuthMiddleware,
 	cfg *config.Config,
 ) *Router {
 	return &Router{
 		authController:         authController,
+		accountController:      accountController,
 		storeController:        storeController,
 		goldPriceController:    goldPriceController,
 		communityController:    communityController,
@@ -47,6 +60,10 @@ func NewRouter(
 		chatController:         chatController,
 		notificationController: notificationController,
 		faqController:          faqController,
+		paymentController:      paymentController,
+		escrowController:       escrowController,
+		priceAlertController:   priceAlertController,
+		healthController:       healthController,
 		authMiddleware:         authMiddleware,
 		config:                 cfg,
 	}
@@ -59,6 +76,7 @@ func (r *Router) Setup() *gin.Engine {
 
 	router.Use(gin.Recovery())
 	router.Use(middleware.LoggingMiddleware())
+	router.Use(middleware.MetricsMiddleware())
 	router.Use(corsMiddleware(r.config.CORS.AllowedOrigins))
 
 	router.GET("/health", func(c *gin.Context) {
@@ -67,6 +85,18 @@ func (r *Router) Setup() *gin.Engine {
 			"message": "UDONGGEUM API is running",
 		})
 	})
+	router.GET("/health/live", r.healthController.Live)
+	router.GET("/health/ready", r.healthController.Ready)
+
+	if r.config.Metrics.Enabled {
+		router.GET("/metrics", middleware.MetricsGuard(r.config.Metrics), gin.WrapH(metrics.Handler()))
+	}
+
+	// 로컬 저장소: presigned POST 업로드 수신 및 파일 제공 (서명으로 인증)
+	if r.config.Storage.Driver == "local" {
+		router.POST(storage.LocalUploadPath, r.uploadController.LocalUpload)
+		router.GET(storage.LocalFilesPath+"/*key", r.uploadController.LocalFile)
+	}
 
 	v1 := router.Group("/api/v1")
 	{
@@ -82,14 +112,28 @@ func (r *Router) Setup() *gin.Engine {
 			auth.POST("/check-email", r.authController.CheckEmailAvailability)
 			auth.GET("/me", r.authMiddleware.Authenticate(), r.authController.GetMe)
 			auth.PUT("/me", r.authMiddleware.Authenticate(), r.authController.UpdateMe)
-
-			// Kakao OAuth
-			auth.GET("/kakao/login", r.authController.GetKakaoLoginURL)
-			auth.GET("/kakao/callback", r.authController.KakaoCallback)
-
-			// Google OAuth
-			auth.GET("/google/login", r.authController.GetGoogleLoginURL)
-			auth.GET("/google/callback", r.authController.GoogleCallback)
+			auth.DELETE("/me", r.authMiddleware.Authenticate(), r.accountController.DeleteMe)
+			auth.POST("/me/export", r.authMiddleware.Authenticate(), r.accountController.ExportMe)
+			auth.GET("/me/store-transfers", r.authMiddleware.Authenticate(), r.accountController.ListStoreTransfers)
+			auth.POST("/me/store-transfers/:id/accept", r.authMiddleware.Authenticate(), r.accountController.AcceptStoreTransfer)
+			auth.POST("/me/store-transfers/:id/decline", r.authMiddleware.Authenticate(), r.accountController.DeclineStoreTransfer)
+
+			// 외부 로그인 연결 관리
+			auth.GET("/me/identities", r.authMiddleware.Authenticate(), r.authController.ListIdentities)
+			auth.GET("/me/identities/:provider/login", r.authMiddleware.Authenticate(), r.authController.GetOAuthLinkURL)
+			auth.POST("/me/identities/:provider", r.authMiddleware.Authenticate(), r.authController.LinkIdentity)
+			auth.DELETE("/me/identities/:provider", r.authMiddleware.Authenticate(), r.authController.UnlinkIdentity)
+
+			// 로그인 세션 (기기별 로그아웃)
+			auth.GET("/sessions", r.authMiddleware.Authenticate(), r.authController.ListSessions)
+			auth.DELETE("/sessions", r.authMiddleware.Authenticate(), r.authController.RevokeOtherSessions)
+			auth.DELETE("/sessions/:id", r.authMiddleware.Authenticate(), r.authController.RevokeSession)
+
+			// 소셜 로그인 (kakao, google, apple, naver)
+			// 애플은 form_post로 콜백하므로 POST도 받음
+			auth.GET("/:provider/login", r.authController.GetOAuthLoginURL)
+			auth.GET("/:provider/callback", r.authController.OAuthCallback)
+			auth.POST("/:provider/callback", r.authController.OAuthCallback)
 
 			// 이메일/휴대폰 인증
 			auth.POST("/send-email-verification", r.authController.SendEmailVerification)
@@ -195,6 +239,24 @@ func (r *Router) Setup() *gin.Engine {
 				r.storeController.GetMyVerificationStatus,
 			)
 
+			// Gold price alerts (시세 알림)
+			users.GET("/me/price-alerts",
+				r.authMiddleware.Authenticate(),
+				r.priceAlertController.ListPriceAlerts,
+			)
+			users.POST("/me/price-alerts",
+				r.authMiddleware.Authenticate(),
+				r.priceAlertController.CreatePriceAlert,
+			)
+			users.PATCH("/me/price-alerts/:id",
+				r.authMiddleware.Authenticate(),
+				r.priceAlertController.UpdatePriceAlert,
+			)
+			users.DELETE("/me/price-alerts/:id",
+				r.authMiddleware.Authenticate(),
+				r.priceAlertController.DeletePriceAlert,
+			)
+
 			// Notification settings
 			users.GET("/notification-settings",
 				r.authMiddleware.Authenticate(),
@@ -240,6 +302,7 @@ func (r *Router) Setup() *gin.Engine {
 			goldPrices.GET("/latest", r.goldPriceController.GetLatestPrices)
 			goldPrices.GET("/type/:type", r.goldPriceController.GetPriceByType)
 			goldPrices.GET("/history/:type", r.goldPriceController.GetPriceHistory)
+			goldPrices.GET("/candles/:type", r.goldPriceController.GetCandles)
 
 			// Master routes (매장 관리자 이상)
 			goldPrices.POST("",
@@ -275,6 +338,14 @@ func (r *Router) Setup() *gin.Engine {
 				r.authMiddleware.Authenticate(),
 				r.uploadController.GenerateChatFilePresignedURL,
 			)
+			upload.POST("/license/presigned-url",
+				r.authMiddleware.Authenticate(),
+				r.uploadController.GenerateLicensePresignedURL,
+			)
+			upload.POST("/confirm",
+				r.authMiddleware.Authenticate(),
+				r.uploadController.ConfirmUpload,
+			)
 		}
 
 		// Tags routes
@@ -308,10 +379,12 @@ func (r *Router) Setup() *gin.Engine {
 				rooms.POST("/:id/join", r.chatController.JoinRoom)                            // 채팅방 참여
 				rooms.POST("/:id/leave", r.chatController.LeaveRoom)                          // 채팅방 나가기
 				rooms.POST("/:id/read", r.chatController.MarkAsRead)                          // 읽음 처리
+				rooms.POST("/:id/delivered", r.chatController.MarkAsDelivered)                // 수신 확인
 				rooms.GET("/:id/messages", r.chatController.GetMessages)                      // 메시지 목록
 				rooms.POST("/:id/messages", r.chatController.SendMessage)                     // 메시지 전송
 				rooms.PATCH("/:id/messages/:messageId", r.chatController.UpdateMessage)       // 메시지 수정
 				rooms.DELETE("/:id/messages/:messageId", r.chatController.DeleteMessage)      // 메시지 삭제
+				rooms.GET("/:id/messages/:messageId/file", r.chatController.GetMessageFile)   // 첨부 파일 URL 발급
 			}
 		}
 
@@ -380,6 +453,28 @@ func (r *Router) Setup() *gin.Engine {
 					r.authMiddleware.Authenticate(),
 					r.communityController.CompleteTransaction,
 				)
+
+				// Escrow (금 판매글 안전거래)
+				posts.GET("/:id/escrow",
+					r.authMiddleware.Authenticate(),
+					r.escrowController.GetEscrow,
+				)
+				posts.POST("/:id/escrow",
+					r.authMiddleware.Authenticate(),
+					r.escrowController.StartEscrow,
+				)
+				posts.POST("/:id/escrow/handover",
+					r.authMiddleware.Authenticate(),
+					r.escrowController.ConfirmHandover,
+				)
+				posts.POST("/:id/escrow/receipt",
+					r.authMiddleware.Authenticate(),
+					r.escrowController.ConfirmReceipt,
+				)
+				posts.POST("/:id/escrow/cancel",
+					r.authMiddleware.Authenticate(),
+					r.escrowController.CancelEscrow,
+				)
 			}
 
 			// Gallery route
@@ -434,6 +529,32 @@ func (r *Router) Setup() *gin.Engine {
 			)
 		}
 
+		// Payment routes (카카오페이)
+		payments := v1.Group("/payments")
+		{
+			// 카카오페이 리다이렉트 콜백 (인증 없음, order_id로 주문 식별)
+			payments.GET("/kakao/success", r.paymentController.KakaoPaySuccess)
+			payments.GET("/kakao/fail", r.paymentController.KakaoPayFail)
+			payments.GET("/kakao/cancel", r.paymentController.KakaoPayCancel)
+
+			payments.POST("/ready",
+				r.authMiddleware.Authenticate(),
+				r.paymentController.ReadyPayment,
+			)
+			payments.GET("",
+				r.authMiddleware.Authenticate(),
+				r.paymentController.GetMyPayments,
+			)
+			payments.GET("/:id",
+				r.authMiddleware.Authenticate(),
+				r.paymentController.GetPayment,
+			)
+			payments.POST("/:id/cancel",
+				r.authMiddleware.Authenticate(),
+				r.paymentController.CancelPayment,
+			)
+		}
+
 		// Admin routes (관리자 전용)
 		admin := v1.Group("/admin")
 		admin.Use(r.authMiddleware.Authenticate())
@@ -442,6 +563,9 @@ func (r *Router) Setup() *gin.Engine {
 			// Store verifications (매장 인증 관리)
 			admin.GET("/verifications", r.storeController.ListPendingVerifications)
 			admin.PUT("/verifications/:id", r.storeController.ReviewVerification)
+
+			// Gold price scheduler runs (금 시세 자동 수집 기록)
+			admin.GET("/gold-prices/runs", r.goldPriceController.ListUpdateRuns)
 		}
 	}
 
@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/robfig/cron/v3"
+)
+
+// AccountDeletionScheduler 유예 기간이 지난 탈퇴 계정 익명화 스케줄러
+type AccountDeletionScheduler struct {
+	cron           *cron.Cron
+	accountService service.AccountService
+}
+
+// NewAccountDeletionScheduler 회원 탈퇴 스케줄러 생성
+func NewAccountDeletionScheduler(accountService service.AccountService) *AccountDeletionScheduler {
+	return &AccountDeletionScheduler{
+		// 이전 실행이 끝나지 않았으면 다음 실행을 건너뜀
+		cron:           cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
+		accountService: accountService,
+	}
+}
+
+// Start 스케줄러 시작
+func (s *AccountDeletionScheduler) Start() error {
+	// 1시간마다 유예 기간이 지난 탈퇴 요청을 익명화
+	_, err := s.cron.AddFunc("@every 1h", func() {
+		processed, err := s.accountService.ProcessDueDeletions(context.Background())
+		if err != nil {
+			logger.Error("Failed to process account deletions", err)
+			return
+		}
+
+		if processed > 0 {
+			logger.Info("Anonymized deleted accounts", map[string]interface{}{
+				"count": processed,
+			})
+		}
+	})
+
+	if err != nil {
+		logger.Error("Failed to add cron job for account deletion", err)
+		return err
+	}
+
+	s.cron.Start()
+	logger.Info("Account deletion scheduler started successfully (every 1 hour)", nil)
+
+	return nil
+}
+
+// Stop 스케줄러 중지 (실행 중인 작업은 ctx 만료 전까지 완료를 기다림)
+func (s *AccountDeletionScheduler) Stop(ctx context.Context) error {
+	logger.Info("Stopping account deletion scheduler...", nil)
+	if err := stopCron(ctx, s.cron); err != nil {
+		return err
+	}
+	logger.Info("Account deletion scheduler stopped", nil)
+	return nil
+}
+
+// Check cron 루프가 동작하는지 확인 (헬스 체크)
+func (s *AccountDeletionScheduler) Check(ctx context.Context) error {
+	return checkCron(ctx, s.cron, time.Now())
+}
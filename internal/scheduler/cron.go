@@ -0,0 +1,18 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+)
+
+// stopCron 새 작업 실행을 멈추고 실행 중인 작업이 끝날 때까지 ctx 만료 전까지 대기
+func stopCron(ctx context.Context, c *cron.Cron) error {
+	select {
+	case <-c.Stop().Done():
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("running jobs did not finish: %w", ctx.Err())
+	}
+}
@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+	"github.com/robfig/cron/v3"
+)
+
+// EscrowScheduler 안전거래 기한 만료 처리 스케줄러
+type EscrowScheduler struct {
+	cron          *cron.Cron
+	escrowService service.EscrowService
+}
+
+// NewEscrowScheduler 안전거래 스케줄러 생성
+func NewEscrowScheduler(escrowService service.EscrowService) *EscrowScheduler {
+	return &EscrowScheduler{
+		// 이전 실행이 끝나지 않았으면 다음 실행을 건너뜀 (같은 건을 중복 환불하지 않도록)
+		cron:          cron.New(cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
+		escrowService: escrowService,
+	}
+}
+
+// Start 스케줄러 시작
+func (s *EscrowScheduler) Start() error {
+	// 1분마다 기한이 지난 안전거래를 취소/환불/지급 처리
+	_, err := s.cron.AddFunc("@every 1m", func() {
+		ctx := tracing.StartJob(context.Background(), "escrow_expiration")
+		log := logger.FromContext(ctx)
+
+		processed, err := s.escrowService.ProcessExpired(ctx)
+		if err != nil {
+			log.Error("Failed to process expired escrows", err)
+			return
+		}
+
+		if processed > 0 {
+			log.Info("Processed expired escrows", map[string]interface{}{
+				"count": processed,
+			})
+		}
+	})
+
+	if err != nil {
+		logger.Error("Failed to add cron job for escrow expiration", err)
+		return err
+	}
+
+	s.cron.Start()
+	logger.Info("Escrow scheduler started successfully (every 1 minute)", nil)
+
+	return nil
+}
+
+// Stop 스케줄러 중지 (실행 중인 작업은 ctx 만료 전까지 완료를 기다림)
+func (s *EscrowScheduler) Stop(ctx context.Context) error {
+	logger.Info("Stopping escrow scheduler...", nil)
+	if err := stopCron(ctx, s.cron); err != nil {
+		return err
+	}
+	logger.Info("Escrow scheduler stopped", nil)
+	return nil
+}
+
+// Check cron 루프가 동작하는지 확인 (헬스 체크)
+func (s *EscrowScheduler) Check(ctx context.Context) error {
+	return checkCron(ctx, s.cron, time.Now())
+}
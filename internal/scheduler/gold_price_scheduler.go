@@ -1,62 +1,264 @@
 package scheduler
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/ikkim/udonggeum-backend/config"
 	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	"github.com/ikkim/udonggeum-backend/internal/metrics"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 	"github.com/robfig/cron/v3"
 )
 
+const (
+	defaultMarketOpen  = 9 * 60     // 09:00
+	defaultMarketClose = 15*60 + 30 // 15:30
+	holidayDateLayout  = "2006-01-02"
+)
+
 // GoldPriceScheduler 금 시세 자동 업데이트 스케줄러
+// 설정된 cron 스케줄마다 실행하되, 장 운영 시간(평일, KRX 휴장일 제외)에만 수집
 type GoldPriceScheduler struct {
 	cron             *cron.Cron
 	goldPriceService service.GoldPriceService
+	location         *time.Location
+
+	specs        []string
+	marketOpen   int // 자정 기준 분
+	marketClose  int // 자정 기준 분
+	holidays     map[string]struct{}
+	maxAttempts  int
+	retryBackoff time.Duration
+
+	now   func() time.Time
+	sleep func(context.Context, time.Duration) error
+
+	// ctx Stop에서 취소되어 실행 중인 수집의 재시도 대기를 끝냄
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// 마지막 수집 결과 (헬스 체크용)
+	mu         sync.Mutex
+	lastRunAt  time.Time
+	lastRunErr error
 }
 
 // NewGoldPriceScheduler 금 시세 스케줄러 생성
-func NewGoldPriceScheduler(goldPriceService service.GoldPriceService) *GoldPriceScheduler {
+func NewGoldPriceScheduler(goldPriceService service.GoldPriceService, cfg config.GoldPriceScheduleConfig) *GoldPriceScheduler {
 	kst, err := time.LoadLocation("Asia/Seoul")
 	if err != nil {
 		logger.Error("Failed to load KST timezone, falling back to UTC", err)
 		kst = time.UTC
 	}
 
-	return &GoldPriceScheduler{
-		cron:             cron.New(cron.WithLocation(kst)),
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &GoldPriceScheduler{
+		// 재시도 대기 중에 다음 스케줄이 돌아오면 건너뜀 (같은 시세를 중복 저장하지 않도록)
+		cron:             cron.New(cron.WithLocation(kst), cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
 		goldPriceService: goldPriceService,
+		location:         kst,
+		specs:            cfg.Specs,
+		marketOpen:       parseClock(cfg.MarketOpen, defaultMarketOpen),
+		marketClose:      parseClock(cfg.MarketClose, defaultMarketClose),
+		holidays:         make(map[string]struct{}),
+		maxAttempts:      cfg.MaxAttempts,
+		retryBackoff:     cfg.RetryBackoff,
+		now:              time.Now,
+		sleep:            sleepContext,
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+
+	if s.maxAttempts < 1 {
+		s.maxAttempts = 1
 	}
+
+	for _, day := range cfg.Holidays {
+		if _, err := time.ParseInLocation(holidayDateLayout, day, kst); err != nil {
+			logger.Warn("Ignoring invalid KRX holiday", map[string]interface{}{
+				"date": day,
+			})
+			continue
+		}
+		s.holidays[day] = struct{}{}
+	}
+
+	return s
 }
 
 // Start 스케줄러 시작
 func (s *GoldPriceScheduler) Start() error {
-	// 매일 오전 9시에 금 시세 업데이트 (KST 기준)
-	// cron 표현식: "0 9 * * *" = 매일 9시 0분
-	_, err := s.cron.AddFunc("0 9 * * *", func() {
-		logger.Info("Starting scheduled gold price update", nil)
-
-		if err := s.goldPriceService.UpdatePricesFromExternalAPI(); err != nil {
-			logger.Error("Failed to update gold prices from scheduler", err)
-			return
+	if len(s.specs) == 0 {
+		return errors.New("금 시세 수집 스케줄이 설정되지 않았습니다")
+	}
+
+	for _, spec := range s.specs {
+		if _, err := s.cron.AddFunc(spec, s.runScheduled); err != nil {
+			logger.Error("Failed to add cron job for gold price update", err, map[string]interface{}{
+				"spec": spec,
+			})
+			return err
 		}
+	}
 
-		logger.Info("Successfully updated gold prices from scheduler", nil)
+	s.cron.Start()
+	logger.Info("Gold price scheduler started successfully", map[string]interface{}{
+		"schedules":    strings.Join(s.specs, "; "),
+		"market_hours": fmt.Sprintf("%s-%s", formatClock(s.marketOpen), formatClock(s.marketClose)),
+		"holidays":     len(s.holidays),
+		"max_attempts": s.maxAttempts,
 	})
 
-	if err != nil {
-		logger.Error("Failed to add cron job for gold price update", err)
+	return nil
+}
+
+// Stop 스케줄러 중지 (실행 중인 작업은 ctx 만료 전까지 완료를 기다림)
+func (s *GoldPriceScheduler) Stop(ctx context.Context) error {
+	logger.Info("Stopping gold price scheduler...", nil)
+	s.cancel()
+	if err := stopCron(ctx, s.cron); err != nil {
 		return err
 	}
+	logger.Info("Gold price scheduler stopped", nil)
+	return nil
+}
+
+// runScheduled cron 실행 진입점 (장 운영 시간이 아니면 기록 없이 건너뜀)
+func (s *GoldPriceScheduler) runScheduled() {
+	now := s.now().In(s.location)
+	if !s.isMarketOpen(now) {
+		return
+	}
+	s.runUpdate(s.ctx)
+}
 
-	s.cron.Start()
-	logger.Info("Gold price scheduler started successfully (daily at 9:00 AM)", nil)
+// runUpdate 외부 API 조회 실패 시 지수 백오프로 재시도하고 실행 기록을 남김
+// ctx가 취소되면(스케줄러 중지) 재시도를 멈추고 마지막 실패를 기록
+func (s *GoldPriceScheduler) runUpdate(ctx context.Context) {
+	ctx = tracing.StartJob(ctx, "gold_price_update")
+	log := logger.FromContext(ctx)
+	log.Info("Starting scheduled gold price update", nil)
 
+	// 기록 실패는 수집을 막지 않음 (run이 nil이면 결과 기록도 생략)
+	run, err := s.goldPriceService.StartUpdateRun(ctx, s.now())
+	if err != nil {
+		log.Error("Failed to record gold price update run start", err, nil)
+	}
+
+	var rows int
+	var updateErr error
+	attempts := 0
+	backoff := s.retryBackoff
+	for attempts < s.maxAttempts {
+		attempts++
+		rows, updateErr = s.goldPriceService.UpdatePricesFromExternalAPI(ctx)
+		// 저장 중 오류는 재시도해도 같은 결과이므로 외부 API 조회 실패만 재시도
+		if updateErr == nil || !errors.Is(updateErr, service.ErrExternalAPIFailed) || attempts >= s.maxAttempts {
+			break
+		}
+
+		log.Warn("Gold price fetch failed, retrying", map[string]interface{}{
+			"attempt": attempts,
+			"backoff": backoff.String(),
+			"error":   updateErr.Error(),
+		})
+		if err := s.sleep(ctx, backoff); err != nil {
+			log.Warn("Gold price retry cancelled", map[string]interface{}{
+				"attempt": attempts,
+			})
+			break
+		}
+		backoff *= 2
+	}
+
+	s.mu.Lock()
+	s.lastRunAt = s.now()
+	s.lastRunErr = updateErr
+	s.mu.Unlock()
+
+	if run != nil {
+		run.Attempts = attempts
+		run.RowsWritten = rows
+		// 중지로 취소된 경우에도 결과는 기록
+		if err := s.goldPriceService.FinishUpdateRun(context.WithoutCancel(ctx), run, s.now(), updateErr); err != nil {
+			log.Error("Failed to record gold price update run result", err, map[string]interface{}{
+				"run_id": run.ID,
+			})
+		}
+	}
+
+	if updateErr != nil {
+		log.Error("Failed to update gold prices from scheduler", updateErr, map[string]interface{}{
+			"attempts": attempts,
+		})
+		return
+	}
+
+	metrics.GoldPriceLastSuccess.SetToCurrentTime()
+	log.Info("Successfully updated gold prices from scheduler", map[string]interface{}{
+		"attempts": attempts,
+		"rows":     rows,
+	})
+}
+
+// sleepContext d 동안 대기 (ctx가 취소되면 바로 반환)
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Check cron 루프가 동작하고 마지막 수집이 성공했는지 확인 (헬스 체크)
+func (s *GoldPriceScheduler) Check(ctx context.Context) error {
+	if err := checkCron(ctx, s.cron, s.now()); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.lastRunErr != nil {
+		return fmt.Errorf("last update at %s failed: %w", s.lastRunAt.Format(time.RFC3339), s.lastRunErr)
+	}
 	return nil
 }
 
-// Stop 스케줄러 중지
-func (s *GoldPriceScheduler) Stop() {
-	logger.Info("Stopping gold price scheduler...", nil)
-	s.cron.Stop()
-	logger.Info("Gold price scheduler stopped", nil)
+// isMarketOpen 평일, KRX 휴장일 아님, 장 운영 시간 내인지 확인 (now는 KST)
+func (s *GoldPriceScheduler) isMarketOpen(now time.Time) bool {
+	if now.Weekday() == time.Saturday || now.Weekday() == time.Sunday {
+		return false
+	}
+	if _, ok := s.holidays[now.Format(holidayDateLayout)]; ok {
+		return false
+	}
+
+	minutes := now.Hour()*60 + now.Minute()
+	return minutes >= s.marketOpen && minutes <= s.marketClose
+}
+
+// parseClock "HH:MM"을 자정 기준 분으로 변환 (잘못된 값이면 기본값)
+func parseClock(value string, fallback int) int {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		logger.Warn("Invalid market hour, using default", map[string]interface{}{
+			"value":   value,
+			"default": formatClock(fallback),
+		})
+		return fallback
+	}
+	return t.Hour()*60 + t.Minute()
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
 }
@@ -0,0 +1,179 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/config"
+	"github.com/ikkim/udonggeum-backend/internal/app/model"
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGoldPriceService 외부 API 결과를 순서대로 돌려주는 테스트용 서비스
+type fakeGoldPriceService struct {
+	service.GoldPriceService
+	results  []error
+	calls    int
+	finished *model.GoldPriceUpdateRun
+	// finishedCtxErr 결과를 기록할 때 ctx의 취소 여부
+	finishedCtxErr error
+}
+
+func (f *fakeGoldPriceService) UpdatePricesFromExternalAPI(ctx context.Context) (int, error) {
+	err := f.results[f.calls]
+	f.calls++
+	if err != nil {
+		return 0, err
+	}
+	return 3, nil
+}
+
+func (f *fakeGoldPriceService) StartUpdateRun(ctx context.Context, startedAt time.Time) (*model.GoldPriceUpdateRun, error) {
+	return &model.GoldPriceUpdateRun{ID: 1, StartedAt: startedAt, Status: model.GoldPriceUpdateRunRunning}, nil
+}
+
+func (f *fakeGoldPriceService) FinishUpdateRun(ctx context.Context, run *model.GoldPriceUpdateRun, finishedAt time.Time, runErr error) error {
+	f.finishedCtxErr = ctx.Err()
+	run.FinishedAt = &finishedAt
+	run.Status = model.GoldPriceUpdateRunSucceeded
+	if runErr != nil {
+		run.Status = model.GoldPriceUpdateRunFailed
+		run.Error = runErr.Error()
+	}
+	f.finished = run
+	return nil
+}
+
+func newTestScheduler(svc service.GoldPriceService, maxAttempts int) (*GoldPriceScheduler, *[]time.Duration) {
+	s := NewGoldPriceScheduler(svc, config.GoldPriceScheduleConfig{
+		Specs:        []string{"*/30 9-15 * * 1-5"},
+		MarketOpen:   "09:00",
+		MarketClose:  "15:30",
+		Holidays:     []string{"2026-10-09", "not-a-date"},
+		MaxAttempts:  maxAttempts,
+		RetryBackoff: time.Second,
+	})
+	var waits []time.Duration
+	s.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	return s, &waits
+}
+
+func TestGoldPriceScheduler_IsMarketOpen(t *testing.T) {
+	s, _ := newTestScheduler(&fakeGoldPriceService{}, 3)
+	kst := s.location
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"weekday open", time.Date(2026, 10, 8, 9, 0, 0, 0, kst), true},
+		{"weekday close", time.Date(2026, 10, 8, 15, 30, 0, 0, kst), true},
+		{"before open", time.Date(2026, 10, 8, 8, 59, 0, 0, kst), false},
+		{"after close", time.Date(2026, 10, 8, 15, 31, 0, 0, kst), false},
+		{"KRX holiday", time.Date(2026, 10, 9, 10, 0, 0, 0, kst), false},
+		{"saturday", time.Date(2026, 10, 10, 10, 0, 0, 0, kst), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, s.isMarketOpen(tt.at))
+		})
+	}
+	assert.Len(t, s.holidays, 1)
+}
+
+func TestGoldPriceScheduler_RetriesFetchFailuresWithBackoff(t *testing.T) {
+	fetchErr := fmt.Errorf("%w: timeout", service.ErrExternalAPIFailed)
+	svc := &fakeGoldPriceService{results: []error{fetchErr, fetchErr, nil}}
+	s, waits := newTestScheduler(svc, 3)
+
+	s.runUpdate(context.Background())
+
+	assert.Equal(t, 3, svc.calls)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *waits)
+	require.NotNil(t, svc.finished)
+	assert.Equal(t, model.GoldPriceUpdateRunSucceeded, svc.finished.Status)
+	assert.Equal(t, 3, svc.finished.Attempts)
+	assert.Equal(t, 3, svc.finished.RowsWritten)
+}
+
+func TestGoldPriceScheduler_RecordsFailure(t *testing.T) {
+	fetchErr := fmt.Errorf("%w: timeout", service.ErrExternalAPIFailed)
+	svc := &fakeGoldPriceService{results: []error{fetchErr, fetchErr}}
+	s, waits := newTestScheduler(svc, 2)
+
+	s.runUpdate(context.Background())
+
+	assert.Equal(t, 2, svc.calls)
+	assert.Len(t, *waits, 1)
+	require.NotNil(t, svc.finished)
+	assert.Equal(t, model.GoldPriceUpdateRunFailed, svc.finished.Status)
+	assert.Contains(t, svc.finished.Error, "timeout")
+}
+
+func TestGoldPriceScheduler_StopInterruptsRetryWait(t *testing.T) {
+	fetchErr := fmt.Errorf("%w: timeout", service.ErrExternalAPIFailed)
+	svc := &fakeGoldPriceService{results: []error{fetchErr, nil}}
+	s, _ := newTestScheduler(svc, 2)
+	s.sleep = sleepContext
+	s.retryBackoff = time.Hour
+
+	done := make(chan struct{})
+	go func() {
+		s.runUpdate(s.ctx)
+		close(done)
+	}()
+	require.NoError(t, s.Stop(context.Background()))
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("retry wait was not interrupted by Stop")
+	}
+	// 중지되어도 재시도하지 않고 실패를 기록
+	assert.Equal(t, 1, svc.calls)
+	require.NotNil(t, svc.finished)
+	assert.Equal(t, model.GoldPriceUpdateRunFailed, svc.finished.Status)
+	assert.NoError(t, svc.finishedCtxErr)
+}
+
+func TestGoldPriceScheduler_DoesNotRetrySaveErrors(t *testing.T) {
+	svc := &fakeGoldPriceService{results: []error{errors.New("db down")}}
+	s, waits := newTestScheduler(svc, 3)
+
+	s.runUpdate(context.Background())
+
+	assert.Equal(t, 1, svc.calls)
+	assert.Empty(t, *waits)
+	assert.Equal(t, model.GoldPriceUpdateRunFailed, svc.finished.Status)
+}
+
+func TestGoldPriceScheduler_Check(t *testing.T) {
+	fetchErr := fmt.Errorf("%w: timeout", service.ErrExternalAPIFailed)
+	svc := &fakeGoldPriceService{results: []error{fetchErr, nil}}
+	s, _ := newTestScheduler(svc, 1)
+	ctx := context.Background()
+
+	require.NoError(t, s.Start())
+	defer s.Stop(ctx)
+	assert.NoError(t, s.Check(ctx))
+
+	// 마지막 수집이 실패하면 보고하고, 다음 수집이 성공하면 회복
+	s.runUpdate(context.Background())
+	assert.ErrorIs(t, s.Check(ctx), service.ErrExternalAPIFailed)
+	s.runUpdate(context.Background())
+	assert.NoError(t, s.Check(ctx))
+
+	// 예정 시각이 한참 지났는데 실행되지 않은 작업이 있으면 실패
+	s.now = func() time.Time { return time.Now().Add(30 * 24 * time.Hour) }
+	assert.Error(t, s.Check(ctx))
+}
@@ -0,0 +1,36 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// cronOverdueGrace 예정 시각이 이만큼 지나도 실행되지 않았으면 cron 루프가 멈춘 것으로 판단
+const cronOverdueGrace = 2 * time.Minute
+
+var errCronNotResponding = errors.New("cron loop is not responding")
+
+// checkCron cron 실행 루프가 응답하고 예정 시각이 지난 작업이 없는지 확인
+// 실행 중인 cron의 Entries는 실행 루프를 거치므로 루프가 멈췄으면 ctx 만료까지 기다린 뒤 실패 처리
+func checkCron(ctx context.Context, c *cron.Cron, now time.Time) error {
+	entries := make(chan []cron.Entry, 1)
+	go func() {
+		entries <- c.Entries()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return errCronNotResponding
+	case list := <-entries:
+		for _, entry := range list {
+			if !entry.Next.IsZero() && now.Sub(entry.Next) > cronOverdueGrace {
+				return fmt.Errorf("job scheduled at %s has not run", entry.Next.Format(time.RFC3339))
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/config"
+	"github.com/ikkim/udonggeum-backend/internal/app/service"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
+	"github.com/robfig/cron/v3"
+)
+
+// orphanSweepDisabled 정리 스케줄을 끄는 설정 값
+const orphanSweepDisabled = "off"
+
+// UploadSweepScheduler 사용되지 않는 업로드 정리 스케줄러
+type UploadSweepScheduler struct {
+	cron          *cron.Cron
+	uploadService service.UploadService
+
+	spec        string
+	gracePeriod time.Duration
+	dryRun      bool
+
+	now func() time.Time
+}
+
+// NewUploadSweepScheduler 업로드 정리 스케줄러 생성
+func NewUploadSweepScheduler(uploadService service.UploadService, cfg config.StorageConfig) *UploadSweepScheduler {
+	kst, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		logger.Error("Failed to load KST timezone, falling back to UTC", err)
+		kst = time.UTC
+	}
+
+	return &UploadSweepScheduler{
+		// 정리가 길어져 다음 스케줄과 겹치면 건너뜀
+		cron:          cron.New(cron.WithLocation(kst), cron.WithChain(cron.SkipIfStillRunning(cron.DiscardLogger))),
+		uploadService: uploadService,
+		spec:          cfg.OrphanSweepSchedule,
+		gracePeriod:   cfg.OrphanGracePeriod,
+		dryRun:        cfg.OrphanSweepDryRun,
+		now:           time.Now,
+	}
+}
+
+// Start 스케줄러 시작 (스케줄이 off면 시작하지 않음)
+func (s *UploadSweepScheduler) Start() error {
+	if s.spec == "" || s.spec == orphanSweepDisabled {
+		logger.Info("Upload sweep scheduler disabled", nil)
+		return nil
+	}
+
+	if _, err := s.cron.AddFunc(s.spec, s.runSweep); err != nil {
+		logger.Error("Failed to add cron job for upload sweep", err, map[string]interface{}{
+			"spec": s.spec,
+		})
+		return err
+	}
+
+	s.cron.Start()
+	logger.Info("Upload sweep scheduler started successfully", map[string]interface{}{
+		"schedule":     s.spec,
+		"grace_period": s.gracePeriod.String(),
+		"dry_run":      s.dryRun,
+	})
+
+	return nil
+}
+
+// Stop 스케줄러 중지 (실행 중인 작업은 ctx 만료 전까지 완료를 기다림)
+func (s *UploadSweepScheduler) Stop(ctx context.Context) error {
+	logger.Info("Stopping upload sweep scheduler...", nil)
+	if err := stopCron(ctx, s.cron); err != nil {
+		return err
+	}
+	logger.Info("Upload sweep scheduler stopped", nil)
+	return nil
+}
+
+// runSweep 유예 기간이 지난 미사용 업로드 정리 (dry-run이면 대상만 보고)
+func (s *UploadSweepScheduler) runSweep() {
+	ctx := tracing.StartJob(context.Background(), "upload_sweep")
+	log := logger.FromContext(ctx)
+
+	report, err := s.uploadService.SweepOrphans(ctx, s.now().Add(-s.gracePeriod), s.dryRun)
+	if err != nil {
+		log.Error("Failed to sweep orphaned uploads", err)
+	}
+	if report == nil {
+		return
+	}
+
+	if report.DryRun {
+		keys := make([]string, 0, len(report.Orphans))
+		for _, orphan := range report.Orphans {
+			keys = append(keys, orphan.Key)
+		}
+		log.Info("Orphaned upload sweep report (dry run)", map[string]interface{}{
+			"scanned": report.Scanned,
+			"orphans": len(report.Orphans),
+			"keys":    keys,
+		})
+		return
+	}
+
+	log.Info("Swept orphaned uploads", map[string]interface{}{
+		"scanned": report.Scanned,
+		"orphans": len(report.Orphans),
+		"deleted": report.Deleted,
+		"failed":  report.Failed,
+	})
+}
+
+// Check cron 루프가 동작하는지 확인 (헬스 체크)
+func (s *UploadSweepScheduler) Check(ctx context.Context) error {
+	return checkCron(ctx, s.cron, s.now())
+}
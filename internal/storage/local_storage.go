@@ -0,0 +1,371 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// LocalUploadPath presigned POST 업로드를 받는 경로
+	LocalUploadPath = "/storage/upload"
+	// LocalFilesPath 업로드된 파일을 제공하는 경로 (뒤에 key)
+	LocalFilesPath = "/storage/files"
+
+	// localMaxFieldSize 파일 외 form 필드 하나의 최대 크기
+	localMaxFieldSize = 4 * 1024
+)
+
+// LocalStorage 로컬 파일시스템 저장소 (개발/테스트용)
+// S3와 같은 흐름을 유지하도록 서버가 직접 서명한 presigned POST를 발급하고 받음
+type LocalStorage struct {
+	root    string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewLocalStorage 로컬 저장소 생성
+// baseURL은 클라이언트가 접근하는 이 서버의 주소 (예: http://localhost:8080)
+func NewLocalStorage(root, baseURL, secret string) (*LocalStorage, error) {
+	if secret == "" {
+		return nil, errors.New("local storage signing secret is required")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create storage root: %w", err)
+	}
+
+	return &LocalStorage{
+		root:    root,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  []byte(secret),
+		now:     time.Now,
+	}, nil
+}
+
+// GeneratePresignedPost 서명된 업로드 form 필드 발급 (15분 유효, key/Content-Type/최대 크기 고정)
+func (s *LocalStorage) GeneratePresignedPost(filename, contentType, folder string, maxSize int64) (*PresignedPostResponse, error) {
+	key, err := newObjectKey(filename, folder)
+	if err != nil {
+		return nil, err
+	}
+
+	expires := strconv.FormatInt(s.now().Add(presignExpiry).Unix(), 10)
+	size := strconv.FormatInt(maxSize, 10)
+
+	return &PresignedPostResponse{
+		UploadURL: s.baseURL + LocalUploadPath,
+		Fields: map[string]string{
+			"key":          key,
+			"Content-Type": contentType,
+			"max_size":     size,
+			"expires":      expires,
+			"signature":    s.sign(key, contentType, size, expires),
+		},
+		FileURL: s.FileURL(key),
+		Key:     key,
+	}, nil
+}
+
+// Stat 파일 크기 조회
+// 로컬 저장소는 업로드 시 Content-Type을 보관하지 않으므로 ContentType은 비어 있음 (내용으로 판별)
+func (s *LocalStorage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, ErrObjectNotFound
+	}
+
+	return &ObjectInfo{
+		Key:  key,
+		Size: info.Size(),
+	}, nil
+}
+
+// ReadHead 파일 앞부분 읽기
+func (s *LocalStorage) ReadHead(ctx context.Context, key string, n int64) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(io.LimitReader(file, n))
+}
+
+// KeyFromURL 이 저장소의 파일 URL이면 key 반환
+func (s *LocalStorage) KeyFromURL(fileURL string) (string, bool) {
+	key, ok := strings.CutPrefix(fileURL, s.baseURL+LocalFilesPath+"/")
+	if !ok || validateKey(key) != nil {
+		return "", false
+	}
+	return key, true
+}
+
+// HandleUpload presigned POST 요청 처리
+// S3와 같이 서명한 필드가 파일보다 먼저 와야 하며, 크기를 넘는 파일은 저장하지 않음
+func (s *LocalStorage) HandleUpload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, "multipart form required", http.StatusBadRequest)
+		return
+	}
+
+	fields := make(map[string]string)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			http.Error(w, "file field is missing", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, "invalid multipart form", http.StatusBadRequest)
+			return
+		}
+
+		if part.FormName() == "file" {
+			s.storeUpload(w, fields, part)
+			return
+		}
+
+		value, err := io.ReadAll(io.LimitReader(part, localMaxFieldSize+1))
+		if err != nil || len(value) > localMaxFieldSize {
+			http.Error(w, "invalid form field", http.StatusBadRequest)
+			return
+		}
+		fields[part.FormName()] = string(value)
+	}
+}
+
+// storeUpload 서명, 만료를 확인한 뒤 파일을 최대 크기까지만 받아 저장
+func (s *LocalStorage) storeUpload(w http.ResponseWriter, fields map[string]string, file io.Reader) {
+	key := fields["key"]
+	expected := s.sign(key, fields["Content-Type"], fields["max_size"], fields["expires"])
+	if !hmac.Equal([]byte(expected), []byte(fields["signature"])) {
+		http.Error(w, "signature does not match", http.StatusForbidden)
+		return
+	}
+
+	expires, err := strconv.ParseInt(fields["expires"], 10, 64)
+	if err != nil || s.now().Unix() > expires {
+		http.Error(w, "upload policy expired", http.StatusForbidden)
+		return
+	}
+	maxSize, err := strconv.ParseInt(fields["max_size"], 10, 64)
+	if err != nil {
+		http.Error(w, "invalid upload policy", http.StatusBadRequest)
+		return
+	}
+
+	path, err := s.path(key)
+	if err != nil {
+		http.Error(w, "invalid key", http.StatusBadRequest)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		http.Error(w, "failed to store file", http.StatusInternalServerError)
+		return
+	}
+
+	// 같은 디렉터리의 임시 파일에 쓴 뒤 이름 변경 (업로드 중이거나 거부된 파일이 노출되지 않도록)
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		http.Error(w, "failed to store file", http.StatusInternalServerError)
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, io.LimitReader(file, maxSize+1))
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		http.Error(w, "failed to store file", http.StatusInternalServerError)
+		return
+	}
+	if written > maxSize {
+		http.Error(w, "file too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	if written == 0 {
+		http.Error(w, "file is empty", http.StatusBadRequest)
+		return
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		http.Error(w, "failed to store file", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ServeFile 업로드된 파일 제공
+// 비공개 파일은 PresignGet으로 서명된 유효한 URL일 때만 제공
+func (s *LocalStorage) ServeFile(w http.ResponseWriter, r *http.Request, key string) {
+	path, err := s.path(key)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	if IsPrivate(key) {
+		if !s.validGetSignature(key, r.URL.Query().Get("expires"), r.URL.Query().Get("signature")) {
+			http.Error(w, "signature does not match or has expired", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Cache-Control", "private, no-store")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+}
+
+// Open 파일 열기
+func (s *LocalStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return file, nil
+}
+
+// Put 파일 저장 (임시 파일에 쓴 뒤 이름 변경)
+func (s *LocalStorage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// FileURL key의 파일 URL
+func (s *LocalStorage) FileURL(key string) string {
+	return fmt.Sprintf("%s%s/%s", s.baseURL, LocalFilesPath, key)
+}
+
+// PresignGet 서명된 파일 URL 발급 (expiry 동안 유효)
+func (s *LocalStorage) PresignGet(key string, expiry time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	expires := strconv.FormatInt(s.now().Add(expiry).Unix(), 10)
+	query := url.Values{
+		"expires":   {expires},
+		"signature": {s.signGet(key, expires)},
+	}
+	return s.FileURL(key) + "?" + query.Encode(), nil
+}
+
+func (s *LocalStorage) validGetSignature(key, expires, signature string) bool {
+	if !hmac.Equal([]byte(s.signGet(key, expires)), []byte(signature)) {
+		return false
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	return err == nil && s.now().Unix() <= expiresAt
+}
+
+// Delete 파일 삭제
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path key를 저장소 내부 경로로 변환
+func (s *LocalStorage) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// signGet 다운로드 서명 (업로드 서명과 필드 수가 달라 서로 바꿔 쓸 수 없음)
+func (s *LocalStorage) signGet(key, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "GET\n%s\n%s", key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStorage) sign(key, contentType, maxSize, expires string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%s", key, contentType, maxSize, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func newTestLocalStorage(t *testing.T) (*LocalStorage, *httptest.Server) {
+	t.Helper()
+
+	var local *LocalStorage
+	mux := http.NewServeMux()
+	mux.HandleFunc(LocalUploadPath, func(w http.ResponseWriter, r *http.Request) {
+		local.HandleUpload(w, r)
+	})
+	mux.HandleFunc(LocalFilesPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		local.ServeFile(w, r, strings.TrimPrefix(r.URL.Path, LocalFilesPath+"/"))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	var err error
+	local, err = NewLocalStorage(t.TempDir(), server.URL, "test-secret")
+	require.NoError(t, err)
+	return local, server
+}
+
+// post presigned POST 필드와 파일을 multipart form으로 전송
+func post(t *testing.T, presigned *PresignedPostResponse, fields map[string]string, file []byte) int {
+	t.Helper()
+
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	for name, value := range presigned.Fields {
+		if override, ok := fields[name]; ok {
+			value = override
+		}
+		require.NoError(t, form.WriteField(name, value))
+	}
+	part, err := form.CreateFormFile("file", "upload")
+	require.NoError(t, err)
+	_, err = part.Write(file)
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	resp, err := http.Post(presigned.UploadURL, form.FormDataContentType(), &body)
+	require.NoError(t, err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestLocalStorage_PresignedUploadRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	local, _ := newTestLocalStorage(t)
+
+	presigned, err := local.GeneratePresignedPost("photo.png", "image/png", "community", 1024)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(presigned.Key, "community/"))
+	assert.Equal(t, presigned.Key, presigned.Fields["key"])
+
+	require.Equal(t, http.StatusNoContent, post(t, presigned, nil, pngHeader))
+
+	key, ok := local.KeyFromURL(presigned.FileURL)
+	require.True(t, ok)
+	assert.Equal(t, presigned.Key, key)
+
+	info, err := local.Stat(ctx, key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(pngHeader)), info.Size)
+
+	head, err := local.ReadHead(ctx, key, 8)
+	require.NoError(t, err)
+	assert.Equal(t, "image/png", SniffContentType(head))
+
+	resp, err := http.Get(presigned.FileURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	require.NoError(t, local.Delete(ctx, key))
+	_, err = local.Stat(ctx, key)
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+	assert.NoError(t, local.Delete(ctx, key))
+}
+
+func TestLocalStorage_EnforcesUploadPolicy(t *testing.T) {
+	ctx := context.Background()
+	local, _ := newTestLocalStorage(t)
+
+	presigned, err := local.GeneratePresignedPost("photo.png", "image/png", "chat", 16)
+	require.NoError(t, err)
+
+	// 최대 크기 초과
+	assert.Equal(t, http.StatusRequestEntityTooLarge, post(t, presigned, nil, make([]byte, 17)))
+
+	// 서명한 필드 변조 (Content-Type, 크기, key)
+	assert.Equal(t, http.StatusForbidden, post(t, presigned, map[string]string{"Content-Type": "text/html"}, pngHeader))
+	assert.Equal(t, http.StatusForbidden, post(t, presigned, map[string]string{"max_size": "2147483648"}, pngHeader))
+	assert.Equal(t, http.StatusForbidden, post(t, presigned, map[string]string{"key": "chat/other.png"}, pngHeader))
+
+	// 만료
+	local.now = func() time.Time { return time.Now().Add(time.Hour) }
+	assert.Equal(t, http.StatusForbidden, post(t, presigned, nil, pngHeader))
+
+	_, err = local.Stat(ctx, presigned.Key)
+	assert.ErrorIs(t, err, ErrObjectNotFound)
+}
+
+func TestLocalStorage_KeyFromURL(t *testing.T) {
+	local, server := newTestLocalStorage(t)
+
+	_, ok := local.KeyFromURL("https://example.com/storage/files/community/a.png")
+	assert.False(t, ok)
+	_, ok = local.KeyFromURL(server.URL + LocalFilesPath + "/../secret")
+	assert.False(t, ok)
+}
+
+func TestSniffContentType(t *testing.T) {
+	assert.Equal(t, "image/png", SniffContentType(pngHeader))
+	assert.Equal(t, "application/x-ole-storage", SniffContentType(append(append([]byte{}, oleSignature...), 0, 0)))
+	assert.Equal(t, "text/plain", SniffContentType([]byte("hello")))
+}
+
+func TestLocalStorage_PrivateFilesRequireSignedURL(t *testing.T) {
+	ctx := context.Background()
+	local, server := newTestLocalStorage(t)
+	require.NoError(t, local.Put(ctx, "private/licenses/a.png", "image/png", pngHeader))
+	require.NoError(t, local.Put(ctx, "community/a.png", "image/png", pngHeader))
+
+	get := func(url string) int {
+		t.Helper()
+		resp, err := http.Get(url)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get(local.FileURL("community/a.png")))
+	assert.Equal(t, http.StatusForbidden, get(local.FileURL("private/licenses/a.png")))
+
+	signed, err := local.PresignGet("private/licenses/a.png", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signed, server.URL+LocalFilesPath+"/private/licenses/a.png?"))
+	assert.Equal(t, http.StatusOK, get(signed))
+
+	// 다른 파일에 서명을 옮겨 쓸 수 없음
+	require.NoError(t, local.Put(ctx, "private/licenses/b.png", "image/png", pngHeader))
+	assert.Equal(t, http.StatusForbidden, get(strings.Replace(signed, "/a.png", "/b.png", 1)))
+
+	// 만료되면 거부
+	local.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	assert.Equal(t, http.StatusForbidden, get(signed))
+}
@@ -1,31 +1,31 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
-	"path/filepath"
+	"io"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/google/uuid"
 )
 
 type S3Storage struct {
-	client  *s3.Client
-	bucket  string
-	baseURL string
+	client   *s3.Client
+	bucket   string
+	baseURL  string
+	endpoint string
 }
 
-type PresignedURLResponse struct {
-	UploadURL string `json:"upload_url"`
-	FileURL   string `json:"file_url"`
-	Key       string `json:"key"`
-}
-
-func NewS3Storage(region, bucket, accessKeyID, secretAccessKey, baseURL string) *S3Storage {
+// NewS3Storage S3 저장소 생성
+// endpoint를 지정하면 해당 주소의 S3 호환 저장소(MinIO 등)를 path-style로 사용
+func NewS3Storage(region, bucket, accessKeyID, secretAccessKey, baseURL, endpoint string) *S3Storage {
 	var cfg aws.Config
 	var err error
 
@@ -52,72 +52,186 @@ func NewS3Storage(region, bucket, accessKeyID, secretAccessKey, baseURL string)
 		}
 	}
 
-	client := s3.NewFromConfig(cfg)
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		}
+	})
 
 	return &S3Storage{
-		client:  client,
-		bucket:  bucket,
-		baseURL: baseURL,
+		client:   client,
+		bucket:   bucket,
+		baseURL:  strings.TrimSuffix(baseURL, "/"),
+		endpoint: strings.TrimSuffix(endpoint, "/"),
 	}
 }
 
-// GeneratePresignedURL generates a pre-signed URL for uploading a file
-// Deprecated: Use GeneratePresignedURLWithFolder instead
-func (s *S3Storage) GeneratePresignedURL(filename, contentType string) (*PresignedURLResponse, error) {
-	return s.GeneratePresignedURLWithFolder(filename, contentType, "uploads")
-}
-
-// GeneratePresignedURLWithFolder generates a pre-signed URL for uploading a file to a specific folder
-func (s *S3Storage) GeneratePresignedURLWithFolder(filename, contentType, folder string) (*PresignedURLResponse, error) {
-	// Generate unique key
-	ext := filepath.Ext(filename)
-	key := fmt.Sprintf("%s/%s%s", folder, uuid.New().String(), ext)
+// GeneratePresignedPost generates a presigned POST policy for uploading a file to a specific folder
+// The policy pins the key and Content-Type and limits the size with content-length-range,
+// so S3 rejects uploads that don't match before storing them
+func (s *S3Storage) GeneratePresignedPost(filename, contentType, folder string, maxSize int64) (*PresignedPostResponse, error) {
+	key, err := newObjectKey(filename, folder)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create presign client
 	presignClient := s3.NewPresignClient(s.client)
 
-	// Generate presigned PUT URL (valid for 15 minutes)
-	presignedReq, err := presignClient.PresignPutObject(context.TODO(), &s3.PutObjectInput{
-		Bucket:      aws.String(s.bucket),
-		Key:         aws.String(key),
-		ContentType: aws.String(contentType),
-	}, s3.WithPresignExpires(15*time.Minute))
-
+	// Generate presigned POST policy (valid for 15 minutes)
+	presignedReq, err := presignClient.PresignPostObject(context.TODO(), &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	}, func(o *s3.PresignPostOptions) {
+		o.Expires = presignExpiry
+		o.Conditions = []interface{}{
+			[]interface{}{"content-length-range", 1, maxSize},
+			map[string]string{"Content-Type": contentType},
+		}
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate presigned URL: %w", err)
+		return nil, fmt.Errorf("failed to generate presigned POST: %w", err)
 	}
 
-	// Generate final file URL
-	var fileURL string
-	if s.baseURL != "" {
-		// Use CloudFront or custom domain
-		fileURL = fmt.Sprintf("%s/%s", s.baseURL, key)
-	} else {
-		// Use S3 direct URL
-		fileURL = fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", s.bucket, s.client.Options().Region, key)
-	}
+	fields := presignedReq.Values
+	fields["Content-Type"] = contentType
 
-	return &PresignedURLResponse{
+	return &PresignedPostResponse{
 		UploadURL: presignedReq.URL,
-		FileURL:   fileURL,
+		Fields:    fields,
+		FileURL:   s.FileURL(key),
 		Key:       key,
 	}, nil
 }
 
-// ValidateFileSize validates the file size
-func (s *S3Storage) ValidateFileSize(size int64, maxSize int64) error {
-	if size > maxSize {
-		return fmt.Errorf("file size exceeds maximum allowed size of %d bytes", maxSize)
+// Stat 객체 메타데이터 조회 (HeadObject)
+func (s *S3Storage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	output, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to head object: %w", err)
+	}
+
+	return &ObjectInfo{
+		Key:         key,
+		Size:        aws.ToInt64(output.ContentLength),
+		ContentType: aws.ToString(output.ContentType),
+	}, nil
+}
+
+// ReadHead 객체 앞부분 조회 (Range GET)
+func (s *S3Storage) ReadHead(ctx context.Context, key string, n int64) ([]byte, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+		Range:  aws.String(fmt.Sprintf("bytes=0-%d", n-1)),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	defer output.Body.Close()
+
+	return io.ReadAll(io.LimitReader(output.Body, n))
+}
+
+// Delete 객체 삭제
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete object: %w", err)
 	}
 	return nil
 }
 
-// ValidateContentType validates the content type
-func (s *S3Storage) ValidateContentType(contentType string, allowedTypes []string) error {
-	for _, allowed := range allowedTypes {
-		if contentType == allowed {
-			return nil
+// Open 객체 전체 조회
+func (s *S3Storage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrObjectNotFound
 		}
+		return nil, fmt.Errorf("failed to get object: %w", err)
+	}
+	return output.Body, nil
+}
+
+// Put 객체 업로드
+func (s *S3Storage) Put(ctx context.Context, key, contentType string, data []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.bucket),
+		Key:           aws.String(key),
+		Body:          bytes.NewReader(data),
+		ContentType:   aws.String(contentType),
+		ContentLength: aws.Int64(int64(len(data))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+	return nil
+}
+
+// FileURL key의 공개 URL
+func (s *S3Storage) FileURL(key string) string {
+	return fmt.Sprintf("%s/%s", s.fileURLPrefix(), key)
+}
+
+// PresignGet 서명된 GetObject URL 발급
+func (s *S3Storage) PresignGet(key string, expiry time.Duration) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+
+	presignClient := s3.NewPresignClient(s.client)
+	presignedReq, err := presignClient.PresignGetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	}, s3.WithPresignExpires(expiry))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate presigned GET: %w", err)
 	}
-	return fmt.Errorf("content type %s is not allowed", contentType)
+	return presignedReq.URL, nil
+}
+
+// KeyFromURL 이 버킷의 파일 URL이면 key 반환
+func (s *S3Storage) KeyFromURL(fileURL string) (string, bool) {
+	key, ok := strings.CutPrefix(fileURL, s.fileURLPrefix()+"/")
+	if !ok || validateKey(key) != nil {
+		return "", false
+	}
+	return key, true
+}
+
+// fileURLPrefix 업로드된 파일의 공개 URL 앞부분
+func (s *S3Storage) fileURLPrefix() string {
+	switch {
+	case s.baseURL != "":
+		// Use CloudFront or custom domain
+		return s.baseURL
+	case s.endpoint != "":
+		// S3 호환 저장소 (path-style)
+		return fmt.Sprintf("%s/%s", s.endpoint, s.bucket)
+	default:
+		// Use S3 direct URL
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", s.bucket, s.client.Options().Region)
+	}
+}
+
+func isS3NotFound(err error) bool {
+	var respErr *awshttp.ResponseError
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() == 404
 }
@@ -0,0 +1,134 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+)
+
+// ErrObjectNotFound 저장소에 객체가 없음
+var ErrObjectNotFound = errors.New("object not found")
+
+// Storage 파일 저장소 인터페이스 (S3/MinIO, 로컬 파일시스템)
+// 클라이언트는 presigned POST로 직접 업로드하고, 서버는 Stat/ReadHead로 업로드 결과를 확인
+// 저장소에 요청을 보내는 메서드만 ctx를 받음 (presign과 URL 변환은 로컬에서 서명만 함)
+type Storage interface {
+	// GeneratePresignedPost folder 아래 새 key로 업로드할 presigned POST 발급
+	// 저장소가 Content-Type과 크기(1 ~ maxSize 바이트)를 업로드 시점에 강제
+	GeneratePresignedPost(filename, contentType, folder string, maxSize int64) (*PresignedPostResponse, error)
+	// Stat 객체 크기와 저장된 Content-Type 조회 (없으면 ErrObjectNotFound, 보관하지 않는 저장소는 ContentType이 비어 있음)
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	// ReadHead 객체 앞부분을 최대 n바이트까지 읽음 (내용 형식 판별용)
+	ReadHead(ctx context.Context, key string, n int64) ([]byte, error)
+	// KeyFromURL 이 저장소가 발급한 파일 URL이면 key 반환
+	KeyFromURL(fileURL string) (string, bool)
+	// Delete 객체 삭제 (없으면 무시)
+	Delete(ctx context.Context, key string) error
+	// Open 객체 전체 읽기 (없으면 ErrObjectNotFound)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Put 서버에서 만든 객체 저장 (이미지 변환본 등, 같은 key가 있으면 덮어씀)
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	// FileURL key의 파일 URL (비공개 key는 이 URL로 접근할 수 없고 참조용으로만 저장)
+	FileURL(key string) string
+	// PresignGet 비공개 객체를 expiry 동안 내려받을 수 있는 presigned GET URL
+	PresignGet(key string, expiry time.Duration) (string, error)
+}
+
+// PrivateFolder 비공개 객체 폴더 (사업자등록증, 채팅 첨부 파일)
+// 이 폴더 아래 객체는 공개 URL로 제공하지 않고 presigned GET으로만 접근
+// S3는 버킷 정책에서 private/* 공개 읽기를 허용하지 않아야 함
+const PrivateFolder = "private"
+
+// IsPrivate key 또는 폴더 경로가 비공개 폴더 아래인지 확인
+func IsPrivate(key string) bool {
+	return key == PrivateFolder || strings.HasPrefix(key, PrivateFolder+"/")
+}
+
+// PresignedPostResponse presigned POST 업로드 정보
+// 클라이언트는 Fields를 모두 multipart/form-data 필드로 넣고 파일은 마지막 "file" 필드로 UploadURL에 POST
+type PresignedPostResponse struct {
+	UploadURL string            `json:"upload_url"`
+	Fields    map[string]string `json:"fields"`
+	FileURL   string            `json:"file_url"`
+	Key       string            `json:"key"`
+}
+
+// presignExpiry presigned 업로드 유효 시간
+const presignExpiry = 15 * time.Minute
+
+// newObjectKey folder 아래 원본 확장자를 유지한 새 key 생성
+func newObjectKey(filename, folder string) (string, error) {
+	key := fmt.Sprintf("%s/%s%s", folder, uuid.New().String(), filepath.Ext(filename))
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// ObjectInfo 저장된 객체 정보
+type ObjectInfo struct {
+	Key         string `json:"key"`
+	Size        int64  `json:"size"`
+	ContentType string `json:"content_type"`
+}
+
+// SniffLength 내용 형식 판별에 필요한 앞부분 길이 (http.DetectContentType 기준)
+const SniffLength = 512
+
+// oleSignature MS Office 97-2003 문서(doc, xls)의 OLE 복합 문서 시그니처
+var oleSignature = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+
+// SniffContentType 파일 앞부분으로 실제 내용 형식 판별 (파라미터 제외)
+func SniffContentType(head []byte) string {
+	if bytes.HasPrefix(head, oleSignature) {
+		return "application/x-ole-storage"
+	}
+	contentType := http.DetectContentType(head)
+	if i := strings.Index(contentType, ";"); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return contentType
+}
+
+// ValidateFileSize validates the file size
+func ValidateFileSize(size int64, maxSize int64) error {
+	if size > maxSize {
+		return fmt.Errorf("file size exceeds maximum allowed size of %d bytes", maxSize)
+	}
+	return nil
+}
+
+// ValidateContentType validates the content type
+func ValidateContentType(contentType string, allowedTypes []string) error {
+	for _, allowed := range allowedTypes {
+		if contentType == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("content type %s is not allowed", contentType)
+}
+
+// validateKey 저장소 밖을 가리키거나 제어 문자가 포함된 key 거부
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return fmt.Errorf("invalid key: %q", key)
+	}
+	if strings.IndexFunc(key, unicode.IsControl) >= 0 {
+		return fmt.Errorf("invalid key: %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("invalid key: %q", key)
+		}
+	}
+	return nil
+}
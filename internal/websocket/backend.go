@@ -0,0 +1,23 @@
+package websocket
+
+// Backend 여러 서버 인스턴스 간 메시지 전파 및 접속 상태 공유
+// Hub에 Backend가 없으면 단일 인스턴스 모드로 동작 (로컬 개발용)
+type Backend interface {
+	// PublishRoom 채팅방 메시지를 모든 인스턴스(자신 포함)로 전파
+	PublishRoom(message *BroadcastMessage) error
+	// PublishUser 사용자 알림을 모든 인스턴스(자신 포함)로 전파
+	PublishUser(userID uint, data []byte) error
+	// PublishMembership 채팅방 참여/나가기를 모든 인스턴스(자신 포함)로 전파
+	PublishMembership(userID, roomID uint, joined bool) error
+	// Run 다른 인스턴스에서 전파된 메시지를 hub에 전달하고 접속 상태를 주기적으로 갱신 (Close까지 블로킹)
+	Run(hub *Hub)
+
+	// MarkOnline 이 인스턴스에 사용자의 첫 세션이 연결됨
+	MarkOnline(userID uint) error
+	// MarkOffline 이 인스턴스에서 사용자의 마지막 세션이 끊김
+	MarkOffline(userID uint) error
+	// IsOnline 클러스터 전체 기준 사용자 접속 여부
+	IsOnline(userID uint) (bool, error)
+
+	Close() error
+}
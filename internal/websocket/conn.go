@@ -29,7 +29,7 @@ type Conn struct {
 // ReadPump 클라이언트로부터 메시지 읽기
 func (c *Client) ReadPump() {
 	defer func() {
-		c.Hub.unregister <- c
+		c.Hub.Unregister(c)
 		c.Conn.Close()
 	}()
 
@@ -66,6 +66,9 @@ func (c *Client) WritePump() {
 	defer func() {
 		ticker.Stop()
 		c.Conn.Close()
+		if c.writeDone != nil {
+			close(c.writeDone)
+		}
 	}()
 
 	for {
@@ -73,8 +76,12 @@ func (c *Client) WritePump() {
 		case message, ok := <-c.Send:
 			c.Conn.SetWriteDeadline(time.Now().Add(writeWait))
 			if !ok {
-				// Hub가 채널을 닫음
-				c.Conn.WriteMessage(websocket.CloseMessage, []byte{})
+				// Hub가 채널을 닫음 (서버 종료 중이면 재연결할 수 있도록 going away 코드 전송)
+				closeMessage := []byte{}
+				if c.Hub.isShuttingDown() {
+					closeMessage = websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+				}
+				c.Conn.WriteMessage(websocket.CloseMessage, closeMessage)
 				return
 			}
 
@@ -1,10 +1,14 @@
 package websocket
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
+	"github.com/ikkim/udonggeum-backend/internal/metrics"
 	"github.com/ikkim/udonggeum-backend/pkg/logger"
 )
 
@@ -12,12 +16,41 @@ const (
 	// Rate limiting: 최대 메시지 수 (1초당)
 	// typing_start/stop 이벤트도 포함되므로 여유있게 설정
 	maxMessagesPerSecond = 30 // 10 → 30 (빠른 타이핑 및 연속 메시지 대응)
+
+	// resume 요청 한 번에 처리하는 최대 채팅방 수
+	maxResumeRooms = 50
+	// resume 시 채팅방당 재전송하는 최대 메시지 수 (초과분은 has_more로 알리고 클라이언트가 이어서 요청)
+	resumeReplayLimit = 200
+
+	// 클라이언트별 전송 대기열 크기 (256 → 2048, 네트워크 느린 클라이언트 대응)
+	clientSendBufferSize = 2048
 )
 
 // ClientMessage 클라이언트로부터 받은 메시지
 type ClientMessage struct {
-	Type       string `json:"type"`        // typing_start, typing_stop
+	Type       string         `json:"type"` // typing_start, typing_stop, resume, delivered
+	ChatRoomID uint           `json:"chat_room_id"`
+	Seq        uint64         `json:"seq,omitempty"`   // delivered: 이 순번까지 수신함
+	Rooms      []ResumeCursor `json:"rooms,omitempty"` // resume: 채팅방별 마지막으로 받은 메시지 순번
+}
+
+// ResumeCursor 클라이언트가 채팅방에서 마지막으로 받은 메시지 순번
+type ResumeCursor struct {
 	ChatRoomID uint   `json:"chat_room_id"`
+	LastSeq    uint64 `json:"last_seq"`
+}
+
+// DeliveryReceiver 클라이언트의 메시지 수신 확인 처리 (채팅 서비스가 구현)
+type DeliveryReceiver interface {
+	// MarkMessagesDelivered upToSeq 이하 메시지를 전달 처리하고 발신자에게 알림
+	MarkMessagesDelivered(ctx context.Context, roomID, userID uint, upToSeq uint64) error
+}
+
+// MessageReplayer 재연결한 클라이언트가 놓친 메시지 조회 (채팅 서비스가 구현)
+type MessageReplayer interface {
+	// ReplayMessages afterSeq 이후 메시지를 순번 순으로 최대 limit개의 전송용 이벤트로 반환
+	// 채팅방 접근 권한이 없으면 에러
+	ReplayMessages(ctx context.Context, userID, roomID uint, afterSeq uint64, limit int) ([]interface{}, error)
 }
 
 // Client WebSocket 클라이언트
@@ -31,6 +64,26 @@ type Client struct {
 	MessageCount  int       // 최근 1초간 받은 메시지 수
 	LastResetTime time.Time // 마지막 카운터 리셋 시간
 	RateMu        sync.Mutex
+
+	// WritePump 종료 알림 (Hub 종료 시 close frame 전송 대기용)
+	writeDone chan struct{}
+
+	// 연결 요청의 컨텍스트 (요청 ID 등 추적 정보 유지, 연결이 끊길 때까지 취소되지 않음)
+	ctx context.Context
+}
+
+// NewClient WebSocket 클라이언트 생성
+func NewClient(ctx context.Context, hub *Hub, conn *Conn, userID uint) *Client {
+	return &Client{
+		ctx:           context.WithoutCancel(ctx),
+		Hub:           hub,
+		Conn:          conn,
+		UserID:        userID,
+		Send:          make(chan []byte, clientSendBufferSize),
+		ChatRooms:     make(map[uint]bool),
+		LastResetTime: time.Now(),
+		writeDone:     make(chan struct{}),
+	}
 }
 
 // Hub WebSocket 연결 관리자
@@ -50,6 +103,29 @@ type Hub struct {
 	// 메시지 브로드캐스트
 	broadcast chan *BroadcastMessage
 
+	// 실행 루프 응답 확인 (헬스 체크)
+	ping chan chan struct{}
+
+	// 종료 요청 (Shutdown에서 닫음)과 실행 루프 종료 알림
+	quit         chan struct{}
+	stopped      chan struct{}
+	shutdownOnce sync.Once
+
+	// 종료 시 등록 대기열을 비우는 동안 새 등록을 막음 (비운 뒤 들어온 클라이언트가 닫히지 않고 남지 않도록)
+	registerMu sync.Mutex
+
+	// 종료 시 Send를 닫은 클라이언트들의 WritePump 종료 알림 (Shutdown에서 대기)
+	closing []chan struct{}
+
+	// 클러스터 백엔드 (nil이면 단일 인스턴스 모드)
+	backend Backend
+
+	// resume 요청 처리 (nil이면 resume 미지원)
+	replayer MessageReplayer
+
+	// 수신 확인 처리 (nil이면 무시)
+	deliveryReceiver DeliveryReceiver
+
 	mu sync.RWMutex
 }
 
@@ -60,7 +136,7 @@ type BroadcastMessage struct {
 	SenderID   uint // 발신자는 제외
 }
 
-// NewHub Hub 생성
+// NewHub 단일 인스턴스 Hub 생성
 func NewHub() *Hub {
 	return &Hub{
 		clients:    make(map[uint][]*Client),
@@ -68,24 +144,66 @@ func NewHub() *Hub {
 		register:   make(chan *Client, 1024),            // 256 → 1024 (동시 연결 폭증 대응)
 		unregister: make(chan *Client, 1024),            // 256 → 1024 (동시 연결 해제 대응)
 		broadcast:  make(chan *BroadcastMessage, 4096),  // 1024 → 4096 (트래픽 폭증 대응)
+		ping:       make(chan chan struct{}),
+		quit:       make(chan struct{}),
+		stopped:    make(chan struct{}),
 	}
 }
 
-// Run Hub 실행
+// NewClusterHub 여러 인스턴스가 backend를 통해 메시지와 접속 상태를 공유하는 Hub 생성
+func NewClusterHub(backend Backend) *Hub {
+	h := NewHub()
+	h.backend = backend
+	return h
+}
+
+// SetMessageReplayer resume 요청 처리기 설정 (서버 시작 전에 한 번만 호출)
+func (h *Hub) SetMessageReplayer(replayer MessageReplayer) {
+	h.replayer = replayer
+}
+
+// SetDeliveryReceiver 수신 확인 처리기 설정 (서버 시작 전에 한 번만 호출)
+func (h *Hub) SetDeliveryReceiver(receiver DeliveryReceiver) {
+	h.deliveryReceiver = receiver
+}
+
+// Run Hub 실행 (Shutdown까지 블로킹)
 func (h *Hub) Run() {
+	defer close(h.stopped)
+
+	if h.backend != nil {
+		go h.backend.Run(h)
+	}
+
 	for {
 		select {
+		case <-h.quit:
+			h.closeClients()
+			return
+
 		case client := <-h.register:
 			h.mu.Lock()
 			// 멀티 디바이스 지원: 클라이언트 리스트에 추가
 			h.clients[client.UserID] = append(h.clients[client.UserID], client)
+			sessions := len(h.clients[client.UserID])
 			h.mu.Unlock()
 			logger.Info("WebSocket client registered", map[string]interface{}{
 				"user_id":        client.UserID,
-				"total_sessions": len(h.clients[client.UserID]),
+				"total_sessions": sessions,
 			})
 
+			// 이 인스턴스의 첫 세션이면 클러스터 presence 등록
+			if sessions == 1 && h.backend != nil {
+				if err := h.backend.MarkOnline(client.UserID); err != nil {
+					logger.Warn("Failed to mark user online", map[string]interface{}{
+						"user_id": client.UserID,
+						"error":   err.Error(),
+					})
+				}
+			}
+
 		case client := <-h.unregister:
+			wentOffline := false
 			h.mu.Lock()
 			if clientList, ok := h.clients[client.UserID]; ok {
 				// 해당 클라이언트만 리스트에서 제거
@@ -99,6 +217,7 @@ func (h *Hub) Run() {
 				if len(newList) == 0 {
 					// 마지막 세션이면 맵에서 삭제
 					delete(h.clients, client.UserID)
+					wentOffline = true
 
 					// 모든 채팅방에서 제거
 					client.mu.RLock()
@@ -123,48 +242,96 @@ func (h *Hub) Run() {
 				"remaining_sessions": len(h.clients[client.UserID]),
 			})
 
+			if wentOffline && h.backend != nil {
+				if err := h.backend.MarkOffline(client.UserID); err != nil {
+					logger.Warn("Failed to mark user offline", map[string]interface{}{
+						"user_id": client.UserID,
+						"error":   err.Error(),
+					})
+				}
+			}
+
 		case message := <-h.broadcast:
-			h.mu.RLock()
-			if users, ok := h.rooms[message.ChatRoomID]; ok {
-				for userID := range users {
-					// 발신자는 제외
-					if userID == message.SenderID {
-						continue
-					}
+			h.deliverToRoom(message)
 
-					// 멀티 디바이스: 모든 세션에 전송
-					if clientList, ok := h.clients[userID]; ok {
-						for _, client := range clientList {
-							select {
-							case client.Send <- message.Message:
-								// 전송 성공
-							case <-time.After(100 * time.Millisecond):
-								// 100ms 대기 후에도 전송 불가 - 메시지 드롭 (연결은 유지)
-								// 네트워크 일시적 지연은 허용하되, 지속적 문제는 클라이언트 측 재연결로 해결
-								logger.Warn("Client send buffer full, message dropped", map[string]interface{}{
-									"user_id":     userID,
-									"buffer_size": len(client.Send),
-								})
-								// 버퍼가 거의 가득 찬 경우에만 연결 끊기 (>90%)
-								if len(client.Send) > 1843 { // 2048 * 0.9
-									go h.Unregister(client)
-									logger.Warn("Client consistently slow, disconnecting", map[string]interface{}{
-										"user_id":     userID,
-										"buffer_size": len(client.Send),
-									})
-								}
-							}
+		case reply := <-h.ping:
+			close(reply)
+		}
+	}
+}
+
+// deliverToRoom 이 인스턴스에 연결된 채팅방 참여자에게 메시지 전달
+func (h *Hub) deliverToRoom(message *BroadcastMessage) {
+	h.mu.RLock()
+	if users, ok := h.rooms[message.ChatRoomID]; ok {
+		for userID := range users {
+			// 발신자는 제외
+			if userID == message.SenderID {
+				continue
+			}
+
+			// 멀티 디바이스: 모든 세션에 전송
+			if clientList, ok := h.clients[userID]; ok {
+				for _, client := range clientList {
+					select {
+					case client.Send <- message.Message:
+						// 전송 성공
+					case <-time.After(100 * time.Millisecond):
+						// 100ms 대기 후에도 전송 불가 - 메시지 드롭 (연결은 유지)
+						// 네트워크 일시적 지연은 허용하되, 지속적 문제는 클라이언트 측 재연결로 해결
+						metrics.WebSocketDroppedMessages.WithLabelValues("room").Inc()
+						logger.Warn("Client send buffer full, message dropped", map[string]interface{}{
+							"user_id":     userID,
+							"buffer_size": len(client.Send),
+						})
+						// 버퍼가 거의 가득 찬 경우에만 연결 끊기 (>90%)
+						if len(client.Send) > 1843 { // 2048 * 0.9
+							go h.Unregister(client)
+							logger.Warn("Client consistently slow, disconnecting", map[string]interface{}{
+								"user_id":     userID,
+								"buffer_size": len(client.Send),
+							})
 						}
 					}
 				}
 			}
-			h.mu.RUnlock()
 		}
 	}
+	h.mu.RUnlock()
 }
 
 // JoinRoom 채팅방 참여
+// 클러스터 모드에서는 사용자의 WebSocket이 연결된 인스턴스에서 반영되도록 전파
 func (h *Hub) JoinRoom(userID, roomID uint) {
+	h.changeMembership(userID, roomID, true)
+}
+
+// LeaveRoom 채팅방 나가기
+func (h *Hub) LeaveRoom(userID, roomID uint) {
+	h.changeMembership(userID, roomID, false)
+}
+
+func (h *Hub) changeMembership(userID, roomID uint, joined bool) {
+	if h.backend != nil {
+		err := h.backend.PublishMembership(userID, roomID, joined)
+		if err == nil {
+			return
+		}
+		logger.Error("Failed to publish room membership, applying locally", err, map[string]interface{}{
+			"user_id": userID,
+			"room_id": roomID,
+		})
+	}
+
+	if joined {
+		h.joinRoomLocal(userID, roomID)
+	} else {
+		h.leaveRoomLocal(userID, roomID)
+	}
+}
+
+// joinRoomLocal 이 인스턴스의 세션을 채팅방에 추가
+func (h *Hub) joinRoomLocal(userID, roomID uint) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
@@ -188,8 +355,8 @@ func (h *Hub) JoinRoom(userID, roomID uint) {
 	}
 }
 
-// LeaveRoom 채팅방 나가기
-func (h *Hub) LeaveRoom(userID, roomID uint) {
+// leaveRoomLocal 이 인스턴스의 세션을 채팅방에서 제거
+func (h *Hub) leaveRoomLocal(userID, roomID uint) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
@@ -223,40 +390,224 @@ func (h *Hub) SendToRoom(roomID uint, message interface{}, senderID uint) error
 		return err
 	}
 
-	select {
-	case h.broadcast <- &BroadcastMessage{
+	broadcast := &BroadcastMessage{
 		ChatRoomID: roomID,
 		Message:    data,
 		SenderID:   senderID,
-	}:
-		return nil
-	default:
-		logger.Warn("Broadcast channel full, message dropped", map[string]interface{}{
+	}
+
+	// 클러스터 모드: 모든 인스턴스(자신 포함)가 구독으로 받아 각자 로컬 참여자에게 전달
+	if h.backend != nil {
+		err := h.backend.PublishRoom(broadcast)
+		if err == nil {
+			return nil
+		}
+		// Redis 장애 시 최소한 이 인스턴스의 참여자에게는 전달
+		logger.Error("Failed to publish room message, delivering locally", err, map[string]interface{}{
 			"room_id": roomID,
 		})
-		return nil // 메시지 손실을 허용 (주요 로직에 영향 없음)
 	}
+
+	h.enqueueBroadcast(broadcast)
+	return nil
 }
 
-// Register 클라이언트 등록
+// enqueueBroadcast 로컬 브로드캐스트 큐에 추가
+func (h *Hub) enqueueBroadcast(message *BroadcastMessage) {
+	select {
+	case h.broadcast <- message:
+	default:
+		metrics.WebSocketDroppedMessages.WithLabelValues("broadcast_queue").Inc()
+		logger.Warn("Broadcast channel full, message dropped", map[string]interface{}{
+			"room_id": message.ChatRoomID,
+		}) // 메시지 손실을 허용 (주요 로직에 영향 없음)
+	}
+}
+
+// Register 클라이언트 등록 (종료 중이면 바로 close frame을 보내고 연결 종료)
 func (h *Hub) Register(client *Client) {
-	h.register <- client
+	h.registerMu.Lock()
+	defer h.registerMu.Unlock()
+
+	if h.isShuttingDown() {
+		close(client.Send)
+		return
+	}
+	select {
+	case h.register <- client:
+	case <-h.quit:
+		close(client.Send)
+	}
 }
 
-// Unregister 클라이언트 등록 해제
+// Unregister 클라이언트 등록 해제 (Hub가 종료된 뒤에는 무시)
 func (h *Hub) Unregister(client *Client) {
-	h.unregister <- client
+	select {
+	case h.unregister <- client:
+	case <-h.stopped:
+	}
+}
+
+// Shutdown 실행 루프를 멈추고 모든 클라이언트에 close frame을 보냄
+// 각 클라이언트의 close frame 전송이 끝나거나 ctx가 만료될 때까지 대기
+func (h *Hub) Shutdown(ctx context.Context) error {
+	h.shutdownOnce.Do(func() {
+		close(h.quit)
+	})
+
+	select {
+	case <-h.stopped:
+	case <-ctx.Done():
+		return fmt.Errorf("hub did not stop: %w", ctx.Err())
+	}
+
+	// 실행 루프가 멈췄으므로 closing은 더 이상 바뀌지 않음
+	h.mu.RLock()
+	pending := h.closing
+	h.mu.RUnlock()
+
+	for _, writeDone := range pending {
+		select {
+		case <-writeDone:
+		case <-ctx.Done():
+			return fmt.Errorf("websocket clients did not close: %w", ctx.Err())
+		}
+	}
+	return nil
 }
 
-// IsUserOnline 사용자 온라인 여부 확인
+// closeClients 모든 클라이언트의 Send를 닫아 WritePump가 close frame을 보내게 하고 클러스터 presence 정리
+// 전송 경로(deliverToUser, sendEventToClient 등)는 clients에서 찾은 클라이언트에만 보내므로
+// Send를 닫는 잠금 안에서 clients와 rooms도 비워 종료 후 전송이 닫힌 채널에 닿지 않게 함
+func (h *Hub) closeClients() {
+	h.mu.Lock()
+	userIDs := make([]uint, 0, len(h.clients))
+	sessions := 0
+	for userID, clientList := range h.clients {
+		userIDs = append(userIDs, userID)
+		for _, client := range clientList {
+			if client.writeDone != nil {
+				h.closing = append(h.closing, client.writeDone)
+			}
+			close(client.Send)
+			sessions++
+		}
+	}
+	h.clients = make(map[uint][]*Client)
+	h.rooms = make(map[uint]map[uint]bool)
+	h.mu.Unlock()
+
+	// 등록 대기 중이던 클라이언트도 종료 (이후 Register는 quit을 보고 바로 닫음)
+	h.registerMu.Lock()
+	for pending := true; pending; {
+		select {
+		case client := <-h.register:
+			close(client.Send)
+		default:
+			pending = false
+		}
+	}
+	h.registerMu.Unlock()
+
+	logger.Info("WebSocket hub shutting down", map[string]interface{}{
+		"users":    len(userIDs),
+		"sessions": sessions,
+	})
+
+	if h.backend == nil {
+		return
+	}
+	for _, userID := range userIDs {
+		if err := h.backend.MarkOffline(userID); err != nil {
+			logger.Warn("Failed to mark user offline", map[string]interface{}{
+				"user_id": userID,
+				"error":   err.Error(),
+			})
+		}
+	}
+	if err := h.backend.Close(); err != nil {
+		logger.Error("Failed to close cluster backend", err)
+	}
+}
+
+// isShuttingDown Shutdown이 호출되었는지 여부
+func (h *Hub) isShuttingDown() bool {
+	select {
+	case <-h.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsUserOnline 사용자 온라인 여부 확인 (클러스터 모드에서는 다른 인스턴스 접속 포함)
 func (h *Hub) IsUserOnline(userID uint) bool {
 	h.mu.RLock()
-	defer h.mu.RUnlock()
 	_, ok := h.clients[userID]
-	return ok
+	h.mu.RUnlock()
+	if ok || h.backend == nil {
+		return ok
+	}
+
+	online, err := h.backend.IsOnline(userID)
+	if err != nil {
+		logger.Warn("Failed to check cluster presence", map[string]interface{}{
+			"user_id": userID,
+			"error":   err.Error(),
+		})
+		return false
+	}
+	return online
+}
+
+// localUserIDs 이 인스턴스에 연결된 사용자 목록
+func (h *Hub) localUserIDs() []uint {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	userIDs := make([]uint, 0, len(h.clients))
+	for userID := range h.clients {
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs
+}
+
+// Stats 이 인스턴스의 연결/채팅방/브로드캐스트 대기열 현황 (metrics.RegisterHub에 전달)
+func (h *Hub) Stats() metrics.HubStats {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	stats := metrics.HubStats{
+		Users:          len(h.clients),
+		Rooms:          len(h.rooms),
+		BroadcastQueue: len(h.broadcast),
+	}
+	for _, clientList := range h.clients {
+		stats.Sessions += len(clientList)
+	}
+	return stats
 }
 
-// GetOnlineUsersInRoom 채팅방의 온라인 사용자 목록
+// Ping 실행 루프가 요청을 처리하고 있는지 확인 (Run이 멈췄으면 ctx 만료 시 에러)
+func (h *Hub) Ping(ctx context.Context) error {
+	reply := make(chan struct{})
+	select {
+	case h.ping <- reply:
+	case <-h.stopped:
+		return errors.New("hub is shut down")
+	case <-ctx.Done():
+		return fmt.Errorf("hub is not running: %w", ctx.Err())
+	}
+
+	select {
+	case <-reply:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("hub is not responding: %w", ctx.Err())
+	}
+}
+
+// GetOnlineUsersInRoom 채팅방의 온라인 사용자 목록 (이 인스턴스에 연결된 사용자 기준)
 func (h *Hub) GetOnlineUsersInRoom(roomID uint) []uint {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
@@ -301,6 +652,27 @@ func (h *Hub) HandleClientMessage(client *Client, message []byte) {
 		return
 	}
 
+	// 재연결 후 누락 메시지 재전송 요청
+	if msg.Type == "resume" {
+		h.handleResume(client, msg.Rooms)
+		return
+	}
+
+	// 메시지 수신 확인
+	if msg.Type == "delivered" {
+		if h.deliveryReceiver == nil || msg.Seq == 0 {
+			return
+		}
+		if err := h.deliveryReceiver.MarkMessagesDelivered(client.ctx, msg.ChatRoomID, client.UserID, msg.Seq); err != nil {
+			logger.Warn("Failed to mark messages delivered", map[string]interface{}{
+				"user_id": client.UserID,
+				"room_id": msg.ChatRoomID,
+				"error":   err.Error(),
+			})
+		}
+		return
+	}
+
 	// typing 이벤트 처리
 	if msg.Type == "typing_start" || msg.Type == "typing_stop" {
 		// 클라이언트가 해당 채팅방에 참여 중인지 확인
@@ -332,6 +704,87 @@ func (h *Hub) HandleClientMessage(client *Client, message []byte) {
 	}
 }
 
+// handleResume 채팅방별 마지막 순번 이후 메시지를 해당 클라이언트에게만 재전송
+// 버퍼 초과로 메시지가 드롭되었거나 잠시 연결이 끊긴 클라이언트는 순번 공백을 발견하면 resume을 요청
+// 재전송 중 실시간 메시지가 섞여 도착할 수 있으므로 클라이언트는 순번으로 중복 제거
+func (h *Hub) handleResume(client *Client, cursors []ResumeCursor) {
+	if h.replayer == nil {
+		return
+	}
+
+	if len(cursors) > maxResumeRooms {
+		cursors = cursors[:maxResumeRooms]
+	}
+
+	for _, cursor := range cursors {
+		events, err := h.replayer.ReplayMessages(client.ctx, client.UserID, cursor.ChatRoomID, cursor.LastSeq, resumeReplayLimit)
+		if err != nil {
+			logger.Warn("Failed to replay messages", map[string]interface{}{
+				"user_id": client.UserID,
+				"room_id": cursor.ChatRoomID,
+				"error":   err.Error(),
+			})
+			h.sendEventToClient(client, map[string]interface{}{
+				"type":         "resume_failed",
+				"chat_room_id": cursor.ChatRoomID,
+			})
+			continue
+		}
+
+		for _, event := range events {
+			if !h.sendEventToClient(client, event) {
+				// 버퍼가 가득 참 - 중단하고 클라이언트의 다음 resume에 맡김
+				return
+			}
+		}
+
+		if !h.sendEventToClient(client, map[string]interface{}{
+			"type":         "resume_complete",
+			"chat_room_id": cursor.ChatRoomID,
+			"replayed":     len(events),
+			"has_more":     len(events) == resumeReplayLimit,
+		}) {
+			return
+		}
+	}
+}
+
+// sendEventToClient 특정 클라이언트(세션) 하나에만 전송
+func (h *Hub) sendEventToClient(client *Client, event interface{}) bool {
+	data, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("Failed to marshal event", err, nil)
+		return false
+	}
+
+	// 등록 해제(Send 채널 close)와 겹치지 않도록 읽기 잠금 상태에서 전송
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	registered := false
+	for _, c := range h.clients[client.UserID] {
+		if c == client {
+			registered = true
+			break
+		}
+	}
+	if !registered {
+		return false
+	}
+
+	select {
+	case client.Send <- data:
+		return true
+	case <-time.After(100 * time.Millisecond):
+		metrics.WebSocketDroppedMessages.WithLabelValues("resume").Inc()
+		logger.Warn("Client send buffer full during resume, stopped", map[string]interface{}{
+			"user_id":     client.UserID,
+			"buffer_size": len(client.Send),
+		})
+		return false
+	}
+}
+
 // SendNotificationToUser 특정 사용자에게 알림 전송 (모든 디바이스)
 func (h *Hub) SendNotificationToUser(userID uint, notification interface{}) error {
 	data, err := json.Marshal(notification)
@@ -340,6 +793,23 @@ func (h *Hub) SendNotificationToUser(userID uint, notification interface{}) erro
 		return err
 	}
 
+	// 클러스터 모드: 사용자가 연결된 인스턴스에서 전달
+	if h.backend != nil {
+		err := h.backend.PublishUser(userID, data)
+		if err == nil {
+			return nil
+		}
+		logger.Error("Failed to publish notification, delivering locally", err, map[string]interface{}{
+			"user_id": userID,
+		})
+	}
+
+	h.deliverToUser(userID, data)
+	return nil
+}
+
+// deliverToUser 이 인스턴스에 연결된 사용자의 모든 세션에 전달
+func (h *Hub) deliverToUser(userID uint, data []byte) {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
@@ -353,6 +823,7 @@ func (h *Hub) SendNotificationToUser(userID uint, notification interface{}) erro
 				sentCount++
 			case <-time.After(100 * time.Millisecond):
 				// 100ms 대기 후에도 전송 불가 - 알림 드롭
+				metrics.WebSocketDroppedMessages.WithLabelValues("notification").Inc()
 				logger.Warn("Client send buffer full for notification, dropped", map[string]interface{}{
 					"user_id":     userID,
 					"buffer_size": len(client.Send),
@@ -373,11 +844,10 @@ func (h *Hub) SendNotificationToUser(userID uint, notification interface{}) erro
 				"sent_devices":  sentCount,
 			})
 		}
-	} else {
+	} else if h.backend == nil {
+		// 클러스터 모드에서는 다른 인스턴스에 연결되어 있을 수 있으므로 로그 생략
 		logger.Info("User not connected, notification will be shown on next login", map[string]interface{}{
 			"user_id": userID,
 		})
 	}
-
-	return nil
 }
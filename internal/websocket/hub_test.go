@@ -0,0 +1,185 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeReplayer struct {
+	seqs map[uint][]uint64 // roomID -> 저장된 메시지 순번
+}
+
+func (f *fakeReplayer) ReplayMessages(ctx context.Context, userID, roomID uint, afterSeq uint64, limit int) ([]interface{}, error) {
+	seqs, ok := f.seqs[roomID]
+	if !ok {
+		return nil, errors.New("unauthorized access to chat room")
+	}
+
+	var events []interface{}
+	for _, seq := range seqs {
+		if seq > afterSeq && len(events) < limit {
+			events = append(events, map[string]interface{}{"type": "new_message", "seq": seq})
+		}
+	}
+	return events, nil
+}
+
+func TestHub_ResumeReplaysGap(t *testing.T) {
+	hub := NewHub()
+	hub.SetMessageReplayer(&fakeReplayer{seqs: map[uint][]uint64{1: {1, 2, 3, 4}}})
+
+	client := &Client{ctx: context.Background(), Hub: hub, UserID: 7, Send: make(chan []byte, 16), ChatRooms: make(map[uint]bool)}
+	hub.clients[client.UserID] = []*Client{client}
+
+	resume, err := json.Marshal(ClientMessage{
+		Type:  "resume",
+		Rooms: []ResumeCursor{{ChatRoomID: 1, LastSeq: 2}, {ChatRoomID: 9, LastSeq: 0}},
+	})
+	require.NoError(t, err)
+	hub.HandleClientMessage(client, resume)
+
+	var received []map[string]interface{}
+	for len(client.Send) > 0 {
+		var event map[string]interface{}
+		require.NoError(t, json.Unmarshal(<-client.Send, &event))
+		received = append(received, event)
+	}
+
+	require.Len(t, received, 4)
+	assert.Equal(t, float64(3), received[0]["seq"])
+	assert.Equal(t, float64(4), received[1]["seq"])
+	assert.Equal(t, "resume_complete", received[2]["type"])
+	assert.Equal(t, false, received[2]["has_more"])
+	// 접근할 수 없는 채팅방은 실패만 알림
+	assert.Equal(t, "resume_failed", received[3]["type"])
+	assert.Equal(t, float64(9), received[3]["chat_room_id"])
+}
+
+type fakeDeliveryReceiver struct {
+	roomID, userID uint
+	upToSeq        uint64
+}
+
+func (f *fakeDeliveryReceiver) MarkMessagesDelivered(ctx context.Context, roomID, userID uint, upToSeq uint64) error {
+	f.roomID, f.userID, f.upToSeq = roomID, userID, upToSeq
+	return nil
+}
+
+func TestHub_DeliveredAck(t *testing.T) {
+	hub := NewHub()
+	receiver := &fakeDeliveryReceiver{}
+	hub.SetDeliveryReceiver(receiver)
+
+	client := &Client{ctx: context.Background(), Hub: hub, UserID: 7, Send: make(chan []byte, 1), ChatRooms: make(map[uint]bool)}
+	hub.HandleClientMessage(client, []byte(`{"type":"delivered","chat_room_id":3,"seq":12}`))
+
+	assert.Equal(t, uint(3), receiver.roomID)
+	assert.Equal(t, uint(7), receiver.userID)
+	assert.Equal(t, uint64(12), receiver.upToSeq)
+}
+
+func TestHub_Stats(t *testing.T) {
+	hub := NewHub()
+	phone := &Client{Hub: hub, UserID: 1, Send: make(chan []byte, 1), ChatRooms: make(map[uint]bool)}
+	laptop := &Client{Hub: hub, UserID: 1, Send: make(chan []byte, 1), ChatRooms: make(map[uint]bool)}
+	other := &Client{Hub: hub, UserID: 2, Send: make(chan []byte, 1), ChatRooms: make(map[uint]bool)}
+	hub.clients[1] = []*Client{phone, laptop}
+	hub.clients[2] = []*Client{other}
+	hub.joinRoomLocal(1, 10)
+	hub.joinRoomLocal(2, 10)
+	hub.joinRoomLocal(2, 11)
+	hub.enqueueBroadcast(&BroadcastMessage{ChatRoomID: 10})
+
+	stats := hub.Stats()
+	assert.Equal(t, 2, stats.Users)
+	assert.Equal(t, 3, stats.Sessions)
+	assert.Equal(t, 2, stats.Rooms)
+	assert.Equal(t, 1, stats.BroadcastQueue)
+}
+
+func TestHub_Ping(t *testing.T) {
+	hub := NewHub()
+
+	// 실행 루프가 없으면 타임아웃
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.Error(t, hub.Ping(ctx))
+
+	go hub.Run()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	assert.NoError(t, hub.Ping(ctx))
+}
+
+func TestHub_Shutdown(t *testing.T) {
+	hub := NewHub()
+	go hub.Run()
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		client := NewClient(context.Background(), hub, &Conn{Conn: conn}, 1)
+		hub.Register(client)
+		go client.WritePump()
+		go client.ReadPump()
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.Eventually(t, func() bool { return hub.IsUserOnline(1) }, time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, hub.Shutdown(ctx))
+
+	// 서버 종료를 알리는 close frame 수신
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err = conn.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), "unexpected error: %v", err)
+
+	// 종료 후 등록/해제는 블로킹되지 않고, 실행 루프는 응답하지 않음
+	late := NewClient(context.Background(), hub, nil, 2)
+	hub.Register(late)
+	_, ok := <-late.Send
+	assert.False(t, ok)
+	hub.Unregister(late)
+	assert.Error(t, hub.Ping(ctx))
+	assert.NoError(t, hub.Shutdown(ctx))
+}
+
+func TestHub_SendAfterShutdown(t *testing.T) {
+	hub := NewHub()
+	go hub.Run()
+
+	client := &Client{Hub: hub, UserID: 7, Send: make(chan []byte, 16), ChatRooms: make(map[uint]bool)}
+	hub.Register(client)
+	hub.JoinRoom(7, 3)
+	require.Eventually(t, func() bool { return hub.IsUserOnline(7) }, time.Second, 10*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, hub.Shutdown(ctx))
+	_, ok := <-client.Send
+	assert.False(t, ok)
+
+	// 종료 후 알림/재전송 경로는 닫힌 Send로 보내지 않음
+	assert.NotPanics(t, func() {
+		require.NoError(t, hub.SendNotificationToUser(7, map[string]interface{}{"type": "notification"}))
+	})
+	assert.False(t, hub.sendEventToClient(client, map[string]interface{}{"type": "new_message"}))
+	assert.False(t, hub.IsUserOnline(7))
+	assert.Empty(t, hub.GetOnlineUsersInRoom(3))
+}
@@ -0,0 +1,225 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisRoomChannel = "ws:room"
+	redisUserChannel = "ws:user"
+	// 채팅방 참여는 REST 요청으로 들어오므로 WebSocket이 연결된 인스턴스로 전달해야 함
+	redisMembershipChannel = "ws:membership"
+
+	// presenceTTL 인스턴스가 비정상 종료되어도 이 시간이 지나면 오프라인으로 간주
+	presenceTTL = 90 * time.Second
+	// presenceRefreshInterval 접속 중인 사용자의 presence 갱신 주기
+	presenceRefreshInterval = 30 * time.Second
+
+	redisOperationTimeout = 3 * time.Second
+)
+
+// clusterEnvelope 인스턴스 간 전달되는 메시지
+type clusterEnvelope struct {
+	Origin   string          `json:"origin"`
+	RoomID   uint            `json:"room_id,omitempty"`
+	SenderID uint            `json:"sender_id,omitempty"`
+	UserID   uint            `json:"user_id,omitempty"`
+	Joined   bool            `json:"joined,omitempty"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+}
+
+// RedisBackend Redis pub/sub 기반 클러스터 백엔드
+//
+// 접속 상태는 사용자별 sorted set(ws:presence:<userID>)에 인스턴스 ID를 멤버로,
+// 만료 시각을 점수로 저장. 만료된 멤버를 정리한 뒤 남은 멤버가 있으면 온라인으로 판단
+type RedisBackend struct {
+	client     *redis.Client
+	instanceID string
+	ctx        context.Context
+	cancel     context.CancelFunc
+}
+
+// NewRedisBackend Redis 클러스터 백엔드 생성
+func NewRedisBackend(client *redis.Client) *RedisBackend {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RedisBackend{
+		client:     client,
+		instanceID: newInstanceID(),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// newInstanceID 인스턴스 식별자 (hostname + 랜덤 접미사, 재시작 시 새로 발급)
+func newInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8])
+}
+
+func presenceKey(userID uint) string {
+	return "ws:presence:" + strconv.FormatUint(uint64(userID), 10)
+}
+
+func (b *RedisBackend) PublishRoom(message *BroadcastMessage) error {
+	return b.publish(redisRoomChannel, clusterEnvelope{
+		Origin:   b.instanceID,
+		RoomID:   message.ChatRoomID,
+		SenderID: message.SenderID,
+		Payload:  message.Message,
+	})
+}
+
+func (b *RedisBackend) PublishUser(userID uint, data []byte) error {
+	return b.publish(redisUserChannel, clusterEnvelope{
+		Origin:  b.instanceID,
+		UserID:  userID,
+		Payload: data,
+	})
+}
+
+func (b *RedisBackend) PublishMembership(userID, roomID uint, joined bool) error {
+	return b.publish(redisMembershipChannel, clusterEnvelope{
+		Origin: b.instanceID,
+		UserID: userID,
+		RoomID: roomID,
+		Joined: joined,
+	})
+}
+
+func (b *RedisBackend) publish(channel string, envelope clusterEnvelope) error {
+	data, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(b.ctx, redisOperationTimeout)
+	defer cancel()
+	return b.client.Publish(ctx, channel, data).Err()
+}
+
+// Run 구독 메시지를 hub로 전달하고 presence를 주기적으로 갱신
+func (b *RedisBackend) Run(hub *Hub) {
+	pubsub := b.client.Subscribe(b.ctx, redisRoomChannel, redisUserChannel, redisMembershipChannel)
+	defer pubsub.Close()
+
+	go b.refreshPresence(hub)
+
+	logger.Info("WebSocket cluster backend started", map[string]interface{}{
+		"instance_id": b.instanceID,
+	})
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-b.ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+
+			var envelope clusterEnvelope
+			if err := json.Unmarshal([]byte(msg.Payload), &envelope); err != nil {
+				logger.Warn("Failed to decode cluster message", map[string]interface{}{
+					"channel": msg.Channel,
+					"error":   err.Error(),
+				})
+				continue
+			}
+
+			switch msg.Channel {
+			case redisRoomChannel:
+				hub.enqueueBroadcast(&BroadcastMessage{
+					ChatRoomID: envelope.RoomID,
+					Message:    envelope.Payload,
+					SenderID:   envelope.SenderID,
+				})
+			case redisUserChannel:
+				hub.deliverToUser(envelope.UserID, envelope.Payload)
+			case redisMembershipChannel:
+				if envelope.Joined {
+					hub.joinRoomLocal(envelope.UserID, envelope.RoomID)
+				} else {
+					hub.leaveRoomLocal(envelope.UserID, envelope.RoomID)
+				}
+			}
+		}
+	}
+}
+
+// refreshPresence 이 인스턴스에 접속 중인 사용자의 presence 만료 시각을 연장
+func (b *RedisBackend) refreshPresence(hub *Hub) {
+	ticker := time.NewTicker(presenceRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.ctx.Done():
+			return
+		case <-ticker.C:
+			for _, userID := range hub.localUserIDs() {
+				if err := b.MarkOnline(userID); err != nil {
+					logger.Warn("Failed to refresh presence", map[string]interface{}{
+						"user_id": userID,
+						"error":   err.Error(),
+					})
+				}
+			}
+		}
+	}
+}
+
+func (b *RedisBackend) MarkOnline(userID uint) error {
+	ctx, cancel := context.WithTimeout(b.ctx, redisOperationTimeout)
+	defer cancel()
+
+	key := presenceKey(userID)
+	expiresAt := time.Now().Add(presenceTTL).Unix()
+	_, err := b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAdd(ctx, key, redis.Z{Score: float64(expiresAt), Member: b.instanceID})
+		pipe.Expire(ctx, key, presenceTTL)
+		return nil
+	})
+	return err
+}
+
+func (b *RedisBackend) MarkOffline(userID uint) error {
+	ctx, cancel := context.WithTimeout(b.ctx, redisOperationTimeout)
+	defer cancel()
+	return b.client.ZRem(ctx, presenceKey(userID), b.instanceID).Err()
+}
+
+func (b *RedisBackend) IsOnline(userID uint) (bool, error) {
+	ctx, cancel := context.WithTimeout(b.ctx, redisOperationTimeout)
+	defer cancel()
+
+	key := presenceKey(userID)
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	if err := b.client.ZRemRangeByScore(ctx, key, "-inf", "("+now).Err(); err != nil {
+		return false, err
+	}
+
+	count, err := b.client.ZCard(ctx, key).Result()
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// Close 구독을 중단 (Redis 클라이언트는 호출자가 닫음)
+func (b *RedisBackend) Close() error {
+	b.cancel()
+	return nil
+}
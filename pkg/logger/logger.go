@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"context"
 	"io"
 	"os"
 	"runtime"
@@ -226,3 +227,20 @@ func Fatal(msg string, err error, fields ...map[string]interface{}) {
 func WithContext(fields map[string]interface{}) *Logger {
 	return Get().WithContext(fields)
 }
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying the logger (e.g. one with request_id fields)
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the logger stored in ctx, or the global logger if there is none
+func FromContext(ctx context.Context) *Logger {
+	if ctx != nil {
+		if l, ok := ctx.Value(contextKey{}).(*Logger); ok {
+			return l
+		}
+	}
+	return Get()
+}
@@ -8,6 +8,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 )
 
 // Client represents a Kakao Pay API client
@@ -22,10 +24,8 @@ func NewClient(config Config) (*Client, error) {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
-	// Create HTTP client with reasonable timeout
-	httpClient := &http.Client{
-		Timeout: 30 * time.Second,
-	}
+	// Create HTTP client with reasonable timeout (request ID/trace context are forwarded)
+	httpClient := tracing.NewClient("kakaopay", 30*time.Second)
 
 	return &Client{
 		config:     config,
@@ -0,0 +1,44 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/pkg/util"
+	"github.com/redis/go-redis/v9"
+)
+
+// oauthStateStore Redis 기반 소셜 로그인 state 저장소
+// 키 구성: oauth_state:<제공자>:<state> = 발급 대상 (TTL = state 유효 시간)
+type oauthStateStore struct {
+	client *redis.Client
+}
+
+// NewOAuthStateStore Init으로 연결된 클라이언트를 사용하는 state 저장소 생성
+func NewOAuthStateStore() util.OAuthStateStore {
+	return &oauthStateStore{client: client}
+}
+
+func oauthStateKey(provider, state string) string {
+	return fmt.Sprintf("oauth_state:%s:%s", provider, state)
+}
+
+func (s *oauthStateStore) Save(ctx context.Context, provider, state, binding string, ttl time.Duration) error {
+	if err := s.client.Set(ctx, oauthStateKey(provider, state), binding, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to store oauth state: %w", err)
+	}
+	return nil
+}
+
+func (s *oauthStateStore) Consume(ctx context.Context, provider, state string) (string, bool, error) {
+	// 조회와 삭제를 한 번에 처리 (같은 state로 동시에 콜백해도 한 번만 사용)
+	binding, err := s.client.GetDel(ctx, oauthStateKey(provider, state)).Result()
+	if err == redis.Nil {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to consume oauth state: %w", err)
+	}
+	return binding, true, nil
+}
@@ -47,6 +47,11 @@ func GetClient() *redis.Client {
 	return client
 }
 
+// Ping checks that the Redis connection is alive
+func Ping(ctx context.Context) error {
+	return client.Ping(ctx).Err()
+}
+
 // Close closes the Redis connection
 func Close() error {
 	if client != nil {
@@ -73,6 +78,28 @@ func BlacklistToken(ctx context.Context, token string, expiry time.Duration) err
 	return nil
 }
 
+// BlacklistTokenIfAbsent adds a token to the blacklist only if it is not already there.
+// It returns false when another request blacklisted the token first, so a token can be redeemed once.
+func BlacklistTokenIfAbsent(ctx context.Context, token string, expiry time.Duration) (bool, error) {
+	key := fmt.Sprintf("blacklist:%s", token)
+	added, err := client.SetNX(ctx, key, "revoked", expiry).Result()
+	if err != nil {
+		logger.Error("Failed to blacklist token", err, nil)
+		return false, err
+	}
+	return added, nil
+}
+
+// UnblacklistToken removes a token from the blacklist
+func UnblacklistToken(ctx context.Context, token string) error {
+	key := fmt.Sprintf("blacklist:%s", token)
+	if err := client.Del(ctx, key).Err(); err != nil {
+		logger.Error("Failed to remove token from blacklist", err, nil)
+		return err
+	}
+	return nil
+}
+
 // IsTokenBlacklisted checks if a token is in the blacklist
 func IsTokenBlacklisted(ctx context.Context, token string) (bool, error) {
 	key := fmt.Sprintf("blacklist:%s", token)
@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+	"github.com/ikkim/udonggeum-backend/pkg/util"
+	"github.com/redis/go-redis/v9"
+)
+
+// verificationCodeStore Redis 기반 인증 코드 저장소
+// 키 구성 (verification:<용도>:<대상>:*):
+//   - code: 인증 코드 (TTL = 코드 유효 시간)
+//   - attempts: 오답 횟수 (TTL = 첫 오답부터 잠금 시간, 재전송해도 유지)
+//   - cooldown: 재전송 쿨다운 표시 (TTL = 쿨다운)
+//   - lock: 잠금 표시 (TTL = 잠금 시간)
+type verificationCodeStore struct {
+	client *redis.Client
+	policy util.VerificationPolicy
+}
+
+// NewVerificationCodeStore Init으로 연결된 클라이언트를 사용하는 인증 코드 저장소 생성
+func NewVerificationCodeStore(policy util.VerificationPolicy) util.VerificationCodeStore {
+	return &verificationCodeStore{
+		client: client,
+		policy: policy,
+	}
+}
+
+func verificationKey(purpose util.VerificationPurpose, target, suffix string) string {
+	return fmt.Sprintf("verification:%s:%s:%s", purpose, target, suffix)
+}
+
+func (s *verificationCodeStore) Save(ctx context.Context, purpose util.VerificationPurpose, target, code string) error {
+	locked, err := s.client.Exists(ctx, verificationKey(purpose, target, "lock")).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check verification lock: %w", err)
+	}
+	if locked > 0 {
+		return util.ErrVerificationLocked
+	}
+
+	// 쿨다운 키를 먼저 선점한 요청만 코드를 발급 (여러 인스턴스 동시 요청 대응)
+	acquired, err := s.client.SetNX(ctx, verificationKey(purpose, target, "cooldown"), "1", s.policy.ResendCooldown).Result()
+	if err != nil {
+		return fmt.Errorf("failed to set verification cooldown: %w", err)
+	}
+	if !acquired {
+		return util.ErrVerificationCooldown
+	}
+
+	// 오답 횟수는 초기화하지 않음 (재전송으로 잠금을 우회하지 못하도록)
+	if err := s.client.Set(ctx, verificationKey(purpose, target, "code"), code, s.policy.CodeTTL).Err(); err != nil {
+		return fmt.Errorf("failed to store verification code: %w", err)
+	}
+	return nil
+}
+
+func (s *verificationCodeStore) Verify(ctx context.Context, purpose util.VerificationPurpose, target, code string) error {
+	lockKey := verificationKey(purpose, target, "lock")
+	codeKey := verificationKey(purpose, target, "code")
+	attemptsKey := verificationKey(purpose, target, "attempts")
+
+	locked, err := s.client.Exists(ctx, lockKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to check verification lock: %w", err)
+	}
+	if locked > 0 {
+		return util.ErrVerificationLocked
+	}
+
+	stored, err := s.client.Get(ctx, codeKey).Result()
+	if err == redis.Nil {
+		return util.ErrVerificationCodeInvalid
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get verification code: %w", err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(stored), []byte(code)) != 1 {
+		attempts, err := s.client.Incr(ctx, attemptsKey).Result()
+		if err != nil {
+			return fmt.Errorf("failed to count verification attempt: %w", err)
+		}
+		if attempts == 1 {
+			s.client.Expire(ctx, attemptsKey, s.policy.LockoutDuration)
+		}
+
+		if attempts >= int64(s.policy.MaxAttempts) {
+			_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.Set(ctx, lockKey, "1", s.policy.LockoutDuration)
+				pipe.Del(ctx, codeKey, attemptsKey)
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("failed to lock verification: %w", err)
+			}
+
+			logger.Warn("Verification locked after too many attempts", map[string]interface{}{
+				"purpose":  purpose,
+				"attempts": attempts,
+			})
+			return util.ErrVerificationLocked
+		}
+		return util.ErrVerificationCodeInvalid
+	}
+
+	// 코드를 삭제한 요청만 성공 처리 (동시에 같은 코드로 검증하는 경우 한 번만 성공)
+	deleted, err := s.client.Del(ctx, codeKey).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete verification code: %w", err)
+	}
+	if deleted == 0 {
+		return util.ErrVerificationCodeInvalid
+	}
+	s.client.Del(ctx, attemptsKey)
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// Package tracing 요청 ID와 W3C trace context(traceparent)로 HTTP 요청, DB 쿼리, 외부 API 호출을 연결
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+)
+
+const (
+	// HeaderRequestID 요청 ID 헤더 (클라이언트가 보낸 값을 사용하고 응답에 그대로 반환)
+	HeaderRequestID = "X-Request-ID"
+	// HeaderTraceparent W3C trace context 헤더
+	HeaderTraceparent = "traceparent"
+
+	// maxRequestIDLength 이보다 긴 요청 ID는 새로 생성한 값으로 대체
+	maxRequestIDLength = 128
+)
+
+// Trace 요청 추적 식별자
+type Trace struct {
+	RequestID string
+	TraceID   string // 16진수 32자
+	SpanID    string // 16진수 16자 (이 서버의 span)
+	Flags     string // 16진수 2자 (01 = sampled)
+}
+
+// New 새 trace 시작 (요청 ID가 비어 있으면 생성)
+func New(requestID string) Trace {
+	if requestID == "" {
+		requestID = uuid.NewString()
+	}
+	return Trace{
+		RequestID: requestID,
+		TraceID:   randomHex(16),
+		SpanID:    randomHex(8),
+		Flags:     "01",
+	}
+}
+
+// FromHeaders X-Request-ID와 traceparent로 호출한 쪽의 trace를 이어받음
+// 없거나 잘못된 값은 새로 생성해 모든 요청이 유효한 식별자를 가지도록 함
+func FromHeaders(requestID, traceparent string) Trace {
+	if !validRequestID(requestID) {
+		requestID = ""
+	}
+	t := New(requestID)
+	if traceID, flags, ok := parseTraceparent(traceparent); ok {
+		t.TraceID = traceID
+		t.Flags = flags
+	}
+	return t
+}
+
+// Traceparent 이 span의 traceparent 헤더 값
+func (t Trace) Traceparent() string {
+	return fmt.Sprintf("00-%s-%s-%s", t.TraceID, t.SpanID, t.Flags)
+}
+
+// Child 같은 trace의 새 span (외부 API 호출용)
+func (t Trace) Child() Trace {
+	t.SpanID = randomHex(8)
+	return t
+}
+
+// Fields trace를 식별하는 로그 필드
+func (t Trace) Fields() map[string]interface{} {
+	return map[string]interface{}{
+		"request_id": t.RequestID,
+		"trace_id":   t.TraceID,
+	}
+}
+
+type contextKey struct{}
+
+// NewContext trace를 담은 context 반환
+func NewContext(ctx context.Context, t Trace) context.Context {
+	return context.WithValue(ctx, contextKey{}, t)
+}
+
+// FromContext ctx에 저장된 trace
+func FromContext(ctx context.Context) (Trace, bool) {
+	if ctx == nil {
+		return Trace{}, false
+	}
+	t, ok := ctx.Value(contextKey{}).(Trace)
+	return t, ok
+}
+
+// RequestID ctx에 저장된 요청 ID (없으면 빈 문자열)
+func RequestID(ctx context.Context) string {
+	t, _ := FromContext(ctx)
+	return t.RequestID
+}
+
+// StartJob 스케줄러 등 HTTP 요청 밖에서 실행되는 작업용 context
+// 실행마다 새 trace를 만들고, 그 식별자와 작업 이름이 붙은 로거를 함께 담음
+func StartJob(ctx context.Context, job string) context.Context {
+	t := New("")
+	fields := t.Fields()
+	fields["job"] = job
+	return logger.NewContext(NewContext(ctx, t), logger.WithContext(fields))
+}
+
+// validRequestID 로그와 응답 헤더에 안전하게 쓸 수 있는 출력 가능한 ASCII만 허용
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTraceparent 버전 00 traceparent의 trace ID와 flags
+func parseTraceparent(value string) (traceID, flags string, ok bool) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		return "", "", false
+	}
+	traceID, spanID, flags := parts[1], parts[2], parts[3]
+	if !isHex(traceID, 32) || !isHex(spanID, 16) || !isHex(flags, 2) {
+		return "", "", false
+	}
+	// 모두 0인 ID는 유효하지 않음
+	if strings.Trim(traceID, "0") == "" || strings.Trim(spanID, "0") == "" {
+		return "", "", false
+	}
+	return traceID, flags, true
+}
+
+func isHex(s string, length int) bool {
+	if len(s) != length {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
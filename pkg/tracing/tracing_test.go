@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromHeaders(t *testing.T) {
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	trace := FromHeaders("client-req-1", traceparent)
+	assert.Equal(t, "client-req-1", trace.RequestID)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceID)
+	assert.Equal(t, "01", trace.Flags)
+	// 이 서버의 span은 새로 생성
+	assert.NotEqual(t, "00f067aa0ba902b7", trace.SpanID)
+	assert.True(t, strings.HasPrefix(trace.Traceparent(), "00-4bf92f3577b34da6a3ce929d0e0e4736-"))
+
+	for _, invalid := range []string{
+		"",
+		"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+	} {
+		trace := FromHeaders("", invalid)
+		assert.Len(t, trace.TraceID, 32, invalid)
+		assert.NotEqual(t, "4bf92f3577b34da6a3ce929d0e0e4736", trace.TraceID, invalid)
+	}
+
+	// 잘못된 요청 ID는 새로 생성
+	for _, invalid := range []string{"", "has space", "line\nbreak", strings.Repeat("a", 129)} {
+		trace := FromHeaders(invalid, "")
+		assert.Len(t, trace.RequestID, 36, invalid)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	trace := New("req-1")
+	ctx := NewContext(context.Background(), trace)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1?key=secret", nil)
+	require.NoError(t, err)
+
+	resp, err := NewClient("test", 0).Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "req-1", received.Get(HeaderRequestID))
+	traceparent := received.Get(HeaderTraceparent)
+	assert.True(t, strings.HasPrefix(traceparent, "00-"+trace.TraceID+"-"))
+	assert.NotEqual(t, trace.Traceparent(), traceparent)
+	// 원래 요청은 변경하지 않음
+	assert.Empty(t, req.Header.Get(HeaderRequestID))
+}
@@ -0,0 +1,63 @@
+package tracing
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/pkg/logger"
+)
+
+// Transport 외부 API 호출에 요청 ID와 trace context를 전달하고 호출 결과를 기록
+type Transport struct {
+	// Service 로그에 남길 외부 서비스 이름 (kakao, google, openai, krx 등)
+	Service string
+	// Base 실제 요청을 보낼 transport (nil이면 http.DefaultTransport)
+	Base http.RoundTripper
+}
+
+// NewClient 외부 서비스 호출용 HTTP 클라이언트
+func NewClient(service string, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &Transport{Service: service},
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	ctx := req.Context()
+	if trace, ok := FromContext(ctx); ok {
+		req = req.Clone(ctx)
+		req.Header.Set(HeaderRequestID, trace.RequestID)
+		req.Header.Set(HeaderTraceparent, trace.Child().Traceparent())
+	}
+
+	// 쿼리 문자열에 API 키가 들어갈 수 있으므로 host와 path만 기록
+	fields := map[string]interface{}{
+		"service": t.Service,
+		"method":  req.Method,
+		"host":    req.URL.Host,
+		"path":    req.URL.Path,
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	fields["latency_ms"] = time.Since(start).Milliseconds()
+
+	log := logger.FromContext(ctx)
+	if err != nil {
+		log.Error("Outbound request failed", err, fields)
+		return nil, err
+	}
+	fields["status_code"] = resp.StatusCode
+	if resp.StatusCode >= 500 {
+		log.Warn("Outbound request completed", fields)
+	} else {
+		log.Info("Outbound request completed", fields)
+	}
+	return resp, nil
+}
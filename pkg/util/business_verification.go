@@ -2,12 +2,15 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"time"
+
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 )
 
 // BusinessVerificationRequest 사업자 등록번호 진위확인 요청 구조체
@@ -53,7 +56,7 @@ type BusinessVerificationResult struct {
 }
 
 // VerifyBusinessNumber 사업자 등록번호 진위 확인
-func VerifyBusinessNumber(businessNumber, startDate, representativeName string) (*BusinessVerificationResult, error) {
+func VerifyBusinessNumber(ctx context.Context, businessNumber, startDate, representativeName string) (*BusinessVerificationResult, error) {
 	// 환경 변수에서 API 키 가져오기
 	apiKey := os.Getenv("BUSINESS_VERIFICATION_API_KEY")
 	if apiKey == "" {
@@ -86,7 +89,7 @@ func VerifyBusinessNumber(businessNumber, startDate, representativeName string)
 
 	// API 요청
 	apiURL := fmt.Sprintf("https://api.odcloud.kr/api/nts-businessman/v1/validate?serviceKey=%s", apiKey)
-	req, err := http.NewRequest("POST", apiURL, bytes.NewBuffer(requestBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewBuffer(requestBody))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -94,10 +97,8 @@ func VerifyBusinessNumber(businessNumber, startDate, representativeName string)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	// HTTP 클라이언트 생성 및 요청 전송
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
+	// HTTP 클라이언트 생성 및 요청 전송 (쿼리 문자열의 API 키는 로그에 남기지 않음)
+	client := tracing.NewClient("odcloud", 30*time.Second)
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to send request: %w", err)
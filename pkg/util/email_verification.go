@@ -7,21 +7,6 @@ import (
 	"math/big"
 	"net/smtp"
 	"os"
-	"sync"
-	"time"
-)
-
-// VerificationCode represents a verification code with expiration
-type VerificationCode struct {
-	Code      string
-	ExpiresAt time.Time
-}
-
-// In-memory storage for verification codes
-var (
-	emailVerificationCodes = make(map[string]VerificationCode)
-	phoneVerificationCodes = make(map[string]VerificationCode)
-	verificationMutex      sync.RWMutex
 )
 
 // GenerateVerificationCode generates a random 6-digit code
@@ -34,96 +19,6 @@ func GenerateVerificationCode() (string, error) {
 	return fmt.Sprintf("%06d", n.Int64()), nil
 }
 
-// StoreEmailVerificationCode stores the verification code for an email
-func StoreEmailVerificationCode(email, code string) {
-	verificationMutex.Lock()
-	defer verificationMutex.Unlock()
-
-	emailVerificationCodes[email] = VerificationCode{
-		Code:      code,
-		ExpiresAt: time.Now().Add(5 * time.Minute), // 5분 유효
-	}
-}
-
-// StorePhoneVerificationCode stores the verification code for a phone number
-func StorePhoneVerificationCode(phone, code string) {
-	verificationMutex.Lock()
-	defer verificationMutex.Unlock()
-
-	phoneVerificationCodes[phone] = VerificationCode{
-		Code:      code,
-		ExpiresAt: time.Now().Add(5 * time.Minute), // 5분 유효
-	}
-}
-
-// VerifyEmailCode verifies the email verification code
-func VerifyEmailCode(email, code string) bool {
-	verificationMutex.RLock()
-	defer verificationMutex.RUnlock()
-
-	storedCode, exists := emailVerificationCodes[email]
-	if !exists {
-		return false
-	}
-
-	// Check if expired
-	if time.Now().After(storedCode.ExpiresAt) {
-		return false
-	}
-
-	// Check if code matches
-	if storedCode.Code != code {
-		return false
-	}
-
-	// Delete the code after successful verification
-	verificationMutex.RUnlock()
-	verificationMutex.Lock()
-	delete(emailVerificationCodes, email)
-	verificationMutex.Unlock()
-	verificationMutex.RLock()
-
-	return true
-}
-
-// VerifyPhoneCode verifies the phone verification code
-func VerifyPhoneCode(phone, code string) bool {
-	// 개발 모드: SENS 설정이 없으면 모든 코드 허용
-	serviceID := os.Getenv("NAVER_SENS_SERVICE_ID")
-	accessKey := os.Getenv("NAVER_SENS_ACCESS_KEY")
-	secretKey := os.Getenv("NAVER_SENS_SECRET_KEY")
-	fromNumber := os.Getenv("NAVER_SENS_FROM_NUMBER")
-
-	verificationMutex.RLock()
-	storedCode, exists := phoneVerificationCodes[phone]
-	verificationMutex.RUnlock()
-
-	if !exists {
-		return false
-	}
-
-	// Check if expired
-	if time.Now().After(storedCode.ExpiresAt) {
-		return false
-	}
-
-	// Check if code matches
-	if storedCode.Code != code {
-		return false
-	}
-
-	// Delete the code after successful verification
-	verificationMutex.Lock()
-	delete(phoneVerificationCodes, phone)
-	verificationMutex.Unlock()
-
-	if serviceID == "" || accessKey == "" || secretKey == "" || fromNumber == "" {
-		log.Printf("[개발 모드] 휴대폰 인증 성공: %s", phone)
-	}
-
-	return true
-}
-
 // SendVerificationEmail sends a verification email via Gmail SMTP
 func SendVerificationEmail(toEmail, code string) error {
 	// Gmail SMTP 설정 (환경변수에서 가져오기)
@@ -285,29 +180,3 @@ func SendPasswordResetEmail(toEmail, resetToken string) error {
 	log.Printf("비밀번호 재설정 이메일 발송 완료: %s", toEmail)
 	return nil
 }
-
-// CleanupExpiredCodes periodically removes expired verification codes
-func CleanupExpiredCodes() {
-	ticker := time.NewTicker(1 * time.Minute)
-	go func() {
-		for range ticker.C {
-			verificationMutex.Lock()
-
-			// Clean email codes
-			for email, code := range emailVerificationCodes {
-				if time.Now().After(code.ExpiresAt) {
-					delete(emailVerificationCodes, email)
-				}
-			}
-
-			// Clean phone codes
-			for phone, code := range phoneVerificationCodes {
-				if time.Now().After(code.ExpiresAt) {
-					delete(phoneVerificationCodes, phone)
-				}
-			}
-
-			verificationMutex.Unlock()
-		}
-	}()
-}
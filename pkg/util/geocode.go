@@ -1,14 +1,20 @@
 package util
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
 	"os"
+	"time"
+
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 )
 
+var geocodeClient = tracing.NewClient("kakao", 10*time.Second)
+
 // KakaoGeocodeResponse represents the response from Kakao address search API
 type KakaoGeocodeResponse struct {
 	Documents []struct {
@@ -30,7 +36,7 @@ type KakaoGeocodeResponse struct {
 
 // GeocodeAddress converts an address string to latitude and longitude using Kakao API
 // Returns (latitude, longitude, error)
-func GeocodeAddress(address string) (*float64, *float64, error) {
+func GeocodeAddress(ctx context.Context, address string) (*float64, *float64, error) {
 	if address == "" {
 		return nil, nil, nil // No error, just no coordinates
 	}
@@ -50,7 +56,7 @@ func GeocodeAddress(address string) (*float64, *float64, error) {
 	requestURL := fmt.Sprintf("%s?%s", baseURL, params.Encode())
 
 	// Create HTTP request
-	req, err := http.NewRequest("GET", requestURL, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -59,8 +65,7 @@ func GeocodeAddress(address string) (*float64, *float64, error) {
 	req.Header.Add("Authorization", fmt.Sprintf("KakaoAK %s", kakaoAPIKey))
 
 	// Make HTTP request
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := geocodeClient.Do(req)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to call Kakao API: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 var (
@@ -12,10 +13,27 @@ var (
 	ErrExpiredToken = errors.New("token has expired")
 )
 
+// 기본 발급자/대상 (설정하지 않은 경우)
+const (
+	DefaultIssuer   = "udonggeum-backend"
+	DefaultAudience = "udonggeum-api"
+)
+
+// TokenType access/refresh 토큰 구분 (서로 대신 쓸 수 없음)
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
 type JWTClaims struct {
 	UserID uint   `json:"user_id"`
 	Email  string `json:"email"`
 	Role   string `json:"role"`
+	// SessionID 토큰이 속한 로그인 세션 (refresh token 회전 단위)
+	SessionID string    `json:"sid,omitempty"`
+	TokenType TokenType `json:"token_type,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -24,14 +42,45 @@ type TokenPair struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
-// GenerateTokenPair generates both access and refresh tokens
-func GenerateTokenPair(userID uint, email, role, secret string, accessExpiry, refreshExpiry time.Duration) (*TokenPair, error) {
-	accessToken, err := generateToken(userID, email, role, secret, accessExpiry)
+// TokenManager 키링으로 토큰을 서명/검증하고 발급자(iss)와 대상(aud)을 확인
+type TokenManager struct {
+	keyring  *Keyring
+	issuer   string
+	audience string
+	// legacyAccessExpiry 토큰 종류 도입 전 access token의 최대 유효기간 (0이면 이전 형식 토큰을 받지 않음)
+	legacyAccessExpiry time.Duration
+}
+
+func NewTokenManager(keyring *Keyring, issuer, audience string) *TokenManager {
+	return &TokenManager{
+		keyring:  keyring,
+		issuer:   issuer,
+		audience: audience,
+	}
+}
+
+// AcceptLegacyRefreshTokens 토큰 종류를 넣기 전에 발급된 토큰을 refresh token으로 받음
+// 이전 형식은 access/refresh가 같은 키로 서명되어 유효기간으로만 구분할 수 있으므로
+// 유효기간이 accessExpiry(당시 access token 유효기간)보다 긴 토큰만 허용
+func (m *TokenManager) AcceptLegacyRefreshTokens(accessExpiry time.Duration) {
+	m.legacyAccessExpiry = accessExpiry
+}
+
+// NewHMACTokenManager 단일 HS256 비밀키와 기본 발급자/대상을 쓰는 TokenManager
+func NewHMACTokenManager(secret string) *TokenManager {
+	keyring, _ := NewKeyring(NewHMACKey(LegacyKeyID, secret))
+	return NewTokenManager(keyring, DefaultIssuer, DefaultAudience)
+}
+
+// GenerateTokenPair generates an access/refresh token pair bound to a login session.
+// Every token gets a unique ID (jti) so that rotated tokens never collide.
+func (m *TokenManager) GenerateTokenPair(userID uint, email, role, sessionID string, accessExpiry, refreshExpiry time.Duration) (*TokenPair, error) {
+	accessToken, err := m.generateToken(userID, email, role, sessionID, TokenTypeAccess, accessExpiry)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := generateToken(userID, email, role, secret, refreshExpiry)
+	refreshToken, err := m.generateToken(userID, email, role, sessionID, TokenTypeRefresh, refreshExpiry)
 	if err != nil {
 		return nil, err
 	}
@@ -42,31 +91,66 @@ func GenerateTokenPair(userID uint, email, role, secret string, accessExpiry, re
 	}, nil
 }
 
-// generateToken generates a JWT token
-func generateToken(userID uint, email, role, secret string, expiry time.Duration) (string, error) {
+func (m *TokenManager) generateToken(userID uint, email, role, sessionID string, tokenType TokenType, expiry time.Duration) (string, error) {
+	now := time.Now()
 	claims := JWTClaims{
-		UserID: userID,
-		Email:  email,
-		Role:   role,
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		SessionID: sessionID,
+		TokenType: tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(expiry)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ID:        uuid.New().String(),
+			Issuer:    m.issuer,
+			Audience:  jwt.ClaimStrings{m.audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+			IssuedAt:  jwt.NewNumericDate(now),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	return m.keyring.sign(claims)
 }
 
-// ValidateToken validates a JWT token and returns the claims
-func ValidateToken(tokenString, secret string) (*JWTClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return []byte(secret), nil
-	})
+// ValidateAccessToken API 인증용. refresh token은 거부
+func (m *TokenManager) ValidateAccessToken(tokenString string) (*JWTClaims, error) {
+	claims, err := m.validate(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeAccess {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// ValidateRefreshToken 토큰 갱신/로그아웃용. access token은 거부
+// 토큰 종류를 구분하기 전에 발급된 토큰(token_type, iss 없음)은 AcceptLegacyRefreshTokens로 설정한 경우에만
+// 새 세션으로 전환할 수 있도록 허용
+func (m *TokenManager) ValidateRefreshToken(tokenString string) (*JWTClaims, error) {
+	claims, err := m.validate(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType == TokenTypeRefresh {
+		return claims, nil
+	}
+	if !claims.isLegacy() || !m.isLegacyRefresh(claims) {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// isLegacyRefresh 이전 형식 토큰 중 유효기간이 access token보다 긴 것(refresh token)인지 여부
+func (m *TokenManager) isLegacyRefresh(claims *JWTClaims) bool {
+	if m.legacyAccessExpiry <= 0 || claims.ExpiresAt == nil || claims.IssuedAt == nil {
+		return false
+	}
+	return claims.ExpiresAt.Sub(claims.IssuedAt.Time) > m.legacyAccessExpiry
+}
 
+// validate 서명, 만료, 발급자/대상을 확인 (토큰 종류는 확인하지 않음)
+func (m *TokenManager) validate(tokenString string) (*JWTClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, m.keyring.keyFunc)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, ErrExpiredToken
@@ -79,5 +163,37 @@ func ValidateToken(tokenString, secret string) (*JWTClaims, error) {
 		return nil, ErrInvalidToken
 	}
 
+	if !claims.isLegacy() {
+		if claims.Issuer != m.issuer {
+			return nil, ErrInvalidToken
+		}
+		audienceMatched := false
+		for _, audience := range claims.Audience {
+			if audience == m.audience {
+				audienceMatched = true
+				break
+			}
+		}
+		if !audienceMatched {
+			return nil, ErrInvalidToken
+		}
+	}
+
 	return claims, nil
 }
+
+// isLegacy 토큰 종류/발급자를 넣기 전에 발급된 토큰
+func (c *JWTClaims) isLegacy() bool {
+	return c.TokenType == "" && c.Issuer == ""
+}
+
+// GenerateTokenPair generates both access and refresh tokens with a single HS256 secret
+func GenerateTokenPair(userID uint, email, role, secret string, accessExpiry, refreshExpiry time.Duration) (*TokenPair, error) {
+	return NewHMACTokenManager(secret).GenerateTokenPair(userID, email, role, "", accessExpiry, refreshExpiry)
+}
+
+// ValidateToken validates a JWT token signed with a single HS256 secret and returns the claims.
+// It accepts either token type; servers should use TokenManager.ValidateAccessToken/ValidateRefreshToken.
+func ValidateToken(tokenString, secret string) (*JWTClaims, error) {
+	return NewHMACTokenManager(secret).validate(tokenString)
+}
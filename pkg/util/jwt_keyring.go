@@ -0,0 +1,146 @@
+package util
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// LegacyKeyID kid 헤더가 없는 토큰(키링 도입 이전 발급)을 검증할 키 ID
+const LegacyKeyID = "default"
+
+// SigningKey kid로 식별되는 서명/검증 키
+type SigningKey struct {
+	ID        string
+	Method    jwt.SigningMethod
+	signKey   interface{} // nil이면 검증 전용 (이전 키)
+	verifyKey interface{}
+}
+
+// CanSign 서명에 쓸 수 있는 키인지 (공개키만 있으면 검증 전용)
+func (k SigningKey) CanSign() bool {
+	return k.signKey != nil
+}
+
+// NewHMACKey HS256 공유 비밀키
+func NewHMACKey(id, secret string) SigningKey {
+	return SigningKey{
+		ID:        id,
+		Method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+}
+
+// ParsePrivateKeyPEM PEM 개인키로 서명 키 생성 (RSA → RS256, Ed25519 → EdDSA)
+func ParsePrivateKeyPEM(id string, data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, fmt.Errorf("key %q: no PEM block found", id)
+	}
+
+	var key interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("key %q: %w", id, err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return SigningKey{ID: id, Method: jwt.SigningMethodRS256, signKey: key, verifyKey: &key.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return SigningKey{ID: id, Method: jwt.SigningMethodEdDSA, signKey: key, verifyKey: key.Public()}, nil
+	default:
+		return SigningKey{}, fmt.Errorf("key %q: unsupported private key type %T", id, key)
+	}
+}
+
+// ParsePublicKeyPEM PEM 공개키로 검증 전용 키 생성 (교체된 이전 비대칭 키)
+func ParsePublicKeyPEM(id string, data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, fmt.Errorf("key %q: no PEM block found", id)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("key %q: %w", id, err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return SigningKey{ID: id, Method: jwt.SigningMethodRS256, verifyKey: key}, nil
+	case ed25519.PublicKey:
+		return SigningKey{ID: id, Method: jwt.SigningMethodEdDSA, verifyKey: key}, nil
+	default:
+		return SigningKey{}, fmt.Errorf("key %q: unsupported public key type %T", id, key)
+	}
+}
+
+// Keyring 현재 서명 키와 교체 전 키들의 모음
+// 새 토큰은 active 키로 서명하고, 검증은 토큰 헤더의 kid로 키를 찾음
+type Keyring struct {
+	active SigningKey
+	keys   map[string]SigningKey
+}
+
+// NewKeyring active 키로 서명하고 previous 키들은 검증에만 사용
+func NewKeyring(active SigningKey, previous ...SigningKey) (*Keyring, error) {
+	if active.ID == "" || !active.CanSign() {
+		return nil, errors.New("active JWT key must have an ID and a signing key")
+	}
+
+	keyring := &Keyring{
+		active: active,
+		keys:   map[string]SigningKey{active.ID: active},
+	}
+	for _, key := range previous {
+		if key.ID == "" {
+			return nil, errors.New("JWT key ID must not be empty")
+		}
+		if _, exists := keyring.keys[key.ID]; exists {
+			return nil, fmt.Errorf("duplicate JWT key ID %q", key.ID)
+		}
+		keyring.keys[key.ID] = key
+	}
+	return keyring, nil
+}
+
+// ActiveKeyID 새 토큰에 붙는 kid
+func (k *Keyring) ActiveKeyID() string {
+	return k.active.ID
+}
+
+func (k *Keyring) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(k.active.Method, claims)
+	token.Header["kid"] = k.active.ID
+	return token.SignedString(k.active.signKey)
+}
+
+// keyFunc kid로 검증 키를 찾고 서명 알고리즘이 키와 일치하는지 확인
+func (k *Keyring) keyFunc(token *jwt.Token) (interface{}, error) {
+	keyID, _ := token.Header["kid"].(string)
+	if keyID == "" {
+		keyID = LegacyKeyID
+	}
+
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, ErrInvalidToken
+	}
+	return key.verifyKey, nil
+}
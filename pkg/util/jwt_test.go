@@ -1,9 +1,14 @@
 package util
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -211,3 +216,121 @@ func TestDifferentSecrets(t *testing.T) {
 	assert.ErrorIs(t, err, ErrInvalidToken)
 	assert.Nil(t, claims)
 }
+
+func TestTokenManager_TokenTypes(t *testing.T) {
+	manager := NewHMACTokenManager(testSecret)
+	first, err := manager.GenerateTokenPair(1, "test@example.com", "user", "session-1", 15*time.Minute, 7*24*time.Hour)
+	require.NoError(t, err)
+	second, err := manager.GenerateTokenPair(1, "test@example.com", "user", "session-1", 15*time.Minute, 7*24*time.Hour)
+	require.NoError(t, err)
+
+	access, err := manager.ValidateAccessToken(first.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, TokenTypeAccess, access.TokenType)
+	assert.Equal(t, "session-1", access.SessionID)
+	assert.NotEmpty(t, access.ID)
+
+	refresh, err := manager.ValidateRefreshToken(first.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, TokenTypeRefresh, refresh.TokenType)
+	assert.NotEqual(t, access.ID, refresh.ID)
+
+	// access/refresh token은 서로 대신 쓸 수 없음
+	_, err = manager.ValidateAccessToken(first.RefreshToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = manager.ValidateRefreshToken(first.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	// 같은 초에 회전해도 refresh token은 서로 달라야 함
+	assert.NotEqual(t, first.RefreshToken, second.RefreshToken)
+}
+
+func TestTokenManager_IssuerAudience(t *testing.T) {
+	keyring, err := NewKeyring(NewHMACKey("k1", testSecret))
+	require.NoError(t, err)
+	manager := NewTokenManager(keyring, "issuer-a", "audience-a")
+	tokens, err := manager.GenerateTokenPair(1, "test@example.com", "user", "", 15*time.Minute, 7*24*time.Hour)
+	require.NoError(t, err)
+
+	_, err = manager.ValidateAccessToken(tokens.AccessToken)
+	assert.NoError(t, err)
+	_, err = NewTokenManager(keyring, "issuer-b", "audience-a").ValidateAccessToken(tokens.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = NewTokenManager(keyring, "issuer-a", "audience-b").ValidateAccessToken(tokens.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestTokenManager_KeyRotation(t *testing.T) {
+	oldKeyring, err := NewKeyring(NewHMACKey("2026-01", "old-secret"))
+	require.NoError(t, err)
+	oldTokens, err := NewTokenManager(oldKeyring, DefaultIssuer, DefaultAudience).GenerateTokenPair(1, "test@example.com", "user", "", 15*time.Minute, 7*24*time.Hour)
+	require.NoError(t, err)
+
+	// HS256 → EdDSA로 교체해도 이전 키로 서명된 토큰은 계속 검증됨
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	activeKey, err := ParsePrivateKeyPEM("2026-10", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	keyring, err := NewKeyring(activeKey, NewHMACKey("2026-01", "old-secret"))
+	require.NoError(t, err)
+	manager := NewTokenManager(keyring, DefaultIssuer, DefaultAudience)
+
+	_, err = manager.ValidateRefreshToken(oldTokens.RefreshToken)
+	assert.NoError(t, err)
+
+	tokens, err := manager.GenerateTokenPair(1, "test@example.com", "user", "", 15*time.Minute, 7*24*time.Hour)
+	require.NoError(t, err)
+	token, _, err := jwt.NewParser().ParseUnverified(tokens.AccessToken, &JWTClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10", token.Header["kid"])
+	assert.Equal(t, "EdDSA", token.Method.Alg())
+	_, err = manager.ValidateAccessToken(tokens.AccessToken)
+	assert.NoError(t, err)
+
+	// 키링에서 빠진 키로 서명된 토큰은 거부
+	_, err = NewTokenManager(oldKeyring, DefaultIssuer, DefaultAudience).ValidateAccessToken(tokens.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = NewKeyring(activeKey, NewHMACKey("2026-10", "other"))
+	assert.Error(t, err)
+}
+
+func TestTokenManager_LegacyToken(t *testing.T) {
+	// 토큰 종류/kid를 넣기 전 형식의 토큰 (access/refresh가 같은 키로 서명되고 유효기간만 다름)
+	legacyToken := func(expiry time.Duration) string {
+		now := time.Now()
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, JWTClaims{
+			UserID: 1,
+			Email:  "test@example.com",
+			Role:   "user",
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
+				IssuedAt:  jwt.NewNumericDate(now),
+			},
+		}).SignedString([]byte(testSecret))
+		require.NoError(t, err)
+		return token
+	}
+	legacyAccess := legacyToken(15 * time.Minute)
+	legacyRefresh := legacyToken(7 * 24 * time.Hour)
+
+	// 설정하지 않으면 이전 형식 토큰은 받지 않음
+	manager := NewHMACTokenManager(testSecret)
+	_, err := manager.ValidateRefreshToken(legacyRefresh)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	manager.AcceptLegacyRefreshTokens(15 * time.Minute)
+	_, err = manager.ValidateAccessToken(legacyRefresh)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	claims, err := manager.ValidateRefreshToken(legacyRefresh)
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), claims.UserID)
+
+	// 유출된 이전 형식 access token으로는 토큰을 갱신할 수 없음
+	_, err = manager.ValidateAccessToken(legacyAccess)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+	_, err = manager.ValidateRefreshToken(legacyAccess)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
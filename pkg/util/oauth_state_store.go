@@ -0,0 +1,68 @@
+package util
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// OAuthStateStore 소셜 로그인 state 저장소
+// 로그인 URL을 만들 때 발급한 state만 콜백에서 한 번 사용할 수 있음
+// state마다 발급 대상(로그인을 시작한 브라우저, 연결을 시작한 사용자)을 함께 저장해 콜백에서 같은 대상인지 확인
+// 여러 서버 인스턴스가 공유할 수 있도록 구현체는 상태를 외부(Redis 등)에 두어야 함
+type OAuthStateStore interface {
+	// Save 제공자별로 발급한 state와 발급 대상을 ttl 동안 저장
+	Save(ctx context.Context, provider, state, binding string, ttl time.Duration) error
+	// Consume state를 삭제하고 저장된 발급 대상을 반환 (만료되었거나 이미 사용했으면 false)
+	Consume(ctx context.Context, provider, state string) (string, bool, error)
+}
+
+type memoryOAuthState struct {
+	binding   string
+	expiresAt time.Time
+}
+
+// MemoryOAuthStateStore 프로세스 메모리 기반 저장소 (테스트/단일 인스턴스 개발용)
+type MemoryOAuthStateStore struct {
+	mu     sync.Mutex
+	states map[string]memoryOAuthState // provider:state → 발급 대상, 만료 시각
+	now    func() time.Time
+}
+
+// NewMemoryOAuthStateStore 메모리 저장소 생성
+func NewMemoryOAuthStateStore() *MemoryOAuthStateStore {
+	return &MemoryOAuthStateStore{
+		states: make(map[string]memoryOAuthState),
+		now:    time.Now,
+	}
+}
+
+func (s *MemoryOAuthStateStore) Save(ctx context.Context, provider, state, binding string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for key, stored := range s.states {
+		if now.After(stored.expiresAt) {
+			delete(s.states, key)
+		}
+	}
+	s.states[provider+":"+state] = memoryOAuthState{binding: binding, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+func (s *MemoryOAuthStateStore) Consume(ctx context.Context, provider, state string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := provider + ":" + state
+	stored, ok := s.states[key]
+	if !ok {
+		return "", false, nil
+	}
+	delete(s.states, key)
+	if s.now().After(stored.expiresAt) {
+		return "", false, nil
+	}
+	return stored.binding, true, nil
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryOAuthStateStore_ConsumeOnce(t *testing.T) {
+	store := NewMemoryOAuthStateStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, "kakao", "state-1", "login:nonce-1", 10*time.Minute))
+
+	// 다른 제공자의 콜백에는 사용할 수 없음
+	_, ok, err := store.Consume(ctx, "google", "state-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	binding, ok, err := store.Consume(ctx, "kakao", "state-1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "login:nonce-1", binding)
+
+	// 사용한 state는 재사용 불가
+	_, ok, err = store.Consume(ctx, "kakao", "state-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestMemoryOAuthStateStore_Expired(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryOAuthStateStore()
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, "naver", "state-1", "link:1", 10*time.Minute))
+	now = now.Add(11 * time.Minute)
+
+	_, ok, err := store.Consume(ctx, "naver", "state-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
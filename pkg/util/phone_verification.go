@@ -2,6 +2,7 @@ package util
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
@@ -15,6 +16,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/ikkim/udonggeum-backend/pkg/tracing"
 )
 
 // Solapi SMS 요청 구조체
@@ -37,7 +39,7 @@ func makeSolapiSignature(date, salt, secretKey string) string {
 }
 
 // SendVerificationSMS sends a verification SMS via Solapi
-func SendVerificationSMS(phoneNumber, code string) error {
+func SendVerificationSMS(ctx context.Context, phoneNumber, code string) error {
 	apiKey := os.Getenv("SOLAPI_API_KEY")
 	apiSecret := os.Getenv("SOLAPI_API_SECRET")
 	fromNumber := os.Getenv("SOLAPI_FROM_NUMBER")
@@ -85,7 +87,7 @@ func SendVerificationSMS(phoneNumber, code string) error {
 
 	// HTTP 요청
 	apiURL := "https://api.solapi.com/messages/v4/send"
-	req, err := http.NewRequest("POST", apiURL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return fmt.Errorf("HTTP 요청 생성 실패: %v", err)
 	}
@@ -93,7 +95,7 @@ func SendVerificationSMS(phoneNumber, code string) error {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", authHeader)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client := tracing.NewClient("solapi", 10*time.Second)
 	resp, err := client.Do(req)
 	if err != nil {
 		return fmt.Errorf("SMS 발송 요청 실패: %v", err)
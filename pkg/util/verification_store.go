@@ -0,0 +1,158 @@
+package util
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"sync"
+	"time"
+)
+
+// VerificationPurpose 인증 코드 용도 (같은 대상이라도 용도별로 분리 저장)
+type VerificationPurpose string
+
+const (
+	VerificationPurposeEmail VerificationPurpose = "email"
+	VerificationPurposePhone VerificationPurpose = "phone"
+)
+
+var (
+	ErrVerificationCodeInvalid = errors.New("유효하지 않거나 만료된 인증 코드입니다")
+	ErrVerificationCooldown    = errors.New("인증 코드는 잠시 후 다시 요청할 수 있습니다")
+	ErrVerificationLocked      = errors.New("인증 시도 횟수를 초과했습니다. 잠시 후 다시 시도해주세요")
+)
+
+// VerificationPolicy 인증 코드 유효기간, 시도 횟수 제한, 재전송 쿨다운
+type VerificationPolicy struct {
+	CodeTTL         time.Duration // 코드 유효 시간
+	MaxAttempts     int           // 잠금 전까지 허용되는 오답 횟수
+	LockoutDuration time.Duration // 잠금 시간 (잠금 중에는 검증/재전송 모두 불가)
+	ResendCooldown  time.Duration // 재전송 최소 간격
+}
+
+// DefaultVerificationPolicy 기본 정책 (5분 유효, 5회 오답 시 15분 잠금, 재전송 60초 간격)
+func DefaultVerificationPolicy() VerificationPolicy {
+	return VerificationPolicy{
+		CodeTTL:         5 * time.Minute,
+		MaxAttempts:     5,
+		LockoutDuration: 15 * time.Minute,
+		ResendCooldown:  60 * time.Second,
+	}
+}
+
+// VerificationCodeStore 인증 코드 저장소
+// 여러 서버 인스턴스가 공유할 수 있도록 구현체는 상태를 외부(Redis 등)에 두어야 함
+type VerificationCodeStore interface {
+	// Save 새 인증 코드를 저장 (오답 횟수는 재전송해도 유지되며 잠금 시간이 지나거나 검증에 성공해야 초기화)
+	// 재전송 쿨다운 중이면 ErrVerificationCooldown, 잠금 중이면 ErrVerificationLocked
+	Save(ctx context.Context, purpose VerificationPurpose, target, code string) error
+	// Verify 인증 코드를 확인하고 성공 시 코드를 삭제
+	// 코드가 없거나 틀리면 ErrVerificationCodeInvalid, 오답 누적으로 잠기면 ErrVerificationLocked
+	Verify(ctx context.Context, purpose VerificationPurpose, target, code string) error
+}
+
+// codesEqual 타이밍 공격을 피하기 위한 상수 시간 비교
+func codesEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
+
+// memoryVerificationEntry 메모리 저장소의 대상별 상태
+type memoryVerificationEntry struct {
+	code            string
+	expiresAt       time.Time
+	attempts        int
+	attemptsResetAt time.Time // 첫 오답 후 잠금 시간이 지나면 오답 횟수 초기화
+	cooldownUntil   time.Time
+	lockedUntil     time.Time
+}
+
+// MemoryVerificationCodeStore 프로세스 메모리 기반 저장소 (테스트/단일 인스턴스 개발용)
+type MemoryVerificationCodeStore struct {
+	mu      sync.Mutex
+	policy  VerificationPolicy
+	entries map[string]*memoryVerificationEntry
+	now     func() time.Time
+}
+
+// NewMemoryVerificationCodeStore 메모리 저장소 생성
+func NewMemoryVerificationCodeStore(policy VerificationPolicy) *MemoryVerificationCodeStore {
+	return &MemoryVerificationCodeStore{
+		policy:  policy,
+		entries: make(map[string]*memoryVerificationEntry),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryVerificationCodeStore) Save(ctx context.Context, purpose VerificationPurpose, target, code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.prune(now)
+
+	key := string(purpose) + ":" + target
+	entry, ok := s.entries[key]
+	if !ok {
+		entry = &memoryVerificationEntry{}
+		s.entries[key] = entry
+	}
+
+	if now.Before(entry.lockedUntil) {
+		return ErrVerificationLocked
+	}
+	if now.Before(entry.cooldownUntil) {
+		return ErrVerificationCooldown
+	}
+
+	entry.code = code
+	entry.expiresAt = now.Add(s.policy.CodeTTL)
+	entry.cooldownUntil = now.Add(s.policy.ResendCooldown)
+	return nil
+}
+
+func (s *MemoryVerificationCodeStore) Verify(ctx context.Context, purpose VerificationPurpose, target, code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	key := string(purpose) + ":" + target
+	entry, ok := s.entries[key]
+	if !ok {
+		return ErrVerificationCodeInvalid
+	}
+	if now.Before(entry.lockedUntil) {
+		return ErrVerificationLocked
+	}
+	if entry.code == "" || now.After(entry.expiresAt) {
+		return ErrVerificationCodeInvalid
+	}
+
+	if !codesEqual(entry.code, code) {
+		if entry.attempts == 0 || !now.Before(entry.attemptsResetAt) {
+			entry.attempts = 0
+			entry.attemptsResetAt = now.Add(s.policy.LockoutDuration)
+		}
+		entry.attempts++
+		if entry.attempts >= s.policy.MaxAttempts {
+			entry.code = ""
+			entry.attempts = 0
+			entry.lockedUntil = now.Add(s.policy.LockoutDuration)
+			return ErrVerificationLocked
+		}
+		return ErrVerificationCodeInvalid
+	}
+
+	// 성공 시 코드만 삭제하고 쿨다운은 유지
+	entry.code = ""
+	entry.attempts = 0
+	return nil
+}
+
+// prune 만료/잠금/쿨다운이 모두 끝난 항목 제거
+func (s *MemoryVerificationCodeStore) prune(now time.Time) {
+	for key, entry := range s.entries {
+		if now.After(entry.expiresAt) && now.After(entry.lockedUntil) && now.After(entry.cooldownUntil) && now.After(entry.attemptsResetAt) {
+			delete(s.entries, key)
+		}
+	}
+}
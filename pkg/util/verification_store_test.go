@@ -0,0 +1,109 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestVerificationStore() (*MemoryVerificationCodeStore, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryVerificationCodeStore(VerificationPolicy{
+		CodeTTL:         5 * time.Minute,
+		MaxAttempts:     3,
+		LockoutDuration: 15 * time.Minute,
+		ResendCooldown:  time.Minute,
+	})
+	store.now = func() time.Time { return now }
+	return store, &now
+}
+
+func TestMemoryVerificationCodeStore_VerifyOnce(t *testing.T) {
+	store, _ := newTestVerificationStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, VerificationPurposeEmail, "test@example.com", "123456"))
+
+	// 다른 용도로는 검증 불가
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "test@example.com", "123456"), ErrVerificationCodeInvalid)
+
+	assert.NoError(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "123456"))
+	// 성공한 코드는 재사용 불가
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "123456"), ErrVerificationCodeInvalid)
+}
+
+func TestMemoryVerificationCodeStore_Expired(t *testing.T) {
+	store, now := newTestVerificationStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, VerificationPurposeEmail, "test@example.com", "123456"))
+	*now = now.Add(6 * time.Minute)
+
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "123456"), ErrVerificationCodeInvalid)
+}
+
+func TestMemoryVerificationCodeStore_ResendCooldown(t *testing.T) {
+	store, now := newTestVerificationStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, VerificationPurposePhone, "01012345678", "111111"))
+	assert.ErrorIs(t, store.Save(ctx, VerificationPurposePhone, "01012345678", "222222"), ErrVerificationCooldown)
+
+	*now = now.Add(61 * time.Second)
+	require.NoError(t, store.Save(ctx, VerificationPurposePhone, "01012345678", "222222"))
+
+	// 재전송하면 이전 코드는 무효
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "111111"), ErrVerificationCodeInvalid)
+	assert.NoError(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "222222"))
+}
+
+func TestMemoryVerificationCodeStore_Lockout(t *testing.T) {
+	store, now := newTestVerificationStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, VerificationPurposeEmail, "test@example.com", "123456"))
+
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "000000"), ErrVerificationCodeInvalid)
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "000001"), ErrVerificationCodeInvalid)
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "000002"), ErrVerificationLocked)
+
+	// 잠금 중에는 올바른 코드도, 재전송도 거부
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "123456"), ErrVerificationLocked)
+	*now = now.Add(2 * time.Minute)
+	assert.ErrorIs(t, store.Save(ctx, VerificationPurposeEmail, "test@example.com", "654321"), ErrVerificationLocked)
+
+	// 잠금 해제 후 새 코드 발급 가능
+	*now = now.Add(15 * time.Minute)
+	require.NoError(t, store.Save(ctx, VerificationPurposeEmail, "test@example.com", "654321"))
+	assert.NoError(t, store.Verify(ctx, VerificationPurposeEmail, "test@example.com", "654321"))
+}
+
+func TestMemoryVerificationCodeStore_ResendKeepsAttempts(t *testing.T) {
+	store, now := newTestVerificationStore()
+	ctx := context.Background()
+
+	require.NoError(t, store.Save(ctx, VerificationPurposePhone, "01012345678", "111111"))
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "000000"), ErrVerificationCodeInvalid)
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "000001"), ErrVerificationCodeInvalid)
+
+	// 재전송해도 오답 횟수는 이어서 계산되어 잠김
+	*now = now.Add(61 * time.Second)
+	require.NoError(t, store.Save(ctx, VerificationPurposePhone, "01012345678", "222222"))
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "000002"), ErrVerificationLocked)
+
+	// 잠금 시간이 지나면 처음부터 다시 계산
+	*now = now.Add(15 * time.Minute)
+	require.NoError(t, store.Save(ctx, VerificationPurposePhone, "01012345678", "333333"))
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "000003"), ErrVerificationCodeInvalid)
+	assert.NoError(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "333333"))
+
+	// 검증에 성공하면 오답 횟수 초기화
+	*now = now.Add(61 * time.Second)
+	require.NoError(t, store.Save(ctx, VerificationPurposePhone, "01012345678", "444444"))
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "000004"), ErrVerificationCodeInvalid)
+	assert.ErrorIs(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "000005"), ErrVerificationCodeInvalid)
+	assert.NoError(t, store.Verify(ctx, VerificationPurposePhone, "01012345678", "444444"))
+}